@@ -1,12 +1,16 @@
 package main
 
 import (
-	"log"
+	"errors"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	_ "time/tzdata" // the runtime image has no zoneinfo; EMAIL_TIMEZONE needs it
 
 	"TinderTrip-Backend/internal/api"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
 	"TinderTrip-Backend/pkg/config"
 	"TinderTrip-Backend/pkg/database"
 )
@@ -35,26 +39,41 @@ import (
 func main() {
 	// Load configuration
 	config.LoadConfig()
+	utils.Info("Configuration loaded", config.AppConfig.Summary())
 
 	// Connect to databases
 	if err := database.ConnectPostgres(); err != nil {
-		log.Fatal("Failed to connect to PostgreSQL:", err)
+		utils.Fatal("Failed to connect to PostgreSQL", map[string]interface{}{"error": err.Error()})
 	}
 	defer database.ClosePostgres()
+	utils.Info("Connected to PostgreSQL", map[string]interface{}{
+		"host":     config.AppConfig.Database.Host,
+		"database": config.AppConfig.Database.Name,
+	})
+
+	version, dirty, err := database.MigrationVersion()
+	if err != nil {
+		utils.Warn("Could not read migration version", map[string]interface{}{"error": err.Error()})
+	} else if dirty {
+		utils.Warn("Database migration is dirty", map[string]interface{}{"version": version})
+	} else {
+		utils.Info("Database migration version", map[string]interface{}{"version": version})
+	}
 
 	if err := database.ConnectRedis(); err != nil {
-		log.Printf("Warning: Failed to connect to Redis: %v (continuing without Redis)", err)
+		utils.Warn("Failed to connect to Redis, continuing without Redis", map[string]interface{}{"error": err.Error()})
 	} else {
 		defer database.CloseRedis()
+		utils.Info("Connected to Redis", map[string]interface{}{"host": config.AppConfig.Redis.Host})
 	}
 
-	// Create and start server
+	// Create and start server; /ready reports ready once it is listening
 	srv := api.NewServer()
 
 	// Start server in a goroutine
 	go func() {
-		if err := srv.Start(); err != nil {
-			log.Fatal("Failed to start server:", err)
+		if err := srv.Start(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			utils.Fatal("Failed to start server", map[string]interface{}{"error": err.Error()})
 		}
 	}()
 
@@ -63,15 +82,18 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	log.Println("Shutting down server...")
+	utils.Info("Shutting down server")
 
 	// Stop background cleanup routines
 	srv.StopCleanup()
 
 	// Graceful shutdown
 	if err := srv.Shutdown(); err != nil {
-		log.Fatal("Server forced to shutdown:", err)
+		utils.Fatal("Server forced to shutdown", map[string]interface{}{"error": err.Error()})
 	}
 
-	log.Println("Server exited")
+	// Let notifications already being sent finish before the database is closed
+	service.WaitForBackgroundSends()
+
+	utils.Info("Server exited")
 }
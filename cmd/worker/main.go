@@ -34,6 +34,14 @@ func main() {
 	go workerService.StartEmailWorker()
 	go workerService.StartNotificationWorker()
 	go workerService.StartCleanupWorker()
+	go workerService.StartWebhookWorker()
+	go workerService.StartStorageCleanupWorker()
+	go workerService.StartStorageReconcileWorker()
+	go workerService.StartTopPicksWorker()
+	go workerService.StartMemberCountReconcileWorker()
+	go workerService.StartPendingMemberExpiryWorker()
+	go workerService.StartMinAttendeesWorker()
+	go workerService.StartReminderSnoozeWorker()
 
 	log.Println("Worker started successfully")
 
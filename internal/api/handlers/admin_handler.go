@@ -0,0 +1,240 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"TinderTrip-Backend/internal/api/middleware"
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminHandler handles admin-only operations
+type AdminHandler struct {
+	eventService        *service.EventService
+	reconcileService    *service.StorageReconcileService
+	notificationService *service.NotificationService
+}
+
+// NewAdminHandler creates a new admin handler
+func NewAdminHandler() *AdminHandler {
+	return &AdminHandler{
+		eventService:        service.NewEventService(),
+		reconcileService:    service.NewStorageReconcileService(),
+		notificationService: service.NewNotificationService(),
+	}
+}
+
+// CompleteEvent force-completes an event
+// @Summary Force complete event
+// @Description Complete an event regardless of its creator (admin only). Members are notified and the action is audit-logged with the admin's ID. Completed and cancelled events get 409.
+// @Tags admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param request body dto.AdminEventActionRequest false "Reason"
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 409 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /admin/events/{id}/complete [post]
+func (h *AdminHandler) CompleteEvent(c *gin.Context) {
+	eventID := c.Param("id")
+	adminID, _ := middleware.GetCurrentUserID(c)
+
+	var req dto.AdminEventActionRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			utils.ValidationErrorResponse(c, "Invalid request", err.Error())
+			return
+		}
+	}
+
+	err := h.eventService.AdminCompleteEvent(eventID, adminID, req.Reason)
+	if err != nil {
+		h.handleEventActionError(c, err, "Failed to complete event")
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Event completed by admin", nil)
+}
+
+// CancelEvent force-cancels an event
+// @Summary Force cancel event
+// @Description Cancel an event regardless of its creator (admin only). Members are notified and the action is audit-logged with the admin's ID.
+// @Tags admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param request body dto.AdminEventActionRequest false "Reason"
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 409 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /admin/events/{id}/cancel [post]
+func (h *AdminHandler) CancelEvent(c *gin.Context) {
+	eventID := c.Param("id")
+	adminID, _ := middleware.GetCurrentUserID(c)
+
+	var req dto.AdminEventActionRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			utils.ValidationErrorResponse(c, "Invalid request", err.Error())
+			return
+		}
+	}
+
+	err := h.eventService.AdminCancelEvent(eventID, adminID, req.Reason)
+	if err != nil {
+		h.handleEventActionError(c, err, "Failed to cancel event")
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Event cancelled by admin", nil)
+}
+
+// PurgeEvent permanently deletes an event
+// @Summary Purge event
+// @Description Permanently delete an event and its related rows (admin only). Cover and gallery images are removed from storage; failed deletes are retried in the background.
+// @Tags admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /admin/events/{id} [delete]
+func (h *AdminHandler) PurgeEvent(c *gin.Context) {
+	eventID := c.Param("id")
+	adminID, _ := middleware.GetCurrentUserID(c)
+
+	err := h.eventService.AdminPurgeEvent(eventID, adminID)
+	if err != nil {
+		h.handleEventActionError(c, err, "Failed to purge event")
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Event purged by admin", nil)
+}
+
+// GetOrphanedStorage reports stored objects that nothing references
+// @Summary Report orphaned storage
+// @Description Dry-run reconciliation of storage against the database (admin only). Nothing is deleted; the worker deletes orphans only when STORAGE_RECONCILE_DELETE is enabled.
+// @Tags admin
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} dto.StorageReconcileReport
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /admin/storage/orphans [get]
+func (h *AdminHandler) GetOrphanedStorage(c *gin.Context) {
+	report, err := h.reconcileService.Reconcile(true)
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to reconcile storage", err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Storage reconciliation report", report)
+}
+
+// GetConfig returns the config the running instance loaded
+// @Summary Get effective config
+// @Description Config loaded by the running instance, with every secret (database, Redis and SMTP passwords, JWT secret, OAuth and provider secrets, OTP pepper) redacted (admin only)
+// @Tags admin
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Router /admin/config [get]
+func (h *AdminHandler) GetConfig(c *gin.Context) {
+	utils.SuccessResponse(c, http.StatusOK, "Effective config", config.AppConfig.Redacted())
+}
+
+// GetUserNotifications lists a user's notifications with how each was delivered
+// @Summary Get a user's notification history
+// @Description A user's notifications, newest first, with the delivery status, the channels each reached and every push and email attempt with its error (admin only). For looking into reports of missed notifications.
+// @Tags admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "User ID"
+// @Param type query string false "Notification type from its data, e.g. event_reminder"
+// @Param from query string false "Only notifications created at or after this time (RFC3339)"
+// @Param to query string false "Only notifications created at or before this time (RFC3339)"
+// @Param page query int false "Page number"
+// @Param limit query int false "Items per page"
+// @Success 200 {object} utils.APIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /admin/users/{id}/notifications [get]
+func (h *AdminHandler) GetUserNotifications(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	page, limit = utils.ValidatePagination(utils.PaginationNotifications, page, limit)
+
+	var query service.NotificationHistoryQuery
+	if notificationType := c.Query("type"); notificationType != "" {
+		query.Type = &notificationType
+	}
+	if from := c.Query("from"); from != "" {
+		t, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			utils.BadRequestResponse(c, "invalid from time")
+			return
+		}
+		query.From = &t
+	}
+	if to := c.Query("to"); to != "" {
+		t, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			utils.BadRequestResponse(c, "invalid to time")
+			return
+		}
+		query.To = &t
+	}
+
+	notifications, total, err := h.notificationService.GetUserNotificationHistory(c.Param("id"), query, page, limit)
+	if err != nil {
+		switch err.Error() {
+		case "invalid user ID":
+			utils.BadRequestResponse(c, "Invalid user ID")
+		case "invalid time range":
+			utils.BadRequestResponse(c, err.Error())
+		case "user not found":
+			utils.NotFoundResponse(c, "The requested user does not exist")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to get notifications", err)
+		}
+		return
+	}
+
+	utils.PaginatedResponse(c, "Notifications retrieved successfully", notifications, total, page, limit)
+}
+
+// handleEventActionError maps admin event action errors to responses
+func (h *AdminHandler) handleEventActionError(c *gin.Context, err error, message string) {
+	switch err.Error() {
+	case "invalid event ID":
+		utils.BadRequestResponse(c, "Invalid event ID")
+	case "event not found":
+		utils.NotFoundResponse(c, "The requested event does not exist")
+	case "event already completed", "event already cancelled":
+		utils.ConflictResponse(c, err.Error())
+	default:
+		utils.InternalServerErrorResponse(c, message, err)
+	}
+}
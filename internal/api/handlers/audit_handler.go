@@ -1,9 +1,14 @@
 package handlers
 
 import (
+	"encoding/json"
+	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
 	"TinderTrip-Backend/internal/utils"
 	"TinderTrip-Backend/pkg/audit"
 
@@ -31,57 +36,115 @@ func NewAuditHandler() *AuditHandler {
 // @Param user_id query string false "Filter by user ID"
 // @Param entity_table query string false "Filter by entity table"
 // @Param action query string false "Filter by action"
+// @Param from query string false "Only logs created at or after this time (RFC3339)"
+// @Param to query string false "Only logs created at or before this time (RFC3339)"
+// @Param before_contains query string false "JSON object the before data must contain, e.g. {\"status\":\"published\"}"
+// @Param after_contains query string false "JSON object the after data must contain"
+// @Param changed query string false "Comma separated keys that differ between before and after data"
 // @Param page query int false "Page number" default(1)
-// @Param limit query int false "Items per page" default(10)
+// @Param limit query int false "Items per page"
+// @Param cursor query string false "Cursor from a previous next_cursor; send empty to start cursor pagination"
 // @Success 200 {object} dto.AuditLogListResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /audit/logs [get]
 func (h *AuditHandler) GetAuditLogs(c *gin.Context) {
-	// Get query parameters
-	userID := c.Query("user_id")
-	entityTable := c.Query("entity_table")
-	action := c.Query("action")
-
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
 
 	// Validate pagination
-	if page < 1 {
-		page = 1
-	}
-	if limit < 1 || limit > 100 {
-		limit = 10
-	}
+	page, limit = utils.ValidatePagination(utils.PaginationAudit, page, limit)
 
-	// Prepare filter parameters
-	var userIDPtr, entityTablePtr, actionPtr *string
-	if userID != "" {
-		userIDPtr = &userID
-	}
-	if entityTable != "" {
-		entityTablePtr = &entityTable
+	// Build log query from filters
+	query, err := parseLogQuery(c)
+	if err != nil {
+		utils.SendBadRequestResponse(c, err.Error())
+		return
 	}
-	if action != "" {
-		actionPtr = &action
+
+	// Cursor pagination avoids large offsets on big tables
+	if rawCursor, ok := c.GetQuery("cursor"); ok {
+		cursor, err := utils.DecodeCursor(rawCursor)
+		if err != nil {
+			utils.SendBadRequestResponse(c, "Invalid cursor")
+			return
+		}
+
+		logs, nextCursor, err := h.auditLogger.QueryLogsByCursor(query, cursor, limit)
+		if err != nil {
+			utils.SendInternalServerErrorResponse(c, "Failed to get audit logs", err)
+			return
+		}
+
+		utils.CursorResponse(c, "Data retrieved successfully", convertAuditLogs(logs), limit, nextCursor)
+		return
 	}
 
 	// Get audit logs
-	logs, total, err := h.auditLogger.GetAuditLogs(userIDPtr, entityTablePtr, actionPtr, page, limit)
+	logs, total, err := h.auditLogger.QueryLogs(query, page, limit)
 	if err != nil {
 		utils.SendInternalServerErrorResponse(c, "Failed to get audit logs", err)
 		return
 	}
 
-	// Convert to response format
-	response := dto.AuditLogListResponse{
-		Logs:       make([]dto.AuditLogResponse, len(logs)),
-		Total:      total,
-		Page:       page,
-		Limit:      limit,
-		TotalPages: int((total + int64(limit) - 1) / int64(limit)),
+	utils.SendPaginatedResponse(c, convertAuditLogs(logs), total, page, limit)
+}
+
+// parseLogQuery reads audit log filters from query parameters
+func parseLogQuery(c *gin.Context) (audit.LogQuery, error) {
+	var query audit.LogQuery
+
+	if userID := c.Query("user_id"); userID != "" {
+		query.UserID = &userID
+	}
+	if entityTable := c.Query("entity_table"); entityTable != "" {
+		query.EntityTable = &entityTable
+	}
+	if action := c.Query("action"); action != "" {
+		query.Action = &action
+	}
+
+	// Time range
+	if from := c.Query("from"); from != "" {
+		t, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return query, fmt.Errorf("invalid from time")
+		}
+		query.From = &t
+	}
+	if to := c.Query("to"); to != "" {
+		t, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return query, fmt.Errorf("invalid to time")
+		}
+		query.To = &t
+	}
+
+	// JSON content filters
+	if raw := c.Query("before_contains"); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &query.BeforeContains); err != nil {
+			return query, fmt.Errorf("before_contains must be a JSON object")
+		}
+	}
+	if raw := c.Query("after_contains"); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &query.AfterContains); err != nil {
+			return query, fmt.Errorf("after_contains must be a JSON object")
+		}
+	}
+	if raw := c.Query("changed"); raw != "" {
+		for _, key := range strings.Split(raw, ",") {
+			if key = strings.TrimSpace(key); key != "" {
+				query.Changed = append(query.Changed, key)
+			}
+		}
 	}
 
+	return query, query.Validate()
+}
+
+// convertAuditLogs converts audit log models to response format
+func convertAuditLogs(logs []models.AuditLog) []dto.AuditLogResponse {
+	responses := make([]dto.AuditLogResponse, len(logs))
 	for i, log := range logs {
 		var actorUserID, entityID *string
 		if log.ActorUserID != nil {
@@ -93,7 +156,7 @@ func (h *AuditHandler) GetAuditLogs(c *gin.Context) {
 			entityID = &entityIDStr
 		}
 
-		response.Logs[i] = dto.AuditLogResponse{
+		responses[i] = dto.AuditLogResponse{
 			ID:          log.ID.String(),
 			ActorUserID: actorUserID,
 			EntityTable: log.EntityTable,
@@ -105,7 +168,7 @@ func (h *AuditHandler) GetAuditLogs(c *gin.Context) {
 		}
 	}
 
-	utils.SendPaginatedResponse(c, response.Logs, total, page, limit)
+	return responses
 }
 
 // GetEntityAuditHistory gets audit history for a specific entity
@@ -117,7 +180,7 @@ func (h *AuditHandler) GetAuditLogs(c *gin.Context) {
 // @Param entity_table path string true "Entity table name"
 // @Param entity_id path string true "Entity ID"
 // @Param page query int false "Page number" default(1)
-// @Param limit query int false "Items per page" default(10)
+// @Param limit query int false "Items per page"
 // @Success 200 {object} dto.AuditLogListResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
@@ -127,15 +190,10 @@ func (h *AuditHandler) GetEntityAuditHistory(c *gin.Context) {
 	entityID := c.Param("entity_id")
 
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
 
 	// Validate pagination
-	if page < 1 {
-		page = 1
-	}
-	if limit < 1 || limit > 100 {
-		limit = 10
-	}
+	page, limit = utils.ValidatePagination(utils.PaginationAudit, page, limit)
 
 	// Get entity audit history
 	logs, total, err := h.auditLogger.GetEntityAuditHistory(entityTable, entityID, page, limit)
@@ -144,37 +202,5 @@ func (h *AuditHandler) GetEntityAuditHistory(c *gin.Context) {
 		return
 	}
 
-	// Convert to response format
-	response := dto.AuditLogListResponse{
-		Logs:       make([]dto.AuditLogResponse, len(logs)),
-		Total:      total,
-		Page:       page,
-		Limit:      limit,
-		TotalPages: int((total + int64(limit) - 1) / int64(limit)),
-	}
-
-	for i, log := range logs {
-		var actorUserID, entityID *string
-		if log.ActorUserID != nil {
-			actorUserIDStr := log.ActorUserID.String()
-			actorUserID = &actorUserIDStr
-		}
-		if log.EntityID != nil {
-			entityIDStr := log.EntityID.String()
-			entityID = &entityIDStr
-		}
-
-		response.Logs[i] = dto.AuditLogResponse{
-			ID:          log.ID.String(),
-			ActorUserID: actorUserID,
-			EntityTable: log.EntityTable,
-			EntityID:    entityID,
-			Action:      log.Action,
-			BeforeData:  log.BeforeData,
-			AfterData:   log.AfterData,
-			CreatedAt:   log.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		}
-	}
-
-	utils.SendPaginatedResponse(c, response.Logs, total, page, limit)
+	utils.SendPaginatedResponse(c, convertAuditLogs(logs), total, page, limit)
 }
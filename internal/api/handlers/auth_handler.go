@@ -3,6 +3,7 @@ package handlers
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	"TinderTrip-Backend/internal/api/middleware"
@@ -12,6 +13,7 @@ import (
 	"TinderTrip-Backend/internal/utils"
 	"TinderTrip-Backend/pkg/config"
 	"TinderTrip-Backend/pkg/database"
+	"TinderTrip-Backend/pkg/email"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -21,7 +23,6 @@ import (
 type AuthHandler struct {
 	authService        *service.AuthService
 	googleOAuthService *service.GoogleOAuthService
-	emailService       *service.EmailService
 }
 
 // NewAuthHandler creates a new auth handler
@@ -29,10 +30,26 @@ func NewAuthHandler() *AuthHandler {
 	return &AuthHandler{
 		authService:        service.NewAuthService(),
 		googleOAuthService: service.NewGoogleOAuthService(),
-		emailService:       service.NewEmailService(),
 	}
 }
 
+// NewAuthHandlerWithEmailClient creates an auth handler whose OTP emails go through the given
+// client (for testing purposes)
+func NewAuthHandlerWithEmailClient(client *email.SMTPClient) *AuthHandler {
+	return &AuthHandler{
+		authService:        service.NewAuthServiceWithEmailClient(client, utils.RealClock{}),
+		googleOAuthService: service.NewGoogleOAuthService(),
+	}
+}
+
+// NewAuthHandlerWithGoogleOAuth creates an auth handler that signs in through the given Google
+// OAuth service (for testing purposes)
+func NewAuthHandlerWithGoogleOAuth(googleOAuthService *service.GoogleOAuthService) *AuthHandler {
+	h := NewAuthHandler()
+	h.googleOAuthService = googleOAuthService
+	return h
+}
+
 // StopCleanup stops background cleanup routines
 func (h *AuthHandler) StopCleanup() {
 	if h.authService != nil {
@@ -47,7 +64,8 @@ func (h *AuthHandler) StopCleanup() {
 // @Accept json
 // @Produce json
 // @Param request body dto.RegisterRequest true "Registration data"
-// @Success 200 {object} dto.SuccessMessageWrapper
+// @Param X-Captcha-Token header string false "CAPTCHA token, required when CAPTCHA_PROVIDER is set"
+// @Success 200 {object} dto.RegisterResponseWrapper
 // @Failure 400 {object} dto.ErrorAPIResponse
 // @Failure 409 {object} dto.ErrorAPIResponse
 // @Failure 500 {object} dto.ErrorAPIResponse
@@ -60,7 +78,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 	}
 
 	// Send email verification OTP
-	err := h.authService.SendEmailVerificationOTP(req.Email, req.DisplayName)
+	cancelToken, err := h.authService.SendEmailVerificationOTP(req.Email, req.DisplayName)
 	if err != nil {
 		// Check if error is due to user already exists
 		if err.Error() == "user already exists" {
@@ -76,7 +94,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 		return
 	}
 
-	utils.SendSuccessResponse(c, "Verification OTP sent to your email", nil)
+	utils.SendSuccessResponse(c, "Verification OTP sent to your email", dto.RegisterResponse{CancelToken: cancelToken})
 }
 
 // Login handles user login
@@ -111,6 +129,11 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		utils.InternalServerErrorResponse(c, "Failed to generate authentication token", err)
 		return
 	}
+	refreshToken, err := h.authService.IssueRefreshToken(user.ID)
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to generate authentication token", err)
+		return
+	}
 
 	// Update last login
 	now := time.Now()
@@ -119,15 +142,17 @@ func (h *AuthHandler) Login(c *gin.Context) {
 
 	// Create custom response with token and user at top level
 	c.JSON(http.StatusOK, dto.AuthResponseWrapper{
-		Success:   true,
-		RequestID: utils.GetRequestID(c),
-		Timestamp: time.Now().UTC().Format(time.RFC3339),
-		Message:   "Login successful",
-		Token:     token,
+		Success:      true,
+		RequestID:    utils.GetRequestID(c),
+		Timestamp:    time.Now().UTC().Format(time.RFC3339),
+		Message:      "Login successful",
+		Token:        token,
+		RefreshToken: refreshToken,
 		User: dto.UserResponse{
 			ID:            user.ID.String(),
 			Email:         *user.Email,
 			DisplayName:   user.GetDisplayName(),
+			Handle:        user.GetHandle(),
 			Provider:      string(user.Provider),
 			EmailVerified: user.EmailVerified,
 			CreatedAt:     user.CreatedAt,
@@ -235,7 +260,7 @@ func (h *AuthHandler) GoogleCallback(c *gin.Context) {
 	}
 
 	// Create or update user
-	user, isNewUser, err := h.googleOAuthService.CreateOrUpdateUser(ctx, userInfo)
+	user, _, err := h.googleOAuthService.CreateOrUpdateUser(ctx, userInfo)
 	if err != nil {
 		redirectToError("user_creation_failed", "Failed to create or update user account")
 		return
@@ -247,44 +272,39 @@ func (h *AuthHandler) GoogleCallback(c *gin.Context) {
 		redirectToError("token_generation_failed", "Failed to generate authentication token")
 		return
 	}
+	refreshToken, err := h.authService.IssueRefreshToken(user.ID)
+	if err != nil {
+		redirectToError("token_generation_failed", "Failed to generate authentication token")
+		return
+	}
 
 	// Clean up state
 	database.DeleteCache(ctx, "oauth_state:"+state)
 
-	// Send welcome email only for new Google OAuth users
-	if isNewUser {
-		go func() {
-			if err := h.emailService.SendWelcomeEmail(userInfo.Email, userInfo.Name); err != nil {
-				utils.Logger().WithFields(map[string]interface{}{
-					"error":   err,
-					"user_id": user.ID.String(),
-					"email":   userInfo.Email,
-				}).Error("Failed to send welcome email for Google OAuth user")
-			}
-		}()
-	}
-
-	// Redirect to frontend with token (success)
+	// Redirect to frontend with token (success). The refresh token goes in the fragment, which
+	// browsers never send to a server, so it stays out of access logs and Referer headers.
 	frontendURL := config.AppConfig.Server.FrontendURL
-	redirectURL := fmt.Sprintf("%s/callback?token=%s&user_id=%s&email=%s&display_name=%s&provider=%s&is_verified=%t",
+	redirectURL := fmt.Sprintf("%s/callback?token=%s&user_id=%s&email=%s&display_name=%s&provider=%s&is_verified=%t#refresh_token=%s",
 		frontendURL,
 		jwtToken,
 		user.ID.String(),
 		userInfo.Email,
 		userInfo.Name,
 		string(user.Provider),
-		user.EmailVerified)
+		user.EmailVerified,
+		url.QueryEscape(refreshToken))
 
 	c.Redirect(http.StatusFound, redirectURL)
 }
 
 // ForgotPassword handles password reset request
 // @Summary Request password reset
-// @Description Send password reset email
+// @Description Send password reset OTP by email, or by SMS when the user has a verified phone
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Param request body dto.ForgotPasswordRequest true "Email address"
+// @Param X-Captcha-Token header string false "CAPTCHA token, required when CAPTCHA_PROVIDER is set"
 // @Success 200 {object} dto.SuccessMessageWrapper
 // @Failure 400 {object} dto.ErrorAPIResponse
 // @Failure 500 {object} dto.ErrorAPIResponse
@@ -297,7 +317,7 @@ func (h *AuthHandler) ForgotPassword(c *gin.Context) {
 	}
 
 	// Send password reset OTP
-	err := h.authService.SendPasswordResetOTP(req.Email)
+	err := h.authService.SendPasswordResetOTPVia(req.Email, req.Channel)
 	if err != nil {
 		// Don't reveal if email exists or not (security best practice)
 		utils.SendSuccessResponse(c, "If the email exists, a password reset OTP has been sent", nil)
@@ -316,6 +336,7 @@ func (h *AuthHandler) ForgotPassword(c *gin.Context) {
 // @Param request body dto.ResetPasswordRequest true "Reset password data with OTP"
 // @Success 200 {object} dto.SuccessMessageWrapper
 // @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 429 {object} dto.ErrorAPIResponse
 // @Failure 500 {object} dto.ErrorAPIResponse
 // @Router /auth/reset-password [post]
 func (h *AuthHandler) ResetPassword(c *gin.Context) {
@@ -328,6 +349,10 @@ func (h *AuthHandler) ResetPassword(c *gin.Context) {
 	// Reset password with OTP
 	err := h.authService.ResetPassword(req.Email, req.OTP, req.Password)
 	if err != nil {
+		if err.Error() == "too many attempts" {
+			utils.TooManyRequestsResponse(c, "Too many attempts, please request a new code")
+			return
+		}
 		utils.BadRequestResponse(c, err.Error())
 		return
 	}
@@ -344,6 +369,7 @@ func (h *AuthHandler) ResetPassword(c *gin.Context) {
 // @Param request body dto.VerifyOTPRequest true "OTP verification data"
 // @Success 200 {object} dto.SuccessMessageWrapper
 // @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 429 {object} dto.ErrorAPIResponse
 // @Failure 500 {object} dto.ErrorAPIResponse
 // @Router /auth/verify-otp [post]
 func (h *AuthHandler) VerifyOTP(c *gin.Context) {
@@ -356,6 +382,10 @@ func (h *AuthHandler) VerifyOTP(c *gin.Context) {
 	// Verify OTP
 	err := h.authService.VerifyOTP(req.Email, req.OTP)
 	if err != nil {
+		if err.Error() == "too many attempts" {
+			utils.TooManyRequestsResponse(c, "Too many attempts, please request a new code")
+			return
+		}
 		utils.BadRequestResponse(c, err.Error())
 		return
 	}
@@ -372,6 +402,8 @@ func (h *AuthHandler) VerifyOTP(c *gin.Context) {
 // @Param request body dto.RegisterWithOTPRequest true "Email verification data"
 // @Success 201 {object} dto.AuthResponseWrapper
 // @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 409 {object} dto.ErrorAPIResponse
+// @Failure 429 {object} dto.ErrorAPIResponse
 // @Failure 500 {object} dto.ErrorAPIResponse
 // @Router /auth/verify-email [post]
 func (h *AuthHandler) VerifyEmail(c *gin.Context) {
@@ -384,6 +416,14 @@ func (h *AuthHandler) VerifyEmail(c *gin.Context) {
 	// Verify OTP and create user
 	user, err := h.authService.VerifyEmailOTP(req.Email, req.OTP, req.Password, req.DisplayName)
 	if err != nil {
+		if err.Error() == "too many attempts" {
+			utils.TooManyRequestsResponse(c, "Too many attempts, please request a new code")
+			return
+		}
+		if err.Error() == "display name already taken" {
+			utils.ConflictResponse(c, "Display name already taken. Please choose a different name.")
+			return
+		}
 		utils.BadRequestResponse(c, err.Error())
 		return
 	}
@@ -394,29 +434,25 @@ func (h *AuthHandler) VerifyEmail(c *gin.Context) {
 		utils.InternalServerErrorResponse(c, "Failed to generate authentication token", err)
 		return
 	}
-
-	// Send welcome email
-	go func() {
-		if err := h.emailService.SendWelcomeEmail(*user.Email, user.GetDisplayName()); err != nil {
-			utils.Logger().WithFields(map[string]interface{}{
-				"error":   err,
-				"user_id": user.ID.String(),
-				"email":   *user.Email,
-			}).Error("Failed to send welcome email")
-		}
-	}()
+	refreshToken, err := h.authService.IssueRefreshToken(user.ID)
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to generate authentication token", err)
+		return
+	}
 
 	// Create custom response with token and user at top level
 	c.JSON(http.StatusCreated, dto.AuthResponseWrapper{
-		Success:   true,
-		RequestID: utils.GetRequestID(c),
-		Timestamp: time.Now().UTC().Format(time.RFC3339),
-		Message:   "Registration successful",
-		Token:     token,
+		Success:      true,
+		RequestID:    utils.GetRequestID(c),
+		Timestamp:    time.Now().UTC().Format(time.RFC3339),
+		Message:      "Registration successful",
+		Token:        token,
+		RefreshToken: refreshToken,
 		User: dto.UserResponse{
 			ID:            user.ID.String(),
 			Email:         *user.Email,
 			DisplayName:   user.GetDisplayName(),
+			Handle:        user.GetHandle(),
 			Provider:      string(user.Provider),
 			EmailVerified: user.EmailVerified,
 			CreatedAt:     user.CreatedAt,
@@ -431,6 +467,7 @@ func (h *AuthHandler) VerifyEmail(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param request body dto.ResendVerificationRequest true "Email address"
+// @Param X-Captcha-Token header string false "CAPTCHA token, required when CAPTCHA_PROVIDER is set"
 // @Success 200 {object} dto.SuccessMessageWrapper
 // @Failure 400 {object} dto.ErrorAPIResponse
 // @Failure 409 {object} dto.ErrorAPIResponse
@@ -440,8 +477,9 @@ func (h *AuthHandler) ResendVerification(c *gin.Context) {
 	var req dto.ResendVerificationRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid request",
-			Message: err.Error(),
+			Error:     "Invalid request",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -461,57 +499,225 @@ func (h *AuthHandler) ResendVerification(c *gin.Context) {
 	utils.SendSuccessResponse(c, "Verification OTP resent to your email. Please check your inbox.", nil)
 }
 
+// CancelRegistration handles cancelling a pending registration
+// @Summary Cancel pending registration
+// @Description Clear the pending email verification for an email, e.g. after registering with a mistyped address. Requires the cancel_token returned by /auth/register. The response is the same whether or not a registration was pending or the token matched.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.CancelRegistrationRequest true "Email address and cancel token"
+// @Param X-Captcha-Token header string false "CAPTCHA token, required when CAPTCHA_PROVIDER is set"
+// @Success 200 {object} dto.SuccessMessageWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /auth/cancel-registration [post]
+func (h *AuthHandler) CancelRegistration(c *gin.Context) {
+	var req dto.CancelRegistrationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:     "Invalid request",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
+		})
+		return
+	}
+
+	if err := h.authService.CancelEmailVerification(req.Email, req.CancelToken); err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to cancel registration", err)
+		return
+	}
+
+	// Don't reveal whether a registration was pending
+	utils.SendSuccessResponse(c, "If a registration was pending for this email, it has been cancelled.", nil)
+}
+
 // Logout handles user logout
 // @Summary Logout user
-// @Description Logout user and invalidate token
+// @Description Logout user and invalidate token. The token is rejected from then on until it would have expired. Send the refresh token from the same login to revoke it and every token rotated from it.
 // @Tags auth
 // @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.LogoutRequest false "Refresh token of this session"
 // @Success 200 {object} dto.SuccessMessageWrapper
 // @Failure 401 {object} dto.ErrorAPIResponse
 // @Router /auth/logout [post]
 func (h *AuthHandler) Logout(c *gin.Context) {
-	// In a real implementation, you might want to blacklist the token
-	// For now, we'll just return success
+	token, err := utils.ExtractTokenFromHeader(c.GetHeader("Authorization"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusUnauthorized, utils.ErrCodeInvalidToken, err.Error(), nil)
+		return
+	}
+	claims, err := utils.ValidateToken(token)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusUnauthorized, utils.ErrCodeInvalidToken, err.Error(), nil)
+		return
+	}
+
+	// The body is optional; older clients log out without one
+	var req dto.LogoutRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			utils.ValidationErrorResponse(c, "Invalid request format", err.Error())
+			return
+		}
+	}
+
+	// Revoke the login's refresh tokens first, so a failure leaves the client able to retry
+	if req.RefreshToken != "" {
+		userID, err := uuid.Parse(claims.UserID)
+		if err != nil {
+			utils.ErrorResponse(c, http.StatusUnauthorized, utils.ErrCodeInvalidToken, "invalid token", nil)
+			return
+		}
+		if err := h.authService.RevokeRefreshToken(userID, req.RefreshToken); err != nil {
+			utils.InternalServerErrorResponse(c, "Failed to log out", err)
+			return
+		}
+	}
+
+	revokeAccessToken(c, claims)
+
 	utils.SendSuccessResponse(c, "Logged out successfully", nil)
 }
 
+// revokeAccessToken blacklists the access token for the rest of its lifetime.
+// Tokens issued before tokens had an ID expire on their own.
+func revokeAccessToken(c *gin.Context, claims *utils.JWTClaims) {
+	if claims.ID == "" {
+		return
+	}
+	remaining := time.Until(time.Unix(claims.ExpiresAt, 0))
+	if err := database.BlacklistToken(c.Request.Context(), claims.ID, remaining); err != nil {
+		// The client discards the token anyway; it stays usable until it expires
+		utils.Logger().WithFields(map[string]interface{}{
+			"error":   err,
+			"user_id": claims.UserID,
+		}).Warn("Failed to blacklist access token")
+	}
+}
+
+// ChangePassword handles a signed-in user changing their password
+// @Summary Change password
+// @Description Change the password of a password account, given the current password. Every refresh token the user holds is revoked; the response carries a new access token and refresh token for this session.
+// @Tags auth
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.ChangePasswordRequest true "Current and new password"
+// @Success 200 {object} dto.TokenResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /auth/change-password [post]
+func (h *AuthHandler) ChangePassword(c *gin.Context) {
+	var req dto.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationErrorResponse(c, "Invalid request format", err.Error())
+		return
+	}
+
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	err := h.authService.ChangePassword(userID, req.CurrentPassword, req.NewPassword)
+	if err != nil {
+		switch err.Error() {
+		case "current password is incorrect":
+			utils.ErrorResponse(c, http.StatusUnauthorized, utils.ErrCodeAuthenticationFailed, "Current password is incorrect", nil)
+		case "this account uses Google login and has no password":
+			utils.ForbiddenResponse(c, "This account uses Google login and has no password to change")
+		case "new password must be different from the current password":
+			utils.BadRequestResponse(c, err.Error())
+		case "invalid user ID", "user not found":
+			utils.UnauthorizedResponse(c, "User not authenticated")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to change password", err)
+		}
+		return
+	}
+
+	// Keep this session signed in; the others end when their access tokens expire
+	user, err := h.authService.GetUserByID(userID)
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to change password", err)
+		return
+	}
+	token, err := utils.GenerateToken(user.ID.String(), *user.Email, string(user.Provider))
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to generate authentication token", err)
+		return
+	}
+	refreshToken, err := h.authService.IssueRefreshToken(user.ID)
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to generate authentication token", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.TokenResponseWrapper{
+		Success:      true,
+		RequestID:    utils.GetRequestID(c),
+		Timestamp:    time.Now().UTC().Format(time.RFC3339),
+		Message:      "Password changed successfully",
+		Token:        token,
+		RefreshToken: refreshToken,
+	})
+}
+
 // RefreshToken handles token refresh
 // @Summary Refresh JWT token
-// @Description Refresh JWT token
+// @Description Exchange a refresh token for a new access token and a new refresh token. The refresh token sent is revoked; sending it again revokes every token issued from the same login.
 // @Tags auth
-// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.RefreshTokenRequest true "Refresh token"
 // @Success 200 {object} dto.TokenResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
 // @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
 // @Router /auth/refresh [post]
 func (h *AuthHandler) RefreshToken(c *gin.Context) {
-	// Get current token from header
-	authHeader := c.GetHeader("Authorization")
-	if authHeader == "" {
-		utils.UnauthorizedResponse(c, "Please provide a valid token")
+	var req dto.RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationErrorResponse(c, "Invalid request format", err.Error())
 		return
 	}
 
-	// Extract token
-	token, err := utils.ExtractTokenFromHeader(authHeader)
+	user, refreshToken, err := h.authService.RotateRefreshToken(req.RefreshToken)
 	if err != nil {
-		utils.ErrorResponse(c, http.StatusUnauthorized, utils.ErrCodeInvalidToken, err.Error(), nil)
+		switch err.Error() {
+		case "invalid or expired refresh token":
+			utils.ErrorResponse(c, http.StatusUnauthorized, utils.ErrCodeExpiredToken, "Refresh token is invalid or expired", nil)
+		case "refresh token reused":
+			utils.ErrorResponse(c, http.StatusUnauthorized, utils.ErrCodeInvalidToken, "Refresh token was already used, please log in again", nil)
+		default:
+			utils.InternalServerErrorResponse(c, "Token refresh failed", err)
+		}
 		return
 	}
 
-	// Refresh token
-	newToken, err := utils.RefreshToken(token)
+	email := ""
+	if user.Email != nil {
+		email = *user.Email
+	}
+	token, err := utils.GenerateToken(user.ID.String(), email, string(user.Provider))
 	if err != nil {
-		utils.ErrorResponse(c, http.StatusUnauthorized, utils.ErrCodeExpiredToken, "Token refresh failed", err)
+		utils.InternalServerErrorResponse(c, "Failed to generate authentication token", err)
 		return
 	}
 
 	// Create custom response with token at top level
 	c.JSON(http.StatusOK, dto.TokenResponseWrapper{
-		Success:   true,
-		RequestID: utils.GetRequestID(c),
-		Timestamp: time.Now().UTC().Format(time.RFC3339),
-		Message:   "Token refreshed successfully",
-		Token:     newToken,
+		Success:      true,
+		RequestID:    utils.GetRequestID(c),
+		Timestamp:    time.Now().UTC().Format(time.RFC3339),
+		Message:      "Token refreshed successfully",
+		Token:        token,
+		RefreshToken: refreshToken,
 	})
 }
 
@@ -538,8 +744,9 @@ func (h *AuthHandler) Check(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "Authentication token is required",
+			Error:     "Unauthorized",
+			Message:   "Authentication token is required",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -40,8 +40,9 @@ func (h *ChatHandler) GetRooms(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -50,8 +51,9 @@ func (h *ChatHandler) GetRooms(c *gin.Context) {
 	rooms, err := h.chatService.GetChatRooms(userID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to get chat rooms",
-			Message: err.Error(),
+			Error:     "Failed to get chat rooms",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -73,29 +75,31 @@ func (h *ChatHandler) GetRooms(c *gin.Context) {
 // @Success 200 {object} dto.ChatMessageListResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 401 {object} dto.ErrorResponse
-// @Failure 403 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /chat/rooms/{id}/messages [get]
 func (h *ChatHandler) GetMessages(c *gin.Context) {
 	roomID := c.Param("id")
 	if roomID == "" {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid room ID",
-			Message: "Room ID is required",
+			Error:     "Invalid room ID",
+			Message:   "Room ID is required",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
 
 	// Get query parameters
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	page, limit = utils.ValidatePagination(utils.PaginationChat, page, limit)
 
 	// Get user ID from context
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -103,24 +107,20 @@ func (h *ChatHandler) GetMessages(c *gin.Context) {
 	// Get messages
 	messages, total, err := h.chatService.GetMessages(roomID, userID, page, limit)
 	if err != nil {
-		if err.Error() == "room not found" {
+		// Rooms the user cannot access look the same as missing ones
+		if err.Error() == "room not found" || err.Error() == "unauthorized" {
 			c.JSON(http.StatusNotFound, dto.ErrorResponse{
-				Error:   "Room not found",
-				Message: err.Error(),
-			})
-			return
-		}
-		if err.Error() == "unauthorized" {
-			c.JSON(http.StatusForbidden, dto.ErrorResponse{
-				Error:   "Unauthorized",
-				Message: "You don't have access to this room",
+				Error:     "Room not found",
+				Message:   "room not found",
+				RequestID: c.GetString(utils.RequestIDKey),
 			})
 			return
 		}
 
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to get messages",
-			Message: err.Error(),
+			Error:     "Failed to get messages",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -149,7 +149,6 @@ func (h *ChatHandler) GetMessages(c *gin.Context) {
 // @Success 201 {object} dto.ChatMessageResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 401 {object} dto.ErrorResponse
-// @Failure 403 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /chat/rooms/{id}/messages [post]
 func (h *ChatHandler) SendMessage(c *gin.Context) {
@@ -280,14 +279,11 @@ func (h *ChatHandler) SendMessage(c *gin.Context) {
 	}
 
 	if err != nil {
-		if err.Error() == "room not found" {
+		// Rooms the user cannot access look the same as missing ones
+		if err.Error() == "room not found" || err.Error() == "unauthorized" {
 			utils.NotFoundResponse(c, "Room not found")
 			return
 		}
-		if err.Error() == "unauthorized" {
-			utils.ForbiddenResponse(c, "You don't have access to this room")
-			return
-		}
 		if strings.Contains(err.Error(), "required for") {
 			utils.BadRequestResponse(c, err.Error())
 			return
@@ -2,28 +2,42 @@ package handlers
 
 import (
 	"fmt"
+	"mime/multipart"
 	"net/http"
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"TinderTrip-Backend/internal/api/middleware"
 	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
 	"TinderTrip-Backend/internal/service"
 	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
 
 	"github.com/gin-gonic/gin"
 )
 
 // EventHandler handles event-related requests
 type EventHandler struct {
-	eventService *service.EventService
+	eventService       *service.EventService
+	reliabilityService *service.ReliabilityService
+	checkinService     *service.CheckinService
+	topPicksService    *service.TopPicksService
+	itemService        *service.EventItemService
+	guestService       *service.GuestService
 }
 
 // NewEventHandler creates a new event handler
 func NewEventHandler() *EventHandler {
 	return &EventHandler{
-		eventService: service.NewEventService(),
+		eventService:       service.NewEventService(),
+		reliabilityService: service.NewReliabilityService(),
+		checkinService:     service.NewCheckinService(),
+		topPicksService:    service.NewTopPicksService(),
+		itemService:        service.NewEventItemService(),
+		guestService:       service.NewGuestService(),
 	}
 }
 
@@ -37,7 +51,10 @@ func NewEventHandler() *EventHandler {
 // @Param limit query int false "Items per page"
 // @Param event_type query string false "Event type filter"
 // @Param status query string false "Event status filter"
+// @Param q query string false "Case-insensitive search in title and description (sorts by created date)"
 // @Param sort query string false "Sort order: 'relevance' (default, by match score) or 'created' (chronological, newest first)"
+// @Param include_members query bool false "Include full member lists (default true). When false, only the viewer's membership status and swipe are returned (sort=created only)"
+// @Param fields query string false "Comma separated fields or groups (summary, details, location, budget, creator, photos, categories, tags, interests, items, members, viewer)"
 // @Success 200 {object} dto.EventListResponseWrapper
 // @Failure 401 {object} dto.ErrorAPIResponse
 // @Failure 500 {object} dto.ErrorAPIResponse
@@ -45,28 +62,45 @@ func NewEventHandler() *EventHandler {
 func (h *EventHandler) GetEvents(c *gin.Context) {
 	// Get query parameters
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
 	eventType := c.Query("event_type")
 	status := c.Query("status")
+	search := strings.TrimSpace(c.Query("q"))
 	sort := c.DefaultQuery("sort", "relevance") // Default: sort by relevance (match score)
 
+	if utf8.RuneCountInString(search) > service.MaxEventSearchLength {
+		utils.BadRequestResponse(c, fmt.Sprintf("q must be at most %d characters", service.MaxEventSearchLength))
+		return
+	}
+
 	// Validate pagination
-	page, limit = utils.ValidatePagination(page, limit)
+	page, limit = utils.ValidatePagination(utils.PaginationEvents, page, limit)
+
+	// Parse sparse fieldset
+	fields, err := dto.ParseEventFields(c.Query("fields"))
+	if err != nil {
+		utils.BadRequestResponse(c, err.Error())
+		return
+	}
 
 	// Get user ID from context
 	userID, _ := middleware.GetCurrentUserID(c)
 
 	// Default: Use suggestion algorithm (sorted by match score)
-	// If sort=created or user not logged in, use chronological sorting
-	if sort == "created" || userID == "" {
+	// If sort=created, searching, or user not logged in, use chronological sorting
+	if sort == "created" || search != "" || userID == "" {
 		// Get events sorted by created_at (chronological)
-		events, total, err := h.eventService.GetEvents(userID, page, limit, eventType, status)
+		getEvents := h.eventService.GetEvents
+		if c.DefaultQuery("include_members", "true") == "false" {
+			getEvents = h.eventService.GetEventsWithMembership
+		}
+		events, total, err := getEvents(userID, page, limit, eventType, status, search)
 		if err != nil {
 			utils.InternalServerErrorResponse(c, "Failed to get events", err)
 			return
 		}
 
-		utils.PaginatedResponse(c, "Events retrieved successfully (sorted by created date)", events, int64(total), page, limit)
+		utils.PaginatedResponse(c, "Events retrieved successfully (sorted by created date)", dto.SelectEventListFields(events, fields), int64(total), page, limit)
 		return
 	}
 
@@ -85,7 +119,104 @@ func (h *EventHandler) GetEvents(c *gin.Context) {
 		events[i] = event
 	}
 
-	utils.PaginatedResponse(c, "Events retrieved successfully (sorted by relevance)", events, total, page, limit)
+	utils.PaginatedResponse(c, "Events retrieved successfully (sorted by relevance)", dto.SelectEventListFields(events, fields), total, page, limit)
+}
+
+// GetEventCounts gets event counts grouped by event type and status
+// @Summary Get event counts
+// @Description Get event counts grouped by event type and status for filter chips. Supports the same filters as the list endpoint.
+// @Tags events
+// @Security BearerAuth
+// @Produce json
+// @Param scope query string false "Count scope: 'all' (default), 'public' or 'joined'"
+// @Param event_type query string false "Event type filter"
+// @Param status query string false "Event status filter"
+// @Param q query string false "Case-insensitive search in title and description"
+// @Success 200 {object} dto.EventCountsResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/counts [get]
+func (h *EventHandler) GetEventCounts(c *gin.Context) {
+	search := strings.TrimSpace(c.Query("q"))
+	if utf8.RuneCountInString(search) > service.MaxEventSearchLength {
+		utils.BadRequestResponse(c, fmt.Sprintf("q must be at most %d characters", service.MaxEventSearchLength))
+		return
+	}
+
+	// Get user ID from context
+	userID, _ := middleware.GetCurrentUserID(c)
+
+	counts, err := h.eventService.GetEventCounts(userID, c.Query("scope"), c.Query("event_type"), c.Query("status"), search)
+	if err != nil {
+		if err.Error() == "invalid scope" {
+			utils.BadRequestResponse(c, "Scope must be one of: all, public, joined")
+		} else {
+			utils.InternalServerErrorResponse(c, "Failed to get event counts", err)
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Event counts retrieved successfully", counts)
+}
+
+// GetEventStates gets the current user's relationship to several events
+// @Summary Get event states
+// @Description Get the current user's membership status and swipe direction for up to 100 events without full event payloads. Unknown or deleted events are reported as untouched.
+// @Tags events
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.EventStateRequest true "Event IDs"
+// @Success 200 {object} dto.EventStateListResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/state [post]
+func (h *EventHandler) GetEventStates(c *gin.Context) {
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	var req dto.EventStateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationErrorResponse(c, "Invalid request", err.Error())
+		return
+	}
+
+	states, err := h.eventService.GetEventStates(userID, req.EventIDs)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid") {
+			utils.BadRequestResponse(c, err.Error())
+		} else {
+			utils.InternalServerErrorResponse(c, "Failed to get event states", err)
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Event states retrieved successfully", states)
+}
+
+// GetPublicEventCounts gets published event counts (no authentication required)
+// @Summary Get public event counts
+// @Description Get published event counts grouped by event type and status without authentication
+// @Tags events
+// @Produce json
+// @Param event_type query string false "Event type filter"
+// @Success 200 {object} dto.EventCountsResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /public/events/counts [get]
+func (h *EventHandler) GetPublicEventCounts(c *gin.Context) {
+	counts, err := h.eventService.GetEventCounts("", "public", c.Query("event_type"), "", "")
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to get event counts", err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Event counts retrieved successfully", counts)
 }
 
 // GetJoinedEvents gets events that the user has joined
@@ -97,6 +228,7 @@ func (h *EventHandler) GetEvents(c *gin.Context) {
 // @Param page query int false "Page number"
 // @Param limit query int false "Items per page"
 // @Param status query string false "Member status filter (pending, confirmed, declined)"
+// @Param fields query string false "Comma separated fields or groups (summary, details, location, budget, creator, photos, categories, tags, interests, items, members, viewer)"
 // @Success 200 {object} dto.EventListResponseWrapper
 // @Failure 401 {object} dto.ErrorAPIResponse
 // @Failure 500 {object} dto.ErrorAPIResponse
@@ -111,11 +243,18 @@ func (h *EventHandler) GetJoinedEvents(c *gin.Context) {
 
 	// Get query parameters
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
 	memberStatus := c.Query("status")
 
 	// Validate pagination
-	page, limit = utils.ValidatePagination(page, limit)
+	page, limit = utils.ValidatePagination(utils.PaginationEvents, page, limit)
+
+	// Parse sparse fieldset
+	fields, err := dto.ParseEventFields(c.Query("fields"))
+	if err != nil {
+		utils.BadRequestResponse(c, err.Error())
+		return
+	}
 
 	// Get joined events
 	events, total, err := h.eventService.GetJoinedEvents(userID, page, limit, memberStatus)
@@ -124,37 +263,109 @@ func (h *EventHandler) GetJoinedEvents(c *gin.Context) {
 		return
 	}
 
-	utils.PaginatedResponse(c, "Joined events retrieved successfully", events, int64(total), page, limit)
+	utils.PaginatedResponse(c, "Joined events retrieved successfully", dto.SelectEventListFields(events, fields), int64(total), page, limit)
+}
+
+// GetCreatedEvents gets events that the user created
+// @Summary Get created events
+// @Description Get events the authenticated user created, newest first. Events the user only joined are not included.
+// @Tags events
+// @Security BearerAuth
+// @Produce json
+// @Param page query int false "Page number"
+// @Param limit query int false "Items per page"
+// @Param status query string false "Event status filter (published, cancelled, completed)"
+// @Param fields query string false "Comma separated fields or groups (summary, details, location, budget, creator, photos, categories, tags, interests, items, members, viewer)"
+// @Success 200 {object} dto.EventListResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/created [get]
+func (h *EventHandler) GetCreatedEvents(c *gin.Context) {
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	// Get query parameters
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	status := c.Query("status")
+
+	// Validate pagination
+	page, limit = utils.ValidatePagination(utils.PaginationEvents, page, limit)
+
+	// Parse sparse fieldset
+	fields, err := dto.ParseEventFields(c.Query("fields"))
+	if err != nil {
+		utils.BadRequestResponse(c, err.Error())
+		return
+	}
+
+	events, total, err := h.eventService.GetCreatedEvents(userID, page, limit, status)
+	if err != nil {
+		switch err.Error() {
+		case "invalid user ID", "invalid status":
+			utils.BadRequestResponse(c, err.Error())
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to get created events", err)
+		}
+		return
+	}
+
+	utils.PaginatedResponse(c, "Created events retrieved successfully", dto.SelectEventListFields(events, fields), total, page, limit)
 }
 
 // GetPublicEvents gets public events (no authentication required)
 // @Summary Get public events
-// @Description Get public events without authentication
+// @Description Get public events without authentication. A valid bearer token is optional and adds the viewer's is_joined, member_status and user_swipe.
 // @Tags events
 // @Produce json
 // @Param page query int false "Page number"
 // @Param limit query int false "Items per page"
 // @Param event_type query string false "Event type filter"
+// @Param fields query string false "Comma separated fields or groups (summary, details, location, budget, creator, photos, categories, tags, interests, items, members, viewer)"
 // @Success 200 {object} dto.EventListResponseWrapper
 // @Failure 500 {object} dto.ErrorAPIResponse
 // @Router /public/events [get]
 func (h *EventHandler) GetPublicEvents(c *gin.Context) {
 	// Get query parameters
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
 	eventType := c.Query("event_type")
 
 	// Validate pagination
-	page, limit = utils.ValidatePagination(page, limit)
+	page, limit = utils.ValidatePagination(utils.PaginationEvents, page, limit)
+
+	// Parse sparse fieldset
+	fields, err := dto.ParseEventFields(c.Query("fields"))
+	if err != nil {
+		utils.BadRequestResponse(c, err.Error())
+		return
+	}
+
+	// Signed-in viewers see their own membership and swipe; anonymous viewers get the plain listing
+	viewerID, _ := middleware.GetCurrentUserID(c)
 
 	// Get public events
-	events, total, err := h.eventService.GetPublicEvents(page, limit, eventType)
+	events, total, err := h.eventService.GetPublicEvents(page, limit, eventType, viewerID)
 	if err != nil {
 		utils.InternalServerErrorResponse(c, "Failed to get events", err)
 		return
 	}
 
-	utils.PaginatedResponse(c, "Events retrieved successfully", events, int64(total), page, limit)
+	// Remember what a guest has seen so it carries over when they sign up
+	if guestID := middleware.GetGuestID(c); guestID != "" {
+		eventIDs := make([]string, 0, len(events))
+		for _, event := range events {
+			eventIDs = append(eventIDs, event.ID)
+		}
+		h.guestService.RecordGuestViews(guestID, eventIDs)
+	}
+
+	utils.PaginatedResponse(c, "Events retrieved successfully", dto.SelectEventListFields(events, fields), int64(total), page, limit)
 }
 
 // GetEvent gets a specific event
@@ -164,6 +375,7 @@ func (h *EventHandler) GetPublicEvents(c *gin.Context) {
 // @Security BearerAuth
 // @Produce json
 // @Param id path string true "Event ID"
+// @Param fields query string false "Comma separated fields or groups (summary, details, location, budget, creator, photos, categories, tags, interests, items, members, viewer)"
 // @Success 200 {object} dto.EventResponseWrapper
 // @Failure 400 {object} dto.ErrorAPIResponse
 // @Failure 401 {object} dto.ErrorAPIResponse
@@ -177,6 +389,13 @@ func (h *EventHandler) GetEvent(c *gin.Context) {
 		return
 	}
 
+	// Parse sparse fieldset
+	fields, err := dto.ParseEventFields(c.Query("fields"))
+	if err != nil {
+		utils.BadRequestResponse(c, err.Error())
+		return
+	}
+
 	// Get user ID from context
 	userID, _ := middleware.GetCurrentUserID(c)
 
@@ -184,22 +403,23 @@ func (h *EventHandler) GetEvent(c *gin.Context) {
 	event, err := h.eventService.GetEvent(eventID, userID)
 	if err != nil {
 		if err.Error() == "event not found" {
-			utils.NotFoundResponse(c, "The requested event does not exist")
+			respondEventNotFound(c)
 		} else {
 			utils.InternalServerErrorResponse(c, "Failed to get event", err)
 		}
 		return
 	}
 
-	utils.SuccessResponse(c, http.StatusOK, "Event retrieved successfully", event)
+	utils.SuccessResponse(c, http.StatusOK, "Event retrieved successfully", dto.SelectEventFields(*event, fields))
 }
 
 // GetPublicEvent gets a specific public event
 // @Summary Get public event
-// @Description Get a specific public event by ID without authentication
+// @Description Get a specific public event by ID without authentication. A valid bearer token is optional and adds the viewer's is_joined, member_status and user_swipe.
 // @Tags events
 // @Produce json
 // @Param id path string true "Event ID"
+// @Param fields query string false "Comma separated fields or groups (summary, details, location, budget, creator, photos, categories, tags, interests, items, members, viewer)"
 // @Success 200 {object} dto.EventResponseWrapper
 // @Failure 400 {object} dto.ErrorAPIResponse
 // @Failure 404 {object} dto.ErrorAPIResponse
@@ -212,8 +432,18 @@ func (h *EventHandler) GetPublicEvent(c *gin.Context) {
 		return
 	}
 
+	// Parse sparse fieldset
+	fields, err := dto.ParseEventFields(c.Query("fields"))
+	if err != nil {
+		utils.BadRequestResponse(c, err.Error())
+		return
+	}
+
+	// Signed-in viewers see their own membership and swipe; anonymous viewers get the plain event
+	viewerID, _ := middleware.GetCurrentUserID(c)
+
 	// Get public event
-	event, err := h.eventService.GetPublicEvent(eventID)
+	event, err := h.eventService.GetPublicEvent(eventID, viewerID)
 	if err != nil {
 		if err.Error() == "event not found" {
 			utils.NotFoundResponse(c, "The requested event does not exist or is not public")
@@ -223,7 +453,11 @@ func (h *EventHandler) GetPublicEvent(c *gin.Context) {
 		return
 	}
 
-	utils.SuccessResponse(c, http.StatusOK, "Event retrieved successfully", event)
+	if guestID := middleware.GetGuestID(c); guestID != "" {
+		h.guestService.RecordGuestViews(guestID, []string{event.ID})
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Event retrieved successfully", dto.SelectEventFields(*event, fields))
 }
 
 // CreateEvent creates a new event
@@ -243,6 +477,7 @@ func (h *EventHandler) GetPublicEvent(c *gin.Context) {
 // @Param start_at formData string false "Start time (multipart)"
 // @Param end_at formData string false "End time (multipart)"
 // @Param capacity formData int false "Capacity (multipart)"
+// @Param min_attendees formData int false "Minimum confirmed members, or the event is cancelled before it starts (multipart)"
 // @Param category_ids formData string false "Category IDs comma separated (multipart)"
 // @Param tag_ids formData string false "Tag IDs comma separated (multipart)"
 // @Param file formData file false "Cover image file (multipart)"
@@ -251,6 +486,7 @@ func (h *EventHandler) GetPublicEvent(c *gin.Context) {
 // @Failure 400 {object} dto.ErrorAPIResponse
 // @Failure 400 {object} dto.ErrorAPIResponse
 // @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 422 {object} dto.ErrorAPIResponse
 // @Router /events [post]
 func (h *EventHandler) CreateEvent(c *gin.Context) {
 	// Get user ID from context
@@ -272,6 +508,10 @@ func (h *EventHandler) CreateEvent(c *gin.Context) {
 		var err error
 		req, coverImageURL, photoURLs, err = h.parseCreateEventMultipart(c)
 		if err != nil {
+			if strings.HasPrefix(err.Error(), "invalid event_type") {
+				utils.UnprocessableEntityResponse(c, err.Error())
+				return
+			}
 			utils.BadRequestResponse(c, "Invalid request: "+err.Error())
 			return
 		}
@@ -291,6 +531,26 @@ func (h *EventHandler) CreateEvent(c *gin.Context) {
 	// Create event
 	event, err := h.eventService.CreateEvent(userID, req)
 	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid category ID") {
+			utils.BadRequestResponse(c, err.Error())
+			return
+		}
+		if err.Error() == "min_attendees must be at least 1" ||
+			err.Error() == "min_attendees cannot exceed capacity" ||
+			isEventTextError(err) {
+			utils.BadRequestResponse(c, err.Error())
+			return
+		}
+		if err.Error() == "at least one category is required" {
+			utils.ValidationErrorResponse(c, "Invalid request", err.Error())
+			return
+		}
+		if strings.HasPrefix(err.Error(), "invalid event_type") ||
+			strings.HasPrefix(err.Error(), "category not found") ||
+			strings.HasPrefix(err.Error(), "tag is not a category") {
+			utils.UnprocessableEntityResponse(c, err.Error())
+			return
+		}
 		utils.InternalServerErrorResponse(c, "Failed to create event", err)
 		return
 	}
@@ -321,6 +581,7 @@ func (h *EventHandler) CreateEvent(c *gin.Context) {
 // @Failure 400 {object} dto.ErrorAPIResponse
 // @Failure 400 {object} dto.ErrorAPIResponse
 // @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 422 {object} dto.ErrorAPIResponse
 // @Router /events/{id} [put]
 func (h *EventHandler) UpdateEvent(c *gin.Context) {
 	eventID := c.Param("id")
@@ -345,12 +606,28 @@ func (h *EventHandler) UpdateEvent(c *gin.Context) {
 	// Update event
 	event, err := h.eventService.UpdateEvent(eventID, userID, req)
 	if err != nil {
-		if err.Error() == "event not found" {
-			utils.NotFoundResponse(c, "Event not found")
+		if err.Error() == "event not found" || err.Error() == "unauthorized" {
+			respondEventNotFound(c)
+			return
+		}
+		if err.Error() == "capacity must be at least 1" ||
+			strings.HasPrefix(err.Error(), "capacity cannot be less than confirmed member count") ||
+			err.Error() == "min_attendees must be at least 1" ||
+			err.Error() == "min_attendees cannot exceed capacity" ||
+			strings.HasPrefix(err.Error(), "invalid category ID") ||
+			strings.HasPrefix(err.Error(), "status cannot be updated") ||
+			isEventTextError(err) {
+			utils.BadRequestResponse(c, err.Error())
 			return
 		}
-		if err.Error() == "unauthorized" {
-			utils.ForbiddenResponse(c, "You don't have permission to update this event")
+		if err.Error() == "at least one category is required" {
+			utils.ValidationErrorResponse(c, "Invalid request", err.Error())
+			return
+		}
+		if strings.HasPrefix(err.Error(), "invalid event_type") ||
+			strings.HasPrefix(err.Error(), "category not found") ||
+			strings.HasPrefix(err.Error(), "tag is not a category") {
+			utils.UnprocessableEntityResponse(c, err.Error())
 			return
 		}
 
@@ -392,12 +669,8 @@ func (h *EventHandler) DeleteEvent(c *gin.Context) {
 	// Delete event
 	err := h.eventService.DeleteEvent(eventID, userID)
 	if err != nil {
-		if err.Error() == "event not found" {
-			utils.NotFoundResponse(c, "Event not found")
-			return
-		}
-		if err.Error() == "unauthorized" {
-			utils.ForbiddenResponse(c, "You don't have permission to delete this event")
+		if err.Error() == "event not found" || err.Error() == "unauthorized" {
+			respondEventNotFound(c)
 			return
 		}
 
@@ -420,6 +693,7 @@ func (h *EventHandler) DeleteEvent(c *gin.Context) {
 // @Failure 400 {object} dto.ErrorAPIResponse
 // @Failure 400 {object} dto.ErrorAPIResponse
 // @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 409 {object} dto.ErrorAPIResponse
 // @Router /events/{id}/join [post]
 func (h *EventHandler) JoinEvent(c *gin.Context) {
 	eventID := c.Param("id")
@@ -438,10 +712,15 @@ func (h *EventHandler) JoinEvent(c *gin.Context) {
 	// Join event
 	err := h.eventService.JoinEvent(eventID, userID)
 	if err != nil {
+		if respondEventClosed(c, err) {
+			return
+		}
 		if err.Error() == "event not found" {
-			utils.NotFoundResponse(c, "The requested event does not exist")
+			respondEventNotFound(c)
 		} else if err.Error() == "user is already a member" {
 			utils.ConflictResponse(c, "You are already a member of this event")
+		} else if err.Error() == "event is full" {
+			utils.ConflictResponse(c, "Cannot join. Event has reached its capacity.")
 		} else {
 			utils.InternalServerErrorResponse(c, "Failed to join event", err)
 		}
@@ -482,10 +761,8 @@ func (h *EventHandler) LeaveEvent(c *gin.Context) {
 	// If creator leaves, event will be soft deleted (same as DELETE)
 	err, isCreator := h.eventService.LeaveEvent(eventID, userID)
 	if err != nil {
-		if err.Error() == "event not found" {
-			utils.NotFoundResponse(c, "The requested event does not exist")
-		} else if err.Error() == "user is not a member" {
-			utils.NotFoundResponse(c, "You are not a member of this event")
+		if err.Error() == "event not found" || err.Error() == "user is not a member" {
+			respondEventNotFound(c)
 		} else {
 			utils.InternalServerErrorResponse(c, "Failed to leave event", err)
 		}
@@ -513,6 +790,7 @@ func (h *EventHandler) LeaveEvent(c *gin.Context) {
 // @Failure 400 {object} dto.ErrorAPIResponse
 // @Failure 400 {object} dto.ErrorAPIResponse
 // @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 409 {object} dto.ErrorAPIResponse
 // @Router /events/{id}/confirm [post]
 func (h *EventHandler) ConfirmEvent(c *gin.Context) {
 	eventID := c.Param("id")
@@ -531,16 +809,15 @@ func (h *EventHandler) ConfirmEvent(c *gin.Context) {
 	// Confirm event participation
 	err := h.eventService.ConfirmEventParticipation(eventID, userID)
 	if err != nil {
-		if err.Error() == "event not found" {
-			utils.NotFoundResponse(c, "Event not found")
+		if respondEventClosed(c, err) {
 			return
 		}
-		if err.Error() == "event is full" {
-			utils.ConflictResponse(c, "Cannot confirm participation. Event has reached its capacity.")
+		if err.Error() == "event not found" || err.Error() == "member not found" {
+			respondEventNotFound(c)
 			return
 		}
-		if err.Error() == "member not found" {
-			utils.NotFoundResponse(c, "You are not a member of this event")
+		if err.Error() == "event is full" {
+			utils.ConflictResponse(c, "Cannot confirm participation. Event has reached its capacity.")
 			return
 		}
 
@@ -551,18 +828,21 @@ func (h *EventHandler) ConfirmEvent(c *gin.Context) {
 	utils.SendSuccessResponse(c, "Successfully confirmed participation in the event", nil)
 }
 
-// CancelEvent cancels participation in an event
-// @Summary Cancel event participation
-// @Description Cancel participation in an event (change status from pending to declined)
+// CancelEvent cancels an event or participation in it
+// @Summary Cancel event or participation
+// @Description For the event creator, cancel the event: it becomes cancelled with an optional reason, pending members are released, confirmed members are notified and an event.cancelled webhook is sent. Completed events cannot be cancelled. For other members, cancel participation (status becomes declined).
 // @Tags events
 // @Security BearerAuth
+// @Accept json
 // @Produce json
 // @Param id path string true "Event ID"
+// @Param request body dto.CancelEventRequest false "Cancellation reason (creator only)"
 // @Success 200 {object} dto.SuccessMessageWrapper
 // @Failure 400 {object} dto.ErrorAPIResponse
-// @Failure 400 {object} dto.ErrorAPIResponse
-// @Failure 400 {object} dto.ErrorAPIResponse
-// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 409 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
 // @Router /events/{id}/cancel [post]
 func (h *EventHandler) CancelEvent(c *gin.Context) {
 	eventID := c.Param("id")
@@ -578,15 +858,42 @@ func (h *EventHandler) CancelEvent(c *gin.Context) {
 		return
 	}
 
+	// The creator cancels the whole event
+	isCreator := false
+	if access, ok := middleware.GetEventAccess(c); ok {
+		isCreator = access.IsCreator()
+	} else {
+		isCreator = h.eventService.VerifyEventCreator(userID, eventID) == nil
+	}
+	if isCreator {
+		var req dto.CancelEventRequest
+		if c.Request.ContentLength > 0 {
+			if err := c.ShouldBindJSON(&req); err != nil {
+				utils.ValidationErrorResponse(c, "Invalid request", err.Error())
+				return
+			}
+		}
+
+		err := h.eventService.CancelEvent(eventID, userID, req.Reason)
+		if err != nil {
+			switch err.Error() {
+			case "event already completed", "event already cancelled":
+				utils.ConflictResponse(c, err.Error())
+			default:
+				utils.InternalServerErrorResponse(c, "Failed to cancel event", err)
+			}
+			return
+		}
+
+		utils.SendSuccessResponse(c, "Event cancelled successfully", nil)
+		return
+	}
+
 	// Cancel event participation
 	err := h.eventService.CancelEventParticipation(eventID, userID)
 	if err != nil {
-		if err.Error() == "event not found" {
-			utils.NotFoundResponse(c, "Event not found")
-			return
-		}
-		if err.Error() == "member not found" {
-			utils.NotFoundResponse(c, "You are not a member of this event")
+		if err.Error() == "event not found" || err.Error() == "member not found" {
+			respondEventNotFound(c)
 			return
 		}
 
@@ -628,12 +935,8 @@ func (h *EventHandler) CompleteEvent(c *gin.Context) {
 	// Complete event
 	err := h.eventService.CompleteEvent(eventID, userID)
 	if err != nil {
-		if err.Error() == "event not found" {
-			utils.NotFoundResponse(c, "Event not found")
-			return
-		}
-		if err.Error() == "not authorized" {
-			utils.ForbiddenResponse(c, "Only the event creator can complete the event")
+		if err.Error() == "event not found" || err.Error() == "not authorized" {
+			respondEventNotFound(c)
 			return
 		}
 
@@ -644,22 +947,22 @@ func (h *EventHandler) CompleteEvent(c *gin.Context) {
 	utils.SendSuccessResponse(c, "Successfully completed the event", nil)
 }
 
-// SwipeEvent swipes on an event
-// @Summary Swipe event
-// @Description Swipe on an event (like or pass)
+// ReportNoShow reports a member who did not attend a completed event
+// @Summary Report no-show
+// @Description Report a confirmed member who did not attend a completed event (creator or confirmed members only, within 7 days)
 // @Tags events
 // @Security BearerAuth
-// @Accept json
 // @Produce json
 // @Param id path string true "Event ID"
-// @Param request body dto.SwipeEventRequest true "Swipe data"
-// @Success 200 {object} dto.SuccessMessageWrapper
-// @Failure 400 {object} dto.ErrorAPIResponse
-// @Failure 400 {object} dto.ErrorAPIResponse
+// @Param user_id path string true "Reported user ID"
+// @Success 201 {object} dto.SuccessMessageWrapper
 // @Failure 400 {object} dto.ErrorAPIResponse
-// @Failure 400 {object} dto.ErrorAPIResponse
-// @Router /events/{id}/swipe [post]
-func (h *EventHandler) SwipeEvent(c *gin.Context) {
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 409 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/no-show/{user_id} [post]
+func (h *EventHandler) ReportNoShow(c *gin.Context) {
 	eventID := c.Param("id")
 	if eventID == "" {
 		utils.BadRequestResponse(c, "Event ID is required")
@@ -673,39 +976,56 @@ func (h *EventHandler) SwipeEvent(c *gin.Context) {
 		return
 	}
 
-	var req dto.SwipeEventRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		utils.ValidationErrorResponse(c, "Invalid request", err.Error())
-		return
-	}
-
-	// Swipe event
-	err := h.eventService.SwipeEvent(eventID, userID, req.Direction)
+	err := h.reliabilityService.ReportNoShow(eventID, userID, c.Param("user_id"))
 	if err != nil {
-		if err.Error() == "event not found" {
-			utils.NotFoundResponse(c, "The requested event does not exist")
-		} else {
-			utils.InternalServerErrorResponse(c, "Failed to swipe event", err)
+		switch err.Error() {
+		case "invalid event ID", "invalid user ID":
+			utils.BadRequestResponse(c, err.Error())
+		case "cannot report yourself":
+			utils.BadRequestResponse(c, "You cannot report yourself")
+		case "event is not completed":
+			utils.BadRequestResponse(c, "No-shows can only be reported after the event is completed")
+		case "no-show report window has closed":
+			utils.BadRequestResponse(c, "The no-show report window has closed")
+		case "event not found", "not authorized":
+			respondEventNotFound(c)
+		case "member not found":
+			utils.NotFoundResponse(c, "User is not a confirmed member of this event")
+		case "already reported":
+			utils.ConflictResponse(c, "You have already reported this member")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to report no-show", err)
 		}
 		return
 	}
 
-	utils.SendSuccessResponse(c, "Swipe recorded successfully", nil)
+	utils.SuccessResponse(c, http.StatusCreated, "No-show reported successfully", nil)
 }
 
-// GetEventSuggestions gets event suggestions based on user interests
-// @Summary Get event suggestions
-// @Description Get event suggestions based on user's interests and tags
+// CheckIn marks the current member as present at the event
+// @Summary Check in to event
+// @Description Check in during the event window using the creator's check-in code or your current location
 // @Tags events
 // @Security BearerAuth
+// @Accept json
 // @Produce json
-// @Param page query int false "Page number"
-// @Param limit query int false "Items per page"
-// @Success 200 {object} dto.EventSuggestionResponseWrapper
-// @Failure 400 {object} dto.ErrorAPIResponse
+// @Param id path string true "Event ID"
+// @Param request body dto.CheckinRequest false "Check-in code or location"
+// @Success 200 {object} dto.CheckinResponseWrapper
 // @Failure 400 {object} dto.ErrorAPIResponse
-// @Router /events/suggestions [get]
-func (h *EventHandler) GetEventSuggestions(c *gin.Context) {
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 409 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/checkin [post]
+func (h *EventHandler) CheckIn(c *gin.Context) {
+	eventID := c.Param("id")
+	if eventID == "" {
+		utils.BadRequestResponse(c, "Event ID is required")
+		return
+	}
+
 	// Get user ID from context
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
@@ -713,47 +1033,1048 @@ func (h *EventHandler) GetEventSuggestions(c *gin.Context) {
 		return
 	}
 
-	// Get query parameters
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
-
-	// Validate pagination
-	page, limit = utils.ValidatePagination(page, limit)
+	var req dto.CheckinRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			utils.ValidationErrorResponse(c, "Invalid request format", err.Error())
+			return
+		}
+	}
 
-	// Get event suggestions
-	suggestions, total, err := h.eventService.GetEventSuggestions(userID, page, limit)
+	checkin, err := h.checkinService.CheckIn(eventID, userID, req)
 	if err != nil {
-		utils.InternalServerErrorResponse(c, "Failed to get event suggestions", err)
+		switch err.Error() {
+		case "invalid event ID", "invalid user ID", "lat and lng must be provided together",
+			"event is not active", "check-in is not open", "invalid check-in code",
+			"too far from event location", "event has no location", "check-in code or location required":
+			utils.BadRequestResponse(c, err.Error())
+		case "event not found", "not a member":
+			respondEventNotFound(c)
+		case "not a confirmed member":
+			utils.ForbiddenResponse(c, "Only confirmed members can check in")
+		case "already checked in":
+			utils.ConflictResponse(c, "Already checked in")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to check in", err)
+		}
 		return
 	}
 
-	// Send response
-	utils.SuccessResponse(c, http.StatusOK, "Event suggestions retrieved successfully", dto.EventSuggestionResponse{
-		Events: suggestions,
-		Total:  total,
-		Page:   page,
-		Limit:  limit,
-	})
+	utils.SuccessResponse(c, http.StatusOK, "Checked in successfully", checkin)
 }
 
-// UpdateCover updates event cover image (multipart: file)
-// @Summary Update event cover image
+// GenerateCheckinCode creates a check-in code for the event
+// @Summary Generate check-in code
+// @Description Generate a new check-in code for members to enter or scan as a QR code (creator only). Replaces any previous code.
 // @Tags events
 // @Security BearerAuth
-// @Accept mpfd
 // @Produce json
 // @Param id path string true "Event ID"
-// @Param file formData file true "Cover image file"
-// @Success 200 {object} map[string]string
-// @Failure 400 {object} dto.ErrorAPIResponse
-// @Failure 400 {object} dto.ErrorAPIResponse
+// @Success 200 {object} dto.CheckinCodeResponseWrapper
 // @Failure 400 {object} dto.ErrorAPIResponse
-// @Failure 400 {object} dto.ErrorAPIResponse
-// @Router /events/{id}/cover [put]
-func (h *EventHandler) UpdateCover(c *gin.Context) {
-	userID, _ := middleware.GetCurrentUserID(c)
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/checkin-code [post]
+func (h *EventHandler) GenerateCheckinCode(c *gin.Context) {
 	eventID := c.Param("id")
-
+	if eventID == "" {
+		utils.BadRequestResponse(c, "Event ID is required")
+		return
+	}
+
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	code, err := h.checkinService.GenerateCheckinCode(eventID, userID)
+	if err != nil {
+		switch err.Error() {
+		case "invalid event ID", "invalid user ID", "event is not active":
+			utils.BadRequestResponse(c, err.Error())
+		case "event not found", "not authorized":
+			respondEventNotFound(c)
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to generate check-in code", err)
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Check-in code generated successfully", code)
+}
+
+// GetEventItems lists the event's bring list
+// @Summary Get event items
+// @Description List the items members are bringing to the event and who claimed each one (confirmed members only)
+// @Tags events
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} dto.EventItemListResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/items [get]
+func (h *EventHandler) GetEventItems(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	items, err := h.itemService.ListItems(c.Param("id"), userID)
+	if err != nil {
+		respondEventItemError(c, err, "Failed to get items")
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Items retrieved successfully", items)
+}
+
+// AddEventItem adds an item to the event's bring list
+// @Summary Add event item
+// @Description Add something to bring to the event (confirmed members only)
+// @Tags events
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param request body dto.AddEventItemRequest true "Item"
+// @Success 201 {object} dto.EventItemResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 409 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/items [post]
+func (h *EventHandler) AddEventItem(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	var req dto.AddEventItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationErrorResponse(c, "Invalid request format", err.Error())
+		return
+	}
+
+	item, err := h.itemService.AddItem(c.Param("id"), userID, req)
+	if err != nil {
+		respondEventItemError(c, err, "Failed to add item")
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusCreated, "Item added successfully", item)
+}
+
+// DeleteEventItem removes an item from the event's bring list
+// @Summary Delete event item
+// @Description Remove an item from the bring list (the member who added it or the event creator)
+// @Tags events
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param item_id path string true "Item ID"
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/items/{item_id} [delete]
+func (h *EventHandler) DeleteEventItem(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	if err := h.itemService.DeleteItem(c.Param("id"), c.Param("item_id"), userID); err != nil {
+		respondEventItemError(c, err, "Failed to delete item")
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Item deleted successfully", nil)
+}
+
+// ClaimEventItem marks the current member as bringing an item
+// @Summary Claim event item
+// @Description Claim an item on the bring list. Each item can be claimed by one member at a time; claiming an item you already hold is a no-op.
+// @Tags events
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param item_id path string true "Item ID"
+// @Success 200 {object} dto.EventItemResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 409 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/items/{item_id}/claim [post]
+func (h *EventHandler) ClaimEventItem(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	item, err := h.itemService.ClaimItem(c.Param("id"), c.Param("item_id"), userID)
+	if err != nil {
+		respondEventItemError(c, err, "Failed to claim item")
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Item claimed successfully", item)
+}
+
+// UnclaimEventItem releases a claim on an item
+// @Summary Unclaim event item
+// @Description Release your claim on an item. The event creator can release any member's claim.
+// @Tags events
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param item_id path string true "Item ID"
+// @Success 200 {object} dto.EventItemResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/items/{item_id}/claim [delete]
+func (h *EventHandler) UnclaimEventItem(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	item, err := h.itemService.UnclaimItem(c.Param("id"), c.Param("item_id"), userID)
+	if err != nil {
+		respondEventItemError(c, err, "Failed to unclaim item")
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Item unclaimed successfully", item)
+}
+
+// respondEventItemError maps bring list service errors to responses
+func respondEventItemError(c *gin.Context, err error, failure string) {
+	switch err.Error() {
+	case "invalid event ID", "invalid user ID", "invalid item ID", "item name is required", "event is not active":
+		utils.BadRequestResponse(c, err.Error())
+	case "event not found", "not a member":
+		respondEventNotFound(c)
+	case "not a confirmed member":
+		utils.ForbiddenResponse(c, "Only confirmed members can use the bring list")
+	case "not authorized", "item claimed by another member":
+		utils.ForbiddenResponse(c, err.Error())
+	case "item not found":
+		utils.NotFoundResponse(c, "Item not found")
+	case "already claimed", "item limit reached":
+		utils.ConflictResponse(c, err.Error())
+	default:
+		utils.InternalServerErrorResponse(c, failure, err)
+	}
+}
+
+// TransferOwnership hands the event to another confirmed member
+// @Summary Transfer event ownership
+// @Description Make another confirmed member the event creator (creator only). The previous creator stays as a participant and members are notified.
+// @Tags events
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param request body dto.TransferOwnershipRequest true "New creator"
+// @Success 200 {object} dto.SuccessMessageWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/transfer [post]
+func (h *EventHandler) TransferOwnership(c *gin.Context) {
+	eventID := c.Param("id")
+	if eventID == "" {
+		utils.BadRequestResponse(c, "Event ID is required")
+		return
+	}
+
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	var req dto.TransferOwnershipRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationErrorResponse(c, "Invalid request format", err.Error())
+		return
+	}
+
+	err := h.eventService.TransferOwnership(eventID, userID, req.NewCreatorID)
+	if err != nil {
+		switch err.Error() {
+		case "invalid event id", "invalid user id", "invalid new creator id", "already the creator",
+			"event is not active", "new creator must be a confirmed member":
+			utils.BadRequestResponse(c, err.Error())
+		case "event not found", "permission denied":
+			respondEventNotFound(c)
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to transfer ownership", err)
+		}
+		return
+	}
+
+	utils.SendSuccessResponse(c, "Event ownership transferred successfully", nil)
+}
+
+// UpdateMembershipNote sets the caller's note on their membership
+// @Summary Update membership note
+// @Description Set a note on your own membership, e.g. dietary needs (max 500 characters). An empty or null note clears it. Only the creator and the member can see the note.
+// @Tags events
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param request body dto.UpdateMemberNoteRequest true "Note"
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/membership/note [put]
+func (h *EventHandler) UpdateMembershipNote(c *gin.Context) {
+	eventID := c.Param("id")
+
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	var req dto.UpdateMemberNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationErrorResponse(c, "Invalid request format", err.Error())
+		return
+	}
+
+	note, err := h.eventService.SetMemberNote(eventID, userID, req.Note)
+	if err != nil {
+		switch err.Error() {
+		case "invalid event ID", "invalid user ID", "note is too long":
+			utils.BadRequestResponse(c, err.Error())
+		case "event not found", "member not found":
+			respondEventNotFound(c)
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to update note", err)
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Note updated successfully", dto.MemberNoteResponse{
+		EventID: eventID,
+		UserID:  userID,
+		Note:    note,
+	})
+}
+
+// ExpirePendingMembers declines the event's stale pending members
+// @Summary Expire stale pending members
+// @Description Decline members who joined but have not confirmed within the pending TTL, and notify them (creator only)
+// @Tags events
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/pending/expire [post]
+func (h *EventHandler) ExpirePendingMembers(c *gin.Context) {
+	eventID := c.Param("id")
+
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	expired, err := h.eventService.ExpireEventPendingMembers(eventID, userID)
+	if err != nil {
+		switch err.Error() {
+		case "invalid event ID", "invalid user ID":
+			utils.BadRequestResponse(c, err.Error())
+		case "event not found", "not authorized":
+			respondEventNotFound(c)
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to expire pending members", err)
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Pending members expired successfully", dto.ExpirePendingMembersResponse{
+		Expired: expired,
+	})
+}
+
+// ResendConfirmationEmail emails the caller their event confirmation again
+// @Summary Resend confirmation email
+// @Description Send the event confirmation email to the calling confirmed member again. Limited to one email every 10 minutes.
+// @Tags events
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} dto.SuccessMessageWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 429 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/confirmation/resend [post]
+func (h *EventHandler) ResendConfirmationEmail(c *gin.Context) {
+	eventID := c.Param("id")
+
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	err := h.eventService.ResendConfirmationEmail(eventID, userID)
+	if err != nil {
+		switch err.Error() {
+		case "invalid event ID", "invalid user ID", "event is not active", "no email address":
+			utils.BadRequestResponse(c, err.Error())
+		case "event not found", "not a member":
+			respondEventNotFound(c)
+		case "not a confirmed member":
+			utils.ForbiddenResponse(c, "Only confirmed members can get a confirmation email")
+		case "confirmation email sent recently":
+			utils.TooManyRequestsResponse(c, "Confirmation email sent recently, try again later")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to resend confirmation email", err)
+		}
+		return
+	}
+
+	utils.SendSuccessResponse(c, "Confirmation email sent successfully", nil)
+}
+
+// BroadcastToMembers sends a message to all confirmed members
+// @Summary Broadcast to event members
+// @Description Send a notification to all confirmed members and optionally post it to the event chat (creator only). Limited to a few broadcasts per event per day.
+// @Tags events
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param request body dto.BroadcastRequest true "Broadcast message"
+// @Success 200 {object} dto.BroadcastResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 429 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/broadcast [post]
+func (h *EventHandler) BroadcastToMembers(c *gin.Context) {
+	eventID := c.Param("id")
+	if eventID == "" {
+		utils.BadRequestResponse(c, "Event ID is required")
+		return
+	}
+
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	var req dto.BroadcastRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationErrorResponse(c, "Invalid request format", err.Error())
+		return
+	}
+
+	result, err := h.eventService.BroadcastToMembers(eventID, userID, req)
+	if err != nil {
+		switch err.Error() {
+		case "invalid event id", "invalid user id", "event is not active", "title and body are required":
+			utils.BadRequestResponse(c, err.Error())
+		case "event not found", "permission denied":
+			respondEventNotFound(c)
+		case "chat room not found":
+			utils.NotFoundResponse(c, "Chat room not found")
+		case "broadcast limit reached":
+			utils.TooManyRequestsResponse(c, "Broadcast limit reached, try again later")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to send broadcast", err)
+		}
+		return
+	}
+
+	utils.SendSuccessResponse(c, "Broadcast sent successfully", result)
+}
+
+// InviteToEvent invites another user to the event
+// @Summary Invite user to event
+// @Description Send an event invitation notification to another user. The creator can always invite; confirmed participants only when the event allows member invites.
+// @Tags events
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param request body dto.InviteToEventRequest true "User to invite"
+// @Success 200 {object} dto.SuccessMessageWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 409 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/invite [post]
+func (h *EventHandler) InviteToEvent(c *gin.Context) {
+	eventID := c.Param("id")
+	if eventID == "" {
+		utils.BadRequestResponse(c, "Event ID is required")
+		return
+	}
+
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	var req dto.InviteToEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationErrorResponse(c, "Invalid request format", err.Error())
+		return
+	}
+
+	err := h.eventService.InviteToEvent(eventID, userID, req.UserID)
+	if err != nil {
+		switch err.Error() {
+		case "invalid event id", "invalid user id", "invalid invitee id", "cannot invite yourself", "event is not active":
+			utils.BadRequestResponse(c, err.Error())
+		case "event not found", "not a member":
+			respondEventNotFound(c)
+		case "user not found":
+			utils.NotFoundResponse(c, "User not found")
+		case "invites are disabled":
+			utils.ForbiddenResponse(c, "The creator has disabled member invites")
+		case "already a member":
+			utils.ConflictResponse(c, "User is already a member of this event")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to send invitation", err)
+		}
+		return
+	}
+
+	utils.SendSuccessResponse(c, "Invitation sent successfully", nil)
+}
+
+// SwipeEvent swipes on an event
+// @Summary Swipe event
+// @Description Swipe on an event (like or pass). A like marks you as interested without joining; join with POST /events/{id}/join.
+// @Tags events
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param request body dto.SwipeEventRequest true "Swipe data"
+// @Success 200 {object} dto.SuccessMessageWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 409 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/swipe [post]
+func (h *EventHandler) SwipeEvent(c *gin.Context) {
+	eventID := c.Param("id")
+	if eventID == "" {
+		utils.BadRequestResponse(c, "Event ID is required")
+		return
+	}
+
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	var req dto.SwipeEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationErrorResponse(c, "Invalid request", err.Error())
+		return
+	}
+
+	// Swipe event
+	err := h.eventService.SwipeEvent(eventID, userID, req.Direction)
+	if err != nil {
+		if respondEventClosed(c, err) {
+			return
+		}
+		if err.Error() == "event not found" {
+			respondEventNotFound(c)
+		} else {
+			utils.InternalServerErrorResponse(c, "Failed to swipe event", err)
+		}
+		return
+	}
+
+	utils.SendSuccessResponse(c, "Swipe recorded successfully", nil)
+}
+
+// UndoSwipe takes back a swipe on an event
+// @Summary Undo swipe
+// @Description Remove your swipe on an event. Undoing a like also withdraws your interest; membership is not affected.
+// @Tags events
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} dto.SuccessMessageWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/swipe [delete]
+func (h *EventHandler) UndoSwipe(c *gin.Context) {
+	eventID := c.Param("id")
+
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	err := h.eventService.UndoSwipe(eventID, userID)
+	if err != nil {
+		switch err.Error() {
+		case "invalid event ID":
+			utils.BadRequestResponse(c, "Invalid event ID")
+		case "swipe not found":
+			utils.NotFoundResponse(c, "You have not swiped on this event")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to undo swipe", err)
+		}
+		return
+	}
+
+	utils.SendSuccessResponse(c, "Swipe undone successfully", nil)
+}
+
+// GetEventSuggestions gets event suggestions based on user interests
+// @Summary Get event suggestions
+// @Description Get event suggestions based on user's interests and tags
+// @Tags events
+// @Security BearerAuth
+// @Produce json
+// @Param page query int false "Page number"
+// @Param limit query int false "Items per page"
+// @Success 200 {object} dto.EventSuggestionResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Router /events/suggestions [get]
+func (h *EventHandler) GetEventSuggestions(c *gin.Context) {
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	// Get query parameters
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	// Validate pagination
+	page, limit = utils.ValidatePagination(utils.PaginationSuggestions, page, limit)
+
+	// Get event suggestions
+	suggestions, total, err := h.eventService.GetEventSuggestions(userID, page, limit)
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to get event suggestions", err)
+		return
+	}
+
+	// Send response
+	utils.SuccessResponse(c, http.StatusOK, "Event suggestions retrieved successfully", dto.EventSuggestionResponse{
+		Events: suggestions,
+		Total:  total,
+		Page:   page,
+		Limit:  limit,
+	})
+}
+
+// GetTopPicks gets the current user's daily top picks
+// @Summary Get top picks
+// @Description Get the current user's best matching events, precomputed nightly. Users without stored picks are scored live (live=true).
+// @Tags events
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} dto.TopPicksResponseWrapper
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/top-picks [get]
+func (h *EventHandler) GetTopPicks(c *gin.Context) {
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	picks, err := h.topPicksService.GetTopPicks(userID)
+	if err != nil {
+		if err.Error() == "invalid user ID" {
+			utils.BadRequestResponse(c, err.Error())
+		} else {
+			utils.InternalServerErrorResponse(c, "Failed to get top picks", err)
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Top picks retrieved successfully", picks)
+}
+
+// GetEventMatch explains the current user's match score for an event
+// @Summary Get event match score
+// @Description Get the current user's component scores (0-100), their weights and the combined match score for one event, computed exactly as in the suggestion list
+// @Tags events
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} dto.EventMatchResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/match [get]
+func (h *EventHandler) GetEventMatch(c *gin.Context) {
+	eventID := c.Param("id")
+	if eventID == "" {
+		utils.BadRequestResponse(c, "Event ID is required")
+		return
+	}
+
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	match, err := h.eventService.GetEventMatch(userID, eventID)
+	if err != nil {
+		switch err.Error() {
+		case "invalid event ID", "invalid user ID":
+			utils.BadRequestResponse(c, err.Error())
+		case "event not found":
+			respondEventNotFound(c)
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to get event match", err)
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Event match retrieved successfully", match)
+}
+
+// GetEventCompatibility reports how well the current user fits an event's confirmed members
+// @Summary Get event compatibility
+// @Description Get the current user's average tag and interest overlap (0-100) with the event's confirmed members, and how many members share each of the user's tags and interests. Members are never identified; the score is null until at least two other confirmed members can be compared.
+// @Tags events
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} dto.EventCompatibilityResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/compatibility [get]
+func (h *EventHandler) GetEventCompatibility(c *gin.Context) {
+	eventID := c.Param("id")
+	if eventID == "" {
+		utils.BadRequestResponse(c, "Event ID is required")
+		return
+	}
+
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	compatibility, err := h.eventService.GetEventCompatibility(userID, eventID)
+	if err != nil {
+		switch err.Error() {
+		case "invalid event ID", "invalid user ID":
+			utils.BadRequestResponse(c, err.Error())
+		case "event not found":
+			respondEventNotFound(c)
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to get event compatibility", err)
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Event compatibility retrieved successfully", compatibility)
+}
+
+// GetSimilarEvents gets events similar to an event
+// @Summary Get similar events
+// @Description Get published events that share tags, categories or type with the event and are close in location and time, best match first. Events the user has joined are excluded.
+// @Tags events
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param page query int false "Page number"
+// @Param limit query int false "Items per page"
+// @Success 200 {object} dto.EventSuggestionResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/similar [get]
+func (h *EventHandler) GetSimilarEvents(c *gin.Context) {
+	eventID := c.Param("id")
+	if eventID == "" {
+		utils.BadRequestResponse(c, "Event ID is required")
+		return
+	}
+
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	// Get query parameters
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	page, limit = utils.ValidatePagination(utils.PaginationEvents, page, limit)
+
+	similar, total, err := h.eventService.GetSimilarEvents(eventID, userID, page, limit)
+	if err != nil {
+		switch err.Error() {
+		case "invalid event ID":
+			utils.BadRequestResponse(c, "Invalid event ID")
+		case "event not found":
+			respondEventNotFound(c)
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to get similar events", err)
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Similar events retrieved successfully", dto.EventSuggestionResponse{
+		Events: similar,
+		Total:  total,
+		Page:   page,
+		Limit:  limit,
+	})
+}
+
+// GetNearbyEvents gets published events near a location
+// @Summary Get nearby events
+// @Description Get published events within radius_km of a location, closest first, each with its distance_km. Events without coordinates are not included.
+// @Tags events
+// @Security BearerAuth
+// @Produce json
+// @Param lat query number true "Latitude"
+// @Param lng query number true "Longitude"
+// @Param radius_km query number false "Search radius in km (default 10, max 200)"
+// @Param page query int false "Page number"
+// @Param limit query int false "Items per page"
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/nearby [get]
+func (h *EventHandler) GetNearbyEvents(c *gin.Context) {
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	lat, latErr := strconv.ParseFloat(c.Query("lat"), 64)
+	lng, lngErr := strconv.ParseFloat(c.Query("lng"), 64)
+	if latErr != nil || lngErr != nil {
+		utils.BadRequestResponse(c, "lat and lng are required numbers")
+		return
+	}
+	radiusKm := service.DefaultNearbyRadiusKm
+	if raw := c.Query("radius_km"); raw != "" {
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			utils.BadRequestResponse(c, "radius_km must be a number")
+			return
+		}
+		radiusKm = parsed
+	}
+
+	// Get query parameters
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	page, limit = utils.ValidatePagination(utils.PaginationEvents, page, limit)
+
+	events, total, err := h.eventService.GetNearbyEvents(userID, lat, lng, radiusKm, page, limit)
+	if err != nil {
+		switch err.Error() {
+		case "invalid coordinates":
+			utils.BadRequestResponse(c, "lat must be between -90 and 90 and lng between -180 and 180")
+		case "invalid radius":
+			utils.BadRequestResponse(c, "radius_km must be greater than 0 and at most 200")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to get nearby events", err)
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Nearby events retrieved successfully", dto.NearbyEventsResponse{
+		Events: events,
+		Total:  total,
+		Page:   page,
+		Limit:  limit,
+	})
+}
+
+// ExportAttendees downloads the confirmed attendee list as CSV
+// @Summary Export attendees as CSV
+// @Description Download the confirmed attendees (display name, role, join, confirm and check-in times) as CSV (creator only)
+// @Tags events
+// @Security BearerAuth
+// @Produce text/csv
+// @Param id path string true "Event ID"
+// @Success 200 {file} file "CSV file"
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/attendees.csv [get]
+func (h *EventHandler) ExportAttendees(c *gin.Context) {
+	eventID := c.Param("id")
+	if eventID == "" {
+		utils.BadRequestResponse(c, "Event ID is required")
+		return
+	}
+
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	// Check access before the CSV headers are sent
+	if !h.verifyEventCreator(c, userID, eventID) {
+		return
+	}
+
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"attendees-%s.csv\"", eventID))
+	c.Status(http.StatusOK)
+
+	if err := h.eventService.ExportAttendeesCSV(eventID, userID, c.Writer); err != nil {
+		// Headers are already sent, so the error can only be logged
+		utils.RequestLogger(c).WithFields(map[string]interface{}{
+			"error":    err,
+			"event_id": eventID,
+		}).Error("Failed to stream attendee CSV")
+	}
+}
+
+// GetEventFunnel gets the event's interest-to-attendance funnel
+// @Summary Get event funnel
+// @Description Count users at each stage, in order: impressions (served in the suggestion feed), likes, joins, confirmations and attended, with the drop-off from the stage before (creator only)
+// @Tags events
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} dto.EventFunnelResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/funnel [get]
+func (h *EventHandler) GetEventFunnel(c *gin.Context) {
+	eventID := c.Param("id")
+	if eventID == "" {
+		utils.BadRequestResponse(c, "Event ID is required")
+		return
+	}
+
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	funnel, err := h.eventService.GetEventFunnel(eventID, userID)
+	if err != nil {
+		switch err.Error() {
+		case "invalid event id", "invalid user id":
+			utils.BadRequestResponse(c, err.Error())
+		case "event not found", "permission denied":
+			respondEventNotFound(c)
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to get event funnel", err)
+		}
+		return
+	}
+
+	utils.SendSuccessResponse(c, "Event funnel retrieved successfully", funnel)
+}
+
+// UpdateCover updates event cover image (multipart: file)
+// @Summary Update event cover image
+// @Tags events
+// @Security BearerAuth
+// @Accept mpfd
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param file formData file true "Cover image file"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/cover [put]
+func (h *EventHandler) UpdateCover(c *gin.Context) {
+	userID, _ := middleware.GetCurrentUserID(c)
+	eventID := c.Param("id")
+
+	// Check ownership before uploading anything
+	if !h.verifyEventCreator(c, userID, eventID) {
+		return
+	}
+
 	file, err := c.FormFile("file")
 	if err != nil {
 		utils.BadRequestResponse(c, "File required")
@@ -779,35 +2100,84 @@ func (h *EventHandler) UpdateCover(c *gin.Context) {
 	}
 
 	if err := h.eventService.UpdateCoverImageURL(userID, eventID, &url); err != nil {
-		utils.ForbiddenResponse(c, err.Error())
+		if err.Error() == "event not found" || err.Error() == "permission denied" {
+			respondEventNotFound(c)
+			return
+		}
+		utils.InternalServerErrorResponse(c, "Failed to update cover image", err)
+		return
+	}
+	utils.SendSuccessResponse(c, "Cover image updated successfully", gin.H{"cover_image_url": url})
+}
+
+// SetCoverFromPhoto promotes a gallery photo to the event cover
+// @Summary Set event cover from photo
+// @Description Use an existing gallery photo of the event as its cover image without re-uploading (creator only)
+// @Tags events
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param photo_id path string true "Photo ID"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/cover/from-photo/{photo_id} [put]
+func (h *EventHandler) SetCoverFromPhoto(c *gin.Context) {
+	userID, _ := middleware.GetCurrentUserID(c)
+	eventID := c.Param("id")
+	photoID := c.Param("photo_id")
+
+	url, err := h.eventService.SetCoverFromPhoto(userID, eventID, photoID)
+	if err != nil {
+		switch err.Error() {
+		case "invalid user id", "invalid event id", "invalid photo id":
+			utils.BadRequestResponse(c, err.Error())
+		case "event not found", "permission denied":
+			respondEventNotFound(c)
+		case "photo not found":
+			utils.NotFoundResponse(c, "Photo not found")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to update cover image", err)
+		}
 		return
 	}
+
 	utils.SendSuccessResponse(c, "Cover image updated successfully", gin.H{"cover_image_url": url})
 }
 
 // AddPhotos appends photos to event gallery (multipart: files[])
+// Every file is attempted; successful uploads are saved even if others fail.
 // @Summary Add event photos
+// @Description Uploads each file independently. Returns 201 when all succeed, 207 with per-file results when some fail.
 // @Tags events
 // @Security BearerAuth
 // @Accept mpfd
 // @Produce json
 // @Param id path string true "Event ID"
 // @Param files[] formData file true "Gallery images (multiple)"
-// @Success 201 {object} map[string][]string
-// @Failure 400 {object} dto.ErrorAPIResponse
-// @Failure 400 {object} dto.ErrorAPIResponse
-// @Failure 400 {object} dto.ErrorAPIResponse
+// @Success 201 {object} dto.PhotoUploadResponse
+// @Success 207 {object} dto.PhotoUploadResponse
 // @Failure 400 {object} dto.ErrorAPIResponse
 // @Router /events/{id}/photos [post]
 func (h *EventHandler) AddPhotos(c *gin.Context) {
 	userID, _ := middleware.GetCurrentUserID(c)
 	eventID := c.Param("id")
 
-	form, err := c.MultipartForm()
-	if err != nil || form.File["files[]"] == nil {
+	form, err := parseMultipartUpload(c)
+	if err != nil {
 		utils.BadRequestResponse(c, "files[] required")
 		return
 	}
+	defer form.RemoveAll()
+	if form.File["files[]"] == nil {
+		utils.BadRequestResponse(c, "files[] required")
+		return
+	}
+
+	// Check ownership before uploading anything
+	if !h.verifyEventCreator(c, userID, eventID) {
+		return
+	}
 
 	fs, err := service.NewFileService()
 	if err != nil {
@@ -815,27 +2185,57 @@ func (h *EventHandler) AddPhotos(c *gin.Context) {
 		return
 	}
 
-	var urls []string
-	for _, f := range form.File["files[]"] {
-		src, err := f.Open()
-		if err != nil {
-			utils.BadRequestResponse(c, "Invalid file")
-			return
-		}
-		_, url, _, _, _, err := fs.UploadImage(c, "event_photos", f.Filename, src)
-		src.Close()
-		if err != nil {
-			utils.ErrorResponse(c, http.StatusUnsupportedMediaType, utils.ErrCodeInvalidInput, "Upload failed", err)
-			return
-		}
-		urls = append(urls, url)
+	result := fs.UploadImages(c, "event_photos", form.File["files[]"])
+	if result.Uploaded == 0 {
+		utils.ValidationErrorResponse(c, "No photos were uploaded", result.Results)
+		return
 	}
 
-	if err := h.eventService.AppendEventPhotos(userID, eventID, urls); err != nil {
-		utils.ForbiddenResponse(c, err.Error())
+	if err := h.eventService.AppendEventPhotos(userID, eventID, result.URLs); err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to save photos", err)
+		return
+	}
+
+	if result.Failed > 0 {
+		utils.SuccessResponse(c, http.StatusMultiStatus, "Some photos failed to upload", result)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusCreated, "Photos added successfully", result)
+}
+
+// RemovePhoto removes a photo from the event gallery
+// @Summary Remove event photo
+// @Description Delete a gallery photo and its stored image (creator only)
+// @Tags events
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param photo_id path string true "Photo ID"
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/photos/{photo_id} [delete]
+func (h *EventHandler) RemovePhoto(c *gin.Context) {
+	userID, _ := middleware.GetCurrentUserID(c)
+	eventID := c.Param("id")
+	photoID := c.Param("photo_id")
+
+	err := h.eventService.RemoveEventPhoto(userID, eventID, photoID)
+	if err != nil {
+		switch err.Error() {
+		case "invalid user id", "invalid event id", "invalid photo id":
+			utils.BadRequestResponse(c, err.Error())
+		case "event not found", "permission denied":
+			respondEventNotFound(c)
+		case "photo not found":
+			utils.NotFoundResponse(c, "Photo not found")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to remove photo", err)
+		}
 		return
 	}
-	utils.SuccessResponse(c, http.StatusCreated, "Photos added successfully", gin.H{"urls": urls})
+
+	utils.SuccessResponse(c, http.StatusOK, "Photo removed successfully", nil)
 }
 
 // parseCreateEventMultipart parses multipart form data for event creation
@@ -844,6 +2244,13 @@ func (h *EventHandler) parseCreateEventMultipart(c *gin.Context) (dto.CreateEven
 	var coverImageURL *string
 	var photoURLs []string
 
+	// Parse the form up front so large files go to temp files, removed once uploaded
+	form, err := parseMultipartUpload(c)
+	if err != nil {
+		return req, nil, nil, fmt.Errorf("invalid multipart form: %w", err)
+	}
+	defer form.RemoveAll()
+
 	// Parse text fields
 	title := c.PostForm("title")
 	description := c.PostForm("description")
@@ -854,6 +2261,7 @@ func (h *EventHandler) parseCreateEventMultipart(c *gin.Context) (dto.CreateEven
 	startAtStr := c.PostForm("start_at")
 	endAtStr := c.PostForm("end_at")
 	capacityStr := c.PostForm("capacity")
+	minAttendeesStr := c.PostForm("min_attendees")
 	budgetMinStr := c.PostForm("budget_min")
 	budgetMaxStr := c.PostForm("budget_max")
 	currency := c.PostForm("currency")
@@ -867,6 +2275,10 @@ func (h *EventHandler) parseCreateEventMultipart(c *gin.Context) (dto.CreateEven
 	if eventType == "" {
 		return req, nil, nil, fmt.Errorf("event_type is required")
 	}
+	// Reject unknown types before any files are uploaded
+	if !models.IsValidEventType(eventType) {
+		return req, nil, nil, fmt.Errorf("invalid event_type %q", eventType)
+	}
 
 	req.Title = title
 	if description != "" {
@@ -912,6 +2324,13 @@ func (h *EventHandler) parseCreateEventMultipart(c *gin.Context) (dto.CreateEven
 		}
 	}
 
+	// Parse min attendees
+	if minAttendeesStr != "" {
+		if minAttendees, err := strconv.Atoi(minAttendeesStr); err == nil {
+			req.MinAttendees = &minAttendees
+		}
+	}
+
 	// Parse budget min
 	if budgetMinStr != "" {
 		if budgetMin, err := strconv.Atoi(budgetMinStr); err == nil {
@@ -970,7 +2389,7 @@ func (h *EventHandler) parseCreateEventMultipart(c *gin.Context) (dto.CreateEven
 	}
 
 	// Handle multiple photos upload
-	if form, err := c.MultipartForm(); err == nil && form.File["files[]"] != nil {
+	if form.File["files[]"] != nil {
 		fs, err := service.NewFileService()
 		if err != nil {
 			return req, nil, nil, fmt.Errorf("storage init failed: %w", err)
@@ -993,3 +2412,67 @@ func (h *EventHandler) parseCreateEventMultipart(c *gin.Context) (dto.CreateEven
 
 	return req, coverImageURL, photoURLs, nil
 }
+
+// parseMultipartUpload parses the request's multipart form, holding at most
+// STORAGE_MULTIPART_MEMORY_MB in memory; anything larger is written to temp files. Callers
+// remove the form once its files have been uploaded.
+func parseMultipartUpload(c *gin.Context) (*multipart.Form, error) {
+	memoryMB := config.AppConfig.Storage.MultipartMemoryMB
+	if err := c.Request.ParseMultipartForm(int64(memoryMB) << 20); err != nil {
+		return nil, err
+	}
+	return c.Request.MultipartForm, nil
+}
+
+// respondEventNotFound reports a missing event. Callers who may not see or act on an event get
+// this same response, so event IDs cannot be probed by comparing 404 and 403 responses.
+func respondEventNotFound(c *gin.Context) {
+	utils.NotFoundResponse(c, "Event not found")
+}
+
+// respondEventClosed writes the response for an event that no longer takes members and reports
+// whether err was one. Cancelled and completed events conflict with the request; an event that
+// has started is a bad request.
+func respondEventClosed(c *gin.Context, err error) bool {
+	switch err.Error() {
+	case "event is cancelled":
+		utils.ConflictResponse(c, "Event has been cancelled")
+	case "event is completed":
+		utils.ConflictResponse(c, "Event has already completed")
+	case "event has already started":
+		utils.BadRequestResponse(c, "Event has already started")
+	default:
+		return false
+	}
+	return true
+}
+
+// isEventTextError reports whether err is a title or description that failed validation
+func isEventTextError(err error) bool {
+	return err.Error() == "title is required" ||
+		strings.HasPrefix(err.Error(), "title must be at most") ||
+		strings.HasPrefix(err.Error(), "description must be at most")
+}
+
+// verifyEventCreator checks that the user created the event and writes the error response if not.
+// Routes behind RequireEventRole(EventRoleCreator) already loaded the event, so it is not queried again.
+func (h *EventHandler) verifyEventCreator(c *gin.Context, userID, eventID string) bool {
+	if access, ok := middleware.GetEventAccess(c); ok && access.Event.ID.String() == eventID && access.IsCreator() {
+		return true
+	}
+
+	err := h.eventService.VerifyEventCreator(userID, eventID)
+	if err == nil {
+		return true
+	}
+
+	switch err.Error() {
+	case "invalid event id", "invalid user id":
+		utils.BadRequestResponse(c, err.Error())
+	case "event not found", "permission denied":
+		respondEventNotFound(c)
+	default:
+		utils.InternalServerErrorResponse(c, "Failed to get event", err)
+	}
+	return false
+}
@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"net/http"
+
+	"TinderTrip-Backend/internal/api/middleware"
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ExpenseHandler handles event expense requests
+type ExpenseHandler struct {
+	expenseService *service.EventExpenseService
+}
+
+// NewExpenseHandler creates a new expense handler
+func NewExpenseHandler() *ExpenseHandler {
+	return &ExpenseHandler{
+		expenseService: service.NewEventExpenseService(),
+	}
+}
+
+// GetExpenses lists the expenses recorded for an event
+// @Summary Get event expenses
+// @Description List what members paid for the event and how each cost is split (confirmed members only). Amounts are in minor currency units.
+// @Tags expenses
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} dto.EventExpenseListResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/expenses [get]
+func (h *ExpenseHandler) GetExpenses(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	expenses, err := h.expenseService.ListExpenses(c.Param("id"), userID)
+	if err != nil {
+		respondExpenseError(c, err, "Failed to get expenses")
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Expenses retrieved successfully", expenses)
+}
+
+// AddExpense records a cost a member paid for the group
+// @Summary Add event expense
+// @Description Record a cost paid by a confirmed member (the caller unless payer_id is set), split evenly among split_among or, if omitted, every confirmed member. Amounts are in minor currency units.
+// @Tags expenses
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param request body dto.CreateEventExpenseRequest true "Expense"
+// @Success 201 {object} dto.EventExpenseResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/expenses [post]
+func (h *ExpenseHandler) AddExpense(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	var req dto.CreateEventExpenseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationErrorResponse(c, "Invalid request format", err.Error())
+		return
+	}
+
+	expense, err := h.expenseService.AddExpense(c.Param("id"), userID, req)
+	if err != nil {
+		respondExpenseError(c, err, "Failed to add expense")
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusCreated, "Expense added successfully", expense)
+}
+
+// DeleteExpense removes an expense
+// @Summary Delete event expense
+// @Description Remove an expense (its payer, the member who recorded it, or the event creator)
+// @Tags expenses
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param expense_id path string true "Expense ID"
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/expenses/{expense_id} [delete]
+func (h *ExpenseHandler) DeleteExpense(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	if err := h.expenseService.DeleteExpense(c.Param("id"), c.Param("expense_id"), userID); err != nil {
+		respondExpenseError(c, err, "Failed to delete expense")
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Expense deleted successfully", nil)
+}
+
+// GetExpenseSummary returns balances and settlements for an event
+// @Summary Get expense summary
+// @Description Get each member's paid, owed and net balance, and the payments that settle everyone up (confirmed members only). A positive balance means the member is owed money.
+// @Tags expenses
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} dto.ExpenseSummaryResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/expenses/summary [get]
+func (h *ExpenseHandler) GetExpenseSummary(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	summary, err := h.expenseService.GetSummary(c.Param("id"), userID)
+	if err != nil {
+		respondExpenseError(c, err, "Failed to get expense summary")
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Expense summary retrieved successfully", summary)
+}
+
+// respondExpenseError maps expense service errors to responses
+func respondExpenseError(c *gin.Context, err error, failure string) {
+	switch err.Error() {
+	case "invalid event ID", "invalid user ID", "invalid expense ID", "description is required",
+		"amount must be positive", "payer must be a confirmed member", "split members must be confirmed members":
+		utils.BadRequestResponse(c, err.Error())
+	case "event not found", "not a member":
+		respondEventNotFound(c)
+	case "not a confirmed member":
+		utils.ForbiddenResponse(c, "Only confirmed members can manage expenses")
+	case "not authorized":
+		utils.ForbiddenResponse(c, err.Error())
+	case "expense not found":
+		utils.NotFoundResponse(c, "Expense not found")
+	default:
+		utils.InternalServerErrorResponse(c, failure, err)
+	}
+}
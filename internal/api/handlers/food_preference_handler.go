@@ -6,6 +6,7 @@ import (
 	"TinderTrip-Backend/internal/api/middleware"
 	"TinderTrip-Backend/internal/dto"
 	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -37,8 +38,9 @@ func (h *FoodPreferenceHandler) GetFoodPreferences(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -47,8 +49,9 @@ func (h *FoodPreferenceHandler) GetFoodPreferences(c *gin.Context) {
 	preferences, err := h.foodPreferenceService.GetFoodPreferences(userID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to get food preferences",
-			Message: err.Error(),
+			Error:     "Failed to get food preferences",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -77,8 +80,9 @@ func (h *FoodPreferenceHandler) UpdateFoodPreference(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -87,8 +91,9 @@ func (h *FoodPreferenceHandler) UpdateFoodPreference(c *gin.Context) {
 	var req dto.UpdateFoodPreferenceRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid request",
-			Message: err.Error(),
+			Error:     "Invalid request",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -97,8 +102,9 @@ func (h *FoodPreferenceHandler) UpdateFoodPreference(c *gin.Context) {
 	err := h.foodPreferenceService.UpdateFoodPreference(userID, req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to update food preference",
-			Message: err.Error(),
+			Error:     "Failed to update food preference",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -127,8 +133,9 @@ func (h *FoodPreferenceHandler) UpdateAllFoodPreferences(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -137,8 +144,9 @@ func (h *FoodPreferenceHandler) UpdateAllFoodPreferences(c *gin.Context) {
 	var req dto.UpdateAllFoodPreferencesRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid request",
-			Message: err.Error(),
+			Error:     "Invalid request",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -147,8 +155,9 @@ func (h *FoodPreferenceHandler) UpdateAllFoodPreferences(c *gin.Context) {
 	err := h.foodPreferenceService.UpdateAllFoodPreferences(userID, req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to update food preferences",
-			Message: err.Error(),
+			Error:     "Failed to update food preferences",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -192,8 +201,9 @@ func (h *FoodPreferenceHandler) GetFoodPreferenceCategoriesWithUserPreferences(c
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -202,8 +212,9 @@ func (h *FoodPreferenceHandler) GetFoodPreferenceCategoriesWithUserPreferences(c
 	categories, err := h.foodPreferenceService.GetFoodPreferenceCategoriesWithUserPreferences(userID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to get food preference categories",
-			Message: err.Error(),
+			Error:     "Failed to get food preference categories",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -229,8 +240,9 @@ func (h *FoodPreferenceHandler) GetFoodPreferenceStats(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -239,8 +251,9 @@ func (h *FoodPreferenceHandler) GetFoodPreferenceStats(c *gin.Context) {
 	stats, err := h.foodPreferenceService.GetFoodPreferenceStats(userID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to get food preference stats",
-			Message: err.Error(),
+			Error:     "Failed to get food preference stats",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -266,8 +279,9 @@ func (h *FoodPreferenceHandler) DeleteFoodPreference(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -276,8 +290,9 @@ func (h *FoodPreferenceHandler) DeleteFoodPreference(c *gin.Context) {
 	foodCategory := c.Param("category")
 	if foodCategory == "" {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid food category",
-			Message: "Food category is required",
+			Error:     "Invalid food category",
+			Message:   "Food category is required",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -286,8 +301,9 @@ func (h *FoodPreferenceHandler) DeleteFoodPreference(c *gin.Context) {
 	err := h.foodPreferenceService.DeleteFoodPreference(userID, foodCategory)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to delete food preference",
-			Message: err.Error(),
+			Error:     "Failed to delete food preference",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
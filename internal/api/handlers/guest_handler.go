@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"net/http"
+
+	"TinderTrip-Backend/internal/api/middleware"
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GuestHandler handles guest browsing token requests
+type GuestHandler struct {
+	guestService *service.GuestService
+}
+
+// NewGuestHandler creates a new guest handler
+func NewGuestHandler() *GuestHandler {
+	return &GuestHandler{
+		guestService: service.NewGuestService(),
+	}
+}
+
+// IssueGuestToken issues a guest browsing token
+// @Summary Issue guest token
+// @Description Issue a token for browsing public endpoints without an account. Send it in the X-Guest-Token header for a higher rate limit than anonymous clients, and convert it after signing up to keep the events seen as a guest. Each IP can only get a few tokens per rate limit window.
+// @Tags auth
+// @Produce json
+// @Success 201 {object} dto.SuccessAPIResponse
+// @Failure 429 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /auth/guest [post]
+func (h *GuestHandler) IssueGuestToken(c *gin.Context) {
+	token, err := h.guestService.IssueGuestToken()
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to issue guest token", err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusCreated, "Guest token issued successfully", token)
+}
+
+// ConvertGuest carries a guest's browsing over to the signed-in user
+// @Summary Convert guest
+// @Description Carry the events seen with a guest token over to your account. The guest token stops working as a guest afterwards.
+// @Tags auth
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.ConvertGuestRequest true "Guest token"
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 409 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /auth/guest/convert [post]
+func (h *GuestHandler) ConvertGuest(c *gin.Context) {
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	var req dto.ConvertGuestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationErrorResponse(c, "Invalid request format", err.Error())
+		return
+	}
+
+	result, err := h.guestService.ConvertGuest(userID, req.GuestToken)
+	if err != nil {
+		switch err.Error() {
+		case "invalid user ID", "invalid guest token":
+			utils.BadRequestResponse(c, err.Error())
+		case "guest already converted":
+			utils.ConflictResponse(c, "Guest token has already been converted by another account")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to convert guest", err)
+		}
+		return
+	}
+
+	utils.SendSuccessResponse(c, "Guest converted successfully", result)
+}
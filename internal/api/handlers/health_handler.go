@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"sync/atomic"
+
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HealthHandler serves the readiness probe. The server reports not ready until it has finished
+// starting up, and again once it begins shutting down, so orchestrators only route traffic to
+// instances that can serve it.
+type HealthHandler struct {
+	ready atomic.Bool
+}
+
+// NewHealthHandler creates a health handler that starts out not ready
+func NewHealthHandler() *HealthHandler {
+	return &HealthHandler{}
+}
+
+// MarkReady reports the server ready to receive traffic
+func (h *HealthHandler) MarkReady() {
+	h.ready.Store(true)
+}
+
+// MarkNotReady stops reporting the server ready, e.g. while it shuts down
+func (h *HealthHandler) MarkNotReady() {
+	h.ready.Store(false)
+}
+
+// IsReady reports whether the server is ready to receive traffic
+func (h *HealthHandler) IsReady() bool {
+	return h.ready.Load()
+}
+
+// Ready reports whether the server is ready to receive traffic
+// @Summary Readiness probe
+// @Description Returns 200 once startup has completed and 503 while the server is starting or shutting down
+// @Tags health
+// @Produce json
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 503 {object} dto.ErrorAPIResponse
+// @Router /ready [get]
+func (h *HealthHandler) Ready(c *gin.Context) {
+	if !h.IsReady() {
+		utils.ServiceUnavailableResponse(c, "TinderTrip API is not ready")
+		return
+	}
+
+	utils.SendSuccessResponse(c, "TinderTrip API is ready", gin.H{
+		"status": "ready",
+	})
+}
@@ -38,11 +38,11 @@ func NewHistoryHandler() *HistoryHandler {
 func (h *HistoryHandler) GetHistory(c *gin.Context) {
 	// Get query parameters
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
 	completed := c.Query("completed")
 
 	// Validate pagination
-	page, limit = utils.ValidatePagination(page, limit)
+	page, limit = utils.ValidatePagination(utils.PaginationHistory, page, limit)
 
 	// Get user ID from context
 	userID, exists := middleware.GetCurrentUserID(c)
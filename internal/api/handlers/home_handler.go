@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"TinderTrip-Backend/internal/api/middleware"
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HomeHandler handles home feed requests
+type HomeHandler struct {
+	homeService *service.HomeService
+}
+
+// NewHomeHandler creates a new home handler
+func NewHomeHandler() *HomeHandler {
+	return &HomeHandler{
+		homeService: service.NewHomeService(),
+	}
+}
+
+// GetHomeFeed gets the composed home screen feed
+// @Summary Get home feed
+// @Description Get suggested events, upcoming joined events and trending events in one response. Each section is paginated on its own with the next_token it returns.
+// @Tags home
+// @Security BearerAuth
+// @Produce json
+// @Param limit query int false "Items per section"
+// @Param suggestions_token query string false "Next page token of the suggestions section"
+// @Param upcoming_token query string false "Next page token of the upcoming section"
+// @Param trending_token query string false "Next page token of the trending section"
+// @Success 200 {object} dto.HomeFeedResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /home [get]
+func (h *HomeHandler) GetHomeFeed(c *gin.Context) {
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	_, limit = utils.ValidatePagination(utils.PaginationHome, 1, limit)
+
+	tokens := dto.HomeFeedTokens{
+		Suggestions: c.Query("suggestions_token"),
+		Upcoming:    c.Query("upcoming_token"),
+		Trending:    c.Query("trending_token"),
+	}
+
+	feed, err := h.homeService.GetHomeFeed(userID, limit, tokens)
+	if err != nil {
+		if err.Error() == "invalid page token" {
+			utils.BadRequestResponse(c, "Invalid page token")
+			return
+		}
+		utils.InternalServerErrorResponse(c, "Failed to get home feed", err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Home feed retrieved successfully", feed)
+}
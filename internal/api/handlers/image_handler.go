@@ -55,8 +55,9 @@ func (h *ImageHandler) ServeAvatar(c *gin.Context) {
 	userID := c.Param("user_id")
 	if userID == "" {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid request",
-			Message: "User ID is required",
+			Error:     "Invalid request",
+			Message:   "User ID is required",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -65,8 +66,9 @@ func (h *ImageHandler) ServeAvatar(c *gin.Context) {
 	_, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "Authentication token is required",
+			Error:     "Unauthorized",
+			Message:   "Authentication token is required",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -75,8 +77,9 @@ func (h *ImageHandler) ServeAvatar(c *gin.Context) {
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid user ID",
-			Message: "User ID must be a valid UUID",
+			Error:     "Invalid user ID",
+			Message:   "User ID must be a valid UUID",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -87,16 +90,18 @@ func (h *ImageHandler) ServeAvatar(c *gin.Context) {
 	if err != nil {
 		utils.Logger().WithField("error", err).Error("Failed to get user profile for avatar serving")
 		c.JSON(http.StatusNotFound, dto.ErrorResponse{
-			Error:   "User not found",
-			Message: "User profile could not be retrieved",
+			Error:     "User not found",
+			Message:   "User profile could not be retrieved",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
 
 	if profile.AvatarURL == nil || *profile.AvatarURL == "" {
 		c.JSON(http.StatusNotFound, dto.ErrorResponse{
-			Error:   "Image not found",
-			Message: "User does not have an avatar",
+			Error:     "Image not found",
+			Message:   "User does not have an avatar",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -109,8 +114,9 @@ func (h *ImageHandler) ServeAvatar(c *gin.Context) {
 	if err != nil {
 		utils.Logger().WithField("error", err).Error("Failed to get image from key")
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Image retrieval failed",
-			Message: "Could not retrieve image data",
+			Error:     "Image retrieval failed",
+			Message:   "Could not retrieve image data",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -149,8 +155,9 @@ func (h *ImageHandler) ServeEventImage(c *gin.Context) {
 	eventID := c.Param("event_id")
 	if eventID == "" {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid request",
-			Message: "Event ID is required",
+			Error:     "Invalid request",
+			Message:   "Event ID is required",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -159,8 +166,9 @@ func (h *ImageHandler) ServeEventImage(c *gin.Context) {
 	_, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "Authentication token is required",
+			Error:     "Unauthorized",
+			Message:   "Authentication token is required",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -172,8 +180,9 @@ func (h *ImageHandler) ServeEventImage(c *gin.Context) {
 	eventUUID, err := uuid.Parse(eventID)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid event ID",
-			Message: "Event ID format is invalid",
+			Error:     "Invalid event ID",
+			Message:   "Event ID format is invalid",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -184,13 +193,15 @@ func (h *ImageHandler) ServeEventImage(c *gin.Context) {
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			c.JSON(http.StatusNotFound, dto.ErrorResponse{
-				Error:   "Event not found",
-				Message: "The requested event does not exist",
+				Error:     "Event not found",
+				Message:   "The requested event does not exist",
+				RequestID: c.GetString(utils.RequestIDKey),
 			})
 		} else {
 			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-				Error:   "Database error",
-				Message: "Failed to retrieve event information",
+				Error:     "Database error",
+				Message:   "Failed to retrieve event information",
+				RequestID: c.GetString(utils.RequestIDKey),
 			})
 		}
 		return
@@ -199,8 +210,9 @@ func (h *ImageHandler) ServeEventImage(c *gin.Context) {
 	// Check if event has cover image
 	if event.CoverImageURL == nil || *event.CoverImageURL == "" {
 		c.JSON(http.StatusNotFound, dto.ErrorResponse{
-			Error:   "Event image not found",
-			Message: "The requested event has no cover image",
+			Error:     "Event image not found",
+			Message:   "The requested event has no cover image",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -213,8 +225,9 @@ func (h *ImageHandler) ServeEventImage(c *gin.Context) {
 	if err != nil {
 		utils.Logger().WithField("error", err).WithField("event_id", eventID).WithField("url", coverURL).Error("Failed to get event image from storage")
 		c.JSON(http.StatusNotFound, dto.ErrorResponse{
-			Error:   "Event image not found",
-			Message: "The requested event image could not be found",
+			Error:     "Event image not found",
+			Message:   "The requested event image could not be found",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"net/http"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MetaHandler handles API metadata requests
+type MetaHandler struct {
+	metaService *service.MetaService
+}
+
+// NewMetaHandler creates a new meta handler
+func NewMetaHandler() *MetaHandler {
+	return &MetaHandler{
+		metaService: service.NewMetaService(),
+	}
+}
+
+// GetEnums gets all valid enum values with display labels
+// @Summary Get enum values
+// @Description Get the valid values and display labels of event types, statuses, member roles and statuses, tag kinds, genders, smoking options, swipe directions, travel styles and food categories. The locale is taken from lang, then Accept-Language (supported: en, th; default: en).
+// @Tags meta
+// @Produce json
+// @Param lang query string false "Label locale (en or th)"
+// @Param Accept-Language header string false "Preferred label locale"
+// @Success 200 {object} dto.EnumsResponseWrapper
+// @Router /meta/enums [get]
+func (h *MetaHandler) GetEnums(c *gin.Context) {
+	locale := service.ResolveLocale(c.Query("lang"), c.GetHeader("Accept-Language"))
+
+	// Enums only change with a deploy, so let clients and proxies cache them
+	c.Header("Cache-Control", "public, max-age=3600")
+	c.Header("Vary", "Accept-Language")
+
+	utils.SuccessResponse(c, http.StatusOK, "Enums retrieved successfully", h.metaService.GetEnums(locale))
+}
+
+// GetExamples gets sample payloads of the key response DTOs
+// @Summary Get DTO examples
+// @Description Get example instances of key response DTOs (EventResponse, AuthResponseWrapper, ...) for client generation and contract tests. Pass type to get a single DTO. Not served in release mode.
+// @Tags development
+// @Produce json
+// @Param type query string false "DTO type name, e.g. EventResponse"
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Router /dev/examples [get]
+func (h *MetaHandler) GetExamples(c *gin.Context) {
+	examples := dto.Examples()
+
+	if name := c.Query("type"); name != "" {
+		example, ok := examples[name]
+		if !ok {
+			utils.NotFoundResponse(c, "Unknown example type: "+name)
+			return
+		}
+		utils.SuccessResponse(c, http.StatusOK, "Example retrieved successfully", example)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Examples retrieved successfully", examples)
+}
@@ -0,0 +1,275 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"TinderTrip-Backend/internal/api/middleware"
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NotificationHandler handles the current user's notification requests
+type NotificationHandler struct {
+	notificationService *service.NotificationService
+}
+
+// NewNotificationHandler creates a new notification handler
+func NewNotificationHandler() *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: service.NewNotificationService(),
+	}
+}
+
+// GetNotifications lists the caller's notifications
+// @Summary Get notifications
+// @Description Get your notifications, newest first, including each notification's data. Pages by cursor: pass the previous response's next_cursor to get the next page.
+// @Tags notifications
+// @Security BearerAuth
+// @Produce json
+// @Param cursor query string false "Cursor from a previous next_cursor; omit for the first page"
+// @Param limit query int false "Items per page"
+// @Param read query bool false "Only read (true) or unread (false) notifications"
+// @Success 200 {object} utils.APIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /notifications [get]
+func (h *NotificationHandler) GetNotifications(c *gin.Context) {
+	// Get query parameters
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	// Validate pagination
+	_, limit = utils.ValidatePagination(utils.PaginationNotifications, 1, limit)
+
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	// Parse read filter
+	var readFilter *bool
+	switch c.Query("read") {
+	case "":
+	case "true":
+		readFilter = &[]bool{true}[0]
+	case "false":
+		readFilter = &[]bool{false}[0]
+	default:
+		utils.BadRequestResponse(c, "read must be true or false")
+		return
+	}
+
+	cursor, err := utils.DecodeCursor(c.Query("cursor"))
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid cursor")
+		return
+	}
+
+	notifications, nextCursor, err := h.notificationService.GetNotificationsByCursor(userID, cursor, limit, readFilter)
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to get notifications", err)
+		return
+	}
+
+	utils.CursorResponse(c, "Notifications retrieved successfully", notifications, limit, nextCursor)
+}
+
+// GetUnreadCount gets how many unread notifications the caller has
+// @Summary Get unread notification count
+// @Description Get the number of your unread notifications, e.g. for a badge
+// @Tags notifications
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /notifications/unread-count [get]
+func (h *NotificationHandler) GetUnreadCount(c *gin.Context) {
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	count, err := h.notificationService.GetUnreadCount(userID)
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to count unread notifications", err)
+		return
+	}
+
+	utils.SendSuccessResponse(c, "Unread count retrieved successfully", dto.UnreadNotificationCountResponse{
+		Unread: count,
+	})
+}
+
+// MarkNotificationRead marks one of the caller's notifications as read
+// @Summary Mark notification as read
+// @Description Mark one of your notifications as read. Marking a read notification again keeps its read_at.
+// @Tags notifications
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Notification ID"
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /notifications/{id}/read [patch]
+func (h *NotificationHandler) MarkNotificationRead(c *gin.Context) {
+	notificationID := c.Param("id")
+
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	notification, err := h.notificationService.MarkNotificationRead(userID, notificationID)
+	if err != nil {
+		switch err.Error() {
+		case "invalid notification ID", "invalid user ID":
+			utils.BadRequestResponse(c, err.Error())
+		case "notification not found":
+			utils.NotFoundResponse(c, "Notification not found")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to mark notification as read", err)
+		}
+		return
+	}
+
+	utils.SendSuccessResponse(c, "Notification marked as read", notification)
+}
+
+// MarkAllNotificationsRead marks all of the caller's notifications as read
+// @Summary Mark all notifications as read
+// @Description Mark all of your unread notifications as read
+// @Tags notifications
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /notifications/read-all [post]
+func (h *NotificationHandler) MarkAllNotificationsRead(c *gin.Context) {
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	updated, err := h.notificationService.MarkAllNotificationsRead(userID)
+	if err != nil {
+		switch err.Error() {
+		case "invalid user ID":
+			utils.BadRequestResponse(c, err.Error())
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to mark notifications as read", err)
+		}
+		return
+	}
+
+	utils.SendSuccessResponse(c, "Notifications marked as read", dto.MarkAllNotificationsReadResponse{
+		Updated: updated,
+	})
+}
+
+// MarkEventNotificationsRead marks the caller's notifications about an event as read
+// @Summary Mark event notifications as read
+// @Description Mark all of your unread notifications about the event as read, e.g. when opening the event
+// @Tags notifications
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /events/{id}/notifications/read [post]
+func (h *NotificationHandler) MarkEventNotificationsRead(c *gin.Context) {
+	eventID := c.Param("id")
+
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	updated, err := h.notificationService.MarkEventNotificationsRead(userID, eventID)
+	if err != nil {
+		switch err.Error() {
+		case "invalid event ID", "invalid user ID":
+			utils.BadRequestResponse(c, err.Error())
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to mark notifications as read", err)
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Notifications marked as read", dto.MarkEventNotificationsReadResponse{
+		EventID: eventID,
+		Updated: updated,
+	})
+}
+
+// SnoozeNotification snoozes an event reminder
+// @Summary Snooze reminder
+// @Description Mark an event reminder as handled and get it again after the chosen delay (5 to 1440 minutes). Only event reminders can be snoozed, once each, and the delay must end before the event starts.
+// @Tags notifications
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Notification ID"
+// @Param request body dto.SnoozeNotificationRequest true "Delay in minutes"
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 409 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /notifications/{id}/snooze [post]
+func (h *NotificationHandler) SnoozeNotification(c *gin.Context) {
+	notificationID := c.Param("id")
+
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	var req dto.SnoozeNotificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationErrorResponse(c, "Invalid request format", err.Error())
+		return
+	}
+
+	snooze, err := h.notificationService.SnoozeNotification(userID, notificationID, time.Duration(req.Minutes)*time.Minute)
+	if err != nil {
+		switch err.Error() {
+		case "invalid notification ID", "invalid user ID", "notification cannot be snoozed",
+			"event is no longer upcoming", "snooze ends after the event starts":
+			utils.BadRequestResponse(c, err.Error())
+		case "notification not found":
+			utils.NotFoundResponse(c, "Notification not found")
+		case "notification already snoozed":
+			utils.ConflictResponse(c, "Notification already snoozed")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to snooze notification", err)
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Reminder snoozed", snooze)
+}
@@ -1,9 +1,12 @@
 package handlers
 
 import (
+	"strings"
+	"sync"
 	"time"
 
 	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
 	"TinderTrip-Backend/internal/utils"
 	"TinderTrip-Backend/pkg/database"
 
@@ -11,17 +14,46 @@ import (
 )
 
 // OTPHandler handles OTP monitoring requests
-type OTPHandler struct{}
+type OTPHandler struct {
+	mu     sync.Mutex
+	issued map[string]issuedOTP // latest plaintext email verification OTP per email
+}
+
+// issuedOTP is an email verification OTP seen since startup
+type issuedOTP struct {
+	otp       string
+	expiresAt time.Time
+}
 
-// NewOTPHandler creates a new OTP handler
+// NewOTPHandler creates a new OTP handler.
+// OTPs are stored hashed, so outside release mode the handler listens for codes as they
+// are issued to be able to show them; in release mode codes are never shown.
 func NewOTPHandler() *OTPHandler {
-	return &OTPHandler{}
+	handler := &OTPHandler{issued: make(map[string]issuedOTP)}
+	if gin.Mode() != gin.ReleaseMode {
+		service.SetEmailVerificationObserver(handler.recordOTP)
+	}
+	return handler
+}
+
+// recordOTP remembers the latest email verification OTP issued to an email and forgets
+// the ones that have expired
+func (h *OTPHandler) recordOTP(email, otp string, expiresAt time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	now := time.Now()
+	for key, issued := range h.issued {
+		if !issued.expiresAt.After(now) {
+			delete(h.issued, key)
+		}
+	}
+	h.issued[strings.ToLower(email)] = issuedOTP{otp: otp, expiresAt: expiresAt}
 }
 
 // OTPInfo represents OTP information
 type OTPInfo struct {
 	Email     string    `json:"email"`
-	OTP       string    `json:"otp"`
+	OTP       string    `json:"otp,omitempty"` // only known for codes issued since startup, outside release mode
 	ExpiresAt time.Time `json:"expires_at"`
 	CreatedAt time.Time `json:"created_at"`
 }
@@ -47,11 +79,13 @@ func (h *OTPHandler) GetOTPs(c *gin.Context) {
 	}
 
 	// Format response
+	h.mu.Lock()
+	defer h.mu.Unlock()
 	var otps []OTPInfo
 	for _, ev := range emailVerifications {
 		otps = append(otps, OTPInfo{
 			Email:     ev.Email,
-			OTP:       ev.OTP,
+			OTP:       h.issued[strings.ToLower(ev.Email)].otp,
 			ExpiresAt: ev.ExpiresAt,
 			CreatedAt: ev.CreatedAt,
 		})
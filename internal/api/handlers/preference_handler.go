@@ -6,6 +6,7 @@ import (
 	"TinderTrip-Backend/internal/api/middleware"
 	"TinderTrip-Backend/internal/dto"
 	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -37,8 +38,9 @@ func (h *PreferenceHandler) GetAvailability(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -47,8 +49,9 @@ func (h *PreferenceHandler) GetAvailability(c *gin.Context) {
 	availability, err := h.preferenceService.GetAvailability(userID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Availability not found",
-			Message: err.Error(),
+			Error:     "Availability not found",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -74,8 +77,9 @@ func (h *PreferenceHandler) UpdateAvailability(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -83,8 +87,9 @@ func (h *PreferenceHandler) UpdateAvailability(c *gin.Context) {
 	var req dto.UpdatePrefAvailabilityRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid request",
-			Message: err.Error(),
+			Error:     "Invalid request",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -93,8 +98,9 @@ func (h *PreferenceHandler) UpdateAvailability(c *gin.Context) {
 	availability, err := h.preferenceService.UpdateAvailability(userID, req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Update failed",
-			Message: err.Error(),
+			Error:     "Update failed",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -117,8 +123,9 @@ func (h *PreferenceHandler) GetBudget(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -127,8 +134,9 @@ func (h *PreferenceHandler) GetBudget(c *gin.Context) {
 	budget, err := h.preferenceService.GetBudget(userID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Budget not found",
-			Message: err.Error(),
+			Error:     "Budget not found",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -154,8 +162,9 @@ func (h *PreferenceHandler) UpdateBudget(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -163,8 +172,9 @@ func (h *PreferenceHandler) UpdateBudget(c *gin.Context) {
 	var req dto.UpdatePrefBudgetRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid request",
-			Message: err.Error(),
+			Error:     "Invalid request",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -173,8 +183,9 @@ func (h *PreferenceHandler) UpdateBudget(c *gin.Context) {
 	budget, err := h.preferenceService.UpdateBudget(userID, req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Update failed",
-			Message: err.Error(),
+			Error:     "Update failed",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
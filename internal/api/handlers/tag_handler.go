@@ -38,11 +38,11 @@ func NewTagHandler() *TagHandler {
 func (h *TagHandler) GetTags(c *gin.Context) {
 	// Get query parameters
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
 	kind := c.Query("kind")
 
 	// Validate pagination
-	page, limit = utils.ValidatePagination(page, limit)
+	page, limit = utils.ValidatePagination(utils.PaginationTags, page, limit)
 
 	// Get tags
 	tags, total, err := h.tagService.GetTags(page, limit, kind)
@@ -55,12 +55,40 @@ func (h *TagHandler) GetTags(c *gin.Context) {
 	utils.PaginatedResponse(c, "Tags retrieved successfully", tags, int64(total), page, limit)
 }
 
+// GetOnboardingTags suggests tags for the signup flow
+// @Summary Get suggested onboarding tags
+// @Description Get popular tags grouped by kind for new users to pick from. Tags matching the optional comma separated interests are marked and listed first.
+// @Tags tags
+// @Security BearerAuth
+// @Produce json
+// @Param interests query string false "Comma separated free-text interests, e.g. hiking,street food"
+// @Param limit query int false "Maximum tags per kind"
+// @Success 200 {object} dto.OnboardingTagsResponseWrapper
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /onboarding/suggested-tags [get]
+func (h *TagHandler) GetOnboardingTags(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	_, limit = utils.ValidatePagination(utils.PaginationOnboarding, 1, limit)
+
+	suggestions, err := h.tagService.GetOnboardingTags(c.Query("interests"), limit)
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to get suggested tags", err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Suggested tags retrieved successfully", suggestions)
+}
+
 // GetUserTags gets user's tags
 // @Summary Get user tags
-// @Description Get tags associated with the current user
+// @Description Get tags associated with the current user. All tags are returned unless page or limit is given.
 // @Tags tags
 // @Security BearerAuth
 // @Produce json
+// @Param kind query string false "Filter by tag kind (interest, category, activity, location, food, transport, accommodation)"
+// @Param page query int false "Page number"
+// @Param limit query int false "Items per page"
 // @Success 200 {object} dto.UserTagListResponse
 // @Failure 401 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
@@ -70,26 +98,36 @@ func (h *TagHandler) GetUserTags(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
 
 	// Get user tags
-	tags, err := h.tagService.GetUserTags(userID)
+	page, limit := optionalTagPage(c)
+	tags, total, err := h.tagService.GetUserTags(userID, page, limit, c.Query("kind"))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to get user tags",
-			Message: err.Error(),
+			Error:     "Failed to get user tags",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
 
 	// Send response
-	c.JSON(http.StatusOK, dto.UserTagListResponse{
-		Tags: tags,
-	})
+	response := dto.UserTagListResponse{
+		Tags:  tags,
+		Total: total,
+	}
+	if limit > 0 {
+		response.Page = page
+		response.Limit = limit
+		response.TotalPages = int((total + int64(limit) - 1) / int64(limit))
+	}
+	c.JSON(http.StatusOK, response)
 }
 
 // AddUserTag adds a tag to user
@@ -110,8 +148,9 @@ func (h *TagHandler) AddUserTag(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -120,8 +159,9 @@ func (h *TagHandler) AddUserTag(c *gin.Context) {
 	var req dto.AddUserTagRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid request",
-			Message: err.Error(),
+			Error:     "Invalid request",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -131,22 +171,25 @@ func (h *TagHandler) AddUserTag(c *gin.Context) {
 	if err != nil {
 		if err.Error() == "tag not found" {
 			c.JSON(http.StatusNotFound, dto.ErrorResponse{
-				Error:   "Tag not found",
-				Message: err.Error(),
+				Error:     "Tag not found",
+				Message:   err.Error(),
+				RequestID: c.GetString(utils.RequestIDKey),
 			})
 			return
 		}
 		if err.Error() == "tag already exists" {
 			c.JSON(http.StatusConflict, dto.ErrorResponse{
-				Error:   "Tag already exists",
-				Message: "This tag is already associated with the user",
+				Error:     "Tag already exists",
+				Message:   "This tag is already associated with the user",
+				RequestID: c.GetString(utils.RequestIDKey),
 			})
 			return
 		}
 
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to add user tag",
-			Message: err.Error(),
+			Error:     "Failed to add user tag",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -157,6 +200,112 @@ func (h *TagHandler) AddUserTag(c *gin.Context) {
 	})
 }
 
+// GetInterestNoteTags suggests tags named in the user's interests note
+// @Summary Get tags from interests note
+// @Description Get existing tags whose names appear in the current user's profile interests note and are not yet picked. Nothing is saved.
+// @Tags tags
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} dto.UserTagListResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /users/tags/from-interests [get]
+func (h *TagHandler) GetInterestNoteTags(c *gin.Context) {
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
+		})
+		return
+	}
+
+	tags, err := h.tagService.GetInterestNoteTags(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:     "Failed to match interests",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.UserTagListResponse{
+		Tags:  tags,
+		Total: int64(len(tags)),
+	})
+}
+
+// AddInterestNoteTags adds confirmed tags from the user's interests note
+// @Summary Add tags from interests note
+// @Description Add the confirmed tags to the current user. Each ID must be one currently suggested by GET /users/tags/from-interests.
+// @Tags tags
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.AddInterestNoteTagsRequest true "Confirmed tag IDs"
+// @Success 200 {object} dto.UserTagListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /users/tags/from-interests [post]
+func (h *TagHandler) AddInterestNoteTags(c *gin.Context) {
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
+		})
+		return
+	}
+
+	// Parse request
+	var req dto.AddInterestNoteTagsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:     "Invalid request",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
+		})
+		return
+	}
+
+	tags, err := h.tagService.AddInterestNoteTags(userID, req.TagIDs)
+	if err != nil {
+		switch err.Error() {
+		case "invalid tag ID":
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:     "Invalid tag ID",
+				Message:   err.Error(),
+				RequestID: c.GetString(utils.RequestIDKey),
+			})
+		case "tag not suggested":
+			c.JSON(http.StatusUnprocessableEntity, dto.ErrorResponse{
+				Error:     "Tag not suggested",
+				Message:   "Only tags suggested from your interests note can be added here",
+				RequestID: c.GetString(utils.RequestIDKey),
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+				Error:     "Failed to add user tags",
+				Message:   err.Error(),
+				RequestID: c.GetString(utils.RequestIDKey),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.UserTagListResponse{
+		Tags:  tags,
+		Total: int64(len(tags)),
+	})
+}
+
 // RemoveUserTag removes a tag from user
 // @Summary Remove user tag
 // @Description Remove a tag from the current user's interests
@@ -175,8 +324,9 @@ func (h *TagHandler) RemoveUserTag(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -185,8 +335,9 @@ func (h *TagHandler) RemoveUserTag(c *gin.Context) {
 	tagID := c.Param("tag_id")
 	if tagID == "" {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid tag ID",
-			Message: "Tag ID is required",
+			Error:     "Invalid tag ID",
+			Message:   "Tag ID is required",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -196,15 +347,17 @@ func (h *TagHandler) RemoveUserTag(c *gin.Context) {
 	if err != nil {
 		if err.Error() == "tag not found" {
 			c.JSON(http.StatusNotFound, dto.ErrorResponse{
-				Error:   "Tag not found",
-				Message: err.Error(),
+				Error:     "Tag not found",
+				Message:   err.Error(),
+				RequestID: c.GetString(utils.RequestIDKey),
 			})
 			return
 		}
 
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to remove user tag",
-			Message: err.Error(),
+			Error:     "Failed to remove user tag",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -215,12 +368,63 @@ func (h *TagHandler) RemoveUserTag(c *gin.Context) {
 	})
 }
 
+// SuggestEventTags suggests tags for a draft event
+// @Summary Suggest event tags
+// @Description Get existing tags whose names appear in a draft event's title or description, for the creator to add as tag_ids. Category tags are not suggested. Nothing is saved.
+// @Tags tags
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.EventTagSuggestionsRequest true "Draft title and description"
+// @Success 200 {object} dto.EventTagListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /events/tag-suggestions [post]
+func (h *TagHandler) SuggestEventTags(c *gin.Context) {
+	// Parse request
+	var req dto.EventTagSuggestionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:     "Invalid request",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
+		})
+		return
+	}
+
+	tags, err := h.tagService.SuggestEventTags(req.Title, req.Description)
+	if err != nil {
+		if isEventTextError(err) {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:     "Invalid request",
+				Message:   err.Error(),
+				RequestID: c.GetString(utils.RequestIDKey),
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:     "Failed to suggest tags",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.EventTagListResponse{
+		Tags:  tags,
+		Total: int64(len(tags)),
+	})
+}
+
 // GetEventTags gets event's tags
 // @Summary Get event tags
-// @Description Get tags associated with an event
+// @Description Get tags associated with an event. All tags are returned unless page or limit is given.
 // @Tags tags
 // @Produce json
 // @Param id path string true "Event ID"
+// @Param kind query string false "Filter by tag kind (interest, category, activity, location, food, transport, accommodation)"
+// @Param page query int false "Page number"
+// @Param limit query int false "Items per page"
 // @Success 200 {object} dto.EventTagListResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
@@ -231,34 +435,57 @@ func (h *TagHandler) GetEventTags(c *gin.Context) {
 	eventID := c.Param("id")
 	if eventID == "" {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid event ID",
-			Message: "Event ID is required",
+			Error:     "Invalid event ID",
+			Message:   "Event ID is required",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
 
 	// Get event tags
-	tags, err := h.tagService.GetEventTags(eventID)
+	page, limit := optionalTagPage(c)
+	tags, total, err := h.tagService.GetEventTags(eventID, page, limit, c.Query("kind"))
 	if err != nil {
 		if err.Error() == "event not found" {
 			c.JSON(http.StatusNotFound, dto.ErrorResponse{
-				Error:   "Event not found",
-				Message: err.Error(),
+				Error:     "Event not found",
+				Message:   err.Error(),
+				RequestID: c.GetString(utils.RequestIDKey),
 			})
 			return
 		}
 
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to get event tags",
-			Message: err.Error(),
+			Error:     "Failed to get event tags",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
 
 	// Send response
-	c.JSON(http.StatusOK, dto.EventTagListResponse{
-		Tags: tags,
-	})
+	response := dto.EventTagListResponse{
+		Tags:  tags,
+		Total: total,
+	}
+	if limit > 0 {
+		response.Page = page
+		response.Limit = limit
+		response.TotalPages = int((total + int64(limit) - 1) / int64(limit))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// optionalTagPage reads page and limit for tag lists that return everything by default.
+// A limit of 0 means no pagination was requested.
+func optionalTagPage(c *gin.Context) (int, int) {
+	if c.Query("page") == "" && c.Query("limit") == "" {
+		return 1, 0
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	return utils.ValidatePagination(utils.PaginationTags, page, limit)
 }
 
 // AddEventTag adds a tag to event
@@ -273,7 +500,6 @@ func (h *TagHandler) GetEventTags(c *gin.Context) {
 // @Success 200 {object} dto.SuccessResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 401 {object} dto.ErrorResponse
-// @Failure 403 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /events/{id}/tags [post]
@@ -282,8 +508,9 @@ func (h *TagHandler) AddEventTag(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -292,8 +519,9 @@ func (h *TagHandler) AddEventTag(c *gin.Context) {
 	eventID := c.Param("id")
 	if eventID == "" {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid event ID",
-			Message: "Event ID is required",
+			Error:     "Invalid event ID",
+			Message:   "Event ID is required",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -302,8 +530,9 @@ func (h *TagHandler) AddEventTag(c *gin.Context) {
 	var req dto.AddEventTagRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid request",
-			Message: err.Error(),
+			Error:     "Invalid request",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -311,38 +540,36 @@ func (h *TagHandler) AddEventTag(c *gin.Context) {
 	// Add event tag
 	err := h.tagService.AddEventTag(eventID, req.TagID, userID)
 	if err != nil {
-		if err.Error() == "event not found" {
+		// Non-creators get the same response as for a missing event
+		if err.Error() == "event not found" || err.Error() == "not authorized" {
 			c.JSON(http.StatusNotFound, dto.ErrorResponse{
-				Error:   "Event not found",
-				Message: err.Error(),
+				Error:     "Event not found",
+				Message:   "event not found",
+				RequestID: c.GetString(utils.RequestIDKey),
 			})
 			return
 		}
 		if err.Error() == "tag not found" {
 			c.JSON(http.StatusNotFound, dto.ErrorResponse{
-				Error:   "Tag not found",
-				Message: err.Error(),
-			})
-			return
-		}
-		if err.Error() == "not authorized" {
-			c.JSON(http.StatusForbidden, dto.ErrorResponse{
-				Error:   "Not authorized",
-				Message: "Only the event creator can add tags",
+				Error:     "Tag not found",
+				Message:   err.Error(),
+				RequestID: c.GetString(utils.RequestIDKey),
 			})
 			return
 		}
 		if err.Error() == "tag already exists" {
 			c.JSON(http.StatusConflict, dto.ErrorResponse{
-				Error:   "Tag already exists",
-				Message: "This tag is already associated with the event",
+				Error:     "Tag already exists",
+				Message:   "This tag is already associated with the event",
+				RequestID: c.GetString(utils.RequestIDKey),
 			})
 			return
 		}
 
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to add event tag",
-			Message: err.Error(),
+			Error:     "Failed to add event tag",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -364,7 +591,6 @@ func (h *TagHandler) AddEventTag(c *gin.Context) {
 // @Success 200 {object} dto.SuccessResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 401 {object} dto.ErrorResponse
-// @Failure 403 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /events/{id}/tags/{tag_id} [delete]
@@ -373,8 +599,9 @@ func (h *TagHandler) RemoveEventTag(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -384,8 +611,9 @@ func (h *TagHandler) RemoveEventTag(c *gin.Context) {
 	tagID := c.Param("tag_id")
 	if eventID == "" || tagID == "" {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid parameters",
-			Message: "Event ID and Tag ID are required",
+			Error:     "Invalid parameters",
+			Message:   "Event ID and Tag ID are required",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -393,31 +621,28 @@ func (h *TagHandler) RemoveEventTag(c *gin.Context) {
 	// Remove event tag
 	err := h.tagService.RemoveEventTag(eventID, tagID, userID)
 	if err != nil {
-		if err.Error() == "event not found" {
+		// Non-creators get the same response as for a missing event
+		if err.Error() == "event not found" || err.Error() == "not authorized" {
 			c.JSON(http.StatusNotFound, dto.ErrorResponse{
-				Error:   "Event not found",
-				Message: err.Error(),
+				Error:     "Event not found",
+				Message:   "event not found",
+				RequestID: c.GetString(utils.RequestIDKey),
 			})
 			return
 		}
 		if err.Error() == "tag not found" {
 			c.JSON(http.StatusNotFound, dto.ErrorResponse{
-				Error:   "Tag not found",
-				Message: err.Error(),
-			})
-			return
-		}
-		if err.Error() == "not authorized" {
-			c.JSON(http.StatusForbidden, dto.ErrorResponse{
-				Error:   "Not authorized",
-				Message: "Only the event creator can remove tags",
+				Error:     "Tag not found",
+				Message:   err.Error(),
+				RequestID: c.GetString(utils.RequestIDKey),
 			})
 			return
 		}
 
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to remove event tag",
-			Message: err.Error(),
+			Error:     "Failed to remove event tag",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -6,6 +6,7 @@ import (
 	"TinderTrip-Backend/internal/api/middleware"
 	"TinderTrip-Backend/internal/dto"
 	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -37,8 +38,9 @@ func (h *TravelPreferenceHandler) GetTravelPreferences(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -47,8 +49,9 @@ func (h *TravelPreferenceHandler) GetTravelPreferences(c *gin.Context) {
 	preferences, err := h.travelPreferenceService.GetTravelPreferences(userID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to get travel preferences",
-			Message: err.Error(),
+			Error:     "Failed to get travel preferences",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -78,8 +81,9 @@ func (h *TravelPreferenceHandler) AddTravelPreference(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -88,8 +92,9 @@ func (h *TravelPreferenceHandler) AddTravelPreference(c *gin.Context) {
 	var req dto.AddTravelPreferenceRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid request",
-			Message: err.Error(),
+			Error:     "Invalid request",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -99,15 +104,17 @@ func (h *TravelPreferenceHandler) AddTravelPreference(c *gin.Context) {
 	if err != nil {
 		if err.Error() == "travel preference already exists" {
 			c.JSON(http.StatusConflict, dto.ErrorResponse{
-				Error:   "Travel preference already exists",
-				Message: err.Error(),
+				Error:     "Travel preference already exists",
+				Message:   err.Error(),
+				RequestID: c.GetString(utils.RequestIDKey),
 			})
 			return
 		}
 
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to add travel preference",
-			Message: err.Error(),
+			Error:     "Failed to add travel preference",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -136,8 +143,9 @@ func (h *TravelPreferenceHandler) UpdateAllTravelPreferences(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -146,8 +154,9 @@ func (h *TravelPreferenceHandler) UpdateAllTravelPreferences(c *gin.Context) {
 	var req dto.UpdateAllTravelPreferencesRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid request",
-			Message: err.Error(),
+			Error:     "Invalid request",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -156,8 +165,9 @@ func (h *TravelPreferenceHandler) UpdateAllTravelPreferences(c *gin.Context) {
 	err := h.travelPreferenceService.UpdateAllTravelPreferences(userID, req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to update travel preferences",
-			Message: err.Error(),
+			Error:     "Failed to update travel preferences",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -201,8 +211,9 @@ func (h *TravelPreferenceHandler) GetTravelPreferenceStylesWithUserPreferences(c
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -211,8 +222,9 @@ func (h *TravelPreferenceHandler) GetTravelPreferenceStylesWithUserPreferences(c
 	styles, err := h.travelPreferenceService.GetTravelPreferenceStylesWithUserPreferences(userID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to get travel preference styles",
-			Message: err.Error(),
+			Error:     "Failed to get travel preference styles",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -238,8 +250,9 @@ func (h *TravelPreferenceHandler) GetTravelPreferenceStats(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -248,8 +261,9 @@ func (h *TravelPreferenceHandler) GetTravelPreferenceStats(c *gin.Context) {
 	stats, err := h.travelPreferenceService.GetTravelPreferenceStats(userID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to get travel preference stats",
-			Message: err.Error(),
+			Error:     "Failed to get travel preference stats",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -275,8 +289,9 @@ func (h *TravelPreferenceHandler) DeleteTravelPreference(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Unauthorized",
-			Message: "User not authenticated",
+			Error:     "Unauthorized",
+			Message:   "User not authenticated",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -285,8 +300,9 @@ func (h *TravelPreferenceHandler) DeleteTravelPreference(c *gin.Context) {
 	travelStyle := c.Param("style")
 	if travelStyle == "" {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid travel style",
-			Message: "Travel style is required",
+			Error:     "Invalid travel style",
+			Message:   "Travel style is required",
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -295,8 +311,9 @@ func (h *TravelPreferenceHandler) DeleteTravelPreference(c *gin.Context) {
 	err := h.travelPreferenceService.DeleteTravelPreference(userID, travelStyle)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to delete travel preference",
-			Message: err.Error(),
+			Error:     "Failed to delete travel preference",
+			Message:   err.Error(),
+			RequestID: c.GetString(utils.RequestIDKey),
 		})
 		return
 	}
@@ -18,13 +18,21 @@ import (
 
 // UserHandler handles user-related requests
 type UserHandler struct {
-	userService *service.UserService
+	userService        *service.UserService
+	phoneService       *service.PhoneService
+	reliabilityService *service.ReliabilityService
+	eventService       *service.EventService
+	deviceService      *service.DeviceService
 }
 
 // NewUserHandler creates a new user handler
 func NewUserHandler() *UserHandler {
 	return &UserHandler{
-		userService: service.NewUserService(),
+		userService:        service.NewUserService(),
+		phoneService:       service.NewPhoneService(),
+		reliabilityService: service.NewReliabilityService(),
+		eventService:       service.NewEventService(),
+		deviceService:      service.NewDeviceService(),
 	}
 }
 
@@ -67,6 +75,7 @@ func (h *UserHandler) GetProfile(c *gin.Context) {
 // @Success 200 {object} dto.UserProfileResponseWrapper
 // @Failure 400 {object} dto.ErrorAPIResponse
 // @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 409 {object} dto.ErrorAPIResponse
 // @Failure 500 {object} dto.ErrorAPIResponse
 // @Router /users/profile [put]
 // UpdateProfile handles both JSON and multipart form.
@@ -208,6 +217,10 @@ func updateProfileJSON(h *UserHandler, c *gin.Context, userID string) {
 	// Update profile
 	profile, err := h.userService.UpdateProfile(userID, req)
 	if err != nil {
+		if err.Error() == "display name already taken" {
+			utils.ConflictResponse(c, "Display name already taken. Please choose a different name.")
+			return
+		}
 		utils.InternalServerErrorResponse(c, "Update failed", err)
 		return
 	}
@@ -316,20 +329,25 @@ func updateProfileMultipart(h *UserHandler, c *gin.Context, userID string) {
 
 	profile, err := h.userService.UpdateProfile(userID, req)
 	if err != nil {
+		if err.Error() == "display name already taken" {
+			utils.ConflictResponse(c, "Display name already taken. Please choose a different name.")
+			return
+		}
 		utils.InternalServerErrorResponse(c, "Update failed", err)
 		return
 	}
 	utils.SuccessResponse(c, http.StatusOK, "Profile updated successfully", profile)
 }
 
-// DeleteProfile deletes user profile
-// @Summary Delete user profile
-// @Description Delete current user's profile (soft delete)
+// DeleteProfile deletes the current user's account
+// @Summary Delete account
+// @Description Delete current user's profile and account (soft delete). The deletion is audited, every session is signed out and a confirmation email is sent.
 // @Tags users
 // @Security BearerAuth
 // @Produce json
 // @Success 200 {object} dto.SuccessMessageWrapper
 // @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
 // @Failure 500 {object} dto.ErrorAPIResponse
 // @Router /users/profile [delete]
 func (h *UserHandler) DeleteProfile(c *gin.Context) {
@@ -340,13 +358,24 @@ func (h *UserHandler) DeleteProfile(c *gin.Context) {
 		return
 	}
 
-	// Delete profile
+	// Delete profile and account
 	err := h.userService.DeleteProfile(userID)
 	if err != nil {
+		if err.Error() == "user not found" {
+			utils.NotFoundResponse(c, "User not found")
+			return
+		}
 		utils.InternalServerErrorResponse(c, "Delete failed", err)
 		return
 	}
 
+	// The refresh tokens are revoked; this access token stops working too
+	if token, err := utils.ExtractTokenFromHeader(c.GetHeader("Authorization")); err == nil {
+		if claims, err := utils.ValidateToken(token); err == nil {
+			revokeAccessToken(c, claims)
+		}
+	}
+
 	utils.SendSuccessResponse(c, "Profile deleted successfully", nil)
 }
 
@@ -379,3 +408,331 @@ func (h *UserHandler) GetSetupStatus(c *gin.Context) {
 		SetupCompleted: setupCompleted,
 	})
 }
+
+// UpdatePhone starts phone number verification
+// @Summary Add or change phone number
+// @Description Normalize the phone number to E.164 and send a verification code by SMS. The number is saved once verified.
+// @Tags users
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.UpdatePhoneRequest true "Phone number in international format"
+// @Success 200 {object} dto.PhoneResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 409 {object} dto.ErrorAPIResponse
+// @Failure 503 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /users/me/phone [post]
+func (h *UserHandler) UpdatePhone(c *gin.Context) {
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	var req dto.UpdatePhoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationErrorResponse(c, "Invalid request format", err.Error())
+		return
+	}
+
+	phone, err := h.phoneService.RequestPhoneVerification(userID, req.Phone)
+	if err != nil {
+		switch err.Error() {
+		case "invalid phone number":
+			utils.BadRequestResponse(c, "Invalid phone number")
+		case "phone number already in use":
+			utils.ConflictResponse(c, "Phone number already in use")
+		case "SMS delivery is not configured":
+			utils.ServiceUnavailableResponse(c, "SMS delivery is not available")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to send verification code", err)
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Verification code sent", dto.PhoneResponse{
+		Phone:         phone,
+		PhoneVerified: false,
+	})
+}
+
+// VerifyPhone completes phone number verification
+// @Summary Verify phone number
+// @Description Verify the code sent by SMS and save the phone number as verified
+// @Tags users
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.VerifyPhoneRequest true "Verification code"
+// @Success 200 {object} dto.PhoneResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 409 {object} dto.ErrorAPIResponse
+// @Failure 429 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /users/me/phone/verify [post]
+func (h *UserHandler) VerifyPhone(c *gin.Context) {
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	var req dto.VerifyPhoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationErrorResponse(c, "Invalid request format", err.Error())
+		return
+	}
+
+	phone, err := h.phoneService.VerifyPhone(userID, req.OTP)
+	if err != nil {
+		switch err.Error() {
+		case "invalid or expired OTP":
+			utils.BadRequestResponse(c, "Invalid or expired OTP")
+		case "too many attempts":
+			utils.TooManyRequestsResponse(c, "Too many attempts, please request a new code")
+		case "phone number already in use":
+			utils.ConflictResponse(c, "Phone number already in use")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to verify phone number", err)
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Phone number verified successfully", dto.PhoneResponse{
+		Phone:         phone,
+		PhoneVerified: true,
+	})
+}
+
+// UpdateHandle changes the current user's handle
+// @Summary Change handle
+// @Description Change the handle used in public profile URLs and mentions. 3 to 30 lowercase letters, digits and underscores, starting and ending with a letter or digit. After the first change the handle can be changed again once per cooldown.
+// @Tags users
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.UpdateHandleRequest true "New handle"
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 409 {object} dto.ErrorAPIResponse
+// @Failure 429 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /users/me/handle [put]
+func (h *UserHandler) UpdateHandle(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	var req dto.UpdateHandleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationErrorResponse(c, "Invalid request format", err.Error())
+		return
+	}
+
+	handle, err := h.userService.ChangeHandle(userID, req.Handle)
+	if err != nil {
+		switch err.Error() {
+		case "invalid handle":
+			utils.BadRequestResponse(c, "Handles are 3 to 30 lowercase letters, digits and underscores, starting and ending with a letter or digit")
+		case "handle is reserved":
+			utils.BadRequestResponse(c, "This handle is reserved")
+		case "handle already taken":
+			utils.ConflictResponse(c, "Handle already taken")
+		case "handle changed recently":
+			utils.TooManyRequestsResponse(c, "Your handle was changed recently. Please try again later.")
+		case "user not found":
+			utils.NotFoundResponse(c, "User not found")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to change handle", err)
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Handle updated successfully", handle)
+}
+
+// GetPublicProfile gets a user's public profile by handle
+// @Summary Get public profile
+// @Description Get the public profile of the user with the handle. A leading @ is ignored.
+// @Tags users
+// @Security BearerAuth
+// @Produce json
+// @Param handle path string true "Handle"
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /users/handle/{handle} [get]
+func (h *UserHandler) GetPublicProfile(c *gin.Context) {
+	profile, err := h.userService.GetPublicProfileByHandle(c.Param("handle"))
+	if err != nil {
+		if err.Error() == "user not found" {
+			utils.NotFoundResponse(c, "User not found")
+			return
+		}
+		utils.InternalServerErrorResponse(c, "Failed to get profile", err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Profile retrieved successfully", profile)
+}
+
+// GetReliability gets a user's attendance reliability
+// @Summary Get user reliability
+// @Description Get how reliably a user attends completed events. The score is null until the user has completed an event.
+// @Tags users
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} dto.ReliabilityResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /users/{id}/reliability [get]
+func (h *UserHandler) GetReliability(c *gin.Context) {
+	reliability, err := h.reliabilityService.GetUserReliability(c.Param("id"))
+	if err != nil {
+		switch err.Error() {
+		case "invalid user ID":
+			utils.BadRequestResponse(c, "Invalid user ID")
+		case "user not found":
+			utils.NotFoundResponse(c, "User not found")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to get reliability", err)
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Reliability retrieved successfully", reliability)
+}
+
+// GetUserEvents gets the upcoming events a user created, for their public profile
+// @Summary Get user's events
+// @Description Get the published, upcoming events a user created. Cancelled, completed and deleted events are not included.
+// @Tags users
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "User ID"
+// @Param page query int false "Page number"
+// @Param limit query int false "Items per page"
+// @Param fields query string false "Comma separated fields or groups (summary, details, location, budget, creator, photos, categories, tags, interests, items, members, viewer)"
+// @Success 200 {object} dto.EventListResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /users/{id}/events [get]
+func (h *UserHandler) GetUserEvents(c *gin.Context) {
+	viewerID, _ := middleware.GetCurrentUserID(c)
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	page, limit = utils.ValidatePagination(utils.PaginationEvents, page, limit)
+
+	fields, err := dto.ParseEventFields(c.Query("fields"))
+	if err != nil {
+		utils.BadRequestResponse(c, err.Error())
+		return
+	}
+
+	events, total, err := h.eventService.GetEventsByCreator(c.Param("id"), viewerID, page, limit)
+	if err != nil {
+		switch err.Error() {
+		case "invalid user ID":
+			utils.BadRequestResponse(c, "Invalid user ID")
+		case "user not found":
+			utils.NotFoundResponse(c, "User not found")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to get user events", err)
+		}
+		return
+	}
+
+	utils.PaginatedResponse(c, "User events retrieved successfully", dto.SelectEventListFields(events, fields), total, page, limit)
+}
+
+// RegisterDevice registers a device for push notifications
+// @Summary Register device for push notifications
+// @Description Save the device's FCM registration token so notifications are pushed to it. Registering a token again refreshes it and moves it to the current user.
+// @Tags users
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.RegisterDeviceRequest true "FCM token and platform (ios, android or web)"
+// @Success 200 {object} dto.DeviceResponseWrapper
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /users/me/devices [post]
+func (h *UserHandler) RegisterDevice(c *gin.Context) {
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	var req dto.RegisterDeviceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationErrorResponse(c, "Invalid request format", err.Error())
+		return
+	}
+
+	device, err := h.deviceService.RegisterDevice(userID, req.Token, req.Platform)
+	if err != nil {
+		switch err.Error() {
+		case "invalid device token":
+			utils.BadRequestResponse(c, "Invalid device token")
+		case "invalid platform":
+			utils.BadRequestResponse(c, "Platform must be ios, android or web")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to register device", err)
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Device registered successfully", device)
+}
+
+// UnregisterDevice stops push notifications to a device
+// @Summary Unregister device from push notifications
+// @Description Remove a device's FCM registration token, e.g. on logout
+// @Tags users
+// @Security BearerAuth
+// @Produce json
+// @Param token path string true "FCM registration token"
+// @Success 200 {object} dto.SuccessMessageWrapper
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /users/me/devices/{token} [delete]
+func (h *UserHandler) UnregisterDevice(c *gin.Context) {
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	err := h.deviceService.UnregisterDevice(userID, c.Param("token"))
+	if err != nil {
+		switch err.Error() {
+		case "device not found":
+			utils.NotFoundResponse(c, "Device not found")
+		default:
+			utils.InternalServerErrorResponse(c, "Failed to unregister device", err)
+		}
+		return
+	}
+
+	utils.SendSuccessResponse(c, "Device unregistered successfully", nil)
+}
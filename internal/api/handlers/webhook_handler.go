@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"TinderTrip-Backend/internal/api/middleware"
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WebhookHandler handles webhook administration requests
+type WebhookHandler struct {
+	webhookService *service.WebhookService
+}
+
+// NewWebhookHandler creates a new webhook handler
+func NewWebhookHandler() *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: service.NewWebhookService(),
+	}
+}
+
+// CreateWebhook registers a webhook
+// @Summary Create webhook
+// @Description Register a webhook URL for event lifecycle notifications (admin only). Payloads are signed with HMAC-SHA256 in the X-Webhook-Signature header; the secret is only returned once.
+// @Tags admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateWebhookRequest true "Webhook"
+// @Success 201 {object} dto.WebhookResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /admin/webhooks [post]
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	// Get user ID from context
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		utils.UnauthorizedResponse(c, "User not authenticated")
+		return
+	}
+
+	var req dto.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationErrorResponse(c, "Invalid request", err.Error())
+		return
+	}
+	if !strings.HasPrefix(req.URL, "https://") && !strings.HasPrefix(req.URL, "http://") {
+		utils.BadRequestResponse(c, "Webhook URL must use http or https")
+		return
+	}
+
+	webhook, err := h.webhookService.CreateWebhook(userID, req)
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to create webhook", err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusCreated, "Webhook created successfully", webhook)
+}
+
+// GetWebhooks lists webhooks
+// @Summary Get webhooks
+// @Description List registered webhooks (admin only)
+// @Tags admin
+// @Security BearerAuth
+// @Produce json
+// @Param page query int false "Page number"
+// @Param limit query int false "Items per page"
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 401 {object} dto.ErrorAPIResponse
+// @Failure 403 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /admin/webhooks [get]
+func (h *WebhookHandler) GetWebhooks(c *gin.Context) {
+	// Get query parameters
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	// Validate pagination
+	page, limit = utils.ValidatePagination(utils.PaginationWebhooks, page, limit)
+
+	webhooks, total, err := h.webhookService.GetWebhooks(page, limit)
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to get webhooks", err)
+		return
+	}
+
+	utils.PaginatedResponse(c, "Webhooks retrieved successfully", webhooks, total, page, limit)
+}
+
+// DeleteWebhook deletes a webhook
+// @Summary Delete webhook
+// @Description Delete a webhook (admin only). Pending deliveries are not sent.
+// @Tags admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 404 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /admin/webhooks/{id} [delete]
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	webhookID := c.Param("id")
+
+	err := h.webhookService.DeleteWebhook(webhookID)
+	if err != nil {
+		if err.Error() == "webhook not found" {
+			utils.NotFoundResponse(c, "Webhook not found")
+		} else if strings.HasPrefix(err.Error(), "invalid webhook ID") {
+			utils.BadRequestResponse(c, "Invalid webhook ID")
+		} else {
+			utils.InternalServerErrorResponse(c, "Failed to delete webhook", err)
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Webhook deleted successfully", nil)
+}
+
+// GetWebhookDeliveries gets the delivery log of a webhook
+// @Summary Get webhook deliveries
+// @Description Get the delivery log of a webhook including attempts and errors (admin only)
+// @Tags admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Param page query int false "Page number"
+// @Param limit query int false "Items per page"
+// @Success 200 {object} dto.SuccessAPIResponse
+// @Failure 400 {object} dto.ErrorAPIResponse
+// @Failure 500 {object} dto.ErrorAPIResponse
+// @Router /admin/webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) GetWebhookDeliveries(c *gin.Context) {
+	webhookID := c.Param("id")
+
+	// Get query parameters
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	// Validate pagination
+	page, limit = utils.ValidatePagination(utils.PaginationWebhooks, page, limit)
+
+	deliveries, total, err := h.webhookService.GetDeliveries(webhookID, page, limit)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid webhook ID") {
+			utils.BadRequestResponse(c, "Invalid webhook ID")
+		} else {
+			utils.InternalServerErrorResponse(c, "Failed to get webhook deliveries", err)
+		}
+		return
+	}
+
+	utils.PaginatedResponse(c, "Webhook deliveries retrieved successfully", deliveries, total, page, limit)
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"time"
+
+	"TinderTrip-Backend/pkg/config"
+
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// NewHTTPServer builds the HTTP server for the listen settings: address, timeouts and protocols.
+// TLS is added by Start, since certificates are only loaded when serving.
+func NewHTTPServer(cfg config.ServerConfig, handler http.Handler) *http.Server {
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetHTTP2(cfg.HTTP2)
+
+	return &http.Server{
+		Addr:              net.JoinHostPort(cfg.ListenHost, cfg.Port),
+		Handler:           handler,
+		ReadTimeout:       time.Duration(cfg.ReadTimeoutSeconds) * time.Second,
+		ReadHeaderTimeout: time.Duration(cfg.ReadHeaderTimeoutSeconds) * time.Second,
+		WriteTimeout:      time.Duration(cfg.WriteTimeoutSeconds) * time.Second,
+		IdleTimeout:       time.Duration(cfg.IdleTimeoutSeconds) * time.Second,
+		Protocols:         protocols,
+	}
+}
+
+// serve serves HTTPS when a certificate source is configured and plain HTTP otherwise
+func serve(server *http.Server, listener net.Listener, cfg config.ServerConfig) error {
+	switch {
+	case len(cfg.AutocertDomains) > 0:
+		manager := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(cfg.AutocertDomains...),
+			Cache:      autocert.DirCache(cfg.AutocertCacheDir),
+		}
+		server.TLSConfig = manager.TLSConfig()
+		server.TLSConfig.MinVersion = tls.VersionTLS12
+		if !cfg.HTTP2 {
+			server.TLSConfig.NextProtos = withoutProto(server.TLSConfig.NextProtos, "h2")
+		}
+		return server.ServeTLS(listener, "", "")
+	case cfg.TLSCertFile != "":
+		server.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		return server.ServeTLS(listener, cfg.TLSCertFile, cfg.TLSKeyFile)
+	default:
+		return server.Serve(listener)
+	}
+}
+
+// withoutProto drops an ALPN protocol, so clients are not offered one the server won't speak
+func withoutProto(protos []string, drop string) []string {
+	kept := make([]string, 0, len(protos))
+	for _, proto := range protos {
+		if proto != drop {
+			kept = append(kept, proto)
+		}
+	}
+	return kept
+}
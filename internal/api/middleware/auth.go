@@ -2,8 +2,11 @@ package middleware
 
 import (
 	"net/http"
+	"strings"
 
 	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/database"
 
 	"github.com/gin-gonic/gin"
 )
@@ -15,8 +18,9 @@ func AuthMiddleware() gin.HandlerFunc {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
 			c.JSON(http.StatusUnauthorized, gin.H{
-				"error":   "Authorization header is required",
-				"message": "Please provide a valid token",
+				"error":      "Authorization header is required",
+				"message":    "Please provide a valid token",
+				"request_id": c.GetString(utils.RequestIDKey),
 			})
 			c.Abort()
 			return
@@ -26,8 +30,9 @@ func AuthMiddleware() gin.HandlerFunc {
 		token, err := utils.ExtractTokenFromHeader(authHeader)
 		if err != nil {
 			c.JSON(http.StatusUnauthorized, gin.H{
-				"error":   "Invalid authorization header",
-				"message": err.Error(),
+				"error":      "Invalid authorization header",
+				"message":    err.Error(),
+				"request_id": c.GetString(utils.RequestIDKey),
 			})
 			c.Abort()
 			return
@@ -37,8 +42,20 @@ func AuthMiddleware() gin.HandlerFunc {
 		claims, err := utils.ValidateToken(token)
 		if err != nil {
 			c.JSON(http.StatusUnauthorized, gin.H{
-				"error":   "Invalid token",
-				"message": err.Error(),
+				"error":      "Invalid token",
+				"message":    err.Error(),
+				"request_id": c.GetString(utils.RequestIDKey),
+			})
+			c.Abort()
+			return
+		}
+
+		// Reject tokens revoked at logout
+		if isTokenRevoked(c, claims) {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error":      "Invalid token",
+				"message":    "token has been revoked",
+				"request_id": c.GetString(utils.RequestIDKey),
 			})
 			c.Abort()
 			return
@@ -72,7 +89,7 @@ func OptionalAuthMiddleware() gin.HandlerFunc {
 
 		// Validate token
 		claims, err := utils.ValidateToken(token)
-		if err != nil {
+		if err != nil || isTokenRevoked(c, claims) {
 			c.Next()
 			return
 		}
@@ -86,26 +103,49 @@ func OptionalAuthMiddleware() gin.HandlerFunc {
 	}
 }
 
-// AdminMiddleware checks if user is admin (you can implement admin logic)
+// isTokenRevoked reports whether the token was revoked at logout. Tokens issued before tokens had
+// an ID can't be revoked. If Redis can't be reached the token is allowed, so an outage doesn't sign
+// everyone out.
+func isTokenRevoked(c *gin.Context, claims *utils.JWTClaims) bool {
+	if claims.ID == "" {
+		return false
+	}
+
+	revoked, err := database.IsTokenBlacklisted(c.Request.Context(), claims.ID)
+	if err != nil {
+		if err != database.ErrRedisNotConnected {
+			utils.Logger().WithFields(map[string]interface{}{
+				"error":   err,
+				"user_id": claims.UserID,
+			}).Warn("Failed to check token blacklist, allowing token")
+		}
+		return false
+	}
+	return revoked
+}
+
+// AdminMiddleware checks if user is an admin (configured via ADMIN_USER_IDS / ADMIN_EMAILS)
 func AdminMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Check if user is authenticated
 		userID, exists := c.Get("user_id")
 		if !exists {
 			c.JSON(http.StatusUnauthorized, gin.H{
-				"error":   "Authentication required",
-				"message": "Please login to access this resource",
+				"error":      "Authentication required",
+				"message":    "Please login to access this resource",
+				"request_id": c.GetString(utils.RequestIDKey),
 			})
 			c.Abort()
 			return
 		}
 
-		// TODO: Implement admin check logic
-		// For now, we'll just check if user exists
-		if userID == "" {
+		userIDStr, _ := userID.(string)
+		email, _ := GetCurrentUserEmail(c)
+		if userIDStr == "" || !IsAdmin(userIDStr, email) {
 			c.JSON(http.StatusForbidden, gin.H{
-				"error":   "Access denied",
-				"message": "Admin privileges required",
+				"error":      "Access denied",
+				"message":    "Admin privileges required",
+				"request_id": c.GetString(utils.RequestIDKey),
 			})
 			c.Abort()
 			return
@@ -115,6 +155,26 @@ func AdminMiddleware() gin.HandlerFunc {
 	}
 }
 
+// IsAdmin checks if the user ID or email is in the configured admin lists
+func IsAdmin(userID, email string) bool {
+	if config.AppConfig == nil {
+		return false
+	}
+
+	for _, id := range config.AppConfig.Admin.UserIDs {
+		if strings.TrimSpace(id) != "" && strings.TrimSpace(id) == userID {
+			return true
+		}
+	}
+	for _, adminEmail := range config.AppConfig.Admin.Emails {
+		if email != "" && strings.EqualFold(strings.TrimSpace(adminEmail), email) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // GetCurrentUserID gets the current user ID from context
 func GetCurrentUserID(c *gin.Context) (string, bool) {
 	userID, exists := c.Get("user_id")
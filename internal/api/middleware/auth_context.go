@@ -27,9 +27,9 @@ func AuthContext() gin.HandlerFunc {
 		// Extract token
 		token := strings.TrimPrefix(authHeader, "Bearer ")
 
-		// Validate token and extract claims
+		// Validate token and extract claims, ignoring tokens revoked at logout
 		claims, err := utils.ValidateToken(token)
-		if err != nil {
+		if err != nil || isTokenRevoked(c, claims) {
 			c.Next()
 			return
 		}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"errors"
+
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/captcha"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CaptchaTokenHeader carries the CAPTCHA token solved by the client
+const CaptchaTokenHeader = "X-Captcha-Token"
+
+// RequireCaptcha middleware rejects requests without a valid CAPTCHA token when CAPTCHA_PROVIDER
+// is set. It lets every request through when CAPTCHA is disabled.
+func RequireCaptcha() gin.HandlerFunc {
+	return RequireCaptchaWithVerifier(captcha.NewVerifier())
+}
+
+// RequireCaptchaWithVerifier is RequireCaptcha checking tokens with the given verifier.
+// A nil verifier disables the check.
+func RequireCaptchaWithVerifier(verifier captcha.Verifier) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if verifier == nil {
+			c.Next()
+			return
+		}
+
+		token := c.GetHeader(CaptchaTokenHeader)
+		if token == "" {
+			utils.BadRequestResponse(c, "CAPTCHA token is required")
+			c.Abort()
+			return
+		}
+
+		if err := verifier.Verify(token, c.ClientIP()); err != nil {
+			if errors.Is(err, captcha.ErrInvalidToken) {
+				utils.BadRequestResponse(c, "Invalid CAPTCHA token")
+			} else {
+				utils.Logger().WithField("error", err).Error("CAPTCHA verification failed")
+				utils.ServiceUnavailableResponse(c, "CAPTCHA verification is unavailable")
+			}
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -17,7 +17,7 @@ func CORS() gin.HandlerFunc {
 		AllowedOrigins:   cfg.AllowedOrigins,
 		AllowedMethods:   cfg.AllowedMethods,
 		AllowedHeaders:   cfg.AllowedHeaders,
-		ExposedHeaders:   []string{"Content-Length", "Content-Type", "Authorization"},
+		ExposedHeaders:   []string{"Content-Length", "Content-Type", "Authorization", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "RateLimit-Policy", "Retry-After", RequestIDHeader()},
 		AllowCredentials: true,
 		MaxAge:           86400, // 24 hours
 		Debug:            false,
@@ -40,9 +40,10 @@ func CustomCORS() gin.HandlerFunc {
 		// Always allow all origins
 		c.Header("Access-Control-Allow-Origin", "*")
 		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Accept, Authorization")
+		c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Accept, Authorization, "+GuestTokenHeader+", "+CaptchaTokenHeader+", "+RequestIDHeader())
 		c.Header("Access-Control-Allow-Credentials", "true")
 		c.Header("Access-Control-Max-Age", "86400")
+		c.Header("Access-Control-Expose-Headers", "RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset, RateLimit-Policy, Retry-After, "+RequestIDHeader())
 
 		// Handle preflight requests
 		if c.Request.Method == "OPTIONS" {
@@ -75,7 +76,7 @@ func CORSOptions() gin.HandlerFunc {
 		if c.Request.Method == "OPTIONS" {
 			c.Header("Access-Control-Allow-Origin", "*")
 			c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-			c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Accept, Authorization, X-Requested-With")
+			c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Accept, Authorization, X-Requested-With, "+RequestIDHeader())
 			c.Header("Access-Control-Max-Age", "86400")
 			c.AbortWithStatus(http.StatusNoContent)
 			return
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// eventAccessKey is the context key RequireEventRole stores the loaded event under
+const eventAccessKey = "event_access"
+
+// EventRole is the relationship to an event a route requires
+type EventRole int
+
+const (
+	// EventRoleMember allows the creator and pending or confirmed members
+	EventRoleMember EventRole = iota
+	// EventRoleConfirmedMember allows confirmed members, including the creator
+	EventRoleConfirmedMember
+	// EventRoleCreator allows only the event creator
+	EventRoleCreator
+)
+
+// RequireEventRole loads the event in the :id path parameter once, checks the current user
+// holds the given role, and stores the result for GetEventAccess.
+//
+// Users with no membership get the same 404 as a missing event so event IDs cannot be
+// probed. Members who lack the role already know the event exists and get a 403.
+func RequireEventRole(role EventRole) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := GetCurrentUserID(c)
+		if !exists {
+			utils.UnauthorizedResponse(c, "User not authenticated")
+			c.Abort()
+			return
+		}
+
+		access, err := service.LoadEventAccess(c.Param("id"), userID)
+		if err != nil {
+			switch err.Error() {
+			case "invalid event ID", "invalid user ID":
+				utils.BadRequestResponse(c, err.Error())
+			case "event not found":
+				utils.NotFoundResponse(c, "Event not found")
+			default:
+				utils.InternalServerErrorResponse(c, "Failed to get event", err)
+			}
+			c.Abort()
+			return
+		}
+
+		if !access.IsMember() {
+			utils.NotFoundResponse(c, "Event not found")
+			c.Abort()
+			return
+		}
+
+		switch role {
+		case EventRoleCreator:
+			if !access.IsCreator() {
+				utils.ForbiddenResponse(c, "Only the event creator can do this")
+				c.Abort()
+				return
+			}
+		case EventRoleConfirmedMember:
+			if !access.IsConfirmedMember() {
+				utils.ForbiddenResponse(c, "Only confirmed members can do this")
+				c.Abort()
+				return
+			}
+		}
+
+		c.Set(eventAccessKey, access)
+		c.Next()
+	}
+}
+
+// GetEventAccess returns the event access loaded by RequireEventRole
+func GetEventAccess(c *gin.Context) (*service.EventAccess, bool) {
+	value, exists := c.Get(eventAccessKey)
+	if !exists {
+		return nil, false
+	}
+	access, ok := value.(*service.EventAccess)
+	return access, ok
+}
@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ulule/limiter/v3"
+	"github.com/ulule/limiter/v3/drivers/store/memory"
+)
+
+// GuestTokenHeader carries a guest browsing token on public endpoints
+const GuestTokenHeader = "X-Guest-Token"
+
+// GuestContext middleware adds the guest ID from a valid guest token to the context.
+// Requests without one stay anonymous.
+func GuestContext() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.GetHeader(GuestTokenHeader)
+		if token == "" {
+			c.Next()
+			return
+		}
+
+		claims, err := utils.ValidateGuestToken(token)
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		c.Set("guest_id", claims.GuestID)
+		c.Next()
+	}
+}
+
+// GetGuestID gets the guest ID set by GuestContext, if any
+func GetGuestID(c *gin.Context) string {
+	return c.GetString("guest_id")
+}
+
+// PublicRateLimit rate limits public endpoints per guest token, or per IP at a lower limit
+// for anonymous clients. Signed-in users are left to the global limit.
+func PublicRateLimit() gin.HandlerFunc {
+	cfg := config.AppConfig.RateLimit
+	period := parseDuration(cfg.Window)
+	guestLimiter := limiter.New(memory.NewStore(), limiter.Rate{Period: period, Limit: int64(cfg.GuestRequests)})
+	anonymousLimiter := limiter.New(memory.NewStore(), limiter.Rate{Period: period, Limit: int64(cfg.AnonymousRequests)})
+
+	return func(c *gin.Context) {
+		if _, exists := c.Get("user_id"); exists {
+			c.Next()
+			return
+		}
+
+		instance, key := anonymousLimiter, c.ClientIP()
+		if guestID := GetGuestID(c); guestID != "" {
+			instance, key = guestLimiter, guestID
+		}
+
+		if !allowRequest(c, instance, key, period, cfg.Window) {
+			return
+		}
+		c.Next()
+	}
+}
+
+// GuestIssueRateLimit limits how many guest tokens one IP can get per window. Without it a client
+// could fetch a fresh token, and with it a fresh guest limit, for every request.
+func GuestIssueRateLimit() gin.HandlerFunc {
+	cfg := config.AppConfig.RateLimit
+	period := parseDuration(cfg.Window)
+	issueLimiter := limiter.New(memory.NewStore(), limiter.Rate{Period: period, Limit: int64(cfg.GuestIssueRequests)})
+
+	return func(c *gin.Context) {
+		if !allowRequest(c, issueLimiter, c.ClientIP(), period, cfg.Window) {
+			return
+		}
+		c.Next()
+	}
+}
+
+// allowRequest counts the request against key and sets the rate limit headers. Over the limit, it
+// aborts with 429 and returns false, unless the limit is not enforced.
+func allowRequest(c *gin.Context, instance *limiter.Limiter, key string, period time.Duration, window string) bool {
+	context, err := instance.Get(context.Background(), key)
+	if err != nil {
+		utils.Logger().WithFields(map[string]interface{}{
+			"error": err,
+			"key":   key,
+		}).Error("Public rate limiter error")
+		return true
+	}
+
+	reset := time.Unix(context.Reset, 0)
+	setRateLimitHeaders(c, context.Limit, context.Remaining, reset, period)
+
+	// Check if limit exceeded (soft mode only reports headers)
+	if context.Reached && config.AppConfig.RateLimit.Enforce {
+		setRetryAfter(c, reset)
+		c.JSON(http.StatusTooManyRequests, gin.H{
+			"error": "Rate limit exceeded",
+			"message": fmt.Sprintf("You have exceeded the rate limit of %d requests per %s",
+				context.Limit, window),
+			"retry_after": context.Reset - time.Now().Unix(),
+			"request_id":  c.GetString(utils.RequestIDKey),
+		})
+		c.Abort()
+		return false
+	}
+	return true
+}
@@ -20,7 +20,7 @@ func Logger() gin.HandlerFunc {
 		latency := time.Since(start)
 
 		// Log request
-		utils.Logger().WithFields(map[string]interface{}{
+		utils.RequestLogger(c).WithFields(map[string]interface{}{
 			"timestamp":  start.Format(time.RFC3339),
 			"status":     c.Writer.Status(),
 			"latency":    latency,
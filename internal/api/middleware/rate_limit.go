@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 	"fmt"
+	"math"
 	"net/http"
 	"strconv"
 	"time"
@@ -61,27 +62,35 @@ func RateLimit() gin.HandlerFunc {
 				"client_ip": clientIP,
 			}).Error("Rate limiter error")
 
+			// Soft mode never blocks requests
+			if !config.AppConfig.RateLimit.Enforce {
+				c.Next()
+				return
+			}
+
 			c.JSON(http.StatusInternalServerError, gin.H{
-				"error":   "Rate limiter error",
-				"message": "Unable to process request",
+				"error":      "Rate limiter error",
+				"message":    "Unable to process request",
+				"request_id": c.GetString(utils.RequestIDKey),
 			})
 			c.Abort()
 			return
 		}
 
 		// Set rate limit headers
-		c.Header("X-RateLimit-Limit", strconv.FormatInt(context.Limit, 10))
-		c.Header("X-RateLimit-Remaining", strconv.FormatInt(context.Remaining, 10))
-		c.Header("X-RateLimit-Reset", strconv.FormatInt(context.Reset, 10))
+		reset := time.Unix(context.Reset, 0)
+		setRateLimitHeaders(c, context.Limit, context.Remaining, reset, rateLimiter.limiter.Rate.Period)
 
-		// Check if limit exceeded
-		if context.Reached {
+		// Check if limit exceeded (soft mode only reports headers)
+		if context.Reached && config.AppConfig.RateLimit.Enforce {
+			setRetryAfter(c, reset)
 			c.JSON(http.StatusTooManyRequests, gin.H{
 				"error": "Rate limit exceeded",
 				"message": fmt.Sprintf("You have exceeded the rate limit of %d requests per %s",
 					config.AppConfig.RateLimit.Requests,
 					config.AppConfig.RateLimit.Window),
 				"retry_after": context.Reset - time.Now().Unix(),
+				"request_id":  c.GetString(utils.RequestIDKey),
 			})
 			c.Abort()
 			return
@@ -113,21 +122,35 @@ func RateLimitWithRedis() gin.HandlerFunc {
 			return
 		}
 
-		// Check if limit exceeded
-		if count >= config.AppConfig.RateLimit.Requests {
+		// Set rate limit headers
+		windowDuration := parseDuration(config.AppConfig.RateLimit.Window)
+		limit := int64(config.AppConfig.RateLimit.Requests)
+		reset, err := rateLimitReset(ctx, key, windowDuration)
+		if err != nil {
+			reset = time.Now().Add(windowDuration)
+		}
+		setRateLimitHeaders(c, limit, limit-int64(count)-1, reset, windowDuration)
+
+		// Check if limit exceeded (soft mode only reports headers)
+		if count >= config.AppConfig.RateLimit.Requests && config.AppConfig.RateLimit.Enforce {
+			setRetryAfter(c, reset)
 			c.JSON(http.StatusTooManyRequests, gin.H{
 				"error": "Rate limit exceeded",
 				"message": fmt.Sprintf("You have exceeded the rate limit of %d requests per %s",
 					config.AppConfig.RateLimit.Requests,
 					config.AppConfig.RateLimit.Window),
+				"request_id": c.GetString(utils.RequestIDKey),
 			})
 			c.Abort()
 			return
 		}
 
-		// Increment counter
-		windowDuration := parseDuration(config.AppConfig.RateLimit.Window)
-		err = database.GetRedisClient().Set(ctx, key, count+1, windowDuration).Err()
+		// Increment counter, keeping the window started by the first request
+		if count == 0 {
+			err = database.GetRedisClient().Set(ctx, key, 1, windowDuration).Err()
+		} else {
+			err = database.GetRedisClient().Incr(ctx, key).Err()
+		}
 		if err != nil {
 			utils.Logger().WithFields(map[string]interface{}{
 				"error":     err,
@@ -135,11 +158,6 @@ func RateLimitWithRedis() gin.HandlerFunc {
 			}).Error("Redis rate limiter set error")
 		}
 
-		// Set rate limit headers
-		c.Header("X-RateLimit-Limit", strconv.Itoa(config.AppConfig.RateLimit.Requests))
-		c.Header("X-RateLimit-Remaining", strconv.Itoa(config.AppConfig.RateLimit.Requests-count-1))
-		c.Header("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(windowDuration).Unix(), 10))
-
 		c.Next()
 	}
 }
@@ -171,21 +189,35 @@ func RateLimitByUser() gin.HandlerFunc {
 			return
 		}
 
-		// Check if limit exceeded
-		if count >= config.AppConfig.RateLimit.Requests {
+		// Set rate limit headers
+		windowDuration := parseDuration(config.AppConfig.RateLimit.Window)
+		limit := int64(config.AppConfig.RateLimit.Requests)
+		reset, err := rateLimitReset(ctx, key, windowDuration)
+		if err != nil {
+			reset = time.Now().Add(windowDuration)
+		}
+		setRateLimitHeaders(c, limit, limit-int64(count)-1, reset, windowDuration)
+
+		// Check if limit exceeded (soft mode only reports headers)
+		if count >= config.AppConfig.RateLimit.Requests && config.AppConfig.RateLimit.Enforce {
+			setRetryAfter(c, reset)
 			c.JSON(http.StatusTooManyRequests, gin.H{
 				"error": "Rate limit exceeded",
 				"message": fmt.Sprintf("You have exceeded the rate limit of %d requests per %s",
 					config.AppConfig.RateLimit.Requests,
 					config.AppConfig.RateLimit.Window),
+				"request_id": c.GetString(utils.RequestIDKey),
 			})
 			c.Abort()
 			return
 		}
 
-		// Increment counter
-		windowDuration := parseDuration(config.AppConfig.RateLimit.Window)
-		err = database.GetRedisClient().Set(ctx, key, count+1, windowDuration).Err()
+		// Increment counter, keeping the window started by the first request
+		if count == 0 {
+			err = database.GetRedisClient().Set(ctx, key, 1, windowDuration).Err()
+		} else {
+			err = database.GetRedisClient().Incr(ctx, key).Err()
+		}
 		if err != nil {
 			utils.Logger().WithFields(map[string]interface{}{
 				"error":   err,
@@ -193,15 +225,54 @@ func RateLimitByUser() gin.HandlerFunc {
 			}).Error("Redis user rate limiter set error")
 		}
 
-		// Set rate limit headers
-		c.Header("X-RateLimit-Limit", strconv.Itoa(config.AppConfig.RateLimit.Requests))
-		c.Header("X-RateLimit-Remaining", strconv.Itoa(config.AppConfig.RateLimit.Requests-count-1))
-		c.Header("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(windowDuration).Unix(), 10))
-
 		c.Next()
 	}
 }
 
+// setRateLimitHeaders sets the RateLimit-* headers from the IETF draft
+// (draft-ietf-httpapi-ratelimit-headers) plus the legacy X-RateLimit-* headers.
+// RateLimit-Reset is the number of seconds until the window resets.
+func setRateLimitHeaders(c *gin.Context, limit, remaining int64, reset time.Time, window time.Duration) {
+	if remaining < 0 {
+		remaining = 0
+	}
+	resetSeconds := int64(math.Ceil(time.Until(reset).Seconds()))
+	if resetSeconds < 0 {
+		resetSeconds = 0
+	}
+
+	c.Header("RateLimit-Limit", strconv.FormatInt(limit, 10))
+	c.Header("RateLimit-Remaining", strconv.FormatInt(remaining, 10))
+	c.Header("RateLimit-Reset", strconv.FormatInt(resetSeconds, 10))
+	c.Header("RateLimit-Policy", fmt.Sprintf("%d;w=%d", limit, int64(window.Seconds())))
+
+	c.Header("X-RateLimit-Limit", strconv.FormatInt(limit, 10))
+	c.Header("X-RateLimit-Remaining", strconv.FormatInt(remaining, 10))
+	c.Header("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+}
+
+// setRetryAfter sets the Retry-After header for a rejected request
+func setRetryAfter(c *gin.Context, reset time.Time) {
+	seconds := int64(math.Ceil(time.Until(reset).Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	c.Header("Retry-After", strconv.FormatInt(seconds, 10))
+}
+
+// rateLimitReset returns when the Redis counter for key expires
+func rateLimitReset(ctx context.Context, key string, window time.Duration) (time.Time, error) {
+	ttl, err := database.GetRedisClient().TTL(ctx, key).Result()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if ttl <= 0 {
+		// No counter yet, the window starts with this request
+		return time.Now().Add(window), nil
+	}
+	return time.Now().Add(ttl), nil
+}
+
 // parseDuration parses duration string to time.Duration
 func parseDuration(duration string) time.Duration {
 	switch duration {
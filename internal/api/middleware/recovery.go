@@ -1,7 +1,7 @@
 package middleware
 
 import (
-	"fmt"
+	"errors"
 	"net"
 	"net/http"
 	"net/http/httputil"
@@ -14,157 +14,93 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-// Recovery middleware for handling panics
-func Recovery() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		defer func() {
-			if err := recover(); err != nil {
-				// Check for a broken connection
-				var brokenPipe bool
-				if ne, ok := err.(*net.OpError); ok {
-					if se, ok := ne.Err.(*os.SyscallError); ok {
-						if strings.Contains(strings.ToLower(se.Error()), "broken pipe") ||
-							strings.Contains(strings.ToLower(se.Error()), "connection reset by peer") {
-							brokenPipe = true
-						}
-					}
-				}
+// panicMessage is all a client learns about a panic; the details stay in the logs
+const panicMessage = "Something went wrong. Please try again later."
 
-				// Log the error
-				utils.Logger().WithFields(map[string]interface{}{
-					"error":   err,
-					"stack":   string(debug.Stack()),
-					"request": c.Request.URL.Path,
-					"method":  c.Request.Method,
-				}).Error("Panic recovered")
+// Recovery middleware for handling panics. The panic is logged with its stack and request ID,
+// counted in the panic metric, and the client gets the standard 500 error envelope.
+func Recovery() gin.HandlerFunc {
+	return recovery(false)
+}
 
-				// If it's a broken pipe, don't send a response
-				if brokenPipe {
-					c.Error(err.(error))
-					c.Abort()
-					return
-				}
+// RecoveryWithWriter recovers like Recovery and also logs the request headers and client
+func RecoveryWithWriter() gin.HandlerFunc {
+	return recovery(true)
+}
 
-				// Send error response
-				c.JSON(http.StatusInternalServerError, gin.H{
-					"error":      "Internal server error",
-					"message":    "Something went wrong. Please try again later.",
-					"request_id": c.GetString("request_id"),
-				})
-				c.Abort()
-			}
-		}()
-		c.Next()
-	}
+// CustomRecovery middleware with custom error handling
+func CustomRecovery() gin.HandlerFunc {
+	return recovery(true)
 }
 
-// RecoveryWithWriter middleware with custom writer
-func RecoveryWithWriter() gin.HandlerFunc {
+func recovery(detailed bool) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		defer func() {
 			if err := recover(); err != nil {
-				// Check for a broken connection
-				var brokenPipe bool
-				if ne, ok := err.(*net.OpError); ok {
-					if se, ok := ne.Err.(*os.SyscallError); ok {
-						if strings.Contains(strings.ToLower(se.Error()), "broken pipe") ||
-							strings.Contains(strings.ToLower(se.Error()), "connection reset by peer") {
-							brokenPipe = true
-						}
-					}
-				}
-
-				// Get request dump
-				httpRequest, _ := httputil.DumpRequest(c.Request, false)
-
-				// Log the error with more details
-				utils.Logger().WithFields(map[string]interface{}{
-					"error":      err,
-					"stack":      string(debug.Stack()),
-					"request":    string(httpRequest),
-					"user_agent": c.Request.UserAgent(),
-					"client_ip":  c.ClientIP(),
-					"request_id": c.GetString("request_id"),
-				}).Error("Panic recovered with detailed logging")
-
-				// If it's a broken pipe, don't send a response
-				if brokenPipe {
-					c.Error(err.(error))
-					c.Abort()
-					return
-				}
-
-				// Send error response
-				c.JSON(http.StatusInternalServerError, gin.H{
-					"error":      "Internal server error",
-					"message":    "Something went wrong. Please try again later.",
-					"request_id": c.GetString("request_id"),
-				})
-				c.Abort()
+				handlePanic(c, err, detailed)
 			}
 		}()
 		c.Next()
 	}
 }
 
-// CustomRecovery middleware with custom error handling
-func CustomRecovery() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		defer func() {
-			if err := recover(); err != nil {
-				// Check for a broken connection
-				var brokenPipe bool
-				if ne, ok := err.(*net.OpError); ok {
-					if se, ok := ne.Err.(*os.SyscallError); ok {
-						if strings.Contains(strings.ToLower(se.Error()), "broken pipe") ||
-							strings.Contains(strings.ToLower(se.Error()), "connection reset by peer") {
-							brokenPipe = true
-						}
-					}
-				}
+// handlePanic logs, counts and answers a recovered panic
+func handlePanic(c *gin.Context, err interface{}, detailed bool) {
+	endpoint := c.FullPath()
+	if endpoint == "" {
+		endpoint = c.Request.URL.Path
+	}
 
-				// Log the error
-				utils.Logger().WithFields(map[string]interface{}{
-					"error":      err,
-					"stack":      string(debug.Stack()),
-					"request":    c.Request.URL.Path,
-					"method":     c.Request.Method,
-					"user_agent": c.Request.UserAgent(),
-					"client_ip":  c.ClientIP(),
-					"request_id": c.GetString("request_id"),
-				}).Error("Panic recovered with custom handler")
+	fields := map[string]interface{}{
+		"error":    err,
+		"stack":    string(debug.Stack()),
+		"request":  c.Request.URL.Path,
+		"method":   c.Request.Method,
+		"endpoint": endpoint,
+	}
+	if detailed {
+		httpRequest, _ := httputil.DumpRequest(c.Request, false)
+		fields["request"] = string(httpRequest)
+		fields["user_agent"] = c.Request.UserAgent()
+		fields["client_ip"] = c.ClientIP()
+	}
+	utils.RequestLogger(c).WithFields(fields).Error("Panic recovered")
 
-				// If it's a broken pipe, don't send a response
-				if brokenPipe {
-					c.Error(err.(error))
-					c.Abort()
-					return
-				}
+	if monitoringService != nil {
+		monitoringService.RecordPanic(c.Request.Method, endpoint)
+	}
 
-				// Determine error type and send appropriate response
-				switch e := err.(type) {
-				case string:
-					c.JSON(http.StatusInternalServerError, gin.H{
-						"error":      "Internal server error",
-						"message":    e,
-						"request_id": c.GetString("request_id"),
-					})
-				case error:
-					c.JSON(http.StatusInternalServerError, gin.H{
-						"error":      "Internal server error",
-						"message":    e.Error(),
-						"request_id": c.GetString("request_id"),
-					})
-				default:
-					c.JSON(http.StatusInternalServerError, gin.H{
-						"error":      "Internal server error",
-						"message":    fmt.Sprintf("%v", err),
-						"request_id": c.GetString("request_id"),
-					})
-				}
-				c.Abort()
-			}
-		}()
-		c.Next()
+	// The client is gone, so there is no one to answer
+	if isBrokenPipe(err) {
+		c.Error(err.(error))
+		c.Abort()
+		return
+	}
+
+	// A handler that already started its response cannot be given a new status
+	if c.Writer.Written() {
+		c.Abort()
+		return
+	}
+
+	utils.ErrorResponse(c, http.StatusInternalServerError, utils.ErrCodeInternalServer, panicMessage, nil)
+	c.Abort()
+}
+
+// isBrokenPipe reports whether the panic came from writing to a connection the client closed
+func isBrokenPipe(err interface{}) bool {
+	e, ok := err.(error)
+	if !ok {
+		return false
+	}
+	var opErr *net.OpError
+	if !errors.As(e, &opErr) {
+		return false
+	}
+	var syscallErr *os.SyscallError
+	if !errors.As(opErr.Err, &syscallErr) {
+		return false
 	}
+	message := strings.ToLower(syscallErr.Error())
+	return strings.Contains(message, "broken pipe") || strings.Contains(message, "connection reset by peer")
 }
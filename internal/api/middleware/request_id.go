@@ -2,18 +2,31 @@ package middleware
 
 import (
 	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
 
-// RequestID middleware adds a unique request ID to each request
+// defaultRequestIDHeader is used when no request ID header is configured
+const defaultRequestIDHeader = "X-Request-ID"
+
+// RequestID middleware adds a unique request ID to each request.
+// A valid inbound ID is reused when trusted; otherwise a new UUID is generated.
+// The ID is returned in the response header and stored for logs and response envelopes.
 func RequestID() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// Check if request ID exists in header (X-Request-ID)
-		requestID := c.GetHeader("X-Request-ID")
+		header := RequestIDHeader()
+
+		// Reuse the client's request ID if it is trusted and well formed
+		requestID := ""
+		if trustInboundRequestID() {
+			if inbound := c.GetHeader(header); utils.IsValidRequestID(inbound) {
+				requestID = inbound
+			}
+		}
 
-		// If not provided, generate a new UUID
+		// Otherwise generate a new UUID
 		if requestID == "" {
 			requestID = uuid.New().String()
 		}
@@ -22,9 +35,25 @@ func RequestID() gin.HandlerFunc {
 		c.Set(utils.RequestIDKey, requestID)
 
 		// Set response header
-		c.Header("X-Request-ID", requestID)
+		c.Header(header, requestID)
 
 		// Continue to next handler
 		c.Next()
 	}
 }
+
+// RequestIDHeader returns the header that carries the request ID
+func RequestIDHeader() string {
+	if config.AppConfig != nil && config.AppConfig.RequestID.Header != "" {
+		return config.AppConfig.RequestID.Header
+	}
+	return defaultRequestIDHeader
+}
+
+// trustInboundRequestID reports whether client-supplied request IDs are reused
+func trustInboundRequestID() bool {
+	if config.AppConfig == nil {
+		return true
+	}
+	return config.AppConfig.RequestID.TrustInbound
+}
@@ -31,12 +31,21 @@ func SetupRoutes(router *gin.Engine) {
 	interestHandlerPublic := handlers.NewInterestHandler()
 	v1.GET("/interests", interestHandlerPublic.GetAllInterests)
 
+	// Public enum metadata
+	metaHandler := handlers.NewMetaHandler()
+	v1.GET("/meta/enums", metaHandler.GetEnums)
+
 	// OTP monitoring for development
 	otpHandler := handlers.NewOTPHandler()
 
 	// Add dev/otp to v1 group (no auth required)
 	v1.GET("/dev/otp", otpHandler.GetOTPs)
 
+	// Example DTO payloads for client generation and contract tests (not served in release mode)
+	if gin.Mode() != gin.ReleaseMode {
+		v1.GET("/dev/examples", metaHandler.GetExamples)
+	}
+
 	// Image serving
 	imageHandler, err := handlers.NewImageHandler()
 	if err != nil {
@@ -56,7 +65,7 @@ func SetupRoutes(router *gin.Engine) {
 	router.OPTIONS("/*path", func(c *gin.Context) {
 		c.Header("Access-Control-Allow-Origin", "*")
 		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Accept, Authorization")
+		c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Accept, Authorization, "+middleware.GuestTokenHeader+", "+middleware.CaptchaTokenHeader+", "+middleware.RequestIDHeader())
 		c.Header("Access-Control-Max-Age", "86400")
 		c.Status(204)
 	})
@@ -65,18 +74,25 @@ func SetupRoutes(router *gin.Engine) {
 	authHandler := handlers.NewAuthHandler()
 	auth := v1.Group("/auth")
 	{
-		auth.POST("/register", authHandler.Register)
+		requireCaptcha := middleware.RequireCaptcha()
+		auth.POST("/register", requireCaptcha, authHandler.Register)
 		auth.POST("/verify-email", authHandler.VerifyEmail)
-		auth.POST("/resend-verification", authHandler.ResendVerification)
+		auth.POST("/resend-verification", requireCaptcha, authHandler.ResendVerification)
+		auth.POST("/cancel-registration", requireCaptcha, authHandler.CancelRegistration)
 		auth.POST("/login", authHandler.Login)
 		auth.GET("/google", authHandler.GoogleAuth)
 		auth.GET("/google/callback", authHandler.GoogleCallback)
-		auth.POST("/forgot-password", authHandler.ForgotPassword)
+		auth.POST("/forgot-password", requireCaptcha, authHandler.ForgotPassword)
 		auth.POST("/verify-otp", authHandler.VerifyOTP)
 		auth.POST("/reset-password", authHandler.ResetPassword)
 		auth.POST("/logout", middleware.AuthMiddleware(), authHandler.Logout)
-		auth.POST("/refresh", middleware.AuthMiddleware(), authHandler.RefreshToken)
+		auth.POST("/change-password", middleware.AuthMiddleware(), authHandler.ChangePassword)
+		auth.POST("/refresh", authHandler.RefreshToken)
 		auth.GET("/check", middleware.AuthMiddleware(), authHandler.Check)
+
+		guestHandler := handlers.NewGuestHandler()
+		auth.POST("/guest", middleware.GuestIssueRateLimit(), guestHandler.IssueGuestToken)
+		auth.POST("/guest/convert", middleware.AuthMiddleware(), guestHandler.ConvertGuest)
 	}
 
 	// Protected routes
@@ -91,8 +107,31 @@ func SetupRoutes(router *gin.Engine) {
 			users.PUT("/profile", userHandler.UpdateProfile)
 			users.DELETE("/profile", userHandler.DeleteProfile)
 			users.GET("/setup-status", userHandler.GetSetupStatus)
+			users.POST("/me/phone", userHandler.UpdatePhone)
+			users.POST("/me/phone/verify", userHandler.VerifyPhone)
+			users.PUT("/me/handle", userHandler.UpdateHandle)
+			users.POST("/me/devices", userHandler.RegisterDevice)
+			users.DELETE("/me/devices/:token", userHandler.UnregisterDevice)
+			users.GET("/handle/:handle", userHandler.GetPublicProfile)
+			users.GET("/:id/reliability", userHandler.GetReliability)
+			users.GET("/:id/events", userHandler.GetUserEvents)
 		}
 
+		// Notification routes
+		notificationHandler := handlers.NewNotificationHandler()
+		notifications := protected.Group("/notifications")
+		{
+			notifications.GET("", notificationHandler.GetNotifications)
+			notifications.GET("/unread-count", notificationHandler.GetUnreadCount)
+			notifications.POST("/read-all", notificationHandler.MarkAllNotificationsRead)
+			notifications.PATCH("/:id/read", notificationHandler.MarkNotificationRead)
+			notifications.POST("/:id/snooze", notificationHandler.SnoozeNotification)
+		}
+
+		// Home feed
+		homeHandler := handlers.NewHomeHandler()
+		protected.GET("/home", homeHandler.GetHomeFeed)
+
 		// Preference routes
 		preferenceHandler := handlers.NewPreferenceHandler()
 		preferences := protected.Group("/users/preferences")
@@ -106,27 +145,73 @@ func SetupRoutes(router *gin.Engine) {
 		// Event routes
 		eventHandler := handlers.NewEventHandler()
 		tagHandler := handlers.NewTagHandler()
+		expenseHandler := handlers.NewExpenseHandler()
 		events := protected.Group("/events")
 		{
+			// Mutation routes load the event once and check the caller's role before the handler runs
+			eventCreator := middleware.RequireEventRole(middleware.EventRoleCreator)
+			eventConfirmedMember := middleware.RequireEventRole(middleware.EventRoleConfirmedMember)
+			eventMember := middleware.RequireEventRole(middleware.EventRoleMember)
+
 			events.GET("", eventHandler.GetEvents)
 			events.GET("/joined", eventHandler.GetJoinedEvents)
+			events.GET("/created", eventHandler.GetCreatedEvents)
+			events.GET("/counts", eventHandler.GetEventCounts)
 			events.GET("/suggestions", eventHandler.GetEventSuggestions)
+			events.GET("/top-picks", eventHandler.GetTopPicks)
+			events.GET("/nearby", eventHandler.GetNearbyEvents)
+			events.POST("/state", eventHandler.GetEventStates)
+			events.POST("/tag-suggestions", tagHandler.SuggestEventTags)
 			events.POST("", eventHandler.CreateEvent)
 			events.GET("/:id", eventHandler.GetEvent)
-			events.PUT("/:id", eventHandler.UpdateEvent)
-			events.DELETE("/:id", eventHandler.DeleteEvent)
+			events.GET("/:id/similar", eventHandler.GetSimilarEvents)
+			events.GET("/:id/match", eventHandler.GetEventMatch)
+			events.GET("/:id/compatibility", eventHandler.GetEventCompatibility)
+			events.GET("/:id/attendees.csv", eventCreator, eventHandler.ExportAttendees)
+			events.GET("/:id/funnel", eventCreator, eventHandler.GetEventFunnel)
+			events.POST("/:id/broadcast", eventCreator, eventHandler.BroadcastToMembers)
+			events.POST("/:id/pending/expire", eventCreator, eventHandler.ExpirePendingMembers)
+			events.POST("/:id/invite", eventMember, eventHandler.InviteToEvent)
+			events.PUT("/:id", eventCreator, eventHandler.UpdateEvent)
+			events.DELETE("/:id", eventCreator, eventHandler.DeleteEvent)
 			events.POST("/:id/join", eventHandler.JoinEvent)
-			events.POST("/:id/leave", eventHandler.LeaveEvent)
-			events.POST("/:id/confirm", eventHandler.ConfirmEvent)
-			events.POST("/:id/cancel", eventHandler.CancelEvent)
-			events.POST("/:id/complete", eventHandler.CompleteEvent)
+			events.POST("/:id/leave", eventMember, eventHandler.LeaveEvent)
+			events.POST("/:id/confirm", eventMember, eventHandler.ConfirmEvent)
+			events.POST("/:id/confirmation/resend", eventMember, eventHandler.ResendConfirmationEmail)
+			events.PUT("/:id/membership/note", eventMember, eventHandler.UpdateMembershipNote)
+			events.POST("/:id/notifications/read", notificationHandler.MarkEventNotificationsRead)
+			events.POST("/:id/cancel", eventMember, eventHandler.CancelEvent)
+			events.POST("/:id/complete", eventCreator, eventHandler.CompleteEvent)
 			events.POST("/:id/swipe", eventHandler.SwipeEvent)
-			events.PUT("/:id/cover", eventHandler.UpdateCover)
-			events.POST("/:id/photos", eventHandler.AddPhotos)
+			events.DELETE("/:id/swipe", eventHandler.UndoSwipe)
+			events.PUT("/:id/cover", eventCreator, eventHandler.UpdateCover)
+			events.PUT("/:id/cover/from-photo/:photo_id", eventCreator, eventHandler.SetCoverFromPhoto)
+			events.POST("/:id/photos", eventCreator, eventHandler.AddPhotos)
+			events.DELETE("/:id/photos/:photo_id", eventCreator, eventHandler.RemovePhoto)
+			events.POST("/:id/no-show/:user_id", eventConfirmedMember, eventHandler.ReportNoShow)
+			events.POST("/:id/checkin", eventConfirmedMember, eventHandler.CheckIn)
+			events.POST("/:id/checkin-code", eventCreator, eventHandler.GenerateCheckinCode)
+			events.GET("/:id/items", eventHandler.GetEventItems)
+			events.POST("/:id/items", eventConfirmedMember, eventHandler.AddEventItem)
+			events.DELETE("/:id/items/:item_id", eventConfirmedMember, eventHandler.DeleteEventItem)
+			events.POST("/:id/items/:item_id/claim", eventConfirmedMember, eventHandler.ClaimEventItem)
+			events.DELETE("/:id/items/:item_id/claim", eventConfirmedMember, eventHandler.UnclaimEventItem)
+			events.POST("/:id/transfer", eventCreator, eventHandler.TransferOwnership)
 			// Event tag routes
 			events.GET("/:id/tags", tagHandler.GetEventTags)
-			events.POST("/:id/tags", tagHandler.AddEventTag)
-			events.DELETE("/:id/tags/:tag_id", tagHandler.RemoveEventTag)
+			events.POST("/:id/tags", eventCreator, tagHandler.AddEventTag)
+			events.DELETE("/:id/tags/:tag_id", eventCreator, tagHandler.RemoveEventTag)
+			// Event expense routes
+			events.GET("/:id/expenses", expenseHandler.GetExpenses)
+			events.POST("/:id/expenses", eventConfirmedMember, expenseHandler.AddExpense)
+			events.GET("/:id/expenses/summary", expenseHandler.GetExpenseSummary)
+			events.DELETE("/:id/expenses/:expense_id", eventConfirmedMember, expenseHandler.DeleteExpense)
+		}
+
+		// Onboarding routes
+		onboarding := protected.Group("/onboarding")
+		{
+			onboarding.GET("/suggested-tags", tagHandler.GetOnboardingTags)
 		}
 
 		// Chat routes
@@ -152,6 +237,8 @@ func SetupRoutes(router *gin.Engine) {
 		{
 			userTags.GET("/tags", tagHandler2.GetUserTags)
 			userTags.POST("/tags", tagHandler2.AddUserTag)
+			userTags.GET("/tags/from-interests", tagHandler2.GetInterestNoteTags)
+			userTags.POST("/tags/from-interests", tagHandler2.AddInterestNoteTags)
 			userTags.DELETE("/tags/:tag_id", tagHandler2.RemoveUserTag)
 		}
 
@@ -195,16 +282,38 @@ func SetupRoutes(router *gin.Engine) {
 			audit.GET("/logs", auditHandler.GetAuditLogs)
 			audit.GET("/entities/:entity_table/:entity_id", auditHandler.GetEntityAuditHistory)
 		}
+
+		// Admin routes
+		admin := protected.Group("/admin")
+		admin.Use(middleware.AdminMiddleware())
+		{
+			webhookHandler := handlers.NewWebhookHandler()
+			admin.POST("/webhooks", webhookHandler.CreateWebhook)
+			admin.GET("/webhooks", webhookHandler.GetWebhooks)
+			admin.DELETE("/webhooks/:id", webhookHandler.DeleteWebhook)
+			admin.GET("/webhooks/:id/deliveries", webhookHandler.GetWebhookDeliveries)
+
+			adminHandler := handlers.NewAdminHandler()
+			admin.POST("/events/:id/complete", adminHandler.CompleteEvent)
+			admin.POST("/events/:id/cancel", adminHandler.CancelEvent)
+			admin.DELETE("/events/:id", adminHandler.PurgeEvent)
+			admin.GET("/storage/orphans", adminHandler.GetOrphanedStorage)
+			admin.GET("/config", adminHandler.GetConfig)
+			admin.GET("/users/:id/notifications", adminHandler.GetUserNotifications)
+		}
 	}
 
-	// Public routes (no authentication required)
+	// Public routes (no authentication required; signed-in users get personalized responses, and
+	// guest tokens get a higher rate limit than anonymous clients)
 	public := v1.Group("/public")
+	public.Use(middleware.OptionalAuthMiddleware(), middleware.GuestContext(), middleware.PublicRateLimit())
 	{
 		// Public event routes
 		eventHandler := handlers.NewEventHandler()
 		events := public.Group("/events")
 		{
 			events.GET("", eventHandler.GetPublicEvents)
+			events.GET("/counts", eventHandler.GetPublicEventCounts)
 			events.GET("/:id", eventHandler.GetPublicEvent)
 		}
 
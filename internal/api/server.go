@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"TinderTrip-Backend/internal/api/middleware"
 	"TinderTrip-Backend/internal/api/routes"
 	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
 	"TinderTrip-Backend/pkg/config"
 	"TinderTrip-Backend/pkg/database"
 
@@ -24,6 +26,7 @@ type Server struct {
 	httpServer        *http.Server
 	router            *gin.Engine
 	authHandler       *handlers.AuthHandler
+	healthHandler     *handlers.HealthHandler
 	monitoringService *service.MonitoringService
 }
 
@@ -48,7 +51,7 @@ func NewServer() *Server {
 	router.Use(middleware.APILogger())   // Add API logging to database
 	router.Use(middleware.Recovery())
 	router.Use(middleware.CustomCORS()) // CORS enabled for all responses
-	// router.Use(middleware.RateLimit()) // Rate limit disabled for development
+	router.Use(middleware.RateLimit())  // RateLimit-* headers; rejects only when RATE_LIMIT_ENFORCE is set
 
 	// Add monitoring middleware
 	if config.AppConfig.Monitoring.Enabled {
@@ -59,6 +62,13 @@ func NewServer() *Server {
 	// Setup routes
 	routes.SetupRoutes(router)
 
+	// Readiness probe, not ready until Start is listening
+	healthHandler := handlers.NewHealthHandler()
+	router.GET("/ready", healthHandler.Ready)
+	if monitoringService != nil {
+		monitoringService.SetReadinessHandler(healthHandler.Ready)
+	}
+
 	// Add Swagger documentation
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
@@ -75,6 +85,7 @@ func NewServer() *Server {
 	return &Server{
 		router:            router,
 		authHandler:       authHandler,
+		healthHandler:     healthHandler,
 		monitoringService: monitoringService,
 	}
 }
@@ -87,22 +98,29 @@ func (s *Server) Start() error {
 		}
 	}
 
-	port := config.AppConfig.Server.Port
-	host := "0.0.0.0" // Hardcode to 0.0.0.0 for LAN access
+	cfg := config.AppConfig.Server
+	s.httpServer = NewHTTPServer(cfg, s.router)
 
-	s.httpServer = &http.Server{
-		Addr:         fmt.Sprintf("%s:%s", host, port),
-		Handler:      s.router,
-		ReadTimeout:  15 * time.Second,
-		WriteTimeout: 15 * time.Second,
-		IdleTimeout:  60 * time.Second,
+	listener, err := net.Listen("tcp", s.httpServer.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.httpServer.Addr, err)
 	}
 
-	log.Printf("Server starting on %s:%s", host, port)
-	return s.httpServer.ListenAndServe()
+	// Everything is initialized once we are listening
+	s.healthHandler.MarkReady()
+	utils.Info("Server ready", map[string]interface{}{
+		"addr":  s.httpServer.Addr,
+		"tls":   cfg.TLSCertFile != "" || len(cfg.AutocertDomains) > 0,
+		"http2": cfg.HTTP2,
+	})
+
+	return serve(s.httpServer, listener, cfg)
 }
 
 func (s *Server) Shutdown() error {
+	// Stop taking new traffic before draining
+	s.healthHandler.MarkNotReady()
+
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
@@ -25,7 +25,8 @@ type LoginResponse struct {
 
 // ForgotPasswordRequest represents a forgot password request
 type ForgotPasswordRequest struct {
-	Email string `json:"email" binding:"required,email"`
+	Email   string `json:"email" binding:"required,email"`
+	Channel string `json:"channel,omitempty" binding:"omitempty,oneof=email sms"`
 }
 
 // VerifyOTPRequest represents a verify OTP request
@@ -59,6 +60,12 @@ type RefreshTokenRequest struct {
 	RefreshToken string `json:"refresh_token" binding:"required"`
 }
 
+// LogoutRequest represents a logout request. The refresh token is optional for older clients,
+// but without it the login's refresh tokens stay valid.
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
 // RefreshTokenResponse represents a refresh token response
 type RefreshTokenResponse struct {
 	AccessToken  string `json:"access_token"`
@@ -82,6 +89,17 @@ type ResendVerificationRequest struct {
 	Email string `json:"email" binding:"required,email"`
 }
 
+// RegisterResponse represents the response to a registration
+type RegisterResponse struct {
+	CancelToken string `json:"cancel_token"` // cancels this registration through /auth/cancel-registration
+}
+
+// CancelRegistrationRequest represents a request to cancel a pending registration
+type CancelRegistrationRequest struct {
+	Email       string `json:"email" binding:"required,email"`
+	CancelToken string `json:"cancel_token" binding:"required"`
+}
+
 // VerifyEmailOTPRequest represents a verify email OTP request
 type VerifyEmailOTPRequest struct {
 	Email string `json:"email" binding:"required,email"`
@@ -96,6 +114,22 @@ type RegisterWithOTPRequest struct {
 	OTP         string `json:"otp" binding:"required,len=6"`
 }
 
+// GuestTokenResponse represents a newly issued guest browsing token
+type GuestTokenResponse struct {
+	GuestToken string    `json:"guest_token"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// ConvertGuestRequest represents a request to carry a guest's browsing over to the signed-in user
+type ConvertGuestRequest struct {
+	GuestToken string `json:"guest_token" binding:"required"`
+}
+
+// ConvertGuestResponse reports what was carried over from the guest
+type ConvertGuestResponse struct {
+	SeenEvents int64 `json:"seen_events"`
+}
+
 // AuthResponse represents an authentication response
 type AuthResponse struct {
 	Token string       `json:"token"`
@@ -107,6 +141,7 @@ type UserResponse struct {
 	ID            string               `json:"id"`
 	Email         string               `json:"email"`
 	DisplayName   string               `json:"display_name"`
+	Handle        string               `json:"handle,omitempty"`
 	Provider      string               `json:"provider"`
 	EmailVerified bool                 `json:"is_verified"`
 	CreatedAt     time.Time            `json:"created_at"`
@@ -115,8 +150,9 @@ type UserResponse struct {
 
 // ErrorResponse represents an error response
 type ErrorResponse struct {
-	Error   string `json:"error"`
-	Message string `json:"message"`
+	Error     string `json:"error"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id,omitempty"`
 }
 
 // SuccessResponse represents a success response
@@ -12,15 +12,26 @@ type ChatRoomResponse struct {
 
 // ChatMessageResponse represents a chat message response
 type ChatMessageResponse struct {
-	ID          string        `json:"id"`
-	RoomID      string        `json:"room_id"`
-	SenderID    string        `json:"sender_id"`
-	Sender      *UserResponse `json:"sender,omitempty"`
-	Body        *string       `json:"body,omitempty"`
-	MessageType string        `json:"message_type"`
-	ImageURL    *string       `json:"image_url,omitempty"`
-	FileURL     *string       `json:"file_url,omitempty"`
-	CreatedAt   time.Time     `json:"created_at"`
+	ID          string                `json:"id"`
+	RoomID      string                `json:"room_id"`
+	SenderID    string                `json:"sender_id"`
+	Sender      *UserResponse         `json:"sender,omitempty"`
+	Body        *string               `json:"body,omitempty"`
+	MessageType string                `json:"message_type"`
+	ImageURL    *string               `json:"image_url,omitempty"`
+	FileURL     *string               `json:"file_url,omitempty"`
+	Mentions    []ChatMentionResponse `json:"mentions,omitempty"`
+	CreatedAt   time.Time             `json:"created_at"`
+}
+
+// ChatMentionResponse is a member @mentioned in a chat message. Start and Length locate the
+// mention, including the @, in the message body in runes.
+type ChatMentionResponse struct {
+	UserID      string `json:"user_id"`
+	Handle      string `json:"handle"`
+	DisplayName string `json:"display_name"`
+	Start       int    `json:"start"`
+	Length      int    `json:"length"`
 }
 
 // SendMessageRequest represents a send message request (JSON)
@@ -15,6 +15,7 @@ type EventResponse struct {
 	StartAt       *time.Time            `json:"start_at,omitempty"`
 	EndAt         *time.Time            `json:"end_at,omitempty"`
 	Capacity      *int                  `json:"capacity,omitempty"`
+	MinAttendees  *int                  `json:"min_attendees,omitempty"`
 	BudgetMin     *int                  `json:"budget_min,omitempty"`
 	BudgetMax     *int                  `json:"budget_max,omitempty"`
 	Currency      *string               `json:"currency,omitempty"`
@@ -26,14 +27,48 @@ type EventResponse struct {
 	Tags          []TagResponse         `json:"tags,omitempty"`
 	Interests     []InterestResponse    `json:"interests,omitempty"`
 	Members       []EventMemberResponse `json:"members,omitempty"`
+	Items         []EventItemResponse   `json:"items,omitempty"`
 	MemberCount   int                   `json:"member_count"`
+	SpotsLeft     *int                  `json:"spots_left,omitempty"`
 	IsJoined      bool                  `json:"is_joined"`
+	MemberStatus  *string               `json:"member_status,omitempty"`
 	UserSwipe     *EventSwipeResponse   `json:"user_swipe,omitempty"`
 	MatchScore    *float64              `json:"match_score,omitempty"`
+	Settings      EventSettingsResponse `json:"settings"`
+	CancelReason  *string               `json:"cancellation_reason,omitempty"`
+	CancelledAt   *time.Time            `json:"cancelled_at,omitempty"`
 	CreatedAt     time.Time             `json:"created_at"`
 	UpdatedAt     time.Time             `json:"updated_at"`
 }
 
+// EventCountGroup represents the number of events for one event type and status
+type EventCountGroup struct {
+	EventType string `json:"event_type"`
+	Status    string `json:"status"`
+	Count     int64  `json:"count"`
+}
+
+// EventCountsResponse represents event counts grouped for filter chips
+type EventCountsResponse struct {
+	Total       int64             `json:"total"`
+	ByEventType map[string]int64  `json:"by_event_type"`
+	ByStatus    map[string]int64  `json:"by_status"`
+	Groups      []EventCountGroup `json:"groups"`
+}
+
+// EventStateRequest represents a batch lookup of the viewer's relationship to events
+type EventStateRequest struct {
+	EventIDs []string `json:"event_ids" binding:"required,min=1,max=100,dive,uuid"`
+}
+
+// EventStateResponse represents the viewer's relationship to one event
+type EventStateResponse struct {
+	EventID        string  `json:"event_id"`
+	IsJoined       bool    `json:"is_joined"`
+	MemberStatus   *string `json:"member_status,omitempty"`
+	SwipeDirection *string `json:"swipe_direction,omitempty"`
+}
+
 // EventPhotoResponse represents an event photo response
 type EventPhotoResponse struct {
 	ID        string    `json:"id"`
@@ -54,6 +89,7 @@ type EventMemberResponse struct {
 	JoinedAt    time.Time  `json:"joined_at"`
 	ConfirmedAt *time.Time `json:"confirmed_at,omitempty"`
 	LeftAt      *time.Time `json:"left_at,omitempty"`
+	CheckedInAt *time.Time `json:"checked_in_at,omitempty"`
 	Note        *string    `json:"note,omitempty"`
 }
 
@@ -67,43 +103,66 @@ type EventSwipeResponse struct {
 
 // CreateEventRequest represents a create event request
 type CreateEventRequest struct {
-	Title         string     `json:"title" binding:"required"`
-	Description   *string    `json:"description,omitempty"`
-	EventType     string     `json:"event_type" binding:"required,oneof=meal one_day_trip overnight"`
-	AddressText   *string    `json:"address_text,omitempty"`
-	Lat           *float64   `json:"lat,omitempty"`
-	Lng           *float64   `json:"lng,omitempty"`
-	StartAt       *time.Time `json:"start_at,omitempty"`
-	EndAt         *time.Time `json:"end_at,omitempty"`
-	Capacity      *int       `json:"capacity,omitempty"`
-	BudgetMin     *int       `json:"budget_min,omitempty"`
-	BudgetMax     *int       `json:"budget_max,omitempty"`
-	Currency      *string    `json:"currency,omitempty"`
-	CoverImageURL *string    `json:"cover_image_url,omitempty"`
-	CategoryIDs   []string   `json:"category_ids,omitempty"`
-	TagIDs        []string   `json:"tag_ids,omitempty"`
-	InterestCodes []string   `json:"interest_codes,omitempty"`
+	Title         string                `json:"title" binding:"required"`
+	Description   *string               `json:"description,omitempty"`
+	EventType     string                `json:"event_type" binding:"required"`
+	AddressText   *string               `json:"address_text,omitempty"`
+	Lat           *float64              `json:"lat,omitempty"`
+	Lng           *float64              `json:"lng,omitempty"`
+	StartAt       *time.Time            `json:"start_at,omitempty"`
+	EndAt         *time.Time            `json:"end_at,omitempty"`
+	Capacity      *int                  `json:"capacity,omitempty"`
+	MinAttendees  *int                  `json:"min_attendees,omitempty"`
+	BudgetMin     *int                  `json:"budget_min,omitempty"`
+	BudgetMax     *int                  `json:"budget_max,omitempty"`
+	Currency      *string               `json:"currency,omitempty"`
+	CoverImageURL *string               `json:"cover_image_url,omitempty"`
+	CategoryIDs   []string              `json:"category_ids,omitempty"`
+	TagIDs        []string              `json:"tag_ids,omitempty"`
+	InterestCodes []string              `json:"interest_codes,omitempty"`
+	Settings      *EventSettingsRequest `json:"settings,omitempty"`
 }
 
 // UpdateEventRequest represents an update event request
 type UpdateEventRequest struct {
-	Title         *string    `json:"title,omitempty"`
-	Description   *string    `json:"description,omitempty"`
-	EventType     *string    `json:"event_type,omitempty" binding:"omitempty,oneof=meal daytrip overnight activity other"`
-	AddressText   *string    `json:"address_text,omitempty"`
-	Lat           *float64   `json:"lat,omitempty"`
-	Lng           *float64   `json:"lng,omitempty"`
-	StartAt       *time.Time `json:"start_at,omitempty"`
-	EndAt         *time.Time `json:"end_at,omitempty"`
-	Capacity      *int       `json:"capacity,omitempty"`
-	BudgetMin     *int       `json:"budget_min,omitempty"`
-	BudgetMax     *int       `json:"budget_max,omitempty"`
-	Currency      *string    `json:"currency,omitempty"`
-	Status        *string    `json:"status,omitempty" binding:"omitempty,oneof=published cancelled completed"`
-	CoverImageURL *string    `json:"cover_image_url,omitempty"`
-	CategoryIDs   []string   `json:"category_ids,omitempty"`
-	TagIDs        []string   `json:"tag_ids,omitempty"`
-	InterestCodes []string   `json:"interest_codes,omitempty"`
+	Title         *string               `json:"title,omitempty"`
+	Description   *string               `json:"description,omitempty"`
+	EventType     *string               `json:"event_type,omitempty"`
+	AddressText   *string               `json:"address_text,omitempty"`
+	Lat           *float64              `json:"lat,omitempty"`
+	Lng           *float64              `json:"lng,omitempty"`
+	StartAt       *time.Time            `json:"start_at,omitempty"`
+	EndAt         *time.Time            `json:"end_at,omitempty"`
+	Capacity      *int                  `json:"capacity,omitempty"`
+	MinAttendees  *int                  `json:"min_attendees,omitempty"`
+	BudgetMin     *int                  `json:"budget_min,omitempty"`
+	BudgetMax     *int                  `json:"budget_max,omitempty"`
+	Currency      *string               `json:"currency,omitempty"`
+	Status        *string               `json:"status,omitempty"` // rejected; use the cancel and complete endpoints
+	CoverImageURL *string               `json:"cover_image_url,omitempty"`
+	CategoryIDs   []string              `json:"category_ids,omitempty"`
+	TagIDs        []string              `json:"tag_ids,omitempty"`
+	InterestCodes []string              `json:"interest_codes,omitempty"`
+	Settings      *EventSettingsRequest `json:"settings,omitempty"`
+}
+
+// EventSettingsRequest changes event settings; omitted fields are left unchanged
+type EventSettingsRequest struct {
+	AllowMemberInvites *bool `json:"allow_member_invites,omitempty"`
+	PendingChatAccess  *bool `json:"pending_chat_access,omitempty"`
+	MembersVisible     *bool `json:"members_visible,omitempty"`
+}
+
+// EventSettingsResponse represents the effective settings of an event
+type EventSettingsResponse struct {
+	AllowMemberInvites bool `json:"allow_member_invites"`
+	PendingChatAccess  bool `json:"pending_chat_access"`
+	MembersVisible     bool `json:"members_visible"`
+}
+
+// InviteToEventRequest represents a request to invite a user to an event
+type InviteToEventRequest struct {
+	UserID string `json:"user_id" binding:"required,uuid"`
 }
 
 // JoinEventRequest represents a join event request
@@ -148,3 +207,176 @@ type GetEventsResponse struct {
 	Limit      int             `json:"limit"`
 	TotalPages int             `json:"total_pages"`
 }
+
+// CancelEventRequest represents a creator cancelling their event
+type CancelEventRequest struct {
+	Reason *string `json:"reason,omitempty" binding:"omitempty,max=500"`
+}
+
+// AdminEventActionRequest represents an admin override on an event
+type AdminEventActionRequest struct {
+	Reason *string `json:"reason,omitempty"`
+}
+
+// PhotoUploadResult represents the outcome of uploading a single photo
+type PhotoUploadResult struct {
+	Filename string  `json:"filename"`
+	URL      *string `json:"url,omitempty"`
+	Error    *string `json:"error,omitempty"`
+}
+
+// PhotoUploadResponse represents a bulk photo upload summary
+type PhotoUploadResponse struct {
+	URLs     []string            `json:"urls"`
+	Results  []PhotoUploadResult `json:"results"`
+	Uploaded int                 `json:"uploaded"`
+	Failed   int                 `json:"failed"`
+}
+
+// CheckinRequest represents an event check-in request.
+// Provide either the creator's check-in code or the member's current location.
+type CheckinRequest struct {
+	Code *string  `json:"code,omitempty"`
+	Lat  *float64 `json:"lat,omitempty" binding:"omitempty,min=-90,max=90"`
+	Lng  *float64 `json:"lng,omitempty" binding:"omitempty,min=-180,max=180"`
+}
+
+// CheckinResponse represents a successful check-in
+type CheckinResponse struct {
+	EventID     string    `json:"event_id"`
+	UserID      string    `json:"user_id"`
+	CheckedInAt time.Time `json:"checked_in_at"`
+	Method      string    `json:"method"`
+}
+
+// CheckinCodeResponse represents a generated check-in code
+type CheckinCodeResponse struct {
+	EventID string `json:"event_id"`
+	Code    string `json:"code"`
+}
+
+// BroadcastRequest represents a creator message to all confirmed members
+type BroadcastRequest struct {
+	Title      string `json:"title" binding:"required,max=100"`
+	Body       string `json:"body" binding:"required,max=1000"`
+	PostToChat bool   `json:"post_to_chat"`
+}
+
+// BroadcastResponse represents the result of a broadcast
+type BroadcastResponse struct {
+	EventID        string  `json:"event_id"`
+	Recipients     int     `json:"recipients"`
+	ChatMessageID  *string `json:"chat_message_id,omitempty"`
+	RemainingToday int     `json:"remaining_today"`
+}
+
+// UpdateMemberNoteRequest sets the caller's note on their membership; empty or null clears it
+type UpdateMemberNoteRequest struct {
+	Note *string `json:"note"`
+}
+
+// MemberNoteResponse represents the caller's note on their membership
+type MemberNoteResponse struct {
+	EventID string  `json:"event_id"`
+	UserID  string  `json:"user_id"`
+	Note    *string `json:"note"`
+}
+
+// ExpirePendingMembersResponse reports how many stale pending memberships were declined
+type ExpirePendingMembersResponse struct {
+	Expired int `json:"expired"`
+}
+
+// TransferOwnershipRequest represents a request to hand an event to another member
+type TransferOwnershipRequest struct {
+	NewCreatorID string `json:"new_creator_id" binding:"required,uuid"`
+}
+
+// EventItemResponse represents an item on an event's bring list
+type EventItemResponse struct {
+	ID            string     `json:"id"`
+	EventID       string     `json:"event_id"`
+	Name          string     `json:"name"`
+	AddedBy       string     `json:"added_by"`
+	ClaimedBy     *string    `json:"claimed_by,omitempty"`
+	ClaimedByName *string    `json:"claimed_by_name,omitempty"`
+	ClaimedAt     *time.Time `json:"claimed_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// AddEventItemRequest represents a request to add an item to an event's bring list
+type AddEventItemRequest struct {
+	Name string `json:"name" binding:"required,max=100"`
+}
+
+// CreateEventExpenseRequest represents a cost a member paid for the group.
+// Amount is in minor currency units. PayerID defaults to the caller and
+// SplitAmong defaults to every confirmed member.
+type CreateEventExpenseRequest struct {
+	Description string   `json:"description" binding:"required,max=200"`
+	Amount      int64    `json:"amount" binding:"required,min=1,max=100000000"`
+	PayerID     *string  `json:"payer_id,omitempty" binding:"omitempty,uuid"`
+	SplitAmong  []string `json:"split_among,omitempty" binding:"omitempty,max=100,dive,uuid"`
+}
+
+// EventExpenseShareResponse represents one member's portion of an expense
+type EventExpenseShareResponse struct {
+	UserID string `json:"user_id"`
+	Amount int64  `json:"amount"`
+}
+
+// EventExpenseResponse represents an expense recorded for an event
+type EventExpenseResponse struct {
+	ID          string                      `json:"id"`
+	EventID     string                      `json:"event_id"`
+	PayerID     string                      `json:"payer_id"`
+	PayerName   string                      `json:"payer_name"`
+	Amount      int64                       `json:"amount"`
+	Currency    *string                     `json:"currency,omitempty"`
+	Description string                      `json:"description"`
+	Shares      []EventExpenseShareResponse `json:"shares"`
+	CreatedBy   string                      `json:"created_by"`
+	CreatedAt   time.Time                   `json:"created_at"`
+}
+
+// ExpenseBalanceResponse represents a member's position across all expenses.
+// A positive balance means the member is owed money; negative means they owe.
+type ExpenseBalanceResponse struct {
+	UserID      string `json:"user_id"`
+	DisplayName string `json:"display_name"`
+	Paid        int64  `json:"paid"`
+	Owed        int64  `json:"owed"`
+	Balance     int64  `json:"balance"`
+}
+
+// ExpenseSettlementResponse represents one payment needed to settle up
+type ExpenseSettlementResponse struct {
+	FromUserID string `json:"from_user_id"`
+	ToUserID   string `json:"to_user_id"`
+	Amount     int64  `json:"amount"`
+}
+
+// ExpenseSummaryResponse represents balances and the payments that settle them
+type ExpenseSummaryResponse struct {
+	EventID     string                      `json:"event_id"`
+	Currency    *string                     `json:"currency,omitempty"`
+	Total       int64                       `json:"total"`
+	Balances    []ExpenseBalanceResponse    `json:"balances"`
+	Settlements []ExpenseSettlementResponse `json:"settlements"`
+}
+
+// EventFunnelStage is one step of an event's interest-to-attendance funnel
+type EventFunnelStage struct {
+	Stage string `json:"stage"`
+	Count int64  `json:"count"`
+	// DropOff is how many fewer users reached this stage than the one before; nil for the first stage
+	DropOff *int64 `json:"drop_off,omitempty"`
+	// ConversionRate is Count over the previous stage's count; nil for the first stage or when that count is 0
+	ConversionRate *float64 `json:"conversion_rate,omitempty"`
+}
+
+// EventFunnelResponse represents an event's funnel, in stage order
+type EventFunnelResponse struct {
+	EventID string             `json:"event_id"`
+	Stages  []EventFunnelStage `json:"stages"`
+}
@@ -0,0 +1,121 @@
+package dto
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// EventFieldGroups maps field group names to the event response keys they include
+var EventFieldGroups = map[string][]string{
+	"summary":    {"id", "title", "event_type", "status", "cover_image_url", "start_at", "end_at"},
+	"details":    {"description", "creator_id", "capacity", "spots_left", "min_attendees", "settings", "cancellation_reason", "cancelled_at", "created_at", "updated_at"},
+	"location":   {"address_text", "lat", "lng"},
+	"budget":     {"budget_min", "budget_max", "currency"},
+	"creator":    {"creator"},
+	"photos":     {"photos"},
+	"categories": {"categories"},
+	"tags":       {"tags"},
+	"interests":  {"interests"},
+	"items":      {"items"},
+	"members":    {"members", "member_count"},
+	"viewer":     {"is_joined", "member_status", "user_swipe", "match_score"},
+}
+
+// eventFieldIndex maps every key that can be selected individually to its EventResponse field.
+// It is read from the json tags once, so new response fields are selectable without a list to update.
+var eventFieldIndex = eventResponseFieldIndex()
+
+// eventResponseFieldIndex maps the json key of each EventResponse field to the field's index
+func eventResponseFieldIndex() map[string]int {
+	responseType := reflect.TypeOf(EventResponse{})
+	index := make(map[string]int, responseType.NumField())
+	for i := 0; i < responseType.NumField(); i++ {
+		key, _, _ := strings.Cut(responseType.Field(i).Tag.Get("json"), ",")
+		if key == "" || key == "-" {
+			continue
+		}
+		index[key] = i
+	}
+	return index
+}
+
+// ParseEventFields parses a comma separated `fields` query value into a set of response keys.
+// Entries may be individual keys or group names. An empty value returns nil (all fields).
+func ParseEventFields(raw string) (map[string]bool, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+
+	// id is always returned so clients can reference the event
+	fields := map[string]bool{"id": true}
+	for _, name := range strings.Split(raw, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if group, ok := EventFieldGroups[name]; ok {
+			for _, key := range group {
+				fields[key] = true
+			}
+			continue
+		}
+		if _, ok := eventFieldIndex[name]; !ok {
+			return nil, fmt.Errorf("unknown field: %s", name)
+		}
+		fields[name] = true
+	}
+
+	return fields, nil
+}
+
+// SelectEventFields returns only the selected keys of an event response.
+// A nil field set returns the full response unchanged.
+func SelectEventFields(event EventResponse, fields map[string]bool) interface{} {
+	if fields == nil {
+		return event
+	}
+
+	// Drop unselected fields before encoding so they are never serialized
+	response := reflect.ValueOf(&event).Elem()
+	for key, i := range eventFieldIndex {
+		if !fields[key] {
+			field := response.Field(i)
+			field.Set(reflect.Zero(field.Type()))
+		}
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return event
+	}
+	var full map[string]interface{}
+	if err := json.Unmarshal(data, &full); err != nil {
+		return event
+	}
+
+	selected := make(map[string]interface{}, len(fields))
+	for key := range fields {
+		if value, ok := full[key]; ok {
+			selected[key] = value
+		}
+	}
+
+	return selected
+}
+
+// SelectEventListFields applies SelectEventFields to each event in a list
+func SelectEventListFields(events []EventResponse, fields map[string]bool) interface{} {
+	if fields == nil {
+		return events
+	}
+
+	selected := make([]interface{}, len(events))
+	for i, event := range events {
+		selected[i] = SelectEventFields(event, fields)
+	}
+
+	return selected
+}
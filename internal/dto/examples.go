@@ -0,0 +1,179 @@
+package dto
+
+import "time"
+
+// exampleTime is the fixed timestamp used in example payloads so they stay stable between runs
+var exampleTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Examples returns sample instances of the key response DTOs, keyed by type name.
+// Optional fields are filled in wherever they apply, so frontend code and contract
+// tests can see the complete shape of each payload.
+func Examples() map[string]interface{} {
+	user := exampleUserResponse()
+	event := exampleEventResponse()
+	page, limit, totalPages := 1, 20, 1
+	total := int64(1)
+
+	return map[string]interface{}{
+		"UserResponse": user,
+		"AuthResponseWrapper": AuthResponseWrapper{
+			Success:      true,
+			RequestID:    "550e8400-e29b-41d4-a716-446655440000",
+			Timestamp:    "2024-01-01T00:00:00Z",
+			Message:      "Login successful",
+			Token:        "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.example.signature",
+			RefreshToken: "3f9c2a7e5b1d4c8a9e0f6b2d7c4a1e5f3f9c2a7e5b1d4c8a9e0f6b2d7c4a1e5f",
+			User:         user,
+		},
+		"EventResponse": event,
+		"EventResponseWrapper": EventResponseWrapper{
+			Success:   true,
+			RequestID: "550e8400-e29b-41d4-a716-446655440000",
+			Timestamp: "2024-01-01T00:00:00Z",
+			Message:   "Event retrieved successfully",
+			Data:      event,
+		},
+		"EventListResponseWrapper": EventListResponseWrapper{
+			Success:   true,
+			RequestID: "550e8400-e29b-41d4-a716-446655440000",
+			Timestamp: "2024-01-01T00:00:00Z",
+			Message:   "Events retrieved successfully",
+			Data:      []EventResponse{event},
+			Meta:      &MetaData{Page: &page, Limit: &limit, Total: &total, TotalPages: &totalPages},
+		},
+		"NotificationResponse": NotificationResponse{
+			ID:        "8a3f2c1e-5b6d-4e7f-9a0b-1c2d3e4f5a6b",
+			UserID:    user.ID,
+			Title:     "New member",
+			Body:      "Somchai joined Sunset dinner at Chao Phraya",
+			Type:      "push",
+			Data:      map[string]interface{}{"event_id": event.ID},
+			Read:      true,
+			CreatedAt: exampleTime,
+			ReadAt:    &exampleTime,
+		},
+		"ErrorAPIResponse": ErrorAPIResponse{
+			Success:   false,
+			RequestID: "550e8400-e29b-41d4-a716-446655440000",
+			Timestamp: "2024-01-01T00:00:00Z",
+			Code:      "VALIDATION_ERROR",
+			Message:   "Invalid request format",
+			Errors:    "Key: 'CreateEventRequest.Title' Error:Field validation for 'Title' failed on the 'required' tag",
+		},
+	}
+}
+
+// exampleUserResponse returns a fully populated sample user
+func exampleUserResponse() UserResponse {
+	displayName := "Nattapong"
+	handle := "nattapong_0427"
+	bio := "Weekend hiker and street food fan"
+	languages := "th,en"
+	age := 28
+	jobTitle := "Designer"
+	interestsNote := "hiking, street food, jazz"
+	avatarURL := "https://cdn.example.com/avatars/2f1b7c1e.jpg"
+	homeLocation := "Bangkok"
+
+	return UserResponse{
+		ID:            "2f1b7c1e-4d5a-4b6c-8d7e-9f0a1b2c3d4e",
+		Email:         "nattapong@example.com",
+		DisplayName:   displayName,
+		Handle:        handle,
+		Provider:      "password",
+		EmailVerified: true,
+		CreatedAt:     exampleTime,
+		Profile: &UserProfileResponse{
+			ID:            "6c5d4e3f-2a1b-4c0d-9e8f-7a6b5c4d3e2f",
+			UserID:        "2f1b7c1e-4d5a-4b6c-8d7e-9f0a1b2c3d4e",
+			Handle:        &handle,
+			DisplayName:   &displayName,
+			Bio:           &bio,
+			Languages:     &languages,
+			DateOfBirth:   &exampleTime,
+			Age:           &age,
+			Gender:        "male",
+			JobTitle:      &jobTitle,
+			Smoking:       "no",
+			InterestsNote: &interestsNote,
+			AvatarURL:     &avatarURL,
+			HomeLocation:  &homeLocation,
+			CreatedAt:     exampleTime,
+			UpdatedAt:     exampleTime,
+		},
+	}
+}
+
+// exampleEventResponse returns a sample cancelled event as seen by its creator
+func exampleEventResponse() EventResponse {
+	eventID := "0b9c8d7e-6f5a-4b3c-2d1e-0f9a8b7c6d5e"
+	creator := exampleUserResponse()
+	description := "Dinner cruise on the river, meet at the pier"
+	address := "River City Pier, Bangkok"
+	lat, lng := 13.7303, 100.5133
+	start := exampleTime.Add(72 * time.Hour)
+	end := start.Add(3 * time.Hour)
+	capacity, budgetMin, budgetMax := 8, 800, 1500
+	minAttendees := 4
+	spotsLeft := capacity - 1
+	currency := "THB"
+	cover := "https://cdn.example.com/events/0b9c8d7e/cover.jpg"
+	sortNo := 1
+	icon := "utensils"
+	memberStatus := "confirmed"
+	matchScore := 0.82
+	note := "Bringing a friend's camera"
+	claimedBy := creator.ID
+	claimedByName := creator.DisplayName
+	cancelReason := "Storm warning on the river"
+
+	return EventResponse{
+		ID:            eventID,
+		CreatorID:     creator.ID,
+		Title:         "Sunset dinner at Chao Phraya",
+		Description:   &description,
+		EventType:     "meal",
+		AddressText:   &address,
+		Lat:           &lat,
+		Lng:           &lng,
+		StartAt:       &start,
+		EndAt:         &end,
+		Capacity:      &capacity,
+		MinAttendees:  &minAttendees,
+		BudgetMin:     &budgetMin,
+		BudgetMax:     &budgetMax,
+		Currency:      &currency,
+		Status:        "cancelled",
+		CoverImageURL: &cover,
+		Creator:       &creator,
+		Photos: []EventPhotoResponse{
+			{ID: "3e4f5a6b-7c8d-4e9f-0a1b-2c3d4e5f6a7b", EventID: eventID, URL: cover, SortNo: &sortNo, CreatedAt: exampleTime},
+		},
+		Categories: []TagResponse{
+			{ID: "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d", Name: "Food", Kind: "category", CreatedAt: exampleTime},
+		},
+		Tags: []TagResponse{
+			{ID: "5d4c3b2a-1f0e-4d9c-8b7a-6f5e4d3c2b1a", Name: "Riverside", Kind: "location", CreatedAt: exampleTime},
+		},
+		Interests: []InterestResponse{
+			{ID: "9f8e7d6c-5b4a-4f3e-2d1c-0b9a8f7e6d5c", Code: "street_food", DisplayName: "Street food", Icon: &icon, Category: "food", SortOrder: 1, IsActive: true, CreatedAt: exampleTime, IsSelected: true},
+		},
+		Members: []EventMemberResponse{
+			{EventID: eventID, UserID: creator.ID, DisplayName: creator.DisplayName, AvatarURL: creator.Profile.AvatarURL, Role: "creator", Status: "confirmed", JoinedAt: exampleTime, ConfirmedAt: &exampleTime, Note: &note},
+		},
+		Items: []EventItemResponse{
+			{ID: "7b6a5f4e-3d2c-4b1a-0f9e-8d7c6b5a4f3e", EventID: eventID, Name: "Mosquito spray", AddedBy: creator.ID, ClaimedBy: &claimedBy, ClaimedByName: &claimedByName, ClaimedAt: &exampleTime, CreatedAt: exampleTime},
+		},
+		MemberCount:  1,
+		SpotsLeft:    &spotsLeft,
+		IsJoined:     true,
+		MemberStatus: &memberStatus,
+		UserSwipe:    &EventSwipeResponse{UserID: creator.ID, EventID: eventID, Direction: "like", CreatedAt: exampleTime},
+		MatchScore:   &matchScore,
+		Settings:     EventSettingsResponse{AllowMemberInvites: true, PendingChatAccess: false, MembersVisible: true},
+		CancelReason: &cancelReason,
+		CancelledAt:  &exampleTime,
+		CreatedAt:    exampleTime,
+		UpdatedAt:    exampleTime,
+	}
+}
@@ -0,0 +1,29 @@
+package dto
+
+// HomeFeedTokens holds the page token of each home feed section; empty means the first page
+type HomeFeedTokens struct {
+	Suggestions string
+	Upcoming    string
+	Trending    string
+}
+
+// HomeFeedResponse represents the composed home screen payload
+type HomeFeedResponse struct {
+	Suggestions HomeSuggestionSection `json:"suggestions"`
+	Upcoming    HomeEventSection      `json:"upcoming"`
+	Trending    HomeEventSection      `json:"trending"`
+}
+
+// HomeSuggestionSection represents one page of suggested events
+type HomeSuggestionSection struct {
+	Items     []EventSuggestionItem `json:"items"`
+	Total     int64                 `json:"total"`
+	NextToken *string               `json:"next_token,omitempty"`
+}
+
+// HomeEventSection represents one page of a home feed event list
+type HomeEventSection struct {
+	Items     []EventResponse `json:"items"`
+	Total     int64           `json:"total"`
+	NextToken *string         `json:"next_token,omitempty"`
+}
@@ -0,0 +1,22 @@
+package dto
+
+// EnumOption represents one valid value of an enum with its display label
+type EnumOption struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+}
+
+// EnumsResponse represents all enum values clients need to build forms and filters
+type EnumsResponse struct {
+	Locale          string       `json:"locale"`
+	EventTypes      []EnumOption `json:"event_types"`
+	EventStatuses   []EnumOption `json:"event_statuses"`
+	MemberRoles     []EnumOption `json:"member_roles"`
+	MemberStatuses  []EnumOption `json:"member_statuses"`
+	TagKinds        []EnumOption `json:"tag_kinds"`
+	Genders         []EnumOption `json:"genders"`
+	SmokingOptions  []EnumOption `json:"smoking_options"`
+	SwipeDirections []EnumOption `json:"swipe_directions"`
+	TravelStyles    []EnumOption `json:"travel_styles"`
+	FoodCategories  []EnumOption `json:"food_categories"`
+}
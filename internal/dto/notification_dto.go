@@ -44,3 +44,50 @@ type GetNotificationsResponse struct {
 	Limit         int                    `json:"limit"`
 	TotalPages    int                    `json:"total_pages"`
 }
+
+// MarkEventNotificationsReadResponse reports how many of the caller's notifications about an
+// event were marked as read
+type MarkEventNotificationsReadResponse struct {
+	EventID string `json:"event_id"`
+	Updated int64  `json:"updated"`
+}
+
+// MarkAllNotificationsReadResponse reports how many of the caller's notifications were marked as read
+type MarkAllNotificationsReadResponse struct {
+	Updated int64 `json:"updated"`
+}
+
+// UnreadNotificationCountResponse reports how many unread notifications the caller has
+type UnreadNotificationCountResponse struct {
+	Unread int64 `json:"unread"`
+}
+
+// SnoozeNotificationRequest asks for a reminder to be sent again after a delay
+type SnoozeNotificationRequest struct {
+	Minutes int `json:"minutes" binding:"required,min=5,max=1440"`
+}
+
+// SnoozeNotificationResponse reports when a snoozed reminder will be sent again
+type SnoozeNotificationResponse struct {
+	NotificationID string    `json:"notification_id"`
+	EventID        string    `json:"event_id"`
+	RemindAt       time.Time `json:"remind_at"`
+}
+
+// NotificationDeliveryResponse is one attempt to deliver a notification through a channel
+type NotificationDeliveryResponse struct {
+	Channel   string    `json:"channel"`
+	Status    string    `json:"status"`
+	Detail    *string   `json:"detail"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AdminNotificationResponse is a notification as support sees it, with how it was delivered.
+// DeliveryStatus is sent when any channel delivered it, otherwise failed or skipped, and unknown
+// when nothing was recorded (still being sent, or sent before deliveries were tracked).
+type AdminNotificationResponse struct {
+	NotificationResponse
+	DeliveryStatus string                         `json:"delivery_status"`
+	Channels       []string                       `json:"channels"`
+	Deliveries     []NotificationDeliveryResponse `json:"deliveries"`
+}
@@ -42,21 +42,32 @@ type ErrorAPIResponse struct {
 
 // AuthResponseWrapper wraps authentication response with token and user at top level
 type AuthResponseWrapper struct {
-	Success   bool         `json:"success" example:"true"`
-	RequestID string       `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
-	Timestamp string       `json:"timestamp" example:"2024-01-01T00:00:00Z"`
-	Message   string       `json:"message" example:"Login successful"`
-	Token     string       `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
-	User      UserResponse `json:"user"`
+	Success      bool         `json:"success" example:"true"`
+	RequestID    string       `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp    string       `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message      string       `json:"message" example:"Login successful"`
+	Token        string       `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	RefreshToken string       `json:"refresh_token,omitempty" example:"3f9c2a7e5b1d4c8a9e0f6b2d7c4a1e5f3f9c2a7e5b1d4c8a9e0f6b2d7c4a1e5f"`
+	User         UserResponse `json:"user"`
 }
 
 // TokenResponseWrapper wraps token-only response (for refresh token)
 type TokenResponseWrapper struct {
-	Success   bool   `json:"success" example:"true"`
-	RequestID string `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
-	Timestamp string `json:"timestamp" example:"2024-01-01T00:00:00Z"`
-	Message   string `json:"message" example:"Token refreshed successfully"`
-	Token     string `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	Success      bool   `json:"success" example:"true"`
+	RequestID    string `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp    string `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message      string `json:"message" example:"Token refreshed successfully"`
+	Token        string `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	RefreshToken string `json:"refresh_token" example:"3f9c2a7e5b1d4c8a9e0f6b2d7c4a1e5f3f9c2a7e5b1d4c8a9e0f6b2d7c4a1e5f"`
+}
+
+// RegisterResponseWrapper wraps RegisterResponse in APIResponse format
+type RegisterResponseWrapper struct {
+	Success   bool             `json:"success" example:"true"`
+	RequestID string           `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp string           `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message   string           `json:"message" example:"Verification OTP sent to your email"`
+	Data      RegisterResponse `json:"data"`
 }
 
 // UserProfileResponseWrapper wraps UserProfileResponse in APIResponse format
@@ -187,3 +198,165 @@ type EventSuggestionResponseWrapper struct {
 	Message   string                  `json:"message" example:"Event suggestions retrieved successfully"`
 	Data      EventSuggestionResponse `json:"data"`
 }
+
+// HomeFeedResponseWrapper wraps HomeFeedResponse in APIResponse format
+type HomeFeedResponseWrapper struct {
+	Success   bool             `json:"success" example:"true"`
+	RequestID string           `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp string           `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message   string           `json:"message" example:"Home feed retrieved successfully"`
+	Data      HomeFeedResponse `json:"data"`
+}
+
+// EnumsResponseWrapper wraps EnumsResponse in APIResponse format
+type EnumsResponseWrapper struct {
+	Success   bool          `json:"success" example:"true"`
+	RequestID string        `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp string        `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message   string        `json:"message" example:"Enums retrieved successfully"`
+	Data      EnumsResponse `json:"data"`
+}
+
+// PhoneResponseWrapper wraps PhoneResponse in APIResponse format
+type PhoneResponseWrapper struct {
+	Success   bool          `json:"success" example:"true"`
+	RequestID string        `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp string        `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message   string        `json:"message" example:"Phone number verified successfully"`
+	Data      PhoneResponse `json:"data"`
+}
+
+// DeviceResponseWrapper wraps DeviceResponse in APIResponse format
+type DeviceResponseWrapper struct {
+	Success   bool           `json:"success" example:"true"`
+	RequestID string         `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp string         `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message   string         `json:"message" example:"Device registered successfully"`
+	Data      DeviceResponse `json:"data"`
+}
+
+// ReliabilityResponseWrapper wraps ReliabilityResponse in APIResponse format
+type ReliabilityResponseWrapper struct {
+	Success   bool                `json:"success" example:"true"`
+	RequestID string              `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp string              `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message   string              `json:"message" example:"Reliability retrieved successfully"`
+	Data      ReliabilityResponse `json:"data"`
+}
+
+// CheckinResponseWrapper wraps CheckinResponse in APIResponse format
+type CheckinResponseWrapper struct {
+	Success   bool            `json:"success" example:"true"`
+	RequestID string          `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp string          `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message   string          `json:"message" example:"Checked in successfully"`
+	Data      CheckinResponse `json:"data"`
+}
+
+// BroadcastResponseWrapper wraps BroadcastResponse in APIResponse format
+type BroadcastResponseWrapper struct {
+	Success   bool              `json:"success" example:"true"`
+	RequestID string            `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp string            `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message   string            `json:"message" example:"Broadcast sent successfully"`
+	Data      BroadcastResponse `json:"data"`
+}
+
+// CheckinCodeResponseWrapper wraps CheckinCodeResponse in APIResponse format
+type CheckinCodeResponseWrapper struct {
+	Success   bool                `json:"success" example:"true"`
+	RequestID string              `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp string              `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message   string              `json:"message" example:"Check-in code generated successfully"`
+	Data      CheckinCodeResponse `json:"data"`
+}
+
+// EventStateListResponseWrapper wraps a list of EventStateResponse in APIResponse format
+type EventStateListResponseWrapper struct {
+	Success   bool                 `json:"success" example:"true"`
+	RequestID string               `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp string               `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message   string               `json:"message" example:"Event states retrieved successfully"`
+	Data      []EventStateResponse `json:"data"`
+}
+
+// OnboardingTagsResponseWrapper wraps OnboardingTagsResponse in APIResponse format
+type OnboardingTagsResponseWrapper struct {
+	Success   bool                   `json:"success" example:"true"`
+	RequestID string                 `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp string                 `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message   string                 `json:"message" example:"Suggested tags retrieved successfully"`
+	Data      OnboardingTagsResponse `json:"data"`
+}
+
+// EventMatchResponseWrapper wraps EventMatchResponse in APIResponse format
+type EventMatchResponseWrapper struct {
+	Success   bool               `json:"success" example:"true"`
+	RequestID string             `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp string             `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message   string             `json:"message" example:"Event match retrieved successfully"`
+	Data      EventMatchResponse `json:"data"`
+}
+
+// EventCompatibilityResponseWrapper wraps EventCompatibilityResponse in APIResponse format
+type EventCompatibilityResponseWrapper struct {
+	Success   bool                       `json:"success" example:"true"`
+	RequestID string                     `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp string                     `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message   string                     `json:"message" example:"Event compatibility retrieved successfully"`
+	Data      EventCompatibilityResponse `json:"data"`
+}
+
+// TopPicksResponseWrapper wraps TopPicksResponse in APIResponse format
+type TopPicksResponseWrapper struct {
+	Success   bool             `json:"success" example:"true"`
+	RequestID string           `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp string           `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message   string           `json:"message" example:"Top picks retrieved successfully"`
+	Data      TopPicksResponse `json:"data"`
+}
+
+// EventItemResponseWrapper wraps EventItemResponse in APIResponse format
+type EventItemResponseWrapper struct {
+	Success   bool              `json:"success" example:"true"`
+	RequestID string            `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp string            `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message   string            `json:"message" example:"Item claimed successfully"`
+	Data      EventItemResponse `json:"data"`
+}
+
+// EventItemListResponseWrapper wraps a list of EventItemResponse in APIResponse format
+type EventItemListResponseWrapper struct {
+	Success   bool                `json:"success" example:"true"`
+	RequestID string              `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp string              `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message   string              `json:"message" example:"Items retrieved successfully"`
+	Data      []EventItemResponse `json:"data"`
+}
+
+// EventExpenseResponseWrapper wraps EventExpenseResponse in APIResponse format
+type EventExpenseResponseWrapper struct {
+	Success   bool                 `json:"success" example:"true"`
+	RequestID string               `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp string               `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message   string               `json:"message" example:"Expense added successfully"`
+	Data      EventExpenseResponse `json:"data"`
+}
+
+// EventExpenseListResponseWrapper wraps a list of EventExpenseResponse in APIResponse format
+type EventExpenseListResponseWrapper struct {
+	Success   bool                   `json:"success" example:"true"`
+	RequestID string                 `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp string                 `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message   string                 `json:"message" example:"Expenses retrieved successfully"`
+	Data      []EventExpenseResponse `json:"data"`
+}
+
+// ExpenseSummaryResponseWrapper wraps ExpenseSummaryResponse in APIResponse format
+type ExpenseSummaryResponseWrapper struct {
+	Success   bool                   `json:"success" example:"true"`
+	RequestID string                 `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Timestamp string                 `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Message   string                 `json:"message" example:"Expense summary retrieved successfully"`
+	Data      ExpenseSummaryResponse `json:"data"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+import "time"
+
+// StorageReconcileReport summarizes an orphaned storage reconciliation run
+type StorageReconcileReport struct {
+	DryRun       bool      `json:"dry_run"`
+	StartedAt    time.Time `json:"started_at"`
+	GraceHours   int       `json:"grace_hours"`
+	Scanned      int       `json:"scanned"`
+	Referenced   int       `json:"referenced"`
+	Orphaned     int       `json:"orphaned"`
+	InGrace      int       `json:"in_grace"`
+	Deleted      int       `json:"deleted"`
+	Failed       int       `json:"failed"`
+	OrphanedKeys []string  `json:"orphaned_keys"`
+}
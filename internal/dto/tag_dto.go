@@ -20,12 +20,38 @@ type TagListResponse struct {
 
 // UserTagListResponse represents a user tag list response
 type UserTagListResponse struct {
-	Tags []TagResponse `json:"tags"`
+	Tags       []TagResponse `json:"tags"`
+	Total      int64         `json:"total"`
+	Page       int           `json:"page,omitempty"`
+	Limit      int           `json:"limit,omitempty"`
+	TotalPages int           `json:"total_pages,omitempty"`
 }
 
 // EventTagListResponse represents an event tag list response
 type EventTagListResponse struct {
-	Tags []TagResponse `json:"tags"`
+	Tags       []TagResponse `json:"tags"`
+	Total      int64         `json:"total"`
+	Page       int           `json:"page,omitempty"`
+	Limit      int           `json:"limit,omitempty"`
+	TotalPages int           `json:"total_pages,omitempty"`
+}
+
+// OnboardingTagResponse represents a tag suggested during signup
+type OnboardingTagResponse struct {
+	TagResponse
+	Popularity int64 `json:"popularity"`
+	Matched    bool  `json:"matched"`
+}
+
+// OnboardingTagGroup represents the suggested tags of one kind
+type OnboardingTagGroup struct {
+	Kind string                  `json:"kind"`
+	Tags []OnboardingTagResponse `json:"tags"`
+}
+
+// OnboardingTagsResponse represents the suggested tags for the signup flow
+type OnboardingTagsResponse struct {
+	Groups []OnboardingTagGroup `json:"groups"`
 }
 
 // AddUserTagRequest represents an add user tag request
@@ -33,6 +59,17 @@ type AddUserTagRequest struct {
 	TagID string `json:"tag_id" binding:"required"`
 }
 
+// AddInterestNoteTagsRequest represents confirming tags matched from the profile interests note
+type AddInterestNoteTagsRequest struct {
+	TagIDs []string `json:"tag_ids" binding:"required,min=1,max=50"`
+}
+
+// EventTagSuggestionsRequest represents a draft event to suggest tags for
+type EventTagSuggestionsRequest struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
 // AddEventTagRequest represents an add event tag request
 type AddEventTagRequest struct {
 	TagID string `json:"tag_id" binding:"required"`
@@ -58,6 +95,63 @@ type EventSuggestionResponse struct {
 	Limit  int                   `json:"limit"`
 }
 
+// NearbyEventItem represents an event and how far it is from the searched location
+type NearbyEventItem struct {
+	Event      EventResponse `json:"event"`
+	DistanceKm float64       `json:"distance_km"`
+}
+
+// NearbyEventsResponse represents the events near a location, closest first
+type NearbyEventsResponse struct {
+	Events []NearbyEventItem `json:"events"`
+	Total  int64             `json:"total"`
+	Page   int               `json:"page"`
+	Limit  int               `json:"limit"`
+}
+
+// TopPicksResponse represents a user's best matching events as of ComputedAt.
+// Live is true when the picks were scored for this request instead of read from the nightly run.
+type TopPicksResponse struct {
+	Picks      []EventSuggestionItem `json:"picks"`
+	ComputedAt time.Time             `json:"computed_at"`
+	Live       bool                  `json:"live"`
+}
+
+// EventMatchResponse explains how one event scores for the current user.
+// Components are scored 0-100 and MatchScore is their weighted sum.
+type EventMatchResponse struct {
+	EventID          string             `json:"event_id"`
+	MatchScore       float64            `json:"match_score"`
+	Components       map[string]float64 `json:"components"`
+	Weights          map[string]float64 `json:"weights"`
+	MatchedInterests []InterestResponse `json:"matched_interests"`
+	MatchedTags      []TagResponse      `json:"matched_tags"`
+}
+
+// SharedTagResponse is a tag the viewer shares with some of an event's confirmed members
+type SharedTagResponse struct {
+	TagResponse
+	MemberCount int `json:"member_count"`
+}
+
+// SharedInterestResponse is an interest the viewer shares with some of an event's confirmed members
+type SharedInterestResponse struct {
+	InterestResponse
+	MemberCount int `json:"member_count"`
+}
+
+// EventCompatibilityResponse summarizes how well the current user fits an event's confirmed
+// members. Scores are 0-100 averages over the members compared; no member is identified.
+// CompatibilityScore is null and nothing is shared while too few members can be compared.
+type EventCompatibilityResponse struct {
+	EventID            string                   `json:"event_id"`
+	MembersCompared    int                      `json:"members_compared"`
+	CompatibilityScore *float64                 `json:"compatibility_score"`
+	Components         map[string]float64       `json:"components,omitempty"`
+	SharedTags         []SharedTagResponse      `json:"shared_tags"`
+	SharedInterests    []SharedInterestResponse `json:"shared_interests"`
+}
+
 // EventSuggestionItem represents an event suggestion item with match score
 type EventSuggestionItem struct {
 	Event            EventResponse      `json:"event"`
@@ -6,6 +6,7 @@ import "time"
 type UserProfileResponse struct {
 	ID            string     `json:"id"`
 	UserID        string     `json:"user_id"`
+	Handle        *string    `json:"handle,omitempty"`
 	DisplayName   *string    `json:"display_name,omitempty"`
 	Bio           *string    `json:"bio,omitempty"`
 	Languages     *string    `json:"languages,omitempty"`
@@ -36,6 +37,30 @@ type UpdateUserProfileRequest struct {
 	HomeLocation  *string    `json:"home_location,omitempty"`
 }
 
+// UpdateHandleRequest represents a request to change the current user's handle
+type UpdateHandleRequest struct {
+	Handle string `json:"handle" binding:"required"`
+}
+
+// HandleResponse is the user's handle and when it may next be changed
+type HandleResponse struct {
+	Handle       string     `json:"handle"`
+	NextChangeAt *time.Time `json:"next_change_at,omitempty"`
+}
+
+// PublicProfileResponse is the part of a profile anyone signed in can see by handle
+type PublicProfileResponse struct {
+	UserID       string  `json:"user_id"`
+	Handle       string  `json:"handle"`
+	DisplayName  string  `json:"display_name"`
+	Bio          *string `json:"bio,omitempty"`
+	Languages    *string `json:"languages,omitempty"`
+	Age          *int    `json:"age,omitempty"`
+	JobTitle     *string `json:"job_title,omitempty"`
+	AvatarURL    *string `json:"avatar_url,omitempty"`
+	HomeLocation *string `json:"home_location,omitempty"`
+}
+
 // UpdateProfileRequest represents an update profile request (alias for compatibility)
 type UpdateProfileRequest = UpdateUserProfileRequest
 
@@ -72,3 +97,42 @@ type UserStatsResponse struct {
 type SetupStatusResponse struct {
 	SetupCompleted bool `json:"setup_completed"`
 }
+
+// UpdatePhoneRequest represents a request to add or change the user's phone number
+type UpdatePhoneRequest struct {
+	Phone string `json:"phone" binding:"required"`
+}
+
+// VerifyPhoneRequest represents a phone verification request
+type VerifyPhoneRequest struct {
+	OTP string `json:"otp" binding:"required,len=6"`
+}
+
+// PhoneResponse represents the user's phone number and verification state
+type PhoneResponse struct {
+	Phone         string `json:"phone"`
+	PhoneVerified bool   `json:"phone_verified"`
+}
+
+// ReliabilityResponse represents a user's attendance reliability
+type ReliabilityResponse struct {
+	UserID           string   `json:"user_id"`
+	CompletedEvents  int64    `json:"completed_events"`
+	NoShows          int64    `json:"no_shows"`
+	NoShowRate       *float64 `json:"no_show_rate"`
+	ReliabilityScore *int     `json:"reliability_score"`
+}
+
+// RegisterDeviceRequest represents a request to receive push notifications on a device
+type RegisterDeviceRequest struct {
+	Token    string `json:"token" binding:"required,max=4096"`
+	Platform string `json:"platform" binding:"required,oneof=ios android web"`
+}
+
+// DeviceResponse represents a device registered for push notifications
+type DeviceResponse struct {
+	Token      string    `json:"token"`
+	Platform   string    `json:"platform"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+}
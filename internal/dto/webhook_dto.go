@@ -0,0 +1,57 @@
+package dto
+
+import "time"
+
+// CreateWebhookRequest represents a create webhook request
+type CreateWebhookRequest struct {
+	URL    string   `json:"url" binding:"required,url"`
+	Events []string `json:"events,omitempty" binding:"omitempty,dive,oneof=event.created event.completed event.cancelled"`
+	Secret *string  `json:"secret,omitempty" binding:"omitempty,min=16"`
+}
+
+// WebhookResponse represents a webhook response
+type WebhookResponse struct {
+	ID          string    `json:"id"`
+	OwnerUserID string    `json:"owner_user_id"`
+	URL         string    `json:"url"`
+	Events      []string  `json:"events"`
+	Active      bool      `json:"active"`
+	Secret      *string   `json:"secret,omitempty"` // Only returned on creation
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// WebhookDeliveryResponse represents a webhook delivery log entry
+type WebhookDeliveryResponse struct {
+	ID             string     `json:"id"`
+	WebhookID      string     `json:"webhook_id"`
+	EventName      string     `json:"event_name"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	LastStatusCode *int       `json:"last_status_code,omitempty"`
+	LastError      *string    `json:"last_error,omitempty"`
+	NextAttemptAt  time.Time  `json:"next_attempt_at"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// WebhookPayload represents the body sent to webhook receivers
+type WebhookPayload struct {
+	ID        string           `json:"id"`
+	Event     string           `json:"event"`
+	CreatedAt time.Time        `json:"created_at"`
+	Data      WebhookEventData `json:"data"`
+}
+
+// WebhookEventData represents the event snapshot included in webhook payloads
+type WebhookEventData struct {
+	EventID   string     `json:"event_id"`
+	CreatorID string     `json:"creator_id"`
+	Title     string     `json:"title"`
+	EventType string     `json:"event_type"`
+	Status    string     `json:"status"`
+	StartAt   *time.Time `json:"start_at,omitempty"`
+	EndAt     *time.Time `json:"end_at,omitempty"`
+	ActorID   *string    `json:"actor_id,omitempty"`
+	// Set on event.cancelled so downstream systems (e.g. refunds) know why
+	CancellationReason *string `json:"cancellation_reason,omitempty"`
+}
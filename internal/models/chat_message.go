@@ -32,8 +32,9 @@ type ChatMessage struct {
 	CreatedAt   time.Time `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
 
 	// Relationships
-	Room   *ChatRoom `json:"room,omitempty" gorm:"foreignKey:RoomID;constraint:OnDelete:CASCADE"`
-	Sender *User     `json:"sender,omitempty" gorm:"foreignKey:SenderID;constraint:OnDelete:CASCADE"`
+	Room     *ChatRoom            `json:"room,omitempty" gorm:"foreignKey:RoomID;constraint:OnDelete:CASCADE"`
+	Sender   *User                `json:"sender,omitempty" gorm:"foreignKey:SenderID;constraint:OnDelete:CASCADE"`
+	Mentions []ChatMessageMention `json:"mentions,omitempty" gorm:"foreignKey:MessageID;constraint:OnDelete:CASCADE"`
 	// Used as confirmation message for event members
 	EventMembers []EventMember `json:"event_members,omitempty" gorm:"foreignKey:ConfirmationMessageID;constraint:OnDelete:SET NULL"`
 }
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChatMessageMention represents the chat_message_mentions table.
+// Each row is one event member mentioned by @handle in a chat message; Start and Length locate
+// the mention in the message body, in runes.
+type ChatMessageMention struct {
+	MessageID uuid.UUID `json:"message_id" gorm:"type:uuid;not null;primaryKey;constraint:OnDelete:CASCADE"`
+	UserID    uuid.UUID `json:"user_id" gorm:"type:uuid;not null;primaryKey;index;constraint:OnDelete:CASCADE"`
+	Start     int       `json:"start" gorm:"column:start_offset;type:int;not null"`
+	Length    int       `json:"length" gorm:"type:int;not null"`
+	CreatedAt time.Time `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
+
+	// Relationships
+	User *User `json:"user,omitempty" gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName returns the table name for ChatMessageMention
+func (ChatMessageMention) TableName() string {
+	return "chat_message_mentions"
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Device platforms a push token can be registered for
+const (
+	DevicePlatformIOS     = "ios"
+	DevicePlatformAndroid = "android"
+	DevicePlatformWeb     = "web"
+)
+
+// DevicePlatforms lists all device platforms
+var DevicePlatforms = []string{DevicePlatformIOS, DevicePlatformAndroid, DevicePlatformWeb}
+
+// IsValidDevicePlatform checks if the platform is one of the known device platforms
+func IsValidDevicePlatform(platform string) bool {
+	for _, valid := range DevicePlatforms {
+		if valid == platform {
+			return true
+		}
+	}
+	return false
+}
+
+// DeviceToken represents the device_tokens table (FCM registration tokens for push notifications).
+// A token belongs to one user at a time; registering it again moves it to the new user.
+type DeviceToken struct {
+	ID         uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID     uuid.UUID `json:"user_id" gorm:"type:uuid;not null;index;constraint:OnDelete:CASCADE"`
+	Token      string    `json:"token" gorm:"type:text;not null;uniqueIndex"`
+	Platform   string    `json:"platform" gorm:"type:text;not null"`
+	CreatedAt  time.Time `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
+	LastSeenAt time.Time `json:"last_seen_at" gorm:"type:timestamptz;not null;default:now()"`
+}
+
+// TableName returns the table name for DeviceToken
+func (DeviceToken) TableName() string {
+	return "device_tokens"
+}
+
+// BeforeCreate hook for DeviceToken
+func (dt *DeviceToken) BeforeCreate(tx *gorm.DB) error {
+	if dt.ID == uuid.Nil {
+		dt.ID = uuid.New()
+	}
+	return nil
+}
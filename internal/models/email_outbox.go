@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EmailKind names which email an outbox row sends
+type EmailKind string
+
+const (
+	EmailKindWelcome        EmailKind = "welcome"
+	EmailKindAccountDeleted EmailKind = "account_deleted"
+)
+
+// EmailOutboxStatus represents the email outbox status enum
+type EmailOutboxStatus string
+
+const (
+	EmailOutboxPending EmailOutboxStatus = "pending"
+	EmailOutboxFailed  EmailOutboxStatus = "failed"
+)
+
+// EmailOutbox represents the email_outbox table (emails waiting to be sent, retried until they
+// are delivered or run out of attempts). Delivered rows are removed.
+type EmailOutbox struct {
+	ID            uuid.UUID         `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Kind          EmailKind         `json:"kind" gorm:"type:text;not null"`
+	Recipient     string            `json:"recipient" gorm:"type:text;not null"`
+	Data          map[string]string `json:"data" gorm:"type:jsonb;serializer:json"`
+	Status        EmailOutboxStatus `json:"status" gorm:"type:text;not null;default:'pending'"`
+	Attempts      int               `json:"attempts" gorm:"not null;default:0"`
+	LastError     *string           `json:"last_error" gorm:"type:text"`
+	NextAttemptAt time.Time         `json:"next_attempt_at" gorm:"type:timestamptz;not null;default:now()"`
+	CreatedAt     time.Time         `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
+	UpdatedAt     time.Time         `json:"updated_at" gorm:"type:timestamptz;not null;default:now()"`
+}
+
+// TableName returns the table name for EmailOutbox
+func (EmailOutbox) TableName() string {
+	return "email_outbox"
+}
+
+// BeforeCreate hook for EmailOutbox
+func (e *EmailOutbox) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
@@ -7,15 +7,17 @@ import (
 	"gorm.io/gorm"
 )
 
-// EmailVerification represents the email_verifications table
+// EmailVerification represents the email_verifications table (at most one pending OTP per email;
+// rows are deleted once used or expired)
 type EmailVerification struct {
-	ID        uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	Email     string     `json:"email" gorm:"type:citext;not null;index"`
-	OTP       string     `json:"otp" gorm:"type:varchar(6);not null"`
-	ExpiresAt time.Time  `json:"expires_at" gorm:"type:timestamptz;not null;index"`
-	CreatedAt time.Time  `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
-	UpdatedAt time.Time  `json:"updated_at" gorm:"type:timestamptz;not null;default:now()"`
-	DeletedAt *time.Time `json:"deleted_at" gorm:"type:timestamptz;index"`
+	ID              uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Email           string    `json:"email" gorm:"type:citext;not null;uniqueIndex:ux_email_verifications_email"`
+	OTP             string    `json:"otp" gorm:"type:text;not null"`
+	ExpiresAt       time.Time `json:"expires_at" gorm:"type:timestamptz;not null;index"`
+	Attempts        int       `json:"-" gorm:"type:int;not null;default:0"` // codes tried against this OTP
+	CancelTokenHash *string   `json:"-" gorm:"type:text"`                   // hash of the token that can cancel the registration
+	CreatedAt       time.Time `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
+	UpdatedAt       time.Time `json:"updated_at" gorm:"type:timestamptz;not null;default:now()"`
 }
 
 // TableName returns the table name for EmailVerification
@@ -10,14 +10,26 @@ import (
 // EventType represents the event type enum
 type EventType string
 
+// The values match the event_type enum in the database
 const (
 	EventTypeMeal      EventType = "meal"
-	EventTypeDaytrip   EventType = "daytrip"
+	EventTypeDaytrip   EventType = "one_day_trip"
 	EventTypeOvernight EventType = "overnight"
-	EventTypeActivity  EventType = "activity"
-	EventTypeOther     EventType = "other"
 )
 
+// EventTypes lists all event types in display order
+var EventTypes = []EventType{EventTypeMeal, EventTypeDaytrip, EventTypeOvernight}
+
+// IsValidEventType checks if the event type is one of the known event types
+func IsValidEventType(eventType string) bool {
+	for _, valid := range EventTypes {
+		if string(valid) == eventType {
+			return true
+		}
+	}
+	return false
+}
+
 // EventStatus represents the event status enum
 type EventStatus string
 
@@ -27,27 +39,59 @@ const (
 	EventStatusCompleted EventStatus = "completed"
 )
 
+// EventStatuses lists all event statuses
+var EventStatuses = []EventStatus{EventStatusPublished, EventStatusCancelled, EventStatusCompleted}
+
+// EventSettings holds the creator-controlled permissions of an event.
+// A nil setting means it was never changed and uses its default (enabled).
+type EventSettings struct {
+	AllowMemberInvites *bool `json:"allow_member_invites" gorm:"column:allow_member_invites;not null;default:true"`
+	PendingChatAccess  *bool `json:"pending_chat_access" gorm:"column:pending_chat_access;not null;default:true"`
+	MembersVisible     *bool `json:"members_visible" gorm:"column:members_visible;not null;default:true"`
+}
+
+// MembersCanInvite reports whether participants may invite other users
+func (s EventSettings) MembersCanInvite() bool {
+	return s.AllowMemberInvites == nil || *s.AllowMemberInvites
+}
+
+// PendingMembersCanChat reports whether pending members may use the event chat
+func (s EventSettings) PendingMembersCanChat() bool {
+	return s.PendingChatAccess == nil || *s.PendingChatAccess
+}
+
+// MemberListVisible reports whether participants may see the member list
+func (s EventSettings) MemberListVisible() bool {
+	return s.MembersVisible == nil || *s.MembersVisible
+}
+
 // Event represents the events table
 type Event struct {
-	ID            uuid.UUID   `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	CreatorID     uuid.UUID   `json:"creator_id" gorm:"type:uuid;not null;constraint:OnDelete:CASCADE"`
-	Title         string      `json:"title" gorm:"type:text;not null"`
-	Description   *string     `json:"description" gorm:"type:text"`
-	EventType     EventType   `json:"event_type" gorm:"type:event_type;not null;default:'meal'"`
-	AddressText   *string     `json:"address_text" gorm:"type:text"`
-	Lat           *float64    `json:"lat" gorm:"type:double precision"`
-	Lng           *float64    `json:"lng" gorm:"type:double precision"`
-	StartAt       *time.Time  `json:"start_at" gorm:"type:timestamptz"`
-	EndAt         *time.Time  `json:"end_at" gorm:"type:timestamptz"`
-	Capacity      *int        `json:"capacity" gorm:"type:int;check:capacity IS NULL OR capacity >= 1"`
-	BudgetMin     *int        `json:"budget_min" gorm:"type:int;check:budget_min IS NULL OR budget_min >= 0"`
-	BudgetMax     *int        `json:"budget_max" gorm:"type:int;check:budget_max IS NULL OR budget_max >= 0"`
-	Currency      *string     `json:"currency" gorm:"type:varchar(3);default:'THB'"`
-	Status        EventStatus `json:"status" gorm:"type:event_status;not null;default:'published'"`
-	CoverImageURL *string     `json:"cover_image_url" gorm:"type:text"`
-	CreatedAt     time.Time   `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
-	UpdatedAt     time.Time   `json:"updated_at" gorm:"type:timestamptz;not null;default:now()"`
-	DeletedAt     *time.Time  `json:"deleted_at" gorm:"type:timestamptz;index"`
+	ID                   uuid.UUID     `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	CreatorID            uuid.UUID     `json:"creator_id" gorm:"type:uuid;not null;constraint:OnDelete:CASCADE"`
+	Title                string        `json:"title" gorm:"type:text;not null"`
+	Description          *string       `json:"description" gorm:"type:text"`
+	EventType            EventType     `json:"event_type" gorm:"type:event_type;not null;default:'meal'"`
+	AddressText          *string       `json:"address_text" gorm:"type:text"`
+	Lat                  *float64      `json:"lat" gorm:"type:double precision"`
+	Lng                  *float64      `json:"lng" gorm:"type:double precision"`
+	StartAt              *time.Time    `json:"start_at" gorm:"type:timestamptz"`
+	EndAt                *time.Time    `json:"end_at" gorm:"type:timestamptz"`
+	Capacity             *int          `json:"capacity" gorm:"type:int;check:capacity IS NULL OR capacity >= 1"`
+	MinAttendees         *int          `json:"min_attendees" gorm:"type:int;check:min_attendees IS NULL OR min_attendees >= 1"`
+	ConfirmedMemberCount int           `json:"confirmed_member_count" gorm:"type:int;not null;default:0"`
+	BudgetMin            *int          `json:"budget_min" gorm:"type:int;check:budget_min IS NULL OR budget_min >= 0"`
+	BudgetMax            *int          `json:"budget_max" gorm:"type:int;check:budget_max IS NULL OR budget_max >= 0"`
+	Currency             *string       `json:"currency" gorm:"type:varchar(3);default:'THB'"`
+	Status               EventStatus   `json:"status" gorm:"type:event_status;not null;default:'published'"`
+	CoverImageURL        *string       `json:"cover_image_url" gorm:"type:text"`
+	CheckinCode          *string       `json:"-" gorm:"type:text"`
+	Settings             EventSettings `json:"settings" gorm:"embedded"`
+	CancelReason         *string       `json:"cancellation_reason" gorm:"column:cancellation_reason;type:text"`
+	CancelledAt          *time.Time    `json:"cancelled_at" gorm:"type:timestamptz"`
+	CreatedAt            time.Time     `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
+	UpdatedAt            time.Time     `json:"updated_at" gorm:"type:timestamptz;not null;default:now()"`
+	DeletedAt            *time.Time    `json:"deleted_at" gorm:"type:timestamptz;index"`
 
 	// Relationships
 	Creator       *User              `json:"creator,omitempty" gorm:"foreignKey:CreatorID;constraint:OnDelete:CASCADE"`
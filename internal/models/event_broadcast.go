@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EventBroadcast represents the event_broadcasts table.
+// Each row is a message the creator sent to all confirmed members of an event.
+type EventBroadcast struct {
+	ID         uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	EventID    uuid.UUID `json:"event_id" gorm:"type:uuid;not null;index;constraint:OnDelete:CASCADE"`
+	SenderID   uuid.UUID `json:"sender_id" gorm:"type:uuid;not null;constraint:OnDelete:CASCADE"`
+	Title      string    `json:"title" gorm:"type:text;not null"`
+	Body       string    `json:"body" gorm:"type:text;not null"`
+	Recipients int       `json:"recipients" gorm:"not null;default:0"`
+	CreatedAt  time.Time `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
+
+	// Relationships
+	Event *Event `json:"event,omitempty" gorm:"foreignKey:EventID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName returns the table name for EventBroadcast
+func (EventBroadcast) TableName() string {
+	return "event_broadcasts"
+}
+
+// BeforeCreate hook for EventBroadcast
+func (eb *EventBroadcast) BeforeCreate(tx *gorm.DB) error {
+	if eb.ID == uuid.Nil {
+		eb.ID = uuid.New()
+	}
+	return nil
+}
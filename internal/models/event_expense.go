@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EventExpense represents the event_expenses table.
+// Amount is in minor units of the event's currency (e.g. satang or cents).
+type EventExpense struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	EventID     uuid.UUID `json:"event_id" gorm:"type:uuid;not null;constraint:OnDelete:CASCADE"`
+	PayerID     uuid.UUID `json:"payer_id" gorm:"type:uuid;not null"`
+	Amount      int64     `json:"amount" gorm:"not null"`
+	Description string    `json:"description" gorm:"type:text;not null"`
+	CreatedBy   uuid.UUID `json:"created_by" gorm:"type:uuid;not null"`
+	CreatedAt   time.Time `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
+
+	// Relationships
+	Event  *Event              `json:"event,omitempty" gorm:"foreignKey:EventID;constraint:OnDelete:CASCADE"`
+	Payer  *User               `json:"payer,omitempty" gorm:"foreignKey:PayerID"`
+	Shares []EventExpenseShare `json:"shares,omitempty" gorm:"foreignKey:ExpenseID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName returns the table name for EventExpense
+func (EventExpense) TableName() string {
+	return "event_expenses"
+}
+
+// BeforeCreate hook for EventExpense
+func (ee *EventExpense) BeforeCreate(tx *gorm.DB) error {
+	if ee.ID == uuid.Nil {
+		ee.ID = uuid.New()
+	}
+	return nil
+}
+
+// EventExpenseShare represents the event_expense_shares table.
+// Shares of an expense always add up to the expense amount.
+type EventExpenseShare struct {
+	ExpenseID uuid.UUID `json:"expense_id" gorm:"type:uuid;not null;primaryKey;constraint:OnDelete:CASCADE"`
+	UserID    uuid.UUID `json:"user_id" gorm:"type:uuid;not null;primaryKey"`
+	Amount    int64     `json:"amount" gorm:"not null"`
+}
+
+// TableName returns the table name for EventExpenseShare
+func (EventExpenseShare) TableName() string {
+	return "event_expense_shares"
+}
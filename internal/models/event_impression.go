@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventImpression represents the event_impressions table: the event was served to the user in
+// their suggestion feed. One row per user and event, counting how often it was served.
+type EventImpression struct {
+	EventID       uuid.UUID `json:"event_id" gorm:"type:uuid;not null;primaryKey;constraint:OnDelete:CASCADE"`
+	UserID        uuid.UUID `json:"user_id" gorm:"type:uuid;not null;primaryKey;constraint:OnDelete:CASCADE"`
+	FirstServedAt time.Time `json:"first_served_at" gorm:"type:timestamptz;not null;default:now()"`
+	LastServedAt  time.Time `json:"last_served_at" gorm:"type:timestamptz;not null;default:now()"`
+	ServedCount   int       `json:"served_count" gorm:"type:int;not null;default:1"`
+}
+
+// TableName returns the table name for EventImpression
+func (EventImpression) TableName() string {
+	return "event_impressions"
+}
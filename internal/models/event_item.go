@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EventItem represents the event_items table.
+// Each item is something a member offers to bring; at most one member can claim it.
+type EventItem struct {
+	ID        uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	EventID   uuid.UUID  `json:"event_id" gorm:"type:uuid;not null;constraint:OnDelete:CASCADE"`
+	Name      string     `json:"name" gorm:"type:text;not null"`
+	AddedBy   uuid.UUID  `json:"added_by" gorm:"type:uuid;not null"`
+	ClaimedBy *uuid.UUID `json:"claimed_by" gorm:"type:uuid"`
+	ClaimedAt *time.Time `json:"claimed_at" gorm:"type:timestamptz"`
+	CreatedAt time.Time  `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
+
+	// Relationships
+	Event         *Event `json:"event,omitempty" gorm:"foreignKey:EventID;constraint:OnDelete:CASCADE"`
+	ClaimedByUser *User  `json:"claimed_by_user,omitempty" gorm:"foreignKey:ClaimedBy"`
+}
+
+// TableName returns the table name for EventItem
+func (EventItem) TableName() string {
+	return "event_items"
+}
+
+// BeforeCreate hook for EventItem
+func (ei *EventItem) BeforeCreate(tx *gorm.DB) error {
+	if ei.ID == uuid.Nil {
+		ei.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsClaimed reports whether a member has claimed the item
+func (ei *EventItem) IsClaimed() bool {
+	return ei.ClaimedBy != nil
+}
@@ -14,6 +14,9 @@ const (
 	MemberRoleParticipant MemberRole = "participant"
 )
 
+// MemberRoles lists all member roles
+var MemberRoles = []MemberRole{MemberRoleCreator, MemberRoleParticipant}
+
 // MemberStatus represents the member status enum
 type MemberStatus string
 
@@ -25,6 +28,9 @@ const (
 	MemberStatusLeft      MemberStatus = "left"
 )
 
+// MemberStatuses lists all member statuses
+var MemberStatuses = []MemberStatus{MemberStatusPending, MemberStatusConfirmed, MemberStatusDeclined, MemberStatusKicked, MemberStatusLeft}
+
 // EventMember represents the event_members table
 type EventMember struct {
 	EventID               uuid.UUID    `json:"event_id" gorm:"type:uuid;not null;primaryKey;constraint:OnDelete:CASCADE"`
@@ -36,6 +42,8 @@ type EventMember struct {
 	LeftAt                *time.Time   `json:"left_at" gorm:"type:timestamptz"`
 	Note                  *string      `json:"note" gorm:"type:text"`
 	ConfirmationMessageID *uuid.UUID   `json:"confirmation_message_id" gorm:"type:uuid;constraint:OnDelete:SET NULL"`
+	CheckedInAt           *time.Time   `json:"checked_in_at" gorm:"type:timestamptz"`
+	ConfirmationEmailAt   *time.Time   `json:"confirmation_email_at" gorm:"type:timestamptz"`
 
 	// Relationships
 	Event               *Event       `json:"event,omitempty" gorm:"foreignKey:EventID;constraint:OnDelete:CASCADE"`
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventNoShow represents the event_no_shows table.
+// Each row is one member's report that another confirmed member did not attend a completed event.
+type EventNoShow struct {
+	EventID        uuid.UUID `json:"event_id" gorm:"type:uuid;not null;primaryKey;constraint:OnDelete:CASCADE"`
+	ReportedUserID uuid.UUID `json:"reported_user_id" gorm:"type:uuid;not null;primaryKey;index;constraint:OnDelete:CASCADE"`
+	ReporterID     uuid.UUID `json:"reporter_id" gorm:"type:uuid;not null;primaryKey;constraint:OnDelete:CASCADE"`
+	CreatedAt      time.Time `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
+
+	// Relationships
+	Event *Event `json:"event,omitempty" gorm:"foreignKey:EventID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName returns the table name for EventNoShow
+func (EventNoShow) TableName() string {
+	return "event_no_shows"
+}
@@ -14,6 +14,9 @@ const (
 	SwipeDirectionPass SwipeDirection = "pass"
 )
 
+// SwipeDirections lists all swipe directions
+var SwipeDirections = []SwipeDirection{SwipeDirectionLike, SwipeDirectionPass}
+
 // EventSwipe represents the event_swipes table
 type EventSwipe struct {
 	UserID    uuid.UUID      `json:"user_id" gorm:"type:uuid;not null;primaryKey;constraint:OnDelete:CASCADE"`
@@ -39,6 +39,12 @@ const (
 	FoodCategoryBBQGrill      FoodCategory = "bbq_grill"
 )
 
+// FoodCategories lists all food categories in display order
+var FoodCategories = []FoodCategory{
+	FoodCategoryThai, FoodCategoryJapanese, FoodCategoryChinese, FoodCategoryInternational,
+	FoodCategoryHalal, FoodCategoryBuffet, FoodCategoryBBQGrill,
+}
+
 // PreferenceLevel represents the preference level
 type PreferenceLevel int
 
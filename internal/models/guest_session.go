@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GuestSession represents the guest_sessions table: a client browsing public endpoints
+// without an account. Converting it hands its browsing context to the user who signed up.
+type GuestSession struct {
+	ID              uuid.UUID  `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	CreatedAt       time.Time  `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
+	LastSeenAt      time.Time  `json:"last_seen_at" gorm:"type:timestamptz;not null;default:now()"`
+	ExpiresAt       time.Time  `json:"expires_at" gorm:"type:timestamptz;not null"`
+	ConvertedUserID *uuid.UUID `json:"converted_user_id" gorm:"type:uuid;constraint:OnDelete:SET NULL"`
+	ConvertedAt     *time.Time `json:"converted_at" gorm:"type:timestamptz"`
+}
+
+// TableName returns the table name for GuestSession
+func (GuestSession) TableName() string {
+	return "guest_sessions"
+}
+
+// IsConverted checks if the guest has been converted to an account
+func (g *GuestSession) IsConverted() bool {
+	return g.ConvertedAt != nil
+}
+
+// GuestEventView represents the guest_event_views table: a public event the guest has seen.
+// One row per guest and event, counting how often it was seen.
+type GuestEventView struct {
+	GuestID     uuid.UUID `json:"guest_id" gorm:"type:uuid;not null;primaryKey;constraint:OnDelete:CASCADE"`
+	EventID     uuid.UUID `json:"event_id" gorm:"type:uuid;not null;primaryKey;constraint:OnDelete:CASCADE"`
+	FirstSeenAt time.Time `json:"first_seen_at" gorm:"type:timestamptz;not null;default:now()"`
+	LastSeenAt  time.Time `json:"last_seen_at" gorm:"type:timestamptz;not null;default:now()"`
+	SeenCount   int       `json:"seen_count" gorm:"type:int;not null;default:1"`
+}
+
+// TableName returns the table name for GuestEventView
+func (GuestEventView) TableName() string {
+	return "guest_event_views"
+}
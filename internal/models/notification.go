@@ -13,7 +13,7 @@ type Notification struct {
 	Title     string                 `json:"title" gorm:"not null"`
 	Body      string                 `json:"body" gorm:"not null"`
 	Type      string                 `json:"type" gorm:"not null"` // push, email, sms
-	Data      map[string]interface{} `json:"data" gorm:"type:jsonb"`
+	Data      map[string]interface{} `json:"data" gorm:"type:jsonb;serializer:json"`
 	Read      bool                   `json:"read" gorm:"default:false"`
 	CreatedAt time.Time              `json:"created_at" gorm:"not null;default:now()"`
 	ReadAt    *time.Time             `json:"read_at"`
@@ -23,3 +23,36 @@ type Notification struct {
 func (Notification) TableName() string {
 	return "notifications"
 }
+
+// NotificationChannel names a channel a notification is delivered through
+type NotificationChannel string
+
+const (
+	NotificationChannelPush  NotificationChannel = "push"
+	NotificationChannelEmail NotificationChannel = "email"
+)
+
+// NotificationDeliveryStatus represents the notification delivery status enum
+type NotificationDeliveryStatus string
+
+const (
+	NotificationDeliverySent    NotificationDeliveryStatus = "sent"
+	NotificationDeliveryFailed  NotificationDeliveryStatus = "failed"
+	NotificationDeliverySkipped NotificationDeliveryStatus = "skipped"
+)
+
+// NotificationDelivery represents the notification_deliveries table (one row per attempt to deliver
+// a notification, or per channel it was not sent through, kept so support can see what happened)
+type NotificationDelivery struct {
+	ID             uuid.UUID                  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	NotificationID uuid.UUID                  `json:"notification_id" gorm:"type:uuid;not null;index;constraint:OnDelete:CASCADE"`
+	Channel        NotificationChannel        `json:"channel" gorm:"type:text;not null"`
+	Status         NotificationDeliveryStatus `json:"status" gorm:"type:text;not null"`
+	Detail         *string                    `json:"detail" gorm:"type:text"` // why it failed or was skipped
+	CreatedAt      time.Time                  `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
+}
+
+// TableName returns the table name for NotificationDelivery
+func (NotificationDelivery) TableName() string {
+	return "notification_deliveries"
+}
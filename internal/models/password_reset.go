@@ -13,6 +13,7 @@ type PasswordReset struct {
 	UserID    uuid.UUID `json:"user_id" gorm:"type:uuid;not null;constraint:OnDelete:CASCADE"`
 	Token     string    `json:"token" gorm:"type:text;uniqueIndex;not null"`
 	ExpiresAt time.Time `json:"expires_at" gorm:"type:timestamptz;not null"`
+	Attempts  int       `json:"-" gorm:"type:int;not null;default:0"` // codes tried against this OTP
 	CreatedAt time.Time `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
 
 	// Relationships
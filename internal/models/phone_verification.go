@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// PhoneVerification represents the phone_verifications table
+type PhoneVerification struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID    uuid.UUID `json:"user_id" gorm:"type:uuid;not null;index"`
+	Phone     string    `json:"phone" gorm:"type:text;not null"`
+	OTP       string    `json:"-" gorm:"type:text;not null"` // hash of the code, peppered like the email OTPs
+	ExpiresAt time.Time `json:"expires_at" gorm:"type:timestamptz;not null"`
+	Attempts  int       `json:"-" gorm:"type:int;not null;default:0"` // codes tried against this OTP
+	CreatedAt time.Time `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
+}
+
+// TableName returns the table name for PhoneVerification
+func (PhoneVerification) TableName() string {
+	return "phone_verifications"
+}
+
+// BeforeCreate hook for PhoneVerification
+func (pv *PhoneVerification) BeforeCreate(tx *gorm.DB) error {
+	if pv.ID == uuid.Nil {
+		pv.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsExpired checks if the verification is expired
+func (pv *PhoneVerification) IsExpired() bool {
+	return time.Now().After(pv.ExpiresAt)
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// RefreshToken represents the refresh_tokens table. Only a hash of the token is stored. Each
+// refresh revokes the token used and issues its replacement in the same family, so every token
+// descended from one login can be revoked together.
+type RefreshToken struct {
+	ID         uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID     uuid.UUID  `json:"user_id" gorm:"type:uuid;not null;index;constraint:OnDelete:CASCADE"`
+	FamilyID   uuid.UUID  `json:"family_id" gorm:"type:uuid;not null;index"`
+	TokenHash  string     `json:"-" gorm:"type:text;uniqueIndex;not null"`
+	ReplacedBy *uuid.UUID `json:"replaced_by" gorm:"type:uuid"`
+	ExpiresAt  time.Time  `json:"expires_at" gorm:"type:timestamptz;not null"`
+	RevokedAt  *time.Time `json:"revoked_at" gorm:"type:timestamptz"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
+
+	// Relationships
+	User *User `json:"user,omitempty" gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName returns the table name for RefreshToken
+func (RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
+
+// BeforeCreate hook for RefreshToken
+func (rt *RefreshToken) BeforeCreate(tx *gorm.DB) error {
+	if rt.ID == uuid.Nil {
+		rt.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ReminderSnooze represents the reminder_snoozes table (queue of snoozed event reminders).
+// Each row is a reminder to send the user again at DueAt; it is removed once sent.
+type ReminderSnooze struct {
+	ID             uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID         uuid.UUID `json:"user_id" gorm:"type:uuid;not null;constraint:OnDelete:CASCADE"`
+	EventID        uuid.UUID `json:"event_id" gorm:"type:uuid;not null;constraint:OnDelete:CASCADE"`
+	NotificationID uuid.UUID `json:"notification_id" gorm:"type:uuid;not null;uniqueIndex"` // the reminder that was snoozed
+	DueAt          time.Time `json:"due_at" gorm:"type:timestamptz;not null;index"`
+	CreatedAt      time.Time `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
+}
+
+// TableName returns the table name for ReminderSnooze
+func (ReminderSnooze) TableName() string {
+	return "reminder_snoozes"
+}
+
+// BeforeCreate hook for ReminderSnooze
+func (rs *ReminderSnooze) BeforeCreate(tx *gorm.DB) error {
+	if rs.ID == uuid.Nil {
+		rs.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// StorageDeletionStatus represents the storage deletion status enum
+type StorageDeletionStatus string
+
+const (
+	StorageDeletionPending StorageDeletionStatus = "pending"
+	StorageDeletionFailed  StorageDeletionStatus = "failed"
+)
+
+// StorageDeletion represents the storage_deletions table (retry queue for storage object deletes)
+type StorageDeletion struct {
+	ID            uuid.UUID             `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ObjectURL     string                `json:"object_url" gorm:"type:text;not null"`
+	Status        StorageDeletionStatus `json:"status" gorm:"type:text;not null;default:'pending'"`
+	Attempts      int                   `json:"attempts" gorm:"not null;default:0"`
+	LastError     *string               `json:"last_error" gorm:"type:text"`
+	NextAttemptAt time.Time             `json:"next_attempt_at" gorm:"type:timestamptz;not null;default:now()"`
+	CreatedAt     time.Time             `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
+	UpdatedAt     time.Time             `json:"updated_at" gorm:"type:timestamptz;not null;default:now()"`
+}
+
+// TableName returns the table name for StorageDeletion
+func (StorageDeletion) TableName() string {
+	return "storage_deletions"
+}
+
+// BeforeCreate hook for StorageDeletion
+func (sd *StorageDeletion) BeforeCreate(tx *gorm.DB) error {
+	if sd.ID == uuid.Nil {
+		sd.ID = uuid.New()
+	}
+	return nil
+}
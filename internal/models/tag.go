@@ -20,6 +20,9 @@ const (
 	TagKindAccommodation TagKind = "accommodation"
 )
 
+// TagKinds lists all tag kinds
+var TagKinds = []TagKind{TagKindInterest, TagKindCategory, TagKindActivity, TagKindLocation, TagKindFood, TagKindTransport, TagKindAccommodation}
+
 // Tag represents the tags table
 type Tag struct {
 	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
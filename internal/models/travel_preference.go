@@ -47,6 +47,14 @@ const (
 	TravelStyleSkateboarding    TravelStyle = "skateboarding"
 )
 
+// TravelStyles lists all travel styles in display order
+var TravelStyles = []TravelStyle{
+	TravelStyleCafeDessert, TravelStyleBubbleTea, TravelStyleBakeryCake, TravelStyleBingsuIceCream,
+	TravelStyleCoffee, TravelStyleMatcha, TravelStylePancakes, TravelStyleSocialActivity,
+	TravelStyleKaraoke, TravelStyleGaming, TravelStyleMovie, TravelStyleBoardGame,
+	TravelStyleOutdoorActivity, TravelStylePartyCelebration, TravelStyleSwimming, TravelStyleSkateboarding,
+}
+
 // IsValidTravelStyle checks if the travel style is valid
 func IsValidTravelStyle(style string) bool {
 	validStyles := []string{
@@ -19,17 +19,23 @@ const (
 
 // User represents the users table
 type User struct {
-	ID            uuid.UUID    `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	Email         *string      `json:"email" gorm:"type:citext;uniqueIndex"`
-	Provider      AuthProvider `json:"provider" gorm:"type:auth_provider;not null"`
-	PasswordHash  *string      `json:"-" gorm:"type:text"`
-	EmailVerified bool         `json:"email_verified" gorm:"type:boolean;not null;default:false"`
-	GoogleID      *string      `json:"google_id" gorm:"type:text;uniqueIndex:ux_users_google_id,where:provider='google'"`
-	DisplayName   *string      `json:"display_name" gorm:"type:text;uniqueIndex:ux_users_display_name,where:deleted_at IS NULL"`
-	LastLoginAt   *time.Time   `json:"last_login_at" gorm:"type:timestamptz"`
-	CreatedAt     time.Time    `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
-	UpdatedAt     time.Time    `json:"updated_at" gorm:"type:timestamptz;not null;default:now()"`
-	DeletedAt     *time.Time   `json:"deleted_at" gorm:"type:timestamptz;index"`
+	ID                uuid.UUID    `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Email             *string      `json:"email" gorm:"type:citext;uniqueIndex"`
+	Provider          AuthProvider `json:"provider" gorm:"type:auth_provider;not null"`
+	PasswordHash      *string      `json:"-" gorm:"type:text"`
+	EmailVerified     bool         `json:"email_verified" gorm:"type:boolean;not null;default:false"`
+	GoogleID          *string      `json:"google_id" gorm:"type:text;uniqueIndex:ux_users_google_id,where:provider='google'"`
+	DisplayName       *string      `json:"display_name" gorm:"type:text;index:ix_users_display_name,where:deleted_at IS NULL;uniqueIndex:ux_users_display_name,where:deleted_at IS NULL AND display_name_shared = false"`
+	DisplayNameShared bool         `json:"-" gorm:"type:boolean;not null;default:false"`
+	Handle            *string      `json:"handle,omitempty" gorm:"type:text;uniqueIndex:ux_users_handle,where:deleted_at IS NULL"`
+	HandleChangedAt   *time.Time   `json:"-" gorm:"type:timestamptz"`
+	Phone             *string      `json:"phone,omitempty" gorm:"type:text;uniqueIndex:ux_users_phone,where:phone IS NOT NULL AND deleted_at IS NULL"`
+	PhoneVerified     bool         `json:"phone_verified" gorm:"type:boolean;not null;default:false"`
+	OTPChannel        *string      `json:"otp_channel,omitempty" gorm:"column:otp_channel;type:text"`
+	LastLoginAt       *time.Time   `json:"last_login_at" gorm:"type:timestamptz"`
+	CreatedAt         time.Time    `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
+	UpdatedAt         time.Time    `json:"updated_at" gorm:"type:timestamptz;not null;default:now()"`
+	DeletedAt         *time.Time   `json:"deleted_at" gorm:"type:timestamptz;index"`
 
 	// Relationships
 	Profile        *UserProfile      `json:"profile,omitempty" gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
@@ -43,6 +49,12 @@ type User struct {
 	PasswordResets []PasswordReset   `json:"-" gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
 }
 
+// OTP delivery channels
+const (
+	OTPChannelEmail = "email"
+	OTPChannelSMS   = "sms"
+)
+
 // TableName returns the table name for User
 func (User) TableName() string {
 	return "users"
@@ -71,6 +83,14 @@ func (u *User) HasPassword() bool {
 	return u.PasswordHash != nil && *u.PasswordHash != ""
 }
 
+// GetHandle returns the user's public handle, if any
+func (u *User) GetHandle() string {
+	if u.Handle != nil {
+		return *u.Handle
+	}
+	return ""
+}
+
 // GetDisplayName returns display name or email as fallback
 func (u *User) GetDisplayName() string {
 	if u.DisplayName != nil && *u.DisplayName != "" {
@@ -14,6 +14,7 @@ type UserEventHistory struct {
 	UserID      uuid.UUID  `json:"user_id" gorm:"type:uuid;not null;constraint:OnDelete:CASCADE"`
 	Completed   bool       `json:"completed" gorm:"type:boolean;not null;default:false"`
 	CompletedAt *time.Time `json:"completed_at" gorm:"type:timestamptz"`
+	Attended    bool       `json:"attended" gorm:"type:boolean;not null;default:false"`
 	CreatedAt   time.Time  `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
 
 	// Relationships
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserEventInterest represents the user_event_interests table: the user liked the event but
+// has not necessarily joined it. Joining is a separate, explicit EventMember.
+// Not to be confused with EventInterest, which tags an event with an interest.
+type UserEventInterest struct {
+	UserID    uuid.UUID `json:"user_id" gorm:"type:uuid;not null;primaryKey;constraint:OnDelete:CASCADE"`
+	EventID   uuid.UUID `json:"event_id" gorm:"type:uuid;not null;primaryKey;constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
+
+	// Relationships
+	User  *User  `json:"user,omitempty" gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	Event *Event `json:"event,omitempty" gorm:"foreignKey:EventID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName returns the table name for UserEventInterest
+func (UserEventInterest) TableName() string {
+	return "user_event_interests"
+}
@@ -17,6 +17,9 @@ const (
 	GenderPreferNotSay Gender = "prefer_not_say"
 )
 
+// Genders lists all gender options
+var Genders = []Gender{GenderMale, GenderFemale, GenderNonBinary, GenderPreferNotSay}
+
 // Smoking represents the smoking preference enum
 type Smoking string
 
@@ -26,6 +29,9 @@ const (
 	SmokingOccasionally Smoking = "occasionally"
 )
 
+// SmokingOptions lists all smoking options
+var SmokingOptions = []Smoking{SmokingNo, SmokingYes, SmokingOccasionally}
+
 // UserProfile represents the user_profiles table
 type UserProfile struct {
 	ID            uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserTopPick represents the user_top_picks table.
+// Each row is one of a user's best matching events as of ComputedAt, ordered by Rank.
+type UserTopPick struct {
+	UserID     uuid.UUID `json:"user_id" gorm:"type:uuid;not null;primaryKey;constraint:OnDelete:CASCADE"`
+	EventID    uuid.UUID `json:"event_id" gorm:"type:uuid;not null;primaryKey;constraint:OnDelete:CASCADE"`
+	Rank       int       `json:"rank" gorm:"not null"`
+	MatchScore float64   `json:"match_score" gorm:"not null"`
+	ComputedAt time.Time `json:"computed_at" gorm:"type:timestamptz;not null;default:now()"`
+
+	// Relationships
+	Event *Event `json:"event,omitempty" gorm:"foreignKey:EventID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName returns the table name for UserTopPick
+func (UserTopPick) TableName() string {
+	return "user_top_picks"
+}
@@ -0,0 +1,112 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Webhook event names fired from event lifecycle transitions
+const (
+	WebhookEventCreated   = "event.created"
+	WebhookEventCompleted = "event.completed"
+	WebhookEventCancelled = "event.cancelled"
+)
+
+// WebhookDeliveryStatus represents the webhook delivery status enum
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// Webhook represents the webhooks table
+type Webhook struct {
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OwnerUserID uuid.UUID  `json:"owner_user_id" gorm:"type:uuid;not null"`
+	URL         string     `json:"url" gorm:"type:text;not null"`
+	Secret      string     `json:"-" gorm:"type:text;not null"`
+	Events      *string    `json:"events" gorm:"type:text"` // Comma-separated event names, empty means all
+	Active      bool       `json:"active" gorm:"type:boolean;not null;default:true"`
+	CreatedAt   time.Time  `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
+	UpdatedAt   time.Time  `json:"updated_at" gorm:"type:timestamptz;not null;default:now()"`
+	DeletedAt   *time.Time `json:"deleted_at" gorm:"type:timestamptz;index"`
+}
+
+// TableName returns the table name for Webhook
+func (Webhook) TableName() string {
+	return "webhooks"
+}
+
+// BeforeCreate hook for Webhook
+func (w *Webhook) BeforeCreate(tx *gorm.DB) error {
+	if w.ID == uuid.Nil {
+		w.ID = uuid.New()
+	}
+	return nil
+}
+
+// GetEventsArray returns subscribed event names as a slice
+func (w *Webhook) GetEventsArray() []string {
+	if w.Events == nil || *w.Events == "" {
+		return []string{}
+	}
+
+	var events []string
+	for _, name := range strings.Split(*w.Events, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			events = append(events, name)
+		}
+	}
+	return events
+}
+
+// Subscribes checks if the webhook wants the given event
+func (w *Webhook) Subscribes(eventName string) bool {
+	events := w.GetEventsArray()
+	if len(events) == 0 {
+		return true
+	}
+	for _, name := range events {
+		if name == eventName {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery represents the webhook_deliveries table (delivery queue and log)
+type WebhookDelivery struct {
+	ID             uuid.UUID             `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	WebhookID      uuid.UUID             `json:"webhook_id" gorm:"type:uuid;not null;constraint:OnDelete:CASCADE"`
+	EventName      string                `json:"event_name" gorm:"type:text;not null"`
+	Payload        string                `json:"payload" gorm:"type:jsonb;not null"`
+	Status         WebhookDeliveryStatus `json:"status" gorm:"type:text;not null;default:'pending'"`
+	Attempts       int                   `json:"attempts" gorm:"type:int;not null;default:0"`
+	LastStatusCode *int                  `json:"last_status_code" gorm:"type:int"`
+	LastError      *string               `json:"last_error" gorm:"type:text"`
+	NextAttemptAt  time.Time             `json:"next_attempt_at" gorm:"type:timestamptz;not null;default:now()"`
+	DeliveredAt    *time.Time            `json:"delivered_at" gorm:"type:timestamptz"`
+	CreatedAt      time.Time             `json:"created_at" gorm:"type:timestamptz;not null;default:now()"`
+	UpdatedAt      time.Time             `json:"updated_at" gorm:"type:timestamptz;not null;default:now()"`
+
+	// Relationships
+	Webhook *Webhook `json:"webhook,omitempty" gorm:"foreignKey:WebhookID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName returns the table name for WebhookDelivery
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// BeforeCreate hook for WebhookDelivery
+func (d *WebhookDelivery) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
@@ -4,31 +4,64 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"fmt"
-	mathrand "math/rand"
 	"strings"
 	"time"
 
 	"TinderTrip-Backend/internal/models"
 	"TinderTrip-Backend/internal/utils"
 	"TinderTrip-Backend/pkg/audit"
+	"TinderTrip-Backend/pkg/config"
 	"TinderTrip-Backend/pkg/database"
 	"TinderTrip-Backend/pkg/email"
+	"TinderTrip-Backend/pkg/sms"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // AuthService handles authentication business logic
 type AuthService struct {
 	emailService *email.SMTPClient
+	smsSender    sms.SMSSender
 	auditLogger  *audit.AuditLogger
+	clock        utils.Clock
 	stopCleanup  chan bool
 }
 
+// otpObserver receives every OTP the auth service issues, in plaintext
+var otpObserver func(recipient, otp string)
+
+// SetOTPObserver registers fn to receive each OTP as it is issued, before it is hashed; nil removes it.
+// OTPs are only stored as hashes, so tests and development tooling use this to read them.
+// It must not be set in production.
+func SetOTPObserver(fn func(recipient, otp string)) {
+	otpObserver = fn
+}
+
+// emailVerificationObserver receives each email verification OTP once it is stored, in plaintext
+var emailVerificationObserver func(email, otp string, expiresAt time.Time)
+
+// SetEmailVerificationObserver registers fn to receive each email verification OTP, with its
+// expiry, once it replaces the email's earlier one; nil removes it. Like SetOTPObserver it is
+// for development tooling and must not be set in production.
+func SetEmailVerificationObserver(fn func(email, otp string, expiresAt time.Time)) {
+	emailVerificationObserver = fn
+}
+
 // NewAuthService creates a new auth service
 func NewAuthService() *AuthService {
+	return NewAuthServiceWithSMSSender(sms.NewSMSSender())
+}
+
+// NewAuthServiceWithSMSSender creates an auth service that delivers SMS OTPs through the given sender.
+// A nil sender disables SMS delivery.
+func NewAuthServiceWithSMSSender(sender sms.SMSSender) *AuthService {
 	service := &AuthService{
 		emailService: email.NewSMTPClient(),
+		smsSender:    sender,
 		auditLogger:  audit.NewAuditLogger(),
+		clock:        utils.RealClock{},
 		stopCleanup:  make(chan bool),
 	}
 
@@ -38,6 +71,22 @@ func NewAuthService() *AuthService {
 	return service
 }
 
+// NewAuthServiceWithClock creates an auth service that reads the time from the given clock.
+// OTP expiry is computed and checked against it.
+func NewAuthServiceWithClock(clock utils.Clock) *AuthService {
+	service := NewAuthService()
+	service.clock = clock
+	return service
+}
+
+// NewAuthServiceWithEmailClient creates an auth service that emails OTPs through client and
+// reads the time from clock
+func NewAuthServiceWithEmailClient(client *email.SMTPClient, clock utils.Clock) *AuthService {
+	service := NewAuthServiceWithClock(clock)
+	service.emailService = client
+	return service
+}
+
 // Register registers a new user
 func (s *AuthService) Register(email, password, displayName string) (*models.User, error) {
 	// Check if user already exists
@@ -50,14 +99,14 @@ func (s *AuthService) Register(email, password, displayName string) (*models.Use
 		return nil, fmt.Errorf("database error: %w", err)
 	}
 
-	// Check if display_name already exists
-	var existingDisplayName models.User
-	err = database.GetDB().Where("display_name = ? AND deleted_at IS NULL", displayName).First(&existingDisplayName).Error
-	if err == nil {
-		return nil, fmt.Errorf("display name already taken")
+	// Apply the display name mode: reject, suffix or allow a taken name
+	displayName, err = resolveDisplayName(displayName, uuid.Nil)
+	if err != nil {
+		return nil, err
 	}
-	if err != gorm.ErrRecordNotFound {
-		return nil, fmt.Errorf("database error: %w", err)
+	handle, err := newUserHandle(displayName)
+	if err != nil {
+		return nil, err
 	}
 
 	// Hash password
@@ -68,11 +117,13 @@ func (s *AuthService) Register(email, password, displayName string) (*models.Use
 
 	// Create user
 	user := &models.User{
-		Email:         &email,
-		Provider:      models.AuthProviderPassword,
-		PasswordHash:  &hashedPassword,
-		DisplayName:   &displayName,
-		EmailVerified: true, // Set as verified initially
+		Email:             &email,
+		Provider:          models.AuthProviderPassword,
+		PasswordHash:      &hashedPassword,
+		DisplayName:       &displayName,
+		DisplayNameShared: displayNameShared(),
+		Handle:            handle,
+		EmailVerified:     true, // Set as verified initially
 	}
 
 	// Save user to database
@@ -103,9 +154,9 @@ func (s *AuthService) Register(email, password, displayName string) (*models.Use
 
 // Login authenticates a user
 func (s *AuthService) Login(email, password string) (*models.User, error) {
-	// Find user by email (any provider)
+	// Find user by email (any provider); deleted accounts can't sign in
 	var user models.User
-	err := database.GetDB().Where("email = ?", email).First(&user).Error
+	err := database.GetDB().Where("email = ? AND deleted_at IS NULL", email).First(&user).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("invalid credentials")
@@ -139,7 +190,7 @@ func (s *AuthService) Login(email, password string) (*models.User, error) {
 	}
 
 	// Update last login time
-	now := time.Now()
+	now := s.clock.Now()
 	user.LastLoginAt = &now
 	if err := database.GetDB().Save(&user).Error; err != nil {
 		return nil, fmt.Errorf("failed to update last login time: %w", err)
@@ -156,8 +207,14 @@ func (s *AuthService) Login(email, password string) (*models.User, error) {
 	return &user, nil
 }
 
-// SendPasswordResetOTP sends a password reset OTP email
+// SendPasswordResetOTP sends a password reset OTP using the user's preferred channel
 func (s *AuthService) SendPasswordResetOTP(email string) error {
+	return s.SendPasswordResetOTPVia(email, "")
+}
+
+// SendPasswordResetOTPVia sends a password reset OTP through the requested channel.
+// SMS is only used when the user has a verified phone; otherwise the OTP goes by email.
+func (s *AuthService) SendPasswordResetOTPVia(email, channel string) error {
 	// Find user by email
 	var user models.User
 	err := database.GetDB().Where("email = ?", email).First(&user).Error
@@ -170,19 +227,22 @@ func (s *AuthService) SendPasswordResetOTP(email string) error {
 	}
 
 	// Generate 6-digit OTP
-	otp := s.generateOTP()
+	otp, err := s.issueOTP(email)
+	if err != nil {
+		return err
+	}
 
 	// Delete existing reset tokens for this user
 	database.GetDB().Where("user_id = ?", user.ID).Delete(&models.PasswordReset{})
 
 	// Clean up expired tokens
-	database.GetDB().Where("expires_at < ?", time.Now()).Delete(&models.PasswordReset{})
+	database.GetDB().Where("expires_at < ?", s.clock.Now()).Delete(&models.PasswordReset{})
 
 	// Create password reset record with OTP
 	passwordReset := &models.PasswordReset{
 		UserID:    user.ID,
-		Token:     otp,
-		ExpiresAt: time.Now().Add(3 * time.Minute), // 3 minutes expiry
+		Token:     s.hashOTP(otp),
+		ExpiresAt: s.clock.Now().Add(3 * time.Minute), // 3 minutes expiry
 	}
 
 	// Save password reset to database
@@ -190,6 +250,15 @@ func (s *AuthService) SendPasswordResetOTP(email string) error {
 		return fmt.Errorf("failed to create password reset: %w", err)
 	}
 
+	// Send OTP by SMS when possible
+	if s.resolveOTPChannel(&user, channel) == models.OTPChannelSMS {
+		message := fmt.Sprintf("Your TinderTrip password reset code is %s. It expires in 3 minutes.", otp)
+		if err := s.smsSender.SendSMS(*user.Phone, message); err != nil {
+			return fmt.Errorf("failed to send OTP SMS: %w", err)
+		}
+		return nil
+	}
+
 	// Send OTP email
 	err = s.emailService.SendPasswordResetOTP(email, otp)
 	if err != nil {
@@ -198,23 +267,35 @@ func (s *AuthService) SendPasswordResetOTP(email string) error {
 	return nil
 }
 
-// ResetPassword resets user password with OTP
-func (s *AuthService) ResetPassword(email, otp, newPassword string) error {
-	// Find password reset record
-	var passwordReset models.PasswordReset
-	err := database.GetDB().Where("token = ? AND expires_at > ?", otp, time.Now()).First(&passwordReset).Error
-	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return fmt.Errorf("invalid or expired OTP")
-		}
-		return fmt.Errorf("database error: %w", err)
+// resolveOTPChannel picks the delivery channel for an OTP.
+// The requested channel wins, then the user's preference, then the configured default.
+func (s *AuthService) resolveOTPChannel(user *models.User, requested string) string {
+	channel := requested
+	if channel == "" && user.OTPChannel != nil {
+		channel = *user.OTPChannel
+	}
+	if channel == "" && config.AppConfig != nil {
+		channel = config.AppConfig.OTP.DefaultChannel
 	}
 
-	// Verify the email matches the OTP
-	var user models.User
-	err = database.GetDB().Where("id = ? AND email = ?", passwordReset.UserID, email).First(&user).Error
+	if channel != models.OTPChannelSMS {
+		return models.OTPChannelEmail
+	}
+
+	// Fall back to email when SMS cannot be delivered
+	if s.smsSender == nil || user.Phone == nil || *user.Phone == "" || !user.PhoneVerified {
+		return models.OTPChannelEmail
+	}
+
+	return models.OTPChannelSMS
+}
+
+// ResetPassword resets user password with OTP
+func (s *AuthService) ResetPassword(email, otp, newPassword string) error {
+	// Find the email's password reset and check the OTP against it
+	passwordReset, err := s.checkPasswordResetOTP(email, otp)
 	if err != nil {
-		return fmt.Errorf("invalid email for this OTP")
+		return err
 	}
 
 	// Hash new password
@@ -234,43 +315,93 @@ func (s *AuthService) ResetPassword(email, otp, newPassword string) error {
 	s.auditLogger.LogPasswordReset(&userIDStr)
 
 	// Delete password reset record
-	err = database.GetDB().Delete(&passwordReset).Error
+	err = database.GetDB().Delete(passwordReset).Error
 	if err != nil {
 		return fmt.Errorf("failed to delete password reset record: %w", err)
 	}
 
 	// Clean up expired tokens
-	database.GetDB().Where("expires_at < ?", time.Now()).Delete(&models.PasswordReset{})
+	database.GetDB().Where("expires_at < ?", s.clock.Now()).Delete(&models.PasswordReset{})
 
 	return nil
 }
 
-// VerifyOTP verifies OTP for password reset
-func (s *AuthService) VerifyOTP(email, otp string) error {
-	// Find password reset record
-	var passwordReset models.PasswordReset
-	err := database.GetDB().Where("token = ? AND expires_at > ?", otp, time.Now()).First(&passwordReset).Error
+// ChangePassword changes a signed-in password user's password after checking their current one.
+// Every refresh token the user holds is revoked, so other sessions end once their access tokens
+// expire.
+func (s *AuthService) ChangePassword(userID, currentPassword, newPassword string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID")
+	}
+
+	var user models.User
+	err = database.GetDB().Where("id = ? AND deleted_at IS NULL", userUUID).First(&user).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return fmt.Errorf("invalid or expired OTP")
+			return fmt.Errorf("user not found")
 		}
 		return fmt.Errorf("database error: %w", err)
 	}
 
-	// Verify the email matches the OTP
-	var user models.User
-	err = database.GetDB().Where("id = ? AND email = ?", passwordReset.UserID, email).First(&user).Error
+	// Google users have no password to change
+	if user.Provider == models.AuthProviderGoogle || user.PasswordHash == nil {
+		return fmt.Errorf("this account uses Google login and has no password")
+	}
+
+	valid, err := utils.VerifyPassword(currentPassword, *user.PasswordHash)
+	if err != nil {
+		return fmt.Errorf("password verification failed: %w", err)
+	}
+	if !valid {
+		return fmt.Errorf("current password is incorrect")
+	}
+	if newPassword == currentPassword {
+		return fmt.Errorf("new password must be different from the current password")
+	}
+
+	hashedPassword, err := utils.HashPassword(newPassword)
 	if err != nil {
-		return fmt.Errorf("invalid email for this OTP")
+		return fmt.Errorf("password hashing failed: %w", err)
+	}
+
+	err = database.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.User{}).Where("id = ?", user.ID).Update("password_hash", hashedPassword).Error; err != nil {
+			return fmt.Errorf("failed to update password: %w", err)
+		}
+		err := tx.Model(&models.RefreshToken{}).
+			Where("user_id = ? AND revoked_at IS NULL", user.ID).
+			Update("revoked_at", s.clock.Now()).Error
+		if err != nil {
+			return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Log password change
+	userIDStr := user.ID.String()
+	s.auditLogger.LogPasswordChange(&userIDStr)
+
+	return nil
+}
+
+// VerifyOTP verifies OTP for password reset
+func (s *AuthService) VerifyOTP(email, otp string) error {
+	// Find the email's password reset and check the OTP against it
+	if _, err := s.checkPasswordResetOTP(email, otp); err != nil {
+		return err
 	}
 
 	// Clean up expired tokens
-	database.GetDB().Where("expires_at < ?", time.Now()).Delete(&models.PasswordReset{})
+	database.GetDB().Where("expires_at < ?", s.clock.Now()).Delete(&models.PasswordReset{})
 
 	return nil
 }
 
-// startCleanupRoutine starts background cleanup for expired OTPs
+// startCleanupRoutine starts background cleanup for expired OTPs and refresh tokens
 func (s *AuthService) startCleanupRoutine() {
 	ticker := time.NewTicker(1 * time.Minute) // Run every minute
 	defer ticker.Stop()
@@ -279,7 +410,8 @@ func (s *AuthService) startCleanupRoutine() {
 		select {
 		case <-ticker.C:
 			// Clean up expired OTPs
-			database.GetDB().Where("expires_at < ?", time.Now()).Delete(&models.PasswordReset{})
+			database.GetDB().Where("expires_at < ?", s.clock.Now()).Delete(&models.PasswordReset{})
+			database.GetDB().Where("expires_at < ?", s.clock.Now()).Delete(&models.RefreshToken{})
 		case <-s.stopCleanup:
 			return
 		}
@@ -300,17 +432,46 @@ func (s *AuthService) generateResetToken() (string, error) {
 	return hex.EncodeToString(bytes), nil
 }
 
+// otpLength is the number of digits in password reset and email verification OTPs
+const otpLength = 6
+
 // generateOTP generates a 6-digit OTP
-func (s *AuthService) generateOTP() string {
-	// Generate a random 6-digit number
-	otp := mathrand.Intn(900000) + 100000 // 100000 to 999999
-	return fmt.Sprintf("%06d", otp)
+func (s *AuthService) generateOTP() (string, error) {
+	return utils.GenerateOTP(otpLength)
+}
+
+// issueOTP generates an OTP for the recipient and hands it to the OTP observer, if any
+func (s *AuthService) issueOTP(recipient string) (string, error) {
+	otp, err := s.generateOTP()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate OTP: %w", err)
+	}
+	if otpObserver != nil {
+		otpObserver(recipient, otp)
+	}
+	return otp, nil
+}
+
+// hashOTP returns the form an OTP is stored and looked up in
+func (s *AuthService) hashOTP(otp string) string {
+	return utils.HashOTP(otp, otpPepper())
+}
+
+// otpPepper returns the secret OTPs are hashed with, falling back to the JWT secret
+func otpPepper() string {
+	if config.AppConfig == nil {
+		return ""
+	}
+	if config.AppConfig.OTP.Pepper != "" {
+		return config.AppConfig.OTP.Pepper
+	}
+	return config.AppConfig.JWT.Secret
 }
 
 // ValidateToken validates a password reset token
 func (s *AuthService) ValidateToken(token string) (*models.PasswordReset, error) {
 	var passwordReset models.PasswordReset
-	err := database.GetDB().Where("token = ? AND expires_at > ?", token, time.Now()).First(&passwordReset).Error
+	err := database.GetDB().Where("token = ? AND expires_at > ?", s.hashOTP(token), s.clock.Now()).First(&passwordReset).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("invalid or expired token")
@@ -359,78 +520,143 @@ func (s *AuthService) UpdateUser(userID string, updates map[string]interface{})
 	return nil
 }
 
-// DeleteUser deletes a user (soft delete)
+// DeleteUser deletes a user (soft delete), records it in the audit log and emails the user a
+// confirmation with how to restore the account
 func (s *AuthService) DeleteUser(userID string) error {
-	now := time.Now()
-	err := database.GetDB().Model(&models.User{}).Where("id = ?", userID).Update("deleted_at", now).Error
+	return deleteUserAccount(userID, s.clock, s.auditLogger)
+}
+
+// deleteUserAccount soft deletes the user, audits the deletion, revokes the user's refresh
+// tokens and queues the account deletion email
+func deleteUserAccount(userID string, clock utils.Clock, auditLogger *audit.AuditLogger) error {
+	var user models.User
+	err := database.GetDB().Where("id = ? AND deleted_at IS NULL", userID).First(&user).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return fmt.Errorf("user not found")
+		}
+		return fmt.Errorf("database error: %w", err)
+	}
+
+	now := clock.Now()
+	err = database.GetDB().Model(&models.User{}).Where("id = ?", user.ID).Update("deleted_at", now).Error
 	if err != nil {
 		return fmt.Errorf("failed to delete user: %w", err)
 	}
 
+	// Log user deletion
+	userIDStr := user.ID.String()
+	auditLogger.LogDelete(&userIDStr, "users", &userIDStr, user)
+
+	// Signed-in devices can't renew their sessions
+	if err := revokeUserRefreshTokens(user.ID, now); err != nil {
+		utils.Logger().WithFields(map[string]interface{}{
+			"error":   err,
+			"user_id": userIDStr,
+		}).Error("Failed to revoke refresh tokens")
+	}
+
+	if user.Email != nil {
+		if err := NewEmailOutboxServiceWithClock(clock).QueueAccountDeletedEmail(*user.Email, user.GetDisplayName()); err != nil {
+			utils.Logger().WithFields(map[string]interface{}{
+				"error":   err,
+				"user_id": userIDStr,
+			}).Error("Failed to queue account deletion email")
+		}
+	}
+
 	return nil
 }
 
-// SendEmailVerificationOTP sends an email verification OTP
-func (s *AuthService) SendEmailVerificationOTP(email, displayName string) error {
+// SendEmailVerificationOTP sends an email verification OTP. It returns a cancel token that
+// CancelEmailVerification requires, so only whoever registered can cancel the registration.
+func (s *AuthService) SendEmailVerificationOTP(email, displayName string) (string, error) {
 	// Check if user already exists
 	var existingUser models.User
 	err := database.GetDB().Where("email = ?", email).First(&existingUser).Error
 	if err == nil {
-		return fmt.Errorf("user already exists")
+		return "", fmt.Errorf("user already exists")
 	}
 	if err != gorm.ErrRecordNotFound {
-		return fmt.Errorf("database error: %w", err)
+		return "", fmt.Errorf("database error: %w", err)
 	}
 
-	// Check if display_name already exists
-	var existingDisplayName models.User
-	err = database.GetDB().Where("display_name = ? AND deleted_at IS NULL", displayName).First(&existingDisplayName).Error
-	if err == nil {
-		return fmt.Errorf("display name already taken")
+	// Only unique mode turns a taken display name away; the others settle it at sign-up
+	if err := checkDisplayNameAvailable(displayName, uuid.Nil); err != nil {
+		return "", err
 	}
-	if err != gorm.ErrRecordNotFound {
-		return fmt.Errorf("database error: %w", err)
+
+	cancelToken, err := s.generateResetToken()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate cancel token: %w", err)
 	}
 
 	// Generate 6-digit OTP
-	otp := s.generateOTP()
+	otp, err := s.issueOTP(email)
+	if err != nil {
+		return "", err
+	}
+
+	// Replace any verification OTP already sent to this email
+	if err := s.storeEmailVerification(email, otp, cancelToken); err != nil {
+		return "", err
+	}
+
+	// Send OTP email
+	err = s.emailService.SendVerificationOTP(email, otp)
+	if err != nil {
+		return "", fmt.Errorf("failed to send verification OTP email: %w", err)
+	}
+	return cancelToken, nil
+}
 
-	// Delete existing verification OTPs for this email
-	database.GetDB().Where("email = ?", email).Delete(&models.EmailVerification{})
+// storeEmailVerification saves the OTP as the email's only verification, replacing an earlier one
+// in place so concurrent registrations for the same email can't leave two behind. A non-empty
+// cancelToken replaces the registration's cancel token; resends keep the earlier one.
+func (s *AuthService) storeEmailVerification(email, otp, cancelToken string) error {
+	now := s.clock.Now()
 
 	// Clean up expired OTPs
-	database.GetDB().Where("expires_at < ?", time.Now()).Delete(&models.EmailVerification{})
+	database.GetDB().Where("expires_at < ?", now).Delete(&models.EmailVerification{})
 
-	// Create email verification record
 	emailVerification := &models.EmailVerification{
 		Email:     email,
-		OTP:       otp,
-		ExpiresAt: time.Now().Add(10 * time.Minute), // 10 minutes expiry
-	}
-
-	// Save email verification to database
-	if err := database.GetDB().Create(emailVerification).Error; err != nil {
+		OTP:       s.hashOTP(otp),
+		ExpiresAt: now.Add(10 * time.Minute), // 10 minutes expiry
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	updates := map[string]interface{}{
+		"otp":        emailVerification.OTP,
+		"expires_at": emailVerification.ExpiresAt,
+		"attempts":   0,
+		"created_at": now,
+		"updated_at": now,
+	}
+	if cancelToken != "" {
+		cancelTokenHash := s.hashOTP(cancelToken)
+		emailVerification.CancelTokenHash = &cancelTokenHash
+		updates["cancel_token_hash"] = cancelTokenHash
+	}
+	err := database.GetDB().Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "email"}},
+		DoUpdates: clause.Assignments(updates),
+	}).Create(emailVerification).Error
+	if err != nil {
 		return fmt.Errorf("failed to create email verification: %w", err)
 	}
-
-	// Send OTP email
-	err = s.emailService.SendVerificationOTP(email, otp)
-	if err != nil {
-		return fmt.Errorf("failed to send verification OTP email: %w", err)
+	if emailVerificationObserver != nil {
+		emailVerificationObserver(email, otp, emailVerification.ExpiresAt)
 	}
 	return nil
 }
 
 // VerifyEmailOTP verifies email OTP and creates user
 func (s *AuthService) VerifyEmailOTP(email, otp, password, displayName string) (*models.User, error) {
-	// Find email verification record
-	var emailVerification models.EmailVerification
-	err := database.GetDB().Where("email = ? AND otp = ? AND expires_at > ?", email, otp, time.Now()).First(&emailVerification).Error
+	// Find the email's verification and check the OTP against it
+	emailVerification, err := s.checkEmailVerificationOTP(email, otp)
 	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("invalid or expired OTP")
-		}
-		return nil, fmt.Errorf("database error: %w", err)
+		return nil, err
 	}
 
 	// Check if user already exists
@@ -443,14 +669,14 @@ func (s *AuthService) VerifyEmailOTP(email, otp, password, displayName string) (
 		return nil, fmt.Errorf("database error: %w", err)
 	}
 
-	// Check if display_name already exists
-	var existingDisplayName models.User
-	err = database.GetDB().Where("display_name = ? AND deleted_at IS NULL", displayName).First(&existingDisplayName).Error
-	if err == nil {
-		return nil, fmt.Errorf("display name already taken")
+	// Apply the display name mode: reject, suffix or allow a taken name
+	displayName, err = resolveDisplayName(displayName, uuid.Nil)
+	if err != nil {
+		return nil, err
 	}
-	if err != gorm.ErrRecordNotFound {
-		return nil, fmt.Errorf("database error: %w", err)
+	handle, err := newUserHandle(displayName)
+	if err != nil {
+		return nil, err
 	}
 
 	// Hash password
@@ -461,11 +687,13 @@ func (s *AuthService) VerifyEmailOTP(email, otp, password, displayName string) (
 
 	// Create user
 	user := &models.User{
-		Email:         &email,
-		Provider:      models.AuthProviderPassword,
-		PasswordHash:  &hashedPassword,
-		DisplayName:   &displayName,
-		EmailVerified: true, // Set as verified since OTP was validated
+		Email:             &email,
+		Provider:          models.AuthProviderPassword,
+		PasswordHash:      &hashedPassword,
+		DisplayName:       &displayName,
+		DisplayNameShared: displayNameShared(),
+		Handle:            handle,
+		EmailVerified:     true, // Set as verified since OTP was validated
 	}
 
 	// Save user to database
@@ -491,19 +719,33 @@ func (s *AuthService) VerifyEmailOTP(email, otp, password, displayName string) (
 	userIDStr := user.ID.String()
 	s.auditLogger.LogCreate(&userIDStr, "users", &userIDStr, user)
 
+	queueSignupWelcomeEmail(user, s.clock)
+
 	// Delete email verification record
-	err = database.GetDB().Delete(&emailVerification).Error
+	err = database.GetDB().Delete(emailVerification).Error
 	if err != nil {
 		// Log error but don't fail the verification
 		fmt.Printf("Warning: Failed to delete email verification record: %v\n", err)
 	}
 
 	// Clean up expired OTPs
-	database.GetDB().Where("expires_at < ?", time.Now()).Delete(&models.EmailVerification{})
+	database.GetDB().Where("expires_at < ?", s.clock.Now()).Delete(&models.EmailVerification{})
 
 	return user, nil
 }
 
+// CancelEmailVerification clears the pending registration for an email, so an OTP sent to a
+// mistyped address stops working. The cancel token returned at registration proves the caller
+// registered it. It succeeds whether or not one was pending or the token matched.
+func (s *AuthService) CancelEmailVerification(email, cancelToken string) error {
+	err := database.GetDB().Where("email = ? AND cancel_token_hash = ?", email, s.hashOTP(cancelToken)).
+		Delete(&models.EmailVerification{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to cancel email verification: %w", err)
+	}
+	return nil
+}
+
 // ResendEmailVerificationOTP resends email verification OTP
 func (s *AuthService) ResendEmailVerificationOTP(email string) error {
 	// Check if user already exists
@@ -517,24 +759,14 @@ func (s *AuthService) ResendEmailVerificationOTP(email string) error {
 	}
 
 	// Generate new 6-digit OTP
-	otp := s.generateOTP()
-
-	// Delete existing verification OTPs for this email
-	database.GetDB().Where("email = ?", email).Delete(&models.EmailVerification{})
-
-	// Clean up expired OTPs
-	database.GetDB().Where("expires_at < ?", time.Now()).Delete(&models.EmailVerification{})
-
-	// Create new email verification record
-	emailVerification := &models.EmailVerification{
-		Email:     email,
-		OTP:       otp,
-		ExpiresAt: time.Now().Add(10 * time.Minute), // 10 minutes expiry
+	otp, err := s.issueOTP(email)
+	if err != nil {
+		return err
 	}
 
-	// Save email verification to database
-	if err := database.GetDB().Create(emailVerification).Error; err != nil {
-		return fmt.Errorf("failed to create email verification: %w", err)
+	// Replace any verification OTP already sent to this email
+	if err := s.storeEmailVerification(email, otp, ""); err != nil {
+		return err
 	}
 
 	// Send OTP email
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"fmt"
+	"log"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// resolveChatMentions turns the @handle mentions in a message body into mention rows for the
+// members who can see the room's chat. Mentions of anyone else are left as plain text, and a
+// member mentioned more than once is recorded at their first mention.
+func resolveChatMentions(tx *gorm.DB, roomID uuid.UUID, body string) ([]models.ChatMessageMention, error) {
+	parsed := utils.ParseMentions(body)
+	if len(parsed) == 0 {
+		return nil, nil
+	}
+
+	handles := make([]string, 0, len(parsed))
+	for _, mention := range parsed {
+		handles = append(handles, mention.Handle)
+	}
+
+	var members []models.User
+	err := tx.Model(&models.User{}).
+		Joins("JOIN event_members ON event_members.user_id = users.id").
+		Joins("JOIN chat_rooms ON chat_rooms.event_id = event_members.event_id").
+		Joins("JOIN events ON events.id = chat_rooms.event_id").
+		Where("chat_rooms.id = ? AND users.handle IN ? AND users.deleted_at IS NULL", roomID, handles).
+		Where(chatAccessCondition, models.MemberStatusConfirmed, models.MemberStatusPending).
+		Find(&members).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve mentions: %w", err)
+	}
+
+	memberIDs := make(map[string]uuid.UUID, len(members))
+	for _, member := range members {
+		memberIDs[member.GetHandle()] = member.ID
+	}
+
+	var mentions []models.ChatMessageMention
+	seen := make(map[uuid.UUID]bool)
+	for _, mention := range parsed {
+		userID, ok := memberIDs[mention.Handle]
+		if !ok || seen[userID] {
+			continue
+		}
+		seen[userID] = true
+		mentions = append(mentions, models.ChatMessageMention{
+			UserID: userID,
+			Start:  mention.Start,
+			Length: mention.Length,
+		})
+	}
+	return mentions, nil
+}
+
+// notifyChatMentions sends a mention notification to each member mentioned in the message,
+// except the sender
+func notifyChatMentions(message models.ChatMessage, eventID uuid.UUID) {
+	notificationService := NewNotificationService()
+	for _, mention := range message.Mentions {
+		if mention.UserID == message.SenderID {
+			continue
+		}
+		err := notificationService.SendChatMentionNotification(message, eventID.String(), mention.UserID.String())
+		if err != nil {
+			log.Printf("Failed to send mention notification to user %s for message %s: %v", mention.UserID, message.ID, err)
+		}
+	}
+}
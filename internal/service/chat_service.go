@@ -2,6 +2,7 @@ package service
 
 import (
 	"fmt"
+	"sort"
 
 	"TinderTrip-Backend/internal/dto"
 	"TinderTrip-Backend/internal/models"
@@ -15,6 +16,10 @@ import (
 type ChatService struct {
 }
 
+// chatAccessCondition matches event members allowed in the event chat: confirmed members,
+// and pending members unless the creator closed the chat to them
+const chatAccessCondition = "(event_members.status = ? OR (event_members.status = ? AND events.pending_chat_access))"
+
 // NewChatService creates a new chat service
 func NewChatService() *ChatService {
 	return &ChatService{}
@@ -28,11 +33,13 @@ func (s *ChatService) GetChatRooms(userID string) ([]dto.ChatRoomResponse, error
 		return nil, fmt.Errorf("invalid user ID: %w", err)
 	}
 
-	// Get chat rooms where user is a member (both pending and confirmed)
+	// Get chat rooms where user is a member (pending members only if the event allows it)
 	var rooms []models.ChatRoom
 	err = database.GetDB().Preload("Event").Preload("Event.Creator").
 		Joins("JOIN event_members ON chat_rooms.event_id = event_members.event_id").
-		Where("event_members.user_id = ? AND event_members.status IN (?, ?)", userUUID, models.MemberStatusPending, models.MemberStatusConfirmed).
+		Joins("JOIN events ON events.id = chat_rooms.event_id").
+		Where("event_members.user_id = ?", userUUID).
+		Where(chatAccessCondition, models.MemberStatusConfirmed, models.MemberStatusPending).
 		Find(&rooms).Error
 	if err != nil {
 		return nil, fmt.Errorf("failed to get chat rooms: %w", err)
@@ -59,10 +66,12 @@ func (s *ChatService) GetMessages(roomID, userID string, page, limit int) ([]dto
 		return nil, 0, fmt.Errorf("invalid user ID: %w", err)
 	}
 
-	// Check if user is a member of the room (both pending and confirmed)
+	// Check if user is a member of the room (pending members only if the event allows it)
 	var member models.EventMember
 	err = database.GetDB().Joins("JOIN chat_rooms ON event_members.event_id = chat_rooms.event_id").
-		Where("chat_rooms.id = ? AND event_members.user_id = ? AND event_members.status IN (?, ?)", roomUUID, userUUID, models.MemberStatusPending, models.MemberStatusConfirmed).
+		Joins("JOIN events ON events.id = chat_rooms.event_id").
+		Where("chat_rooms.id = ? AND event_members.user_id = ?", roomUUID, userUUID).
+		Where(chatAccessCondition, models.MemberStatusConfirmed, models.MemberStatusPending).
 		First(&member).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -81,7 +90,7 @@ func (s *ChatService) GetMessages(roomID, userID string, page, limit int) ([]dto
 	// Get messages with pagination
 	var messages []models.ChatMessage
 	offset := (page - 1) * limit
-	err = database.GetDB().Preload("Sender").Where("room_id = ?", roomUUID).
+	err = database.GetDB().Preload("Sender").Preload("Mentions.User").Where("room_id = ?", roomUUID).
 		Offset(offset).Limit(limit).Order("created_at DESC").Find(&messages).Error
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get messages: %w", err)
@@ -113,10 +122,12 @@ func (s *ChatService) SendMessageWithMedia(roomID, userID string, req dto.SendMe
 		return nil, fmt.Errorf("invalid user ID: %w", err)
 	}
 
-	// Check if user is a member of the room (both pending and confirmed)
+	// Check if user is a member of the room (pending members only if the event allows it)
 	var member models.EventMember
 	err = database.GetDB().Joins("JOIN chat_rooms ON event_members.event_id = chat_rooms.event_id").
-		Where("chat_rooms.id = ? AND event_members.user_id = ? AND event_members.status IN (?, ?)", roomUUID, userUUID, models.MemberStatusPending, models.MemberStatusConfirmed).
+		Joins("JOIN events ON events.id = chat_rooms.event_id").
+		Where("chat_rooms.id = ? AND event_members.user_id = ?", roomUUID, userUUID).
+		Where(chatAccessCondition, models.MemberStatusConfirmed, models.MemberStatusPending).
 		First(&member).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -147,18 +158,42 @@ func (s *ChatService) SendMessageWithMedia(roomID, userID string, req dto.SendMe
 		FileURL:     fileURL,
 	}
 
-	err = database.GetDB().Create(message).Error
+	err = database.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(message).Error; err != nil {
+			return fmt.Errorf("failed to send message: %w", err)
+		}
+
+		// Record @mentions of members who can see the chat
+		mentions, err := resolveChatMentions(tx, roomUUID, req.Body)
+		if err != nil {
+			return err
+		}
+		for i := range mentions {
+			mentions[i].MessageID = message.ID
+		}
+		if len(mentions) > 0 {
+			if err := tx.Create(&mentions).Error; err != nil {
+				return fmt.Errorf("failed to save mentions: %w", err)
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to send message: %w", err)
+		return nil, err
 	}
 
-	// Load message with sender
-	err = database.GetDB().Preload("Sender").Where("id = ?", message.ID).First(message).Error
+	// Load message with sender and mentions
+	err = database.GetDB().Preload("Sender").Preload("Mentions.User").Where("id = ?", message.ID).First(message).Error
 	if err != nil {
 		return nil, fmt.Errorf("failed to load message: %w", err)
 	}
 
 	response := s.convertChatMessageToResponse(*message)
+
+	// Notify mentioned members in the background so the sender doesn't wait on delivery
+	mentioned, eventID := *message, member.EventID
+	sendInBackground(func() { notifyChatMentions(mentioned, eventID) })
+
 	return &response, nil
 }
 
@@ -211,6 +246,7 @@ func (s *ChatService) GetRoomMembers(roomID string) ([]dto.UserResponse, error)
 				ID:          member.User.ID.String(),
 				Email:       *member.User.Email,
 				DisplayName: member.User.GetDisplayName(),
+				Handle:      member.User.GetHandle(),
 				Provider:    string(member.User.Provider),
 				CreatedAt:   member.User.CreatedAt,
 			}
@@ -261,6 +297,7 @@ func (s *ChatService) convertChatRoomToResponse(room models.ChatRoom) dto.ChatRo
 			StartAt:       room.Event.StartAt,
 			EndAt:         room.Event.EndAt,
 			Capacity:      room.Event.Capacity,
+			MinAttendees:  room.Event.MinAttendees,
 			Status:        string(room.Event.Status),
 			CoverImageURL: room.Event.CoverImageURL,
 			CreatedAt:     room.Event.CreatedAt,
@@ -273,6 +310,7 @@ func (s *ChatService) convertChatRoomToResponse(room models.ChatRoom) dto.ChatRo
 				ID:          room.Event.Creator.ID.String(),
 				Email:       *room.Event.Creator.Email,
 				DisplayName: room.Event.Creator.GetDisplayName(),
+				Handle:      room.Event.Creator.GetHandle(),
 				Provider:    string(room.Event.Creator.Provider),
 				CreatedAt:   room.Event.Creator.CreatedAt,
 			}
@@ -305,11 +343,29 @@ func (s *ChatService) convertChatMessageToResponse(message models.ChatMessage) d
 			ID:          message.Sender.ID.String(),
 			Email:       *message.Sender.Email,
 			DisplayName: message.Sender.GetDisplayName(),
+			Handle:      message.Sender.GetHandle(),
 			Provider:    string(message.Sender.Provider),
 			CreatedAt:   message.Sender.CreatedAt,
 		}
 	}
 
+	// Add mentions, ordered as they appear in the body
+	for _, mention := range message.Mentions {
+		mentionResponse := dto.ChatMentionResponse{
+			UserID: mention.UserID.String(),
+			Start:  mention.Start,
+			Length: mention.Length,
+		}
+		if mention.User != nil {
+			mentionResponse.Handle = mention.User.GetHandle()
+			mentionResponse.DisplayName = mention.User.GetDisplayName()
+		}
+		response.Mentions = append(response.Mentions, mentionResponse)
+	}
+	sort.Slice(response.Mentions, func(i, j int) bool {
+		return response.Mentions[i].Start < response.Mentions[j].Start
+	})
+
 	return response
 }
 
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// checkinOpensBefore is how early before the start time members may check in
+	checkinOpensBefore = 30 * time.Minute
+	// checkinDefaultDuration closes check-in for events without an end time
+	checkinDefaultDuration = 6 * time.Hour
+	// defaultCheckinRadiusMeters is used when no radius is configured
+	defaultCheckinRadiusMeters = 200
+	// checkinCodeAlphabet omits characters that are easy to confuse when read aloud
+	checkinCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	checkinCodeLength   = 6
+)
+
+// Check-in methods
+const (
+	CheckinMethodCode     = "code"
+	CheckinMethodLocation = "location"
+	CheckinMethodNone     = "none"
+)
+
+// CheckinService handles attendance check-in at events
+type CheckinService struct {
+	clock utils.Clock
+}
+
+// NewCheckinService creates a new check-in service
+func NewCheckinService() *CheckinService {
+	return NewCheckinServiceWithClock(utils.RealClock{})
+}
+
+// NewCheckinServiceWithClock creates a check-in service that reads the time from clock
+func NewCheckinServiceWithClock(clock utils.Clock) *CheckinService {
+	return &CheckinService{clock: clock}
+}
+
+// GenerateCheckinCode creates a new check-in code for the event (creator only).
+// The code can be shown as text or encoded in a QR code by the client.
+func (s *CheckinService) GenerateCheckinCode(eventID, userID string) (*dto.CheckinCodeResponse, error) {
+	eventUUID, err := uuid.Parse(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event ID")
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID")
+	}
+
+	event, err := s.getEvent(eventUUID)
+	if err != nil {
+		return nil, err
+	}
+	if event.CreatorID != userUUID {
+		return nil, fmt.Errorf("not authorized")
+	}
+	if !event.IsPublished() {
+		return nil, fmt.Errorf("event is not active")
+	}
+
+	code, err := generateCheckinCode()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate check-in code: %w", err)
+	}
+
+	err = database.GetDB().Model(&models.Event{}).Where("id = ?", eventUUID).Update("checkin_code", code).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to save check-in code: %w", err)
+	}
+
+	return &dto.CheckinCodeResponse{EventID: eventID, Code: code}, nil
+}
+
+// CheckIn marks a confirmed member as present at the event.
+// A code or location is required whenever the event has one to check against.
+func (s *CheckinService) CheckIn(eventID, userID string, req dto.CheckinRequest) (*dto.CheckinResponse, error) {
+	eventUUID, err := uuid.Parse(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event ID")
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID")
+	}
+
+	if (req.Lat == nil) != (req.Lng == nil) {
+		return nil, fmt.Errorf("lat and lng must be provided together")
+	}
+
+	event, err := s.getEvent(eventUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check membership first so outsiders learn nothing about the event's state
+	var member models.EventMember
+	err = database.GetDB().Where("event_id = ? AND user_id = ?", eventUUID, userUUID).First(&member).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("not a member")
+		}
+		return nil, fmt.Errorf("failed to get member: %w", err)
+	}
+	if member.Status != models.MemberStatusConfirmed {
+		return nil, fmt.Errorf("not a confirmed member")
+	}
+
+	if !event.IsPublished() {
+		return nil, fmt.Errorf("event is not active")
+	}
+	if member.CheckedInAt != nil {
+		return nil, fmt.Errorf("already checked in")
+	}
+
+	now := s.clock.Now()
+	if !checkinOpen(event, now) {
+		return nil, fmt.Errorf("check-in is not open")
+	}
+
+	method, err := s.verifyCheckinProof(event, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := database.GetDB().Model(&models.EventMember{}).
+		Where("event_id = ? AND user_id = ? AND checked_in_at IS NULL", eventUUID, userUUID).
+		Update("checked_in_at", now)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to check in: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, fmt.Errorf("already checked in")
+	}
+
+	return &dto.CheckinResponse{
+		EventID:     eventID,
+		UserID:      userID,
+		CheckedInAt: now,
+		Method:      method,
+	}, nil
+}
+
+// verifyCheckinProof validates the code or location and returns the method used
+func (s *CheckinService) verifyCheckinProof(event *models.Event, req dto.CheckinRequest) (string, error) {
+	if req.Code != nil && *req.Code != "" {
+		if event.CheckinCode == nil || !strings.EqualFold(strings.TrimSpace(*req.Code), *event.CheckinCode) {
+			return "", fmt.Errorf("invalid check-in code")
+		}
+		return CheckinMethodCode, nil
+	}
+
+	if req.Lat != nil && req.Lng != nil {
+		if event.Lat == nil || event.Lng == nil {
+			return "", fmt.Errorf("event has no location")
+		}
+		distance := utils.DistanceMeters(*req.Lat, *req.Lng, *event.Lat, *event.Lng)
+		if distance > float64(checkinRadiusMeters()) {
+			return "", fmt.Errorf("too far from event location")
+		}
+		return CheckinMethodLocation, nil
+	}
+
+	// Events with neither a code nor a location cannot be verified, so any member may check in
+	if event.CheckinCode != nil || (event.Lat != nil && event.Lng != nil) {
+		return "", fmt.Errorf("check-in code or location required")
+	}
+
+	return CheckinMethodNone, nil
+}
+
+// getEvent loads an event that has not been deleted
+func (s *CheckinService) getEvent(eventID uuid.UUID) (*models.Event, error) {
+	var event models.Event
+	err := database.GetDB().Where("id = ? AND deleted_at IS NULL", eventID).First(&event).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("event not found")
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	return &event, nil
+}
+
+// checkinOpen reports whether now falls within the event's check-in window
+func checkinOpen(event *models.Event, now time.Time) bool {
+	if event.StartAt == nil {
+		return false
+	}
+
+	opensAt := event.StartAt.Add(-checkinOpensBefore)
+	closesAt := event.StartAt.Add(checkinDefaultDuration)
+	if event.EndAt != nil {
+		closesAt = *event.EndAt
+	}
+
+	return !now.Before(opensAt) && !now.After(closesAt)
+}
+
+// checkinRadiusMeters returns the configured check-in radius
+func checkinRadiusMeters() int {
+	if config.AppConfig != nil && config.AppConfig.Checkin.RadiusMeters > 0 {
+		return config.AppConfig.Checkin.RadiusMeters
+	}
+	return defaultCheckinRadiusMeters
+}
+
+// generateCheckinCode generates a random check-in code
+func generateCheckinCode() (string, error) {
+	code := make([]byte, checkinCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(checkinCodeAlphabet))))
+		if err != nil {
+			return "", err
+		}
+		code[i] = checkinCodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
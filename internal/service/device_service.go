@@ -0,0 +1,98 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+)
+
+// DeviceService handles the devices users receive push notifications on
+type DeviceService struct {
+	clock utils.Clock
+}
+
+// NewDeviceService creates a new device service
+func NewDeviceService() *DeviceService {
+	return NewDeviceServiceWithClock(utils.RealClock{})
+}
+
+// NewDeviceServiceWithClock creates a device service that reads the time from the given clock
+func NewDeviceServiceWithClock(clock utils.Clock) *DeviceService {
+	return &DeviceService{clock: clock}
+}
+
+// RegisterDevice saves the device's push token for the user. Registering a token again
+// updates its last_seen_at, and moves it over if it was registered to another user.
+func (s *DeviceService) RegisterDevice(userID, token, platform string) (*dto.DeviceResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID")
+	}
+
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, fmt.Errorf("invalid device token")
+	}
+	if !models.IsValidDevicePlatform(platform) {
+		return nil, fmt.Errorf("invalid platform")
+	}
+
+	now := s.clock.Now()
+	device := &models.DeviceToken{
+		UserID:     userUUID,
+		Token:      token,
+		Platform:   platform,
+		CreatedAt:  now,
+		LastSeenAt: now,
+	}
+	err = database.GetDB().Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "token"}},
+		DoUpdates: clause.AssignmentColumns([]string{"user_id", "platform", "last_seen_at"}),
+	}).Create(device).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to register device: %w", err)
+	}
+
+	// The insert may have become an update, so read back the stored row
+	var stored models.DeviceToken
+	if err := database.GetDB().Where("token = ?", token).First(&stored).Error; err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return deviceResponse(stored), nil
+}
+
+// deviceResponse converts a device token to its API form
+func deviceResponse(device models.DeviceToken) *dto.DeviceResponse {
+	return &dto.DeviceResponse{
+		Token:      device.Token,
+		Platform:   device.Platform,
+		CreatedAt:  device.CreatedAt,
+		LastSeenAt: device.LastSeenAt,
+	}
+}
+
+// UnregisterDevice stops push notifications to the device. Only the user the token is
+// registered to can remove it.
+func (s *DeviceService) UnregisterDevice(userID, token string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID")
+	}
+
+	result := database.GetDB().Where("token = ? AND user_id = ?", strings.TrimSpace(token), userUUID).
+		Delete(&models.DeviceToken{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to unregister device: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("device not found")
+	}
+	return nil
+}
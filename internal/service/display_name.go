@@ -0,0 +1,85 @@
+package service
+
+import (
+	"fmt"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+)
+
+// maxDisplayNameSuffix bounds the search for a free name_N in unique-suffix-auto mode
+const maxDisplayNameSuffix = 1000
+
+// displayNameMode returns the configured display name uniqueness mode
+func displayNameMode() string {
+	if config.AppConfig != nil && config.AppConfig.User.DisplayNameMode != "" {
+		return config.AppConfig.User.DisplayNameMode
+	}
+	return config.DisplayNameUnique
+}
+
+// displayNameShared reports whether display names are stored under the configured mode as
+// shared names, which the unique index on display_name leaves out
+func displayNameShared() bool {
+	return displayNameMode() == config.DisplayNameNonUnique
+}
+
+// displayNameTaken reports whether an active user other than excludeUserID has the display name
+func displayNameTaken(name string, excludeUserID uuid.UUID) (bool, error) {
+	var count int64
+	err := database.GetDB().Model(&models.User{}).
+		Where("display_name = ? AND id != ? AND deleted_at IS NULL", name, excludeUserID).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("database error: %w", err)
+	}
+	return count > 0, nil
+}
+
+// checkDisplayNameAvailable rejects a taken display name in unique mode. The other modes
+// accept any name, since it is either suffixed or allowed to repeat when it is stored.
+func checkDisplayNameAvailable(name string, excludeUserID uuid.UUID) error {
+	if displayNameMode() != config.DisplayNameUnique {
+		return nil
+	}
+	taken, err := displayNameTaken(name, excludeUserID)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return fmt.Errorf("display name already taken")
+	}
+	return nil
+}
+
+// resolveDisplayName returns the display name to store for the user under the configured mode.
+// Pass uuid.Nil as the user for someone who is signing up.
+func resolveDisplayName(name string, excludeUserID uuid.UUID) (string, error) {
+	switch displayNameMode() {
+	case config.DisplayNameNonUnique:
+		return name, nil
+	case config.DisplayNameAutoSuffix:
+		for n := 1; n <= maxDisplayNameSuffix; n++ {
+			candidate := name
+			if n > 1 {
+				candidate = fmt.Sprintf("%s_%d", name, n)
+			}
+			taken, err := displayNameTaken(candidate, excludeUserID)
+			if err != nil {
+				return "", err
+			}
+			if !taken {
+				return candidate, nil
+			}
+		}
+		return "", fmt.Errorf("display name already taken")
+	default:
+		if err := checkDisplayNameAvailable(name, excludeUserID); err != nil {
+			return "", err
+		}
+		return name, nil
+	}
+}
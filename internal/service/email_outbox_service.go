@@ -0,0 +1,182 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/database"
+	"TinderTrip-Backend/pkg/email"
+)
+
+const (
+	emailOutboxMaxAttempts = 8
+	emailOutboxBaseDelay   = 1 * time.Minute
+	emailOutboxMaxDelay    = 6 * time.Hour
+	// emailOutboxLease keeps a row away from other workers while it is being sent
+	emailOutboxLease = 5 * time.Minute
+)
+
+// EmailOutboxService queues emails and sends them from the worker, retrying failed sends
+type EmailOutboxService struct {
+	emailService *EmailService
+	clock        utils.Clock
+}
+
+// NewEmailOutboxService creates a new email outbox service
+func NewEmailOutboxService() *EmailOutboxService {
+	return NewEmailOutboxServiceWithClock(utils.RealClock{})
+}
+
+// NewEmailOutboxServiceWithClock creates an email outbox service that reads the time from clock
+func NewEmailOutboxServiceWithClock(clock utils.Clock) *EmailOutboxService {
+	return NewEmailOutboxServiceWithEmailClient(email.NewSMTPClient(), clock)
+}
+
+// NewEmailOutboxServiceWithEmailClient creates an email outbox service that delivers through
+// client and reads the time from clock
+func NewEmailOutboxServiceWithEmailClient(client *email.SMTPClient, clock utils.Clock) *EmailOutboxService {
+	return &EmailOutboxService{
+		emailService: NewEmailServiceWithClient(client),
+		clock:        clock,
+	}
+}
+
+// QueueWelcomeEmail queues the welcome email for a new user unless the user was created with
+// sendWelcome off or onboarding emails are suppressed. It reports whether it was queued.
+func (s *EmailOutboxService) QueueWelcomeEmail(to, name string, sendWelcome bool) (bool, error) {
+	if !sendWelcome || onboardingEmailsSuppressed() {
+		return false, nil
+	}
+	if err := s.Enqueue(models.EmailKindWelcome, to, map[string]string{"name": name}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// queueSignupWelcomeEmail queues the welcome email for a user who just signed up. Signups always
+// get one unless onboarding emails are suppressed; failures are logged, never returned, so they
+// can't fail the signup.
+func queueSignupWelcomeEmail(user *models.User, clock utils.Clock) {
+	if user.Email == nil {
+		return
+	}
+	if _, err := NewEmailOutboxServiceWithClock(clock).QueueWelcomeEmail(*user.Email, user.GetDisplayName(), true); err != nil {
+		utils.Logger().WithFields(map[string]interface{}{
+			"error":   err,
+			"user_id": user.ID.String(),
+			"email":   *user.Email,
+		}).Error("Failed to queue welcome email")
+	}
+}
+
+// QueueAccountDeletedEmail queues the confirmation sent after a user deletes their account
+func (s *EmailOutboxService) QueueAccountDeletedEmail(to, name string) error {
+	return s.Enqueue(models.EmailKindAccountDeleted, to, map[string]string{"name": name})
+}
+
+// Enqueue records an email for the worker to send
+func (s *EmailOutboxService) Enqueue(kind models.EmailKind, recipient string, data map[string]string) error {
+	now := s.clock.Now()
+	entry := &models.EmailOutbox{
+		Kind:          kind,
+		Recipient:     recipient,
+		Data:          data,
+		Status:        models.EmailOutboxPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := database.GetDB().Create(entry).Error; err != nil {
+		return fmt.Errorf("failed to queue email: %w", err)
+	}
+	return nil
+}
+
+// ProcessPendingEmails sends due emails and returns how many were attempted
+func (s *EmailOutboxService) ProcessPendingEmails(batchSize int) (int, error) {
+	var entries []models.EmailOutbox
+	err := database.GetDB().
+		Where("status = ? AND next_attempt_at <= ?", models.EmailOutboxPending, s.clock.Now()).
+		Order("next_attempt_at ASC").
+		Limit(batchSize).
+		Find(&entries).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to get pending emails: %w", err)
+	}
+
+	attempted := 0
+	for _, entry := range entries {
+		sent, err := s.attemptSend(entry)
+		if err != nil {
+			log.Printf("Failed to record email %s: %v", entry.ID, err)
+		}
+		if sent {
+			attempted++
+		}
+	}
+
+	return attempted, nil
+}
+
+// attemptSend sends one queued email, removing the row on success. It returns false when
+// another worker claimed the row first.
+func (s *EmailOutboxService) attemptSend(entry models.EmailOutbox) (bool, error) {
+	// Claim the row so a second worker running the same batch does not send it twice
+	now := s.clock.Now()
+	claim := database.GetDB().Model(&models.EmailOutbox{}).
+		Where("id = ? AND status = ? AND next_attempt_at = ?", entry.ID, models.EmailOutboxPending, entry.NextAttemptAt).
+		Update("next_attempt_at", now.Add(emailOutboxLease))
+	if claim.Error != nil {
+		return false, claim.Error
+	}
+	if claim.RowsAffected == 0 {
+		return false, nil
+	}
+
+	sendErr := s.send(entry)
+	if sendErr == nil {
+		return true, database.GetDB().Delete(&models.EmailOutbox{}, "id = ?", entry.ID).Error
+	}
+
+	attempts := entry.Attempts + 1
+	updates := map[string]interface{}{
+		"attempts":   attempts,
+		"last_error": sendErr.Error(),
+		"updated_at": now,
+	}
+	if attempts >= emailOutboxMaxAttempts {
+		updates["status"] = models.EmailOutboxFailed
+		log.Printf("Giving up on %s email to %s after %d attempts: %v", entry.Kind, entry.Recipient, attempts, sendErr)
+	} else {
+		updates["next_attempt_at"] = now.Add(emailOutboxRetryDelay(attempts))
+	}
+
+	return true, database.GetDB().Model(&models.EmailOutbox{}).Where("id = ?", entry.ID).Updates(updates).Error
+}
+
+// send delivers a queued email according to its kind
+func (s *EmailOutboxService) send(entry models.EmailOutbox) error {
+	switch entry.Kind {
+	case models.EmailKindWelcome:
+		return s.emailService.SendWelcomeEmail(entry.Recipient, entry.Data["name"])
+	case models.EmailKindAccountDeleted:
+		return s.emailService.SendAccountDeletedEmail(entry.Recipient, entry.Data["name"])
+	default:
+		return fmt.Errorf("unknown email kind %q", entry.Kind)
+	}
+}
+
+// emailOutboxRetryDelay returns an exponential backoff delay for the given attempt count
+func emailOutboxRetryDelay(attempts int) time.Duration {
+	delay := emailOutboxBaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= emailOutboxMaxDelay {
+			return emailOutboxMaxDelay
+		}
+	}
+	return delay
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"TinderTrip-Backend/pkg/config"
 	"TinderTrip-Backend/pkg/email"
 )
 
@@ -11,8 +12,13 @@ type EmailService struct {
 
 // NewEmailService creates a new email service
 func NewEmailService() *EmailService {
+	return NewEmailServiceWithClient(email.NewSMTPClient())
+}
+
+// NewEmailServiceWithClient creates an email service that sends through the given client
+func NewEmailServiceWithClient(client *email.SMTPClient) *EmailService {
 	return &EmailService{
-		smtpClient: email.NewSMTPClient(),
+		smtpClient: client,
 	}
 }
 
@@ -21,6 +27,16 @@ func (s *EmailService) SendWelcomeEmail(to, name string) error {
 	return s.smtpClient.SendWelcomeEmail(to, name)
 }
 
+// onboardingEmailsSuppressed reports whether SUPPRESS_ONBOARDING_EMAILS is set
+func onboardingEmailsSuppressed() bool {
+	return config.AppConfig != nil && config.AppConfig.Email.SuppressOnboarding
+}
+
+// SendAccountDeletedEmail confirms an account deletion to its owner
+func (s *EmailService) SendAccountDeletedEmail(to, name string) error {
+	return s.smtpClient.SendAccountDeletedEmail(to, name)
+}
+
 // SendPasswordResetOTP sends a password reset OTP email
 func (s *EmailService) SendPasswordResetOTP(to, otp string) error {
 	return s.smtpClient.SendPasswordResetOTP(to, otp)
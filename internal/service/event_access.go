@@ -0,0 +1,70 @@
+package service
+
+import (
+	"fmt"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EventAccess is an event together with the caller's relationship to it, loaded once per request
+type EventAccess struct {
+	Event  *models.Event
+	Member *models.EventMember // nil when the user has no membership row
+	UserID uuid.UUID
+}
+
+// IsCreator reports whether the user created the event
+func (a *EventAccess) IsCreator() bool {
+	return a.Event.CreatorID == a.UserID
+}
+
+// IsMember reports whether the user has joined the event and is still in it (pending or confirmed)
+func (a *EventAccess) IsMember() bool {
+	if a.IsCreator() {
+		return true
+	}
+	return a.Member != nil &&
+		(a.Member.Status == models.MemberStatusPending || a.Member.Status == models.MemberStatusConfirmed)
+}
+
+// IsConfirmedMember reports whether the user is a confirmed member of the event
+func (a *EventAccess) IsConfirmedMember() bool {
+	return a.Member != nil && a.Member.Status == models.MemberStatusConfirmed
+}
+
+// LoadEventAccess loads a non-deleted event and the user's membership in it
+func LoadEventAccess(eventID, userID string) (*EventAccess, error) {
+	eventUUID, err := uuid.Parse(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event ID")
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID")
+	}
+
+	var event models.Event
+	err = database.GetDB().Where("id = ? AND deleted_at IS NULL", eventUUID).First(&event).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("event not found")
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	access := &EventAccess{Event: &event, UserID: userUUID}
+
+	var member models.EventMember
+	err = database.GetDB().Where("event_id = ? AND user_id = ?", eventUUID, userUUID).First(&member).Error
+	if err == nil {
+		access.Member = &member
+	} else if err != gorm.ErrRecordNotFound {
+		return nil, fmt.Errorf("failed to get member: %w", err)
+	}
+
+	return access, nil
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// minCompatibilityMembers is how many confirmed members, besides the viewer, an event needs before
+// compatibility is reported. With a single member the aggregate would be that member's own tags.
+const minCompatibilityMembers = 2
+
+// GetEventCompatibility scores the user against each confirmed member of the event with the same
+// tag and interest overlap scoring as event matches, and reports only the averages and how many
+// members share each of the user's tags and interests.
+func (s *TagService) GetEventCompatibility(userID, eventID string) (*dto.EventCompatibilityResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID")
+	}
+	eventUUID, err := uuid.Parse(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event ID")
+	}
+
+	var event models.Event
+	err = database.GetDB().Where("id = ? AND deleted_at IS NULL", eventUUID).First(&event).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("event not found")
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	var memberIDs []uuid.UUID
+	err = database.GetDB().Model(&models.EventMember{}).
+		Joins("JOIN users ON users.id = event_members.user_id AND users.deleted_at IS NULL").
+		Where("event_members.event_id = ? AND event_members.status = ? AND event_members.user_id <> ?",
+			eventUUID, models.MemberStatusConfirmed, userUUID).
+		Pluck("event_members.user_id", &memberIDs).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get members: %w", err)
+	}
+
+	response := &dto.EventCompatibilityResponse{
+		EventID:         event.ID.String(),
+		MembersCompared: len(memberIDs),
+		SharedTags:      []dto.SharedTagResponse{},
+		SharedInterests: []dto.SharedInterestResponse{},
+	}
+	if len(memberIDs) < minCompatibilityMembers {
+		return response, nil
+	}
+
+	// The viewer's side of the comparison
+	userInterests, err := s.loadUserInterests(userUUID)
+	if err != nil {
+		return nil, err
+	}
+	var userTags []models.UserTag
+	if err := database.GetDB().Preload("Tag").Where("user_id = ?", userUUID).Find(&userTags).Error; err != nil {
+		return nil, fmt.Errorf("failed to get user tags: %w", err)
+	}
+
+	// Each member is scored as if their tags and interests were an event's, so the match scoring
+	// is reused as it is
+	var memberTags []models.UserTag
+	if err := database.GetDB().Preload("Tag").Where("user_id IN ?", memberIDs).Find(&memberTags).Error; err != nil {
+		return nil, fmt.Errorf("failed to get member tags: %w", err)
+	}
+	var memberInterests []models.UserInterest
+	if err := database.GetDB().Preload("Interest").Where("user_id IN ?", memberIDs).Find(&memberInterests).Error; err != nil {
+		return nil, fmt.Errorf("failed to get member interests: %w", err)
+	}
+	profiles := make(map[uuid.UUID]*models.Event, len(memberIDs))
+	for _, memberID := range memberIDs {
+		profiles[memberID] = &models.Event{}
+	}
+	for _, tag := range memberTags {
+		profiles[tag.UserID].Tags = append(profiles[tag.UserID].Tags, models.EventTag{TagID: tag.TagID, Tag: tag.Tag})
+	}
+	for _, interest := range memberInterests {
+		profiles[interest.UserID].Interests = append(profiles[interest.UserID].Interests,
+			models.EventInterest{InterestID: interest.InterestID, Interest: interest.Interest})
+	}
+
+	var tagsTotal, interestsTotal float64
+	sharedTags := make(map[string]*dto.SharedTagResponse)
+	sharedInterests := make(map[string]*dto.SharedInterestResponse)
+	for _, memberID := range memberIDs {
+		profile := profiles[memberID]
+		tagsScore, matchedTags := s.calculateTagMatchScore(userTags, profile.Tags)
+		interestsScore, matchedInterests := s.calculateInterestsMatchScore(userInterests, *profile)
+		tagsTotal += tagsScore
+		interestsTotal += interestsScore
+
+		for _, tag := range matchedTags {
+			if shared, ok := sharedTags[tag.ID]; ok {
+				shared.MemberCount++
+				continue
+			}
+			sharedTags[tag.ID] = &dto.SharedTagResponse{TagResponse: tag, MemberCount: 1}
+		}
+		for _, interest := range matchedInterests {
+			if shared, ok := sharedInterests[interest.ID]; ok {
+				shared.MemberCount++
+				continue
+			}
+			sharedInterests[interest.ID] = &dto.SharedInterestResponse{InterestResponse: interest, MemberCount: 1}
+		}
+	}
+
+	members := float64(len(memberIDs))
+	response.Components = map[string]float64{
+		MatchComponentTags:      math.Round(tagsTotal/members*100) / 100,
+		MatchComponentInterests: math.Round(interestsTotal/members*100) / 100,
+	}
+	score := math.Round((tagsTotal+interestsTotal)/(2*members)*100) / 100
+	response.CompatibilityScore = &score
+
+	// Most widely shared first
+	for _, shared := range sharedTags {
+		response.SharedTags = append(response.SharedTags, *shared)
+	}
+	sort.Slice(response.SharedTags, func(i, j int) bool {
+		a, b := response.SharedTags[i], response.SharedTags[j]
+		if a.MemberCount != b.MemberCount {
+			return a.MemberCount > b.MemberCount
+		}
+		return a.Name < b.Name
+	})
+	for _, shared := range sharedInterests {
+		response.SharedInterests = append(response.SharedInterests, *shared)
+	}
+	sort.Slice(response.SharedInterests, func(i, j int) bool {
+		a, b := response.SharedInterests[i], response.SharedInterests[j]
+		if a.MemberCount != b.MemberCount {
+			return a.MemberCount > b.MemberCount
+		}
+		return a.DisplayName < b.DisplayName
+	})
+
+	return response, nil
+}
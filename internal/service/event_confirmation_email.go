@@ -0,0 +1,90 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/database"
+)
+
+// confirmationResendCooldown is how long a member waits between confirmation emails
+const confirmationResendCooldown = 10 * time.Minute
+
+// emailDateLayout shows the zone abbreviation so readers know which local time is meant
+const emailDateLayout = "Monday, 2 January 2006 at 15:04 MST"
+
+// emailLocation returns the configured time zone for dates in emails, UTC when unset or unknown
+func emailLocation() *time.Location {
+	if config.AppConfig == nil || config.AppConfig.Email.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(config.AppConfig.Email.Timezone)
+	if err != nil {
+		log.Printf("Unknown EMAIL_TIMEZONE %q, using UTC: %v", config.AppConfig.Email.Timezone, err)
+		return time.UTC
+	}
+	return loc
+}
+
+// formatEmailEventDate formats when the event starts for an email
+func formatEmailEventDate(event models.Event, loc *time.Location) string {
+	if event.StartAt == nil {
+		return "Date to be announced"
+	}
+	return event.StartAt.In(loc).Format(emailDateLayout)
+}
+
+// ResendConfirmationEmail emails the confirmed member their event confirmation again.
+// Resends are limited to one per confirmationResendCooldown.
+func (s *EventService) ResendConfirmationEmail(eventID, userID string) error {
+	access, err := LoadEventAccess(eventID, userID)
+	if err != nil {
+		return err
+	}
+	if access.Member == nil {
+		return fmt.Errorf("not a member")
+	}
+	if !access.IsConfirmedMember() {
+		return fmt.Errorf("not a confirmed member")
+	}
+	if !access.Event.IsPublished() {
+		return fmt.Errorf("event is not active")
+	}
+
+	var user models.User
+	if err := database.GetDB().Where("id = ?", access.UserID).First(&user).Error; err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.Email == nil || *user.Email == "" {
+		return fmt.Errorf("no email address")
+	}
+
+	// Claim the send with a conditional update, so concurrent requests cannot both send
+	now := s.clock.Now()
+	previous := access.Member.ConfirmationEmailAt
+	result := database.GetDB().Model(&models.EventMember{}).
+		Where("event_id = ? AND user_id = ?", access.Event.ID, access.UserID).
+		Where("confirmation_email_at IS NULL OR confirmation_email_at <= ?", now.Add(-confirmationResendCooldown)).
+		UpdateColumn("confirmation_email_at", now)
+	if result.Error != nil {
+		return fmt.Errorf("failed to record confirmation email: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("confirmation email sent recently")
+	}
+
+	eventDate := formatEmailEventDate(*access.Event, emailLocation())
+	err = s.emailService.SendEventConfirmationEmail(*user.Email, user.GetDisplayName(), access.Event.Title, eventDate)
+	if err != nil {
+		// Give the slot back so the member can retry straight away
+		database.GetDB().Model(&models.EventMember{}).
+			Where("event_id = ? AND user_id = ?", access.Event.ID, access.UserID).
+			UpdateColumn("confirmation_email_at", previous)
+		return fmt.Errorf("failed to send confirmation email: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,369 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EventExpenseService records what members paid for an event and works out who owes whom
+type EventExpenseService struct{}
+
+// NewEventExpenseService creates a new event expense service
+func NewEventExpenseService() *EventExpenseService {
+	return &EventExpenseService{}
+}
+
+// ListExpenses returns the event's expenses in the order they were recorded
+func (s *EventExpenseService) ListExpenses(eventID, userID string) ([]dto.EventExpenseResponse, error) {
+	event, _, err := loadEventForConfirmedMember(eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses, err := s.loadExpenses(event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.EventExpenseResponse, len(expenses))
+	for i, expense := range expenses {
+		responses[i] = convertEventExpenseToResponse(expense, event.Currency)
+	}
+	return responses, nil
+}
+
+// AddExpense records an expense and splits it evenly among the chosen members.
+// Any remainder from the split goes one minor unit at a time to the first members
+// by ID, so shares always add up to the amount.
+func (s *EventExpenseService) AddExpense(eventID, userID string, req dto.CreateEventExpenseRequest) (*dto.EventExpenseResponse, error) {
+	event, userUUID, err := loadEventForConfirmedMember(eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	description := strings.TrimSpace(req.Description)
+	if description == "" {
+		return nil, fmt.Errorf("description is required")
+	}
+	if req.Amount <= 0 {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+
+	confirmed, err := confirmedMemberIDs(event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	payerID := userUUID
+	if req.PayerID != nil {
+		payerID, err = uuid.Parse(*req.PayerID)
+		if err != nil || !confirmed[payerID] {
+			return nil, fmt.Errorf("payer must be a confirmed member")
+		}
+	}
+
+	var splitAmong []uuid.UUID
+	if len(req.SplitAmong) == 0 {
+		for id := range confirmed {
+			splitAmong = append(splitAmong, id)
+		}
+	} else {
+		seen := make(map[uuid.UUID]bool, len(req.SplitAmong))
+		for _, raw := range req.SplitAmong {
+			id, err := uuid.Parse(raw)
+			if err != nil || !confirmed[id] {
+				return nil, fmt.Errorf("split members must be confirmed members")
+			}
+			if !seen[id] {
+				seen[id] = true
+				splitAmong = append(splitAmong, id)
+			}
+		}
+	}
+
+	expense := models.EventExpense{
+		EventID:     event.ID,
+		PayerID:     payerID,
+		Amount:      req.Amount,
+		Description: description,
+		CreatedBy:   userUUID,
+		Shares:      splitExpense(req.Amount, splitAmong),
+	}
+	if err := database.GetDB().Create(&expense).Error; err != nil {
+		return nil, fmt.Errorf("failed to add expense: %w", err)
+	}
+
+	if err := database.GetDB().Preload("Payer").First(&expense, "id = ?", expense.ID).Error; err != nil {
+		return nil, fmt.Errorf("failed to get expense: %w", err)
+	}
+
+	response := convertEventExpenseToResponse(expense, event.Currency)
+	return &response, nil
+}
+
+// DeleteExpense removes an expense (its payer, the member who recorded it, or the event creator)
+func (s *EventExpenseService) DeleteExpense(eventID, expenseID, userID string) error {
+	event, userUUID, err := loadEventForConfirmedMember(eventID, userID)
+	if err != nil {
+		return err
+	}
+	expenseUUID, err := uuid.Parse(expenseID)
+	if err != nil {
+		return fmt.Errorf("invalid expense ID")
+	}
+
+	var expense models.EventExpense
+	err = database.GetDB().Where("id = ? AND event_id = ?", expenseUUID, event.ID).First(&expense).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return fmt.Errorf("expense not found")
+		}
+		return fmt.Errorf("failed to get expense: %w", err)
+	}
+	if expense.PayerID != userUUID && expense.CreatedBy != userUUID && event.CreatorID != userUUID {
+		return fmt.Errorf("not authorized")
+	}
+
+	return database.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("expense_id = ?", expense.ID).Delete(&models.EventExpenseShare{}).Error; err != nil {
+			return fmt.Errorf("failed to delete expense shares: %w", err)
+		}
+		if err := tx.Delete(&models.EventExpense{}, "id = ?", expense.ID).Error; err != nil {
+			return fmt.Errorf("failed to delete expense: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetSummary returns each member's balance and the fewest payments that settle them
+func (s *EventExpenseService) GetSummary(eventID, userID string) (*dto.ExpenseSummaryResponse, error) {
+	event, _, err := loadEventForConfirmedMember(eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses, err := s.loadExpenses(event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Everyone currently confirmed appears, plus anyone who paid or owes but has since left
+	confirmed, err := confirmedMemberIDs(event.ID)
+	if err != nil {
+		return nil, err
+	}
+	participants := make(map[uuid.UUID]bool, len(confirmed))
+	for id := range confirmed {
+		participants[id] = true
+	}
+	paid := make(map[uuid.UUID]int64)
+	owed := make(map[uuid.UUID]int64)
+	var total int64
+	for _, expense := range expenses {
+		total += expense.Amount
+		paid[expense.PayerID] += expense.Amount
+		participants[expense.PayerID] = true
+		for _, share := range expense.Shares {
+			owed[share.UserID] += share.Amount
+			participants[share.UserID] = true
+		}
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(participants))
+	for id := range participants {
+		userIDs = append(userIDs, id)
+	}
+	var users []models.User
+	if len(userIDs) > 0 {
+		if err := database.GetDB().Where("id IN ?", userIDs).Find(&users).Error; err != nil {
+			return nil, fmt.Errorf("failed to get members: %w", err)
+		}
+	}
+	names := make(map[uuid.UUID]string, len(users))
+	for i := range users {
+		names[users[i].ID] = users[i].GetDisplayName()
+	}
+
+	balances := make([]dto.ExpenseBalanceResponse, 0, len(userIDs))
+	net := make(map[string]int64, len(userIDs))
+	for _, id := range userIDs {
+		balance := paid[id] - owed[id]
+		balances = append(balances, dto.ExpenseBalanceResponse{
+			UserID:      id.String(),
+			DisplayName: names[id],
+			Paid:        paid[id],
+			Owed:        owed[id],
+			Balance:     balance,
+		})
+		net[id.String()] = balance
+	}
+	sort.Slice(balances, func(i, j int) bool {
+		if balances[i].Balance != balances[j].Balance {
+			return balances[i].Balance > balances[j].Balance
+		}
+		return balances[i].UserID < balances[j].UserID
+	})
+
+	return &dto.ExpenseSummaryResponse{
+		EventID:     event.ID.String(),
+		Currency:    event.Currency,
+		Total:       total,
+		Balances:    balances,
+		Settlements: ComputeSettlements(net),
+	}, nil
+}
+
+// ComputeSettlements turns net balances (positive: owed money, negative: owes money)
+// into payments that settle everyone. Debts that exactly cancel are paired first,
+// then the largest debtor repeatedly pays the largest creditor, so n members with a
+// non-zero balance settle in at most n-1 payments.
+func ComputeSettlements(balances map[string]int64) []dto.ExpenseSettlementResponse {
+	type party struct {
+		userID string
+		amount int64
+	}
+	var creditors, debtors []*party
+	for userID, balance := range balances {
+		switch {
+		case balance > 0:
+			creditors = append(creditors, &party{userID, balance})
+		case balance < 0:
+			debtors = append(debtors, &party{userID, -balance})
+		}
+	}
+	byAmount := func(parties []*party) {
+		sort.Slice(parties, func(i, j int) bool {
+			if parties[i].amount != parties[j].amount {
+				return parties[i].amount > parties[j].amount
+			}
+			return parties[i].userID < parties[j].userID
+		})
+	}
+	byAmount(creditors)
+	byAmount(debtors)
+
+	settlements := []dto.ExpenseSettlementResponse{}
+	pay := func(debtor, creditor *party, amount int64) {
+		settlements = append(settlements, dto.ExpenseSettlementResponse{
+			FromUserID: debtor.userID,
+			ToUserID:   creditor.userID,
+			Amount:     amount,
+		})
+		debtor.amount -= amount
+		creditor.amount -= amount
+	}
+
+	for _, debtor := range debtors {
+		for _, creditor := range creditors {
+			if creditor.amount > 0 && creditor.amount == debtor.amount {
+				pay(debtor, creditor, debtor.amount)
+				break
+			}
+		}
+	}
+
+	for {
+		byAmount(creditors)
+		byAmount(debtors)
+		if len(creditors) == 0 || len(debtors) == 0 || creditors[0].amount == 0 || debtors[0].amount == 0 {
+			break
+		}
+		debtor, creditor := debtors[0], creditors[0]
+		amount := debtor.amount
+		if creditor.amount < amount {
+			amount = creditor.amount
+		}
+		pay(debtor, creditor, amount)
+	}
+
+	return settlements
+}
+
+// splitExpense divides amount evenly among users, giving the remainder to the first users by ID
+func splitExpense(amount int64, userIDs []uuid.UUID) []models.EventExpenseShare {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	sorted := append([]uuid.UUID(nil), userIDs...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].String() < sorted[j].String()
+	})
+
+	base := amount / int64(len(sorted))
+	remainder := amount % int64(len(sorted))
+	shares := make([]models.EventExpenseShare, len(sorted))
+	for i, id := range sorted {
+		share := base
+		if int64(i) < remainder {
+			share++
+		}
+		shares[i] = models.EventExpenseShare{UserID: id, Amount: share}
+	}
+	return shares
+}
+
+// confirmedMemberIDs returns the set of confirmed members of an event
+func confirmedMemberIDs(eventID uuid.UUID) (map[uuid.UUID]bool, error) {
+	var ids []uuid.UUID
+	err := database.GetDB().Model(&models.EventMember{}).
+		Where("event_id = ? AND status = ?", eventID, models.MemberStatusConfirmed).
+		Pluck("user_id", &ids).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get members: %w", err)
+	}
+
+	confirmed := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		confirmed[id] = true
+	}
+	return confirmed, nil
+}
+
+// loadExpenses returns an event's expenses with payers and shares, oldest first
+func (s *EventExpenseService) loadExpenses(eventID uuid.UUID) ([]models.EventExpense, error) {
+	var expenses []models.EventExpense
+	err := database.GetDB().Preload("Payer").
+		Preload("Shares", func(db *gorm.DB) *gorm.DB {
+			return db.Order("user_id ASC")
+		}).
+		Where("event_id = ?", eventID).
+		Order("created_at ASC, id ASC").
+		Find(&expenses).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expenses: %w", err)
+	}
+	return expenses, nil
+}
+
+// convertEventExpenseToResponse converts an expense model to its response
+func convertEventExpenseToResponse(expense models.EventExpense, currency *string) dto.EventExpenseResponse {
+	response := dto.EventExpenseResponse{
+		ID:          expense.ID.String(),
+		EventID:     expense.EventID.String(),
+		PayerID:     expense.PayerID.String(),
+		Amount:      expense.Amount,
+		Currency:    currency,
+		Description: expense.Description,
+		Shares:      make([]dto.EventExpenseShareResponse, len(expense.Shares)),
+		CreatedBy:   expense.CreatedBy.String(),
+		CreatedAt:   expense.CreatedAt,
+	}
+	if expense.Payer != nil {
+		response.PayerName = expense.Payer.GetDisplayName()
+	}
+	for i, share := range expense.Shares {
+		response.Shares[i] = dto.EventExpenseShareResponse{
+			UserID: share.UserID.String(),
+			Amount: share.Amount,
+		}
+	}
+	return response
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"fmt"
+	"math"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/pkg/database"
+
+	"gorm.io/gorm"
+)
+
+// Event funnel stages, in order
+const (
+	FunnelStageImpressions   = "impressions"
+	FunnelStageLikes         = "likes"
+	FunnelStageJoins         = "joins"
+	FunnelStageConfirmations = "confirmations"
+	FunnelStageAttended      = "attended"
+)
+
+// GetEventFunnel counts the users at each stage from seeing the event to attending it (creator only):
+// served in their suggestion feed, liked it, joined, were confirmed and completed it. The creator
+// is not counted. Each stage is counted on its own, so a later stage can exceed an earlier one,
+// e.g. when users join from a shared link without the event being suggested to them.
+func (s *EventService) GetEventFunnel(eventID, userID string) (*dto.EventFunnelResponse, error) {
+	event, err := s.getCreatorEvent(userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	db := database.GetDB()
+	counts := []struct {
+		stage string
+		query *gorm.DB
+	}{
+		{FunnelStageImpressions, db.Model(&models.EventImpression{}).
+			Where("event_id = ? AND user_id != ?", event.ID, event.CreatorID)},
+		{FunnelStageLikes, db.Model(&models.UserEventInterest{}).
+			Where("event_id = ? AND user_id != ?", event.ID, event.CreatorID)},
+		{FunnelStageJoins, db.Model(&models.EventMember{}).
+			Where("event_id = ? AND user_id != ?", event.ID, event.CreatorID)},
+		{FunnelStageConfirmations, db.Model(&models.EventMember{}).
+			Where("event_id = ? AND user_id != ? AND status = ?", event.ID, event.CreatorID, models.MemberStatusConfirmed)},
+		{FunnelStageAttended, db.Model(&models.UserEventHistory{}).
+			Where("event_id = ? AND user_id != ? AND attended = ?", event.ID, event.CreatorID, true)},
+	}
+
+	response := &dto.EventFunnelResponse{
+		EventID: event.ID.String(),
+		Stages:  make([]dto.EventFunnelStage, len(counts)),
+	}
+	for i, count := range counts {
+		stage := dto.EventFunnelStage{Stage: count.stage}
+		if err := count.query.Distinct("user_id").Count(&stage.Count).Error; err != nil {
+			return nil, fmt.Errorf("failed to count %s: %w", count.stage, err)
+		}
+		if i > 0 {
+			previous := response.Stages[i-1].Count
+			dropOff := previous - stage.Count
+			stage.DropOff = &dropOff
+			if previous > 0 {
+				rate := math.Round(float64(stage.Count)/float64(previous)*1000) / 1000
+				stage.ConversionRate = &rate
+			}
+		}
+		response.Stages[i] = stage
+	}
+
+	return response, nil
+}
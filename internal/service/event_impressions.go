@@ -0,0 +1,57 @@
+package service
+
+import (
+	"time"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// servedEventIDs returns the IDs of the suggested events
+func servedEventIDs(suggestions []dto.EventSuggestionItem) []uuid.UUID {
+	eventIDs := make([]uuid.UUID, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		eventUUID, err := uuid.Parse(suggestion.Event.ID)
+		if err != nil {
+			continue
+		}
+		eventIDs = append(eventIDs, eventUUID)
+	}
+	return eventIDs
+}
+
+// recordEventImpressions notes that the events were served to the user, for the creator
+// funnel. Failures are only logged so they never break the feed.
+func recordEventImpressions(userUUID uuid.UUID, eventIDs []uuid.UUID, now time.Time) {
+	if len(eventIDs) == 0 {
+		return
+	}
+
+	impressions := make([]models.EventImpression, 0, len(eventIDs))
+	for _, eventUUID := range eventIDs {
+		impressions = append(impressions, models.EventImpression{
+			EventID:       eventUUID,
+			UserID:        userUUID,
+			FirstServedAt: now,
+			LastServedAt:  now,
+			ServedCount:   1,
+		})
+	}
+
+	err := database.GetDB().Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "event_id"}, {Name: "user_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"last_served_at": gorm.Expr("excluded.last_served_at"),
+			"served_count":   gorm.Expr("event_impressions.served_count + 1"),
+		}),
+	}).Create(&impressions).Error
+	if err != nil {
+		utils.Logger().WithField("error", err).Warn("Failed to record event impressions")
+	}
+}
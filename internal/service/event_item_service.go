@@ -0,0 +1,252 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// maxEventItems caps how many items an event's bring list can hold
+const maxEventItems = 100
+
+// EventItemService handles an event's bring list: items members add and claim
+type EventItemService struct {
+	clock utils.Clock
+}
+
+// NewEventItemService creates a new event item service
+func NewEventItemService() *EventItemService {
+	return NewEventItemServiceWithClock(utils.RealClock{})
+}
+
+// NewEventItemServiceWithClock creates an event item service that reads the time from clock
+func NewEventItemServiceWithClock(clock utils.Clock) *EventItemService {
+	return &EventItemService{clock: clock}
+}
+
+// ListItems returns the event's bring list for a confirmed member
+func (s *EventItemService) ListItems(eventID, userID string) ([]dto.EventItemResponse, error) {
+	event, _, err := loadEventForConfirmedMember(eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadEventItems(event.ID)
+}
+
+// AddItem adds an item to the event's bring list
+func (s *EventItemService) AddItem(eventID, userID string, req dto.AddEventItemRequest) (*dto.EventItemResponse, error) {
+	event, userUUID, err := loadEventForConfirmedMember(eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !event.IsPublished() {
+		return nil, fmt.Errorf("event is not active")
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, fmt.Errorf("item name is required")
+	}
+
+	var count int64
+	if err := database.GetDB().Model(&models.EventItem{}).Where("event_id = ?", event.ID).Count(&count).Error; err != nil {
+		return nil, fmt.Errorf("failed to count items: %w", err)
+	}
+	if count >= maxEventItems {
+		return nil, fmt.Errorf("item limit reached")
+	}
+
+	item := models.EventItem{
+		EventID: event.ID,
+		Name:    name,
+		AddedBy: userUUID,
+	}
+	if err := database.GetDB().Create(&item).Error; err != nil {
+		return nil, fmt.Errorf("failed to add item: %w", err)
+	}
+
+	response := convertEventItemToResponse(item)
+	return &response, nil
+}
+
+// ClaimItem marks the current member as bringing the item.
+// The claim is a single conditional update, so when several members race for
+// the same item exactly one of them wins and the rest see "already claimed".
+func (s *EventItemService) ClaimItem(eventID, itemID, userID string) (*dto.EventItemResponse, error) {
+	event, userUUID, err := loadEventForConfirmedMember(eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	itemUUID, err := uuid.Parse(itemID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID")
+	}
+	if !event.IsPublished() {
+		return nil, fmt.Errorf("event is not active")
+	}
+
+	result := database.GetDB().Model(&models.EventItem{}).
+		Where("id = ? AND event_id = ? AND claimed_by IS NULL", itemUUID, event.ID).
+		Updates(map[string]interface{}{
+			"claimed_by": userUUID,
+			"claimed_at": s.clock.Now(),
+		})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to claim item: %w", result.Error)
+	}
+
+	item, err := s.getItem(event.ID, itemUUID)
+	if err != nil {
+		return nil, err
+	}
+	// Claiming an item you already hold is a no-op
+	if result.RowsAffected == 0 && (item.ClaimedBy == nil || *item.ClaimedBy != userUUID) {
+		return nil, fmt.Errorf("already claimed")
+	}
+
+	response := convertEventItemToResponse(*item)
+	return &response, nil
+}
+
+// UnclaimItem releases a claim. The claimer can release their own claim and
+// the event creator can release anyone's.
+func (s *EventItemService) UnclaimItem(eventID, itemID, userID string) (*dto.EventItemResponse, error) {
+	event, userUUID, err := loadEventForConfirmedMember(eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	itemUUID, err := uuid.Parse(itemID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID")
+	}
+	if !event.IsPublished() {
+		return nil, fmt.Errorf("event is not active")
+	}
+
+	query := database.GetDB().Model(&models.EventItem{}).
+		Where("id = ? AND event_id = ? AND claimed_by IS NOT NULL", itemUUID, event.ID)
+	if event.CreatorID != userUUID {
+		query = query.Where("claimed_by = ?", userUUID)
+	}
+	result := query.Updates(map[string]interface{}{
+		"claimed_by": nil,
+		"claimed_at": nil,
+	})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to unclaim item: %w", result.Error)
+	}
+
+	item, err := s.getItem(event.ID, itemUUID)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected == 0 && item.IsClaimed() {
+		return nil, fmt.Errorf("item claimed by another member")
+	}
+
+	response := convertEventItemToResponse(*item)
+	return &response, nil
+}
+
+// DeleteItem removes an item from the bring list (the member who added it or the event creator)
+func (s *EventItemService) DeleteItem(eventID, itemID, userID string) error {
+	event, userUUID, err := loadEventForConfirmedMember(eventID, userID)
+	if err != nil {
+		return err
+	}
+	itemUUID, err := uuid.Parse(itemID)
+	if err != nil {
+		return fmt.Errorf("invalid item ID")
+	}
+
+	item, err := s.getItem(event.ID, itemUUID)
+	if err != nil {
+		return err
+	}
+	if item.AddedBy != userUUID && event.CreatorID != userUUID {
+		return fmt.Errorf("not authorized")
+	}
+
+	if err := database.GetDB().Delete(&models.EventItem{}, "id = ?", item.ID).Error; err != nil {
+		return fmt.Errorf("failed to delete item: %w", err)
+	}
+	return nil
+}
+
+// loadEventForConfirmedMember loads the event and checks the user is a confirmed member.
+// Membership is checked before anything else so outsiders learn nothing about the event.
+func loadEventForConfirmedMember(eventID, userID string) (*models.Event, uuid.UUID, error) {
+	access, err := LoadEventAccess(eventID, userID)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+	if access.Member == nil {
+		return nil, uuid.Nil, fmt.Errorf("not a member")
+	}
+	if !access.IsConfirmedMember() {
+		return nil, uuid.Nil, fmt.Errorf("not a confirmed member")
+	}
+
+	return access.Event, access.UserID, nil
+}
+
+// getItem loads an item of the event along with its claimer
+func (s *EventItemService) getItem(eventID, itemID uuid.UUID) (*models.EventItem, error) {
+	var item models.EventItem
+	err := database.GetDB().Preload("ClaimedByUser").
+		Where("id = ? AND event_id = ?", itemID, eventID).First(&item).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("item not found")
+		}
+		return nil, fmt.Errorf("failed to get item: %w", err)
+	}
+	return &item, nil
+}
+
+// loadEventItems returns an event's bring list in the order items were added
+func loadEventItems(eventID uuid.UUID) ([]dto.EventItemResponse, error) {
+	var items []models.EventItem
+	err := database.GetDB().Preload("ClaimedByUser").
+		Where("event_id = ?", eventID).
+		Order("created_at ASC, id ASC").
+		Find(&items).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get items: %w", err)
+	}
+
+	responses := make([]dto.EventItemResponse, len(items))
+	for i, item := range items {
+		responses[i] = convertEventItemToResponse(item)
+	}
+	return responses, nil
+}
+
+// convertEventItemToResponse converts an event item model to its response
+func convertEventItemToResponse(item models.EventItem) dto.EventItemResponse {
+	response := dto.EventItemResponse{
+		ID:        item.ID.String(),
+		EventID:   item.EventID.String(),
+		Name:      item.Name,
+		AddedBy:   item.AddedBy.String(),
+		ClaimedAt: item.ClaimedAt,
+		CreatedAt: item.CreatedAt,
+	}
+	if item.ClaimedBy != nil {
+		claimedBy := item.ClaimedBy.String()
+		response.ClaimedBy = &claimedBy
+		if item.ClaimedByUser != nil {
+			name := item.ClaimedByUser.GetDisplayName()
+			response.ClaimedByName = &name
+		}
+	}
+	return response
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"fmt"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// confirmedMemberCountQuery counts an event's confirmed members, correlated on events.id
+const confirmedMemberCountQuery = "(SELECT COUNT(*) FROM event_members WHERE event_members.event_id = events.id AND event_members.status = ?)"
+
+// reserveConfirmedSeat counts a newly confirmed member against the event. The capacity check and
+// the increment are one statement, so concurrent confirmations cannot push the event over capacity.
+// UpdateColumn leaves updated_at alone; the counter is bookkeeping, not an edit of the event.
+func reserveConfirmedSeat(tx *gorm.DB, eventID uuid.UUID) error {
+	result := tx.Model(&models.Event{}).
+		Where("id = ? AND (capacity IS NULL OR confirmed_member_count < capacity)", eventID).
+		UpdateColumn("confirmed_member_count", gorm.Expr("confirmed_member_count + 1"))
+	if result.Error != nil {
+		return fmt.Errorf("failed to update confirmed member count: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("event is full")
+	}
+	return nil
+}
+
+// releaseConfirmedSeat stops counting a confirmed member who left or cancelled
+func releaseConfirmedSeat(tx *gorm.DB, eventID uuid.UUID) error {
+	return releaseConfirmedSeats(tx, eventID, 1)
+}
+
+// releaseConfirmedSeats stops counting n confirmed members at once
+func releaseConfirmedSeats(tx *gorm.DB, eventID uuid.UUID, n int64) error {
+	err := tx.Model(&models.Event{}).
+		Where("id = ?", eventID).
+		UpdateColumn("confirmed_member_count",
+			gorm.Expr("CASE WHEN confirmed_member_count > ? THEN confirmed_member_count - ? ELSE 0 END", n, n)).Error
+	if err != nil {
+		return fmt.Errorf("failed to update confirmed member count: %w", err)
+	}
+	return nil
+}
+
+// isEventFull reports whether every seat of the event is taken by a confirmed member
+func isEventFull(event models.Event) bool {
+	return event.Capacity != nil && event.ConfirmedMemberCount >= *event.Capacity
+}
+
+// spotsLeft returns how many more members the event can confirm, or nil when it has no capacity
+func spotsLeft(event models.Event) *int {
+	if event.Capacity == nil {
+		return nil
+	}
+	left := *event.Capacity - event.ConfirmedMemberCount
+	if left < 0 {
+		left = 0
+	}
+	return &left
+}
+
+// ReconcileConfirmedMemberCounts recounts confirmed members for every event whose counter has
+// drifted, e.g. after members were removed by a cascading user delete. Returns how many events
+// were corrected.
+func ReconcileConfirmedMemberCounts() (int64, error) {
+	result := database.GetDB().Model(&models.Event{}).
+		Where("confirmed_member_count <> "+confirmedMemberCountQuery, models.MemberStatusConfirmed).
+		UpdateColumn("confirmed_member_count", gorm.Expr(confirmedMemberCountQuery, models.MemberStatusConfirmed))
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to reconcile confirmed member counts: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
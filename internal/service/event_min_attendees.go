@@ -0,0 +1,69 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/database"
+)
+
+// defaultMinAttendeesDeadlineHours is used when EVENTS_MIN_ATTENDEES_DEADLINE_HOURS is not configured
+const defaultMinAttendeesDeadlineHours = 24
+
+// minAttendeesDeadline returns how long before the start an event must have reached its min_attendees
+func minAttendeesDeadline() time.Duration {
+	if config.AppConfig != nil && config.AppConfig.Event.MinAttendeesDeadlineHours > 0 {
+		return time.Duration(config.AppConfig.Event.MinAttendeesDeadlineHours) * time.Hour
+	}
+	return defaultMinAttendeesDeadlineHours * time.Hour
+}
+
+// validateMinAttendees checks a min_attendees threshold against the event capacity, if any
+func validateMinAttendees(minAttendees, capacity *int) error {
+	if minAttendees == nil {
+		return nil
+	}
+	if *minAttendees < 1 {
+		return fmt.Errorf("min_attendees must be at least 1")
+	}
+	if capacity != nil && *minAttendees > *capacity {
+		return fmt.Errorf("min_attendees cannot exceed capacity")
+	}
+	return nil
+}
+
+// CancelUnderfilledEvents cancels published events that are inside the min attendees deadline
+// and still have fewer confirmed members than their min_attendees. Cancelling releases pending
+// and confirmed participants and notifies everyone who was confirmed, creator included.
+// Returns how many were cancelled.
+func (s *EventService) CancelUnderfilledEvents() (int, error) {
+	now := s.clock.Now()
+	deadline := now.Add(minAttendeesDeadline())
+
+	var underfilled []models.Event
+	err := database.GetDB().
+		Where("status = ? AND deleted_at IS NULL", models.EventStatusPublished).
+		Where("min_attendees IS NOT NULL AND confirmed_member_count < min_attendees").
+		Where("start_at IS NOT NULL AND start_at > ? AND start_at <= ?", now, deadline).
+		Find(&underfilled).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to get underfilled events: %w", err)
+	}
+
+	cancelled := 0
+	for i := range underfilled {
+		event := &underfilled[i]
+		reason := fmt.Sprintf("Only %d of the minimum %d attendees confirmed before the deadline",
+			event.ConfirmedMemberCount, *event.MinAttendees)
+		if err := s.cancelEvent(event, "system", &reason, "AUTO_CANCEL", true); err != nil {
+			log.Printf("Error auto-cancelling underfilled event %s: %v", event.ID, err)
+			continue
+		}
+		cancelled++
+	}
+
+	return cancelled, nil
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// DefaultNearbyRadiusKm is used when no radius is given
+	DefaultNearbyRadiusKm = 10.0
+	// maxNearbyRadiusKm is the largest radius that can be searched
+	maxNearbyRadiusKm = 200.0
+)
+
+// GetNearbyEvents gets published events within radiusKm of the location, closest first.
+// Candidates are narrowed with a bounding box in the database and the exact haversine
+// distance is computed here. Events without coordinates are never returned.
+func (s *EventService) GetNearbyEvents(userID string, lat, lng, radiusKm float64, page, limit int) ([]dto.NearbyEventItem, int64, error) {
+	if lat < -90 || lat > 90 || lng < -180 || lng > 180 || math.IsNaN(lat) || math.IsNaN(lng) {
+		return nil, 0, fmt.Errorf("invalid coordinates")
+	}
+	if radiusKm <= 0 || radiusKm > maxNearbyRadiusKm || math.IsNaN(radiusKm) {
+		return nil, 0, fmt.Errorf("invalid radius")
+	}
+
+	radiusMeters := radiusKm * 1000
+	box := utils.BoundingBoxAround(lat, lng, radiusMeters)
+
+	db := database.GetDB()
+	query := db.Model(&models.Event{}).
+		Select("id", "lat", "lng").
+		Where("deleted_at IS NULL AND status = ?", models.EventStatusPublished).
+		Where("lat IS NOT NULL AND lng IS NOT NULL").
+		Where("lat BETWEEN ? AND ?", box.MinLat, box.MaxLat)
+	if box.CrossesAntimeridian() {
+		query = query.Where("(lng >= ? OR lng <= ?)", box.MinLng, box.MaxLng)
+	} else {
+		query = query.Where("lng BETWEEN ? AND ?", box.MinLng, box.MaxLng)
+	}
+
+	var candidates []models.Event
+	if err := query.Find(&candidates).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to get candidate events: %w", err)
+	}
+
+	type nearbyEvent struct {
+		id       uuid.UUID
+		distance float64
+	}
+
+	// The box corners are further away than the radius
+	nearby := make([]nearbyEvent, 0, len(candidates))
+	for _, candidate := range candidates {
+		if !candidate.HasLocation() {
+			continue
+		}
+		distance := utils.DistanceMeters(lat, lng, *candidate.Lat, *candidate.Lng)
+		if distance > radiusMeters {
+			continue
+		}
+		nearby = append(nearby, nearbyEvent{id: candidate.ID, distance: distance})
+	}
+
+	sort.SliceStable(nearby, func(i, j int) bool {
+		return nearby[i].distance < nearby[j].distance
+	})
+
+	// Apply pagination
+	total := int64(len(nearby))
+	offset := (page - 1) * limit
+	if offset >= len(nearby) {
+		return []dto.NearbyEventItem{}, total, nil
+	}
+	end := offset + limit
+	if end > len(nearby) {
+		end = len(nearby)
+	}
+	nearby = nearby[offset:end]
+
+	// Load the full details only for the page being returned
+	pageIDs := make([]uuid.UUID, len(nearby))
+	for i, item := range nearby {
+		pageIDs[i] = item.id
+	}
+	var pageEvents []models.Event
+	err := db.
+		Preload("Creator").
+		Preload("Photos").
+		Preload("Categories.Tag").
+		Preload("Tags.Tag").
+		Preload("Interests.Interest").
+		Preload("Members", func(db *gorm.DB) *gorm.DB {
+			return db.Preload("User").Preload("User.Profile")
+		}).
+		Preload("Swipes", viewerSwipeScope(userID)).
+		Where("id IN ?", pageIDs).
+		Find(&pageEvents).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to load events: %w", err)
+	}
+	byID := make(map[uuid.UUID]models.Event, len(pageEvents))
+	for _, event := range pageEvents {
+		byID[event.ID] = event
+	}
+
+	items := make([]dto.NearbyEventItem, 0, len(nearby))
+	for _, item := range nearby {
+		event, ok := byID[item.id]
+		if !ok {
+			continue
+		}
+		items = append(items, dto.NearbyEventItem{
+			Event:      s.convertEventToResponse(event, userID),
+			DistanceKm: math.Round(item.distance) / 1000,
+		})
+	}
+
+	return items, total, nil
+}
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"fmt"
+
+	"TinderTrip-Backend/internal/models"
+)
+
+// checkEventOpen rejects joining, swiping on or confirming an event that is over or already
+// underway. Cancelled and completed events are refused outright; a published event stops taking
+// members once it has started.
+func (s *EventService) checkEventOpen(event models.Event) error {
+	if event.IsCancelled() {
+		return fmt.Errorf("event is cancelled")
+	}
+	if event.IsCompleted() {
+		return fmt.Errorf("event is completed")
+	}
+	if event.StartAt != nil && !event.StartAt.After(s.clock.Now()) {
+		return fmt.Errorf("event has already started")
+	}
+	return nil
+}
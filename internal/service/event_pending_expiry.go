@@ -0,0 +1,89 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+)
+
+// defaultPendingMemberTTLHours is used when EVENTS_PENDING_MEMBER_TTL_HOURS is not configured
+const defaultPendingMemberTTLHours = 72
+
+// pendingMemberTTL returns how long a member may stay pending before their membership expires
+func pendingMemberTTL() time.Duration {
+	if config.AppConfig != nil && config.AppConfig.Event.PendingMemberTTLHours > 0 {
+		return time.Duration(config.AppConfig.Event.PendingMemberTTLHours) * time.Hour
+	}
+	return defaultPendingMemberTTLHours * time.Hour
+}
+
+// ExpireStalePendingMembers declines every pending membership older than the pending TTL on
+// published events that have not started yet, and tells the members. Returns how many expired.
+func (s *EventService) ExpireStalePendingMembers() (int, error) {
+	return s.expirePendingMembers(nil)
+}
+
+// ExpireEventPendingMembers declines the event's stale pending memberships (creator only)
+func (s *EventService) ExpireEventPendingMembers(eventID, userID string) (int, error) {
+	access, err := LoadEventAccess(eventID, userID)
+	if err != nil {
+		return 0, err
+	}
+	if !access.IsCreator() {
+		return 0, fmt.Errorf("not authorized")
+	}
+
+	return s.expirePendingMembers(&access.Event.ID)
+}
+
+// expirePendingMembers declines stale pending memberships, limited to one event when eventID is set
+func (s *EventService) expirePendingMembers(eventID *uuid.UUID) (int, error) {
+	now := s.clock.Now()
+	cutoff := now.Add(-pendingMemberTTL())
+
+	query := database.GetDB().Model(&models.EventMember{}).
+		Joins("JOIN events ON events.id = event_members.event_id").
+		Where("event_members.status = ? AND event_members.joined_at <= ?", models.MemberStatusPending, cutoff).
+		Where("events.status = ? AND events.deleted_at IS NULL", models.EventStatusPublished).
+		Where("events.start_at IS NULL OR events.start_at > ?", now)
+	if eventID != nil {
+		query = query.Where("event_members.event_id = ?", *eventID)
+	}
+
+	var stale []models.EventMember
+	if err := query.Find(&stale).Error; err != nil {
+		return 0, fmt.Errorf("failed to get pending members: %w", err)
+	}
+
+	expired := 0
+	for _, member := range stale {
+		// Only still-pending rows, so a member confirming meanwhile keeps their spot
+		result := database.GetDB().Model(&models.EventMember{}).
+			Where("event_id = ? AND user_id = ? AND status = ?", member.EventID, member.UserID, models.MemberStatusPending).
+			Update("status", models.MemberStatusDeclined)
+		if result.Error != nil {
+			return expired, fmt.Errorf("failed to expire pending member: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			continue
+		}
+		expired++
+
+		// Send notification (in background - don't block on error)
+		eventID, userID := member.EventID.String(), member.UserID.String()
+		sendInBackground(func() {
+			notificationService := NewNotificationService()
+			if err := notificationService.SendPendingMembershipExpiredNotification(eventID, userID); err != nil {
+				log.Printf("Failed to send pending membership expired notification: %v", err)
+			}
+		})
+	}
+
+	return expired, nil
+}
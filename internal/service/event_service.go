@@ -1,33 +1,84 @@
 package service
 
 import (
+	"encoding/csv"
 	"fmt"
+	"io"
 	"log"
+	"math"
+	"sort"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"TinderTrip-Backend/internal/dto"
 	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
 	"TinderTrip-Backend/pkg/audit"
+	"TinderTrip-Backend/pkg/config"
 	"TinderTrip-Backend/pkg/database"
+	"TinderTrip-Backend/pkg/email"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // EventService handles event business logic
 type EventService struct {
-	auditLogger *audit.AuditLogger
+	auditLogger    *audit.AuditLogger
+	webhookService *WebhookService
+	storageCleanup *StorageCleanupService
+	emailService   *EmailService
+	clock          utils.Clock
 }
 
 // NewEventService creates a new event service
 func NewEventService() *EventService {
 	return &EventService{
-		auditLogger: audit.NewAuditLogger(),
+		auditLogger:    audit.NewAuditLogger(),
+		webhookService: NewWebhookService(),
+		storageCleanup: NewStorageCleanupService(),
+		emailService:   NewEmailService(),
+		clock:          utils.RealClock{},
 	}
 }
 
-// GetEvents gets events with pagination and filters
-func (s *EventService) GetEvents(userID string, page, limit int, eventType, status string) ([]dto.EventResponse, int64, error) {
+// NewEventServiceWithStorageCleanup creates an event service with the given storage cleanup service
+func NewEventServiceWithStorageCleanup(storageCleanup *StorageCleanupService) *EventService {
+	service := NewEventService()
+	service.storageCleanup = storageCleanup
+	return service
+}
+
+// NewEventServiceWithClock creates an event service that reads the time from the given clock
+func NewEventServiceWithClock(clock utils.Clock) *EventService {
+	service := NewEventService()
+	service.clock = clock
+	service.webhookService.clock = clock
+	service.storageCleanup.clock = clock
+	return service
+}
+
+// NewEventServiceWithEmailClient creates an event service that sends confirmation emails through
+// client and reads the time from clock
+func NewEventServiceWithEmailClient(client *email.SMTPClient, clock utils.Clock) *EventService {
+	service := NewEventServiceWithClock(clock)
+	service.emailService = NewEmailServiceWithClient(client)
+	return service
+}
+
+// dispatchWebhook queues webhook deliveries for an event lifecycle transition
+func (s *EventService) dispatchWebhook(eventName string, event models.Event, actorID *string) {
+	err := s.webhookService.Dispatch(eventName, webhookEventData(event, actorID))
+	if err != nil {
+		log.Printf("Failed to queue %s webhook for event %s: %v", eventName, event.ID, err)
+	}
+}
+
+// GetEvents gets events with pagination and filters. A non-empty search matches title or
+// description, case-insensitively.
+func (s *EventService) GetEvents(userID string, page, limit int, eventType, status, search string) ([]dto.EventResponse, int64, error) {
 	// Build query
 	query := database.GetDB().Model(&models.Event{}).Where("deleted_at IS NULL")
 
@@ -38,6 +89,7 @@ func (s *EventService) GetEvents(userID string, page, limit int, eventType, stat
 	if status != "" {
 		query = query.Where("status = ?", status)
 	}
+	query = applyEventSearch(query, search)
 
 	// Get total count
 	var total int64
@@ -58,7 +110,52 @@ func (s *EventService) GetEvents(userID string, page, limit int, eventType, stat
 		Preload("Members", func(db *gorm.DB) *gorm.DB {
 			return db.Preload("User").Preload("User.Profile")
 		}).
-		Preload("Swipes").
+		Preload("Swipes", viewerSwipeScope(userID)).
+		Offset(offset).Limit(limit).Order("created_at DESC").Find(&events).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get events: %w", err)
+	}
+
+	// Convert to response DTOs
+	responses := make([]dto.EventResponse, len(events))
+	for i, event := range events {
+		responses[i] = s.convertEventToResponse(event, userID)
+	}
+
+	return responses, total, nil
+}
+
+// GetEventsWithMembership gets events without loading full member/swipe lists.
+// Only the viewer's own member and swipe rows are fetched to annotate each event.
+func (s *EventService) GetEventsWithMembership(userID string, page, limit int, eventType, status, search string) ([]dto.EventResponse, int64, error) {
+	// Build query
+	query := database.GetDB().Model(&models.Event{}).Where("deleted_at IS NULL")
+
+	// Apply filters
+	if eventType != "" {
+		query = query.Where("event_type = ?", eventType)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	query = applyEventSearch(query, search)
+
+	// Get total count
+	var total int64
+	err := query.Count(&total).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count events: %w", err)
+	}
+
+	// Get events with pagination (no member/swipe preloads)
+	var events []models.Event
+	offset := (page - 1) * limit
+	err = query.
+		Preload("Creator").
+		Preload("Photos").
+		Preload("Categories.Tag").
+		Preload("Tags.Tag").
+		Preload("Interests.Interest").
 		Offset(offset).Limit(limit).Order("created_at DESC").Find(&events).Error
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get events: %w", err)
@@ -70,11 +167,212 @@ func (s *EventService) GetEvents(userID string, page, limit int, eventType, stat
 		responses[i] = s.convertEventToResponse(event, userID)
 	}
 
+	if err := s.applyViewerState(responses, userID); err != nil {
+		return nil, 0, err
+	}
+
 	return responses, total, nil
 }
 
-// GetPublicEvents gets public events (no authentication required)
-func (s *EventService) GetPublicEvents(page, limit int, eventType string) ([]dto.EventResponse, int64, error) {
+// MaxEventSearchLength is the longest search accepted by GetEvents, in characters
+const MaxEventSearchLength = 100
+
+// likeEscaper escapes the LIKE wildcards in user input so they match literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// applyEventSearch narrows the query to events whose title or description contains search,
+// ignoring case. Postgres uses ILIKE; SQLite's LIKE already ignores ASCII case.
+func applyEventSearch(query *gorm.DB, search string) *gorm.DB {
+	search = strings.TrimSpace(search)
+	if search == "" {
+		return query
+	}
+
+	operator := "LIKE"
+	if query.Dialector.Name() == "postgres" {
+		operator = "ILIKE"
+	}
+	pattern := "%" + likeEscaper.Replace(search) + "%"
+	return query.Where(
+		fmt.Sprintf(`(title %[1]s ? ESCAPE '\' OR description %[1]s ? ESCAPE '\')`, operator),
+		pattern, pattern,
+	)
+}
+
+// applyViewerState fills membership status and swipe of the viewer
+// for a page of events using one query per table instead of preloading every row
+func (s *EventService) applyViewerState(responses []dto.EventResponse, userID string) error {
+	if len(responses) == 0 {
+		return nil
+	}
+
+	eventIDs := make([]string, len(responses))
+	index := make(map[string]int, len(responses))
+	for i, response := range responses {
+		eventIDs[i] = response.ID
+		index[response.ID] = i
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil
+	}
+
+	// Viewer membership rows
+	var members []models.EventMember
+	err = database.GetDB().Where("user_id = ? AND event_id IN ?", userUUID, eventIDs).Find(&members).Error
+	if err != nil {
+		return fmt.Errorf("failed to get viewer membership: %w", err)
+	}
+	for _, member := range members {
+		if i, ok := index[member.EventID.String()]; ok {
+			status := string(member.Status)
+			responses[i].MemberStatus = &status
+			responses[i].IsJoined = member.Status == models.MemberStatusConfirmed
+		}
+	}
+
+	// Viewer swipe rows
+	var swipes []models.EventSwipe
+	err = database.GetDB().Where("user_id = ? AND event_id IN ?", userUUID, eventIDs).Find(&swipes).Error
+	if err != nil {
+		return fmt.Errorf("failed to get viewer swipes: %w", err)
+	}
+	for _, swipe := range swipes {
+		if i, ok := index[swipe.EventID.String()]; ok {
+			responses[i].UserSwipe = &dto.EventSwipeResponse{
+				UserID:    swipe.UserID.String(),
+				EventID:   swipe.EventID.String(),
+				Direction: string(swipe.Direction),
+				CreatedAt: swipe.CreatedAt,
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetEventCounts counts events grouped by event type and status with a single grouped query.
+// Scope "public" limits to published events, "joined" to events the user is a member of. A
+// non-empty search narrows the counts as it does GetEvents.
+func (s *EventService) GetEventCounts(userID, scope, eventType, status, search string) (*dto.EventCountsResponse, error) {
+	// Build query
+	query := database.GetDB().Model(&models.Event{}).Where("deleted_at IS NULL")
+
+	// Apply scope
+	switch scope {
+	case "", "all":
+	case "public":
+		query = query.Where("status = ?", models.EventStatusPublished)
+	case "joined":
+		userUUID, err := uuid.Parse(userID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user ID: %w", err)
+		}
+		query = query.Where("id IN (?)", database.GetDB().Model(&models.EventMember{}).
+			Select("event_id").Where("user_id = ?", userUUID))
+	default:
+		return nil, fmt.Errorf("invalid scope")
+	}
+
+	// Apply filters
+	if eventType != "" {
+		query = query.Where("event_type = ?", eventType)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	query = applyEventSearch(query, search)
+
+	var groups []dto.EventCountGroup
+	err := query.Select("event_type, status, COUNT(*) AS count").
+		Group("event_type, status").
+		Order("event_type, status").
+		Scan(&groups).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to count events: %w", err)
+	}
+
+	response := &dto.EventCountsResponse{
+		ByEventType: make(map[string]int64),
+		ByStatus:    make(map[string]int64),
+		Groups:      groups,
+	}
+	if response.Groups == nil {
+		response.Groups = []dto.EventCountGroup{}
+	}
+	for _, group := range groups {
+		response.Total += group.Count
+		response.ByEventType[group.EventType] += group.Count
+		response.ByStatus[group.Status] += group.Count
+	}
+
+	return response, nil
+}
+
+// GetEventStates returns the user's membership status and swipe for each event, in request order.
+// Duplicate IDs are collapsed and deleted events are reported as untouched.
+func (s *EventService) GetEventStates(userID string, eventIDs []string) ([]dto.EventStateResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	responses := make([]dto.EventStateResponse, 0, len(eventIDs))
+	index := make(map[string]int, len(eventIDs))
+	ids := make([]uuid.UUID, 0, len(eventIDs))
+	for _, eventID := range eventIDs {
+		eventUUID, err := uuid.Parse(eventID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid event ID: %w", err)
+		}
+		if _, ok := index[eventUUID.String()]; ok {
+			continue
+		}
+		index[eventUUID.String()] = len(responses)
+		responses = append(responses, dto.EventStateResponse{EventID: eventUUID.String()})
+		ids = append(ids, eventUUID)
+	}
+
+	// Viewer membership rows on live events
+	var members []models.EventMember
+	err = database.GetDB().
+		Joins("JOIN events ON events.id = event_members.event_id AND events.deleted_at IS NULL").
+		Where("event_members.user_id = ? AND event_members.event_id IN ?", userUUID, ids).
+		Find(&members).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get viewer membership: %w", err)
+	}
+	for _, member := range members {
+		if i, ok := index[member.EventID.String()]; ok {
+			status := string(member.Status)
+			responses[i].MemberStatus = &status
+			responses[i].IsJoined = member.Status == models.MemberStatusConfirmed
+		}
+	}
+
+	// Viewer swipe rows on live events
+	var swipes []models.EventSwipe
+	err = database.GetDB().
+		Joins("JOIN events ON events.id = event_swipes.event_id AND events.deleted_at IS NULL").
+		Where("event_swipes.user_id = ? AND event_swipes.event_id IN ?", userUUID, ids).
+		Find(&swipes).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get viewer swipes: %w", err)
+	}
+	for _, swipe := range swipes {
+		if i, ok := index[swipe.EventID.String()]; ok {
+			direction := string(swipe.Direction)
+			responses[i].SwipeDirection = &direction
+		}
+	}
+
+	return responses, nil
+}
+
+// GetPublicEvents gets public events (no authentication required). With a viewer ID, each event
+// includes the viewer's membership and swipe; anonymous viewers pass "".
+func (s *EventService) GetPublicEvents(page, limit int, eventType, viewerID string) ([]dto.EventResponse, int64, error) {
 	// Build query for active events only
 	query := database.GetDB().Model(&models.Event{}).Where("deleted_at IS NULL AND status = ?", models.EventStatusPublished)
 
@@ -102,7 +400,7 @@ func (s *EventService) GetPublicEvents(page, limit int, eventType string) ([]dto
 		Preload("Members", func(db *gorm.DB) *gorm.DB {
 			return db.Preload("User").Preload("User.Profile")
 		}).
-		Preload("Swipes").
+		Preload("Swipes", viewerSwipeScope(viewerID)).
 		Offset(offset).Limit(limit).Order("created_at DESC").Find(&events).Error
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get events: %w", err)
@@ -111,7 +409,7 @@ func (s *EventService) GetPublicEvents(page, limit int, eventType string) ([]dto
 	// Convert to response DTOs
 	responses := make([]dto.EventResponse, len(events))
 	for i, event := range events {
-		responses[i] = s.convertEventToResponse(event, "")
+		responses[i] = s.convertEventToResponse(event, viewerID)
 	}
 
 	return responses, total, nil
@@ -175,7 +473,7 @@ func (s *EventService) GetJoinedEvents(userID string, page, limit int, memberSta
 		Preload("Members", func(db *gorm.DB) *gorm.DB {
 			return db.Preload("User").Preload("User.Profile")
 		}).
-		Preload("Swipes").
+		Preload("Swipes", viewerSwipeScope(userID)).
 		Offset(offset).Limit(limit).Order("created_at DESC").Find(&events).Error
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get joined events: %w", err)
@@ -209,7 +507,7 @@ func (s *EventService) GetEvent(eventID, userID string) (*dto.EventResponse, err
 		Preload("Members", func(db *gorm.DB) *gorm.DB {
 			return db.Preload("User").Preload("User.Profile")
 		}).
-		Preload("Swipes").
+		Preload("Swipes", viewerSwipeScope(userID)).
 		Where("id = ? AND deleted_at IS NULL", eventUUID).First(&event).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -219,11 +517,21 @@ func (s *EventService) GetEvent(eventID, userID string) (*dto.EventResponse, err
 	}
 
 	response := s.convertEventToResponse(event, userID)
+
+	// The bring list is only shown to confirmed members
+	if response.IsJoined {
+		items, err := loadEventItems(event.ID)
+		if err != nil {
+			return nil, err
+		}
+		response.Items = items
+	}
+
 	return &response, nil
 }
 
-// GetPublicEvent gets a specific public event
-func (s *EventService) GetPublicEvent(eventID string) (*dto.EventResponse, error) {
+// GetPublicEvent gets a specific public event, personalized for the viewer when viewerID is set
+func (s *EventService) GetPublicEvent(eventID, viewerID string) (*dto.EventResponse, error) {
 	// Parse event ID
 	eventUUID, err := uuid.Parse(eventID)
 	if err != nil {
@@ -241,7 +549,7 @@ func (s *EventService) GetPublicEvent(eventID string) (*dto.EventResponse, error
 		Preload("Members", func(db *gorm.DB) *gorm.DB {
 			return db.Preload("User").Preload("User.Profile")
 		}).
-		Preload("Swipes").
+		Preload("Swipes", viewerSwipeScope(viewerID)).
 		Where("id = ? AND deleted_at IS NULL AND status = ?", eventUUID, models.EventStatusPublished).First(&event).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -250,7 +558,7 @@ func (s *EventService) GetPublicEvent(eventID string) (*dto.EventResponse, error
 		return nil, fmt.Errorf("database error: %w", err)
 	}
 
-	response := s.convertEventToResponse(event, "")
+	response := s.convertEventToResponse(event, viewerID)
 	return &response, nil
 }
 
@@ -262,11 +570,34 @@ func (s *EventService) CreateEvent(userID string, req dto.CreateEventRequest) (*
 		return nil, fmt.Errorf("invalid user ID: %w", err)
 	}
 
+	if !models.IsValidEventType(req.EventType) {
+		return nil, fmt.Errorf("invalid event_type %q", req.EventType)
+	}
+
+	categoryIDs, err := resolveCategoryIDs(database.GetDB(), req.CategoryIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(categoryIDs) == 0 && eventsRequireCategory() {
+		return nil, fmt.Errorf("at least one category is required")
+	}
+	if err := validateMinAttendees(req.MinAttendees, req.Capacity); err != nil {
+		return nil, err
+	}
+	title, err := normalizeEventTitle(req.Title)
+	if err != nil {
+		return nil, err
+	}
+	description, err := normalizeEventDescription(req.Description)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create event
 	event := &models.Event{
 		CreatorID:     userUUID,
-		Title:         req.Title,
-		Description:   req.Description,
+		Title:         title,
+		Description:   description,
 		EventType:     models.EventType(req.EventType),
 		AddressText:   req.AddressText,
 		Lat:           req.Lat,
@@ -274,44 +605,51 @@ func (s *EventService) CreateEvent(userID string, req dto.CreateEventRequest) (*
 		StartAt:       req.StartAt,
 		EndAt:         req.EndAt,
 		Capacity:      req.Capacity,
+		MinAttendees:  req.MinAttendees,
 		BudgetMin:     req.BudgetMin,
 		BudgetMax:     req.BudgetMax,
 		Currency:      req.Currency,
 		Status:        models.EventStatusPublished,
 		CoverImageURL: req.CoverImageURL,
+		// The creator joins as a confirmed member below
+		ConfirmedMemberCount: 1,
 	}
+	applyEventSettings(&event.Settings, req.Settings)
+
+	// Save the event, its creator membership and chat room together
+	err = database.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(event).Error; err != nil {
+			return fmt.Errorf("failed to create event: %w", err)
+		}
+
+		// Add creator as member
+		if err := AddCreatorMember(tx, event.ID, userUUID); err != nil {
+			return err
+		}
 
-	// Save event
-	err = database.GetDB().Create(event).Error
+		if err := addEventCategories(tx, event.ID, categoryIDs); err != nil {
+			return err
+		}
+
+		// Create chat room
+		chatRoom := &models.ChatRoom{
+			EventID: event.ID,
+		}
+		err := tx.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "event_id"}}, DoNothing: true}).Create(chatRoom).Error
+		if err != nil {
+			return fmt.Errorf("failed to create chat room: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create event: %w", err)
+		return nil, err
 	}
 
 	// Log event creation
 	eventID := event.ID.String()
 	s.auditLogger.LogCreate(&userID, "events", &eventID, event)
 
-	// Add creator as member
-	member := &models.EventMember{
-		EventID: event.ID,
-		UserID:  userUUID,
-		Role:    models.MemberRoleCreator,
-		Status:  models.MemberStatusConfirmed,
-	}
-	err = database.GetDB().Create(member).Error
-	if err != nil {
-		return nil, fmt.Errorf("failed to add creator as member: %w", err)
-	}
-
-	// Create chat room
-	chatRoom := &models.ChatRoom{
-		EventID: event.ID,
-	}
-	err = database.GetDB().Create(chatRoom).Error
-	if err != nil {
-		return nil, fmt.Errorf("failed to create chat room: %w", err)
-	}
-
 	// Add tags if provided
 	if len(req.TagIDs) > 0 {
 		for _, tagIDStr := range req.TagIDs {
@@ -381,16 +719,38 @@ func (s *EventService) CreateEvent(userID string, req dto.CreateEventRequest) (*
 		Preload("Members", func(db *gorm.DB) *gorm.DB {
 			return db.Preload("User").Preload("User.Profile")
 		}).
-		Preload("Swipes").
+		Preload("Swipes", viewerSwipeScope(userID)).
 		Where("id = ?", event.ID).First(event).Error
 	if err != nil {
 		return nil, fmt.Errorf("failed to load event: %w", err)
 	}
 
+	// Notify integrations
+	s.dispatchWebhook(models.WebhookEventCreated, *event, &userID)
+
 	response := s.convertEventToResponse(*event, userID)
 	return &response, nil
 }
 
+// AddCreatorMember makes the user a confirmed creator member of the event.
+// It is an upsert, so running it again for the same event (e.g. on a retried transaction) is safe.
+func AddCreatorMember(tx *gorm.DB, eventID, creatorID uuid.UUID) error {
+	member := &models.EventMember{
+		EventID: eventID,
+		UserID:  creatorID,
+		Role:    models.MemberRoleCreator,
+		Status:  models.MemberStatusConfirmed,
+	}
+	err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "event_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role", "status"}),
+	}).Create(member).Error
+	if err != nil {
+		return fmt.Errorf("failed to add creator as member: %w", err)
+	}
+	return nil
+}
+
 // UpdateEvent updates an event
 func (s *EventService) UpdateEvent(eventID, userID string, req dto.UpdateEventRequest) (*dto.EventResponse, error) {
 	// Parse IDs
@@ -403,6 +763,11 @@ func (s *EventService) UpdateEvent(eventID, userID string, req dto.UpdateEventRe
 		return nil, fmt.Errorf("invalid user ID: %w", err)
 	}
 
+	// Cancelling and completing have their own guarded endpoints
+	if req.Status != nil {
+		return nil, fmt.Errorf("status cannot be updated; use the cancel or complete endpoint")
+	}
+
 	// Check if event exists and user is creator
 	var event models.Event
 	err = database.GetDB().Where("id = ? AND deleted_at IS NULL", eventUUID).First(&event).Error
@@ -417,15 +782,65 @@ func (s *EventService) UpdateEvent(eventID, userID string, req dto.UpdateEventRe
 		return nil, fmt.Errorf("unauthorized")
 	}
 
+	// Capacity cannot drop below the members already confirmed
+	if req.Capacity != nil {
+		if *req.Capacity < 1 {
+			return nil, fmt.Errorf("capacity must be at least 1")
+		}
+		if *req.Capacity < event.ConfirmedMemberCount {
+			return nil, fmt.Errorf("capacity cannot be less than confirmed member count (%d)", event.ConfirmedMemberCount)
+		}
+	}
+
+	// The threshold is checked against the capacity the event will have after the update.
+	// Sending min_attendees 0 removes the threshold.
+	minAttendees, capacity := event.MinAttendees, event.Capacity
+	if req.MinAttendees != nil {
+		minAttendees = req.MinAttendees
+		if *req.MinAttendees == 0 {
+			minAttendees = nil
+		}
+	}
+	if req.Capacity != nil {
+		capacity = req.Capacity
+	}
+	if err := validateMinAttendees(minAttendees, capacity); err != nil {
+		return nil, err
+	}
+
+	// Validate categories before changing anything
+	var categoryIDs []uuid.UUID
+	if req.CategoryIDs != nil {
+		categoryIDs, err = resolveCategoryIDs(database.GetDB(), req.CategoryIDs)
+		if err != nil {
+			return nil, err
+		}
+		if len(categoryIDs) == 0 && eventsRequireCategory() {
+			return nil, fmt.Errorf("at least one category is required")
+		}
+	}
+
 	// Update fields
 	updates := make(map[string]interface{})
 	if req.Title != nil {
-		updates["title"] = *req.Title
+		title, err := normalizeEventTitle(*req.Title)
+		if err != nil {
+			return nil, err
+		}
+		updates["title"] = title
 	}
 	if req.Description != nil {
-		updates["description"] = *req.Description
+		// A blank description clears it
+		description, err := normalizeEventDescription(req.Description)
+		if err != nil {
+			return nil, err
+		}
+		updates["description"] = description
 	}
 	if req.EventType != nil {
+		if !models.IsValidEventType(*req.EventType) {
+			return nil, fmt.Errorf("invalid event_type %q", *req.EventType)
+		}
 		updates["event_type"] = *req.EventType
 	}
 	if req.AddressText != nil {
@@ -446,17 +861,44 @@ func (s *EventService) UpdateEvent(eventID, userID string, req dto.UpdateEventRe
 	if req.Capacity != nil {
 		updates["capacity"] = *req.Capacity
 	}
-	if req.Status != nil {
-		updates["status"] = *req.Status
+	if req.MinAttendees != nil {
+		updates["min_attendees"] = minAttendees
 	}
 	if req.CoverImageURL != nil {
 		updates["cover_image_url"] = *req.CoverImageURL
 	}
+	if req.Settings != nil {
+		if req.Settings.AllowMemberInvites != nil {
+			updates["allow_member_invites"] = *req.Settings.AllowMemberInvites
+		}
+		if req.Settings.PendingChatAccess != nil {
+			updates["pending_chat_access"] = *req.Settings.PendingChatAccess
+		}
+		if req.Settings.MembersVisible != nil {
+			updates["members_visible"] = *req.Settings.MembersVisible
+		}
+	}
+
+	// Update event and its categories together
+	err = database.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&event).Updates(updates).Error; err != nil {
+			return fmt.Errorf("failed to update event: %w", err)
+		}
+
+		// Categories are replaced as a whole when provided
+		if req.CategoryIDs != nil {
+			if err := tx.Where("event_id = ?", eventUUID).Delete(&models.EventCategory{}).Error; err != nil {
+				return fmt.Errorf("failed to delete existing categories: %w", err)
+			}
+			if err := addEventCategories(tx, eventUUID, categoryIDs); err != nil {
+				return err
+			}
+		}
 
-	// Update event
-	err = database.GetDB().Model(&event).Updates(updates).Error
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update event: %w", err)
+		return nil, err
 	}
 
 	// Update interests if provided (bulk replace)
@@ -503,7 +945,7 @@ func (s *EventService) UpdateEvent(eventID, userID string, req dto.UpdateEventRe
 		Preload("Members", func(db *gorm.DB) *gorm.DB {
 			return db.Preload("User").Preload("User.Profile")
 		}).
-		Preload("Swipes").
+		Preload("Swipes", viewerSwipeScope(userID)).
 		Where("id = ?", event.ID).First(&event).Error
 	if err != nil {
 		return nil, fmt.Errorf("failed to load event: %w", err)
@@ -513,6 +955,67 @@ func (s *EventService) UpdateEvent(eventID, userID string, req dto.UpdateEventRe
 	return &response, nil
 }
 
+// resolveCategoryIDs parses and validates category IDs: each must be an existing tag of kind "category".
+// Duplicates are dropped.
+func resolveCategoryIDs(db *gorm.DB, ids []string) ([]uuid.UUID, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	categoryIDs := make([]uuid.UUID, 0, len(ids))
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		categoryID, err := uuid.Parse(strings.TrimSpace(id))
+		if err != nil {
+			return nil, fmt.Errorf("invalid category ID: %s", id)
+		}
+		if !seen[categoryID] {
+			seen[categoryID] = true
+			categoryIDs = append(categoryIDs, categoryID)
+		}
+	}
+
+	var tags []models.Tag
+	if err := db.Where("id IN ?", categoryIDs).Find(&tags).Error; err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+	kinds := make(map[uuid.UUID]string, len(tags))
+	for _, tag := range tags {
+		kinds[tag.ID] = tag.Kind
+	}
+
+	for _, categoryID := range categoryIDs {
+		kind, ok := kinds[categoryID]
+		if !ok {
+			return nil, fmt.Errorf("category not found: %s", categoryID)
+		}
+		if kind != string(models.TagKindCategory) {
+			return nil, fmt.Errorf("tag is not a category: %s", categoryID)
+		}
+	}
+
+	return categoryIDs, nil
+}
+
+// addEventCategories links validated categories to an event
+func addEventCategories(tx *gorm.DB, eventID uuid.UUID, categoryIDs []uuid.UUID) error {
+	if len(categoryIDs) == 0 {
+		return nil
+	}
+
+	eventCategories := make([]models.EventCategory, len(categoryIDs))
+	for i, categoryID := range categoryIDs {
+		eventCategories[i] = models.EventCategory{
+			EventID: eventID,
+			TagID:   categoryID,
+		}
+	}
+	if err := tx.Create(&eventCategories).Error; err != nil {
+		return fmt.Errorf("failed to add categories to event: %w", err)
+	}
+	return nil
+}
+
 // DeleteEvent deletes an event
 func (s *EventService) DeleteEvent(eventID, userID string) error {
 	// Parse IDs
@@ -540,7 +1043,7 @@ func (s *EventService) DeleteEvent(eventID, userID string) error {
 	}
 
 	// Soft delete event
-	now := time.Now()
+	now := s.clock.Now()
 	err = database.GetDB().Model(&event).Update("deleted_at", now).Error
 	if err != nil {
 		return fmt.Errorf("failed to delete event: %w", err)
@@ -581,6 +1084,15 @@ func (s *EventService) JoinEvent(eventID, userID string) error {
 		return fmt.Errorf("database error: %w", err)
 	}
 
+	if err := s.checkEventOpen(event); err != nil {
+		return err
+	}
+
+	// No point queueing pending members for an event whose seats are all confirmed
+	if isEventFull(event) {
+		return fmt.Errorf("event is full")
+	}
+
 	// Create member
 	member := &models.EventMember{
 		EventID: eventUUID,
@@ -598,13 +1110,13 @@ func (s *EventService) JoinEvent(eventID, userID string) error {
 	s.auditLogger.LogEventJoin(&userID, eventID)
 
 	// Send notification (in background - don't block on error)
-	go func() {
+	sendInBackground(func() {
 		notificationService := NewNotificationService()
 		if err := notificationService.SendUserJoinedEventNotification(eventID, userID); err != nil {
 			// Log error but don't fail the join operation
 			log.Printf("Failed to send join notification: %v", err)
 		}
-	}()
+	})
 
 	return nil
 }
@@ -636,7 +1148,7 @@ func (s *EventService) LeaveEvent(eventID, userID string) (error, bool) {
 
 	// If user is creator, soft delete the event (same as DELETE)
 	if event.CreatorID == userUUID {
-		now := time.Now()
+		now := s.clock.Now()
 		err = database.GetDB().Model(&event).Update("deleted_at", now).Error
 		if err != nil {
 			return fmt.Errorf("failed to delete event: %w", err), false
@@ -656,27 +1168,37 @@ func (s *EventService) LeaveEvent(eventID, userID string) (error, bool) {
 	}
 
 	// Delete member record instead of updating status
-	err = database.GetDB().Delete(&member).Error
+	err = database.GetDB().Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("event_id = ? AND user_id = ?", eventUUID, userUUID).Delete(&models.EventMember{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to leave event: %w", result.Error)
+		}
+		if result.RowsAffected > 0 && member.Status == models.MemberStatusConfirmed {
+			return releaseConfirmedSeat(tx, eventUUID)
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to leave event: %w", err), false
+		return err, false
 	}
 
 	// Log event leave
 	s.auditLogger.LogEventLeave(&userID, eventID)
 
 	// Send notification (in background - don't block on error)
-	go func() {
+	sendInBackground(func() {
 		notificationService := NewNotificationService()
 		if err := notificationService.SendUserLeftEventNotification(eventID, userID); err != nil {
 			// Log error but don't fail the leave operation
 			log.Printf("Failed to send leave notification: %v", err)
 		}
-	}()
+	})
 
 	return nil, false // Normal member left
 }
 
-// SwipeEvent swipes on an event
+// SwipeEvent swipes on an event. A like marks the user as interested in the event;
+// a pass withdraws that interest.
 func (s *EventService) SwipeEvent(eventID, userID, direction string) error {
 	// Parse IDs
 	eventUUID, err := uuid.Parse(eventID)
@@ -697,59 +1219,86 @@ func (s *EventService) SwipeEvent(eventID, userID, direction string) error {
 		}
 		return fmt.Errorf("database error: %w", err)
 	}
-
-	// Create or update swipe
-	swipe := &models.EventSwipe{
-		UserID:    userUUID,
-		EventID:   eventUUID,
-		Direction: models.SwipeDirection(direction),
-	}
-
-	// Use upsert to create or update
-	err = database.GetDB().Where("user_id = ? AND event_id = ?", userUUID, eventUUID).
-		Assign(models.EventSwipe{Direction: models.SwipeDirection(direction)}).
-		FirstOrCreate(swipe).Error
-	if err != nil {
-		return fmt.Errorf("failed to swipe event: %w", err)
+	if err := s.checkEventOpen(event); err != nil {
+		return err
 	}
 
-	// If swipe is like, create member as pending
-	if direction == "like" {
-		// Check if user is already a member
-		var existingMember models.EventMember
-		err = database.GetDB().Where("event_id = ? AND user_id = ?", eventUUID, userUUID).First(&existingMember).Error
-		if err == gorm.ErrRecordNotFound {
-			// Create member as pending
-			member := &models.EventMember{
-				EventID: eventUUID,
-				UserID:  userUUID,
-				Role:    models.MemberRoleParticipant,
-				Status:  models.MemberStatusPending,
-			}
+	// Record the swipe, and whether the user is interested. Liking is not joining: the user
+	// becomes a member only through JoinEvent.
+	err = database.GetDB().Transaction(func(tx *gorm.DB) error {
+		swipe := &models.EventSwipe{
+			UserID:    userUUID,
+			EventID:   eventUUID,
+			Direction: models.SwipeDirection(direction),
+		}
+		err := tx.Where("user_id = ? AND event_id = ?", userUUID, eventUUID).
+			Assign(models.EventSwipe{Direction: models.SwipeDirection(direction)}).
+			FirstOrCreate(swipe).Error
+		if err != nil {
+			return fmt.Errorf("failed to swipe event: %w", err)
+		}
 
-			err = database.GetDB().Create(member).Error
+		if direction == string(models.SwipeDirectionLike) {
+			interest := &models.UserEventInterest{UserID: userUUID, EventID: eventUUID, CreatedAt: s.clock.Now()}
+			err = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(interest).Error
 			if err != nil {
-				return fmt.Errorf("failed to create member: %w", err)
+				return fmt.Errorf("failed to record interest: %w", err)
 			}
+			return nil
+		}
 
-			// Send notification (in background - don't block on error)
-			// Swipe like = join event, so send join notification
-			go func() {
-				notificationService := NewNotificationService()
-				if err := notificationService.SendUserJoinedEventNotification(eventID, userID); err != nil {
-					// Log error but don't fail the swipe operation
-					log.Printf("Failed to send swipe like notification: %v", err)
-				}
-			}()
-		} else if err != nil {
-			return fmt.Errorf("failed to check member: %w", err)
+		// Passing after a like withdraws the interest
+		err = tx.Where("user_id = ? AND event_id = ?", userUUID, eventUUID).Delete(&models.UserEventInterest{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to remove interest: %w", err)
 		}
-		// If user already a member, no need to send notification again
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	return nil
 }
 
+// UndoSwipe takes back the user's swipe on an event. Undoing a like also withdraws the interest;
+// membership is left alone, since only JoinEvent makes the user a member.
+func (s *EventService) UndoSwipe(eventID, userID string) error {
+	eventUUID, err := uuid.Parse(eventID)
+	if err != nil {
+		return fmt.Errorf("invalid event ID")
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID")
+	}
+
+	return database.GetDB().Transaction(func(tx *gorm.DB) error {
+		var swipe models.EventSwipe
+		err := tx.Where("user_id = ? AND event_id = ?", userUUID, eventUUID).First(&swipe).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("swipe not found")
+			}
+			return fmt.Errorf("database error: %w", err)
+		}
+
+		err = tx.Where("user_id = ? AND event_id = ?", userUUID, eventUUID).Delete(&models.EventSwipe{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to undo swipe: %w", err)
+		}
+		if !swipe.IsLike() {
+			return nil
+		}
+
+		err = tx.Where("user_id = ? AND event_id = ?", userUUID, eventUUID).Delete(&models.UserEventInterest{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to remove interest: %w", err)
+		}
+		return nil
+	})
+}
+
 // Helper function to convert event to response DTO
 func (s *EventService) convertEventToResponse(event models.Event, userID string) dto.EventResponse {
 	// Convert cover image URL to public URL
@@ -771,11 +1320,15 @@ func (s *EventService) convertEventToResponse(event models.Event, userID string)
 		StartAt:       event.StartAt,
 		EndAt:         event.EndAt,
 		Capacity:      event.Capacity,
+		MinAttendees:  event.MinAttendees,
 		BudgetMin:     event.BudgetMin,
 		BudgetMax:     event.BudgetMax,
 		Currency:      event.Currency,
 		Status:        string(event.Status),
 		CoverImageURL: publicCoverURL,
+		Settings:      eventSettingsResponse(event.Settings),
+		CancelReason:  event.CancelReason,
+		CancelledAt:   event.CancelledAt,
 		CreatedAt:     event.CreatedAt,
 		UpdatedAt:     event.UpdatedAt,
 	}
@@ -786,6 +1339,7 @@ func (s *EventService) convertEventToResponse(event models.Event, userID string)
 			ID:          event.Creator.ID.String(),
 			Email:       *event.Creator.Email,
 			DisplayName: event.Creator.GetDisplayName(),
+			Handle:      event.Creator.GetHandle(),
 			Provider:    string(event.Creator.Provider),
 			CreatedAt:   event.Creator.CreatedAt,
 		}
@@ -850,9 +1404,10 @@ func (s *EventService) convertEventToResponse(event models.Event, userID string)
 		}
 	}
 
-	// Add members
-	response.Members = make([]dto.EventMemberResponse, len(event.Members))
-	for i, member := range event.Members {
+	// Add members the viewer is allowed to see
+	members := visibleMembers(event, userID)
+	response.Members = make([]dto.EventMemberResponse, len(members))
+	for i, member := range members {
 		displayName := ""
 		var avatarURL *string
 		if member.User != nil {
@@ -870,26 +1425,23 @@ func (s *EventService) convertEventToResponse(event models.Event, userID string)
 			JoinedAt:    member.JoinedAt,
 			ConfirmedAt: member.ConfirmedAt,
 			LeftAt:      member.LeftAt,
-			Note:        member.Note,
+			CheckedInAt: member.CheckedInAt,
+			Note:        visibleMemberNote(event, member, userID),
 		}
 	}
 
-	// Count confirmed members
-	confirmedCount := 0
-	for _, member := range event.Members {
-		if member.Status == models.MemberStatusConfirmed {
-			confirmedCount++
-		}
-	}
-	response.MemberCount = confirmedCount
+	response.MemberCount = event.ConfirmedMemberCount
+	response.SpotsLeft = spotsLeft(event)
 
 	// Check if user is joined
 	if userID != "" {
 		userUUID, err := uuid.Parse(userID)
 		if err == nil {
 			for _, member := range event.Members {
-				if member.UserID == userUUID && member.Status == models.MemberStatusConfirmed {
-					response.IsJoined = true
+				if member.UserID == userUUID {
+					status := string(member.Status)
+					response.MemberStatus = &status
+					response.IsJoined = member.Status == models.MemberStatusConfirmed
 					break
 				}
 			}
@@ -963,33 +1515,38 @@ func (s *EventService) ConfirmEventParticipation(eventID, userID string) error {
 	if member.Status == models.MemberStatusConfirmed {
 		return fmt.Errorf("already confirmed")
 	}
+	if err := s.checkEventOpen(event); err != nil {
+		return err
+	}
 
-	// Check if event has capacity
-	if event.Capacity != nil {
-		var confirmedCount int64
-		err = database.GetDB().Model(&models.EventMember{}).
-			Where("event_id = ? AND status = ?", eventUUID, models.MemberStatusConfirmed).
-			Count(&confirmedCount).Error
+	// Update member status and take a seat together; the seat is refused when the event is full.
+	// The event row is locked first so concurrent confirmations queue up behind each other.
+	now := s.clock.Now()
+	return database.GetDB().Transaction(func(tx *gorm.DB) error {
+		var locked models.Event
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", eventUUID).First(&locked).Error
 		if err != nil {
-			return fmt.Errorf("failed to count confirmed members: %w", err)
+			return fmt.Errorf("failed to get event: %w", err)
 		}
-
-		if int(confirmedCount) >= *event.Capacity {
+		if isEventFull(locked) {
 			return fmt.Errorf("event is full")
 		}
-	}
 
-	// Update member status to confirmed
-	now := time.Now()
-	err = database.GetDB().Model(&member).Updates(map[string]interface{}{
-		"status":       models.MemberStatusConfirmed,
-		"confirmed_at": &now,
-	}).Error
-	if err != nil {
-		return fmt.Errorf("failed to confirm participation: %w", err)
-	}
-
-	return nil
+		result := tx.Model(&models.EventMember{}).
+			Where("event_id = ? AND user_id = ? AND status <> ?", eventUUID, userUUID, models.MemberStatusConfirmed).
+			Updates(map[string]interface{}{
+				"status":       models.MemberStatusConfirmed,
+				"confirmed_at": &now,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to confirm participation: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("already confirmed")
+		}
+		return reserveConfirmedSeat(tx, eventUUID)
+	})
 }
 
 // CancelEventParticipation cancels participation in an event
@@ -1025,15 +1582,21 @@ func (s *EventService) CancelEventParticipation(eventID, userID string) error {
 		return fmt.Errorf("failed to get member: %w", err)
 	}
 
-	// Update member status to declined
-	err = database.GetDB().Model(&member).Updates(map[string]interface{}{
-		"status": models.MemberStatusDeclined,
-	}).Error
-	if err != nil {
-		return fmt.Errorf("failed to cancel participation: %w", err)
-	}
-
-	return nil
+	// Update member status to declined, giving up the seat if it was confirmed
+	return database.GetDB().Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.EventMember{}).
+			Where("event_id = ? AND user_id = ? AND status = ?", eventUUID, userUUID, member.Status).
+			Updates(map[string]interface{}{
+				"status": models.MemberStatusDeclined,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to cancel participation: %w", result.Error)
+		}
+		if result.RowsAffected > 0 && member.Status == models.MemberStatusConfirmed {
+			return releaseConfirmedSeat(tx, eventUUID)
+		}
+		return nil
+	})
 }
 
 // CompleteEvent completes an event (creator only)
@@ -1065,7 +1628,7 @@ func (s *EventService) CompleteEvent(eventID, userID string) error {
 	}
 
 	// Update event status to completed
-	now := time.Now()
+	now := s.clock.Now()
 	err = database.GetDB().Model(&event).Updates(map[string]interface{}{
 		"status":     models.EventStatusCompleted,
 		"updated_at": now,
@@ -1077,6 +1640,10 @@ func (s *EventService) CompleteEvent(eventID, userID string) error {
 	// Log event completion
 	s.auditLogger.LogEventComplete(&userID, eventID)
 
+	// Notify integrations
+	event.Status = models.EventStatusCompleted
+	s.dispatchWebhook(models.WebhookEventCompleted, event, &userID)
+
 	// Create history records for all confirmed members
 	var confirmedMembers []models.EventMember
 	err = database.GetDB().Where("event_id = ? AND status = ?", eventUUID, models.MemberStatusConfirmed).Find(&confirmedMembers).Error
@@ -1085,12 +1652,14 @@ func (s *EventService) CompleteEvent(eventID, userID string) error {
 	}
 
 	// Create history record for each confirmed member
+	checkinsUsed := anyCheckedIn(confirmedMembers)
 	for _, member := range confirmedMembers {
 		history := &models.UserEventHistory{
 			UserID:      member.UserID,
 			EventID:     eventUUID,
 			Completed:   true,
 			CompletedAt: &now,
+			Attended:    memberAttended(member, checkinsUsed),
 		}
 
 		err = database.GetDB().Create(history).Error
@@ -1105,7 +1674,7 @@ func (s *EventService) CompleteEvent(eventID, userID string) error {
 
 // AutoCompleteExpiredEvents automatically completes events that have passed their end date
 func (s *EventService) AutoCompleteExpiredEvents() error {
-	now := time.Now()
+	now := s.clock.Now()
 
 	// Get all published events that have passed their end date
 	var expiredEvents []models.Event
@@ -1139,28 +1708,283 @@ func (s *EventService) autoCompleteEvent(event models.Event) error {
 	eventID := event.ID.String()
 
 	// Update event status to completed
-	now := time.Now()
-	err := database.GetDB().Model(&event).Updates(map[string]interface{}{
-		"status":     models.EventStatusCompleted,
-		"updated_at": now,
-	}).Error
+	err := s.markEventCompleted(&event)
 	if err != nil {
-		return fmt.Errorf("failed to complete event: %w", err)
+		return err
 	}
 
 	// Log event completion (use system as actor)
 	systemUserID := "system"
 	s.auditLogger.LogEventComplete(&systemUserID, eventID)
 
+	// Notify integrations
+	s.dispatchWebhook(models.WebhookEventCompleted, event, &systemUserID)
+
+	// Create history records and notify members
+	s.finalizeCompletedEvent(event)
+
+	return nil
+}
+
+// AdminCompleteEvent completes an event on behalf of an admin (bypasses creator check)
+func (s *EventService) AdminCompleteEvent(eventID, adminID string, reason *string) error {
+	event, err := s.getEventForAdmin(eventID)
+	if err != nil {
+		return err
+	}
+
+	if event.IsCompleted() {
+		return fmt.Errorf("event already completed")
+	}
+	// A cancelled event never happened, so there is nothing to complete
+	if event.IsCancelled() {
+		return fmt.Errorf("event already cancelled")
+	}
+
+	before := map[string]interface{}{"status": event.Status}
+
+	// Update event status to completed
+	err = s.markEventCompleted(event)
+	if err != nil {
+		return err
+	}
+
+	// Log admin override
+	s.auditLogger.LogAction(&adminID, "events", &eventID, "ADMIN_COMPLETE", before, map[string]interface{}{
+		"status":   event.Status,
+		"admin_id": adminID,
+		"reason":   reason,
+	})
+
+	// Notify integrations
+	s.dispatchWebhook(models.WebhookEventCompleted, *event, &adminID)
+
+	// Create history records and notify members
+	s.finalizeCompletedEvent(*event)
+
+	return nil
+}
+
+// CancelEvent cancels an event on behalf of its creator
+func (s *EventService) CancelEvent(eventID, userID string, reason *string) error {
+	event, err := s.getCreatorEvent(userID, eventID)
+	if err != nil {
+		return err
+	}
+
+	return s.cancelEvent(event, userID, reason, "CANCEL", false)
+}
+
+// AdminCancelEvent cancels an event on behalf of an admin (bypasses creator check)
+func (s *EventService) AdminCancelEvent(eventID, adminID string, reason *string) error {
+	event, err := s.getEventForAdmin(eventID)
+	if err != nil {
+		return err
+	}
+
+	return s.cancelEvent(event, adminID, reason, "ADMIN_CANCEL", false)
+}
+
+// cancelEvent marks an event cancelled with its reason, releases pending members, and with
+// releaseConfirmed also the confirmed participants, then notifies members and downstream integrations
+func (s *EventService) cancelEvent(event *models.Event, actorID string, reason *string, auditAction string, releaseConfirmed bool) error {
+	if event.IsCompleted() {
+		return fmt.Errorf("event already completed")
+	}
+	if event.IsCancelled() {
+		return fmt.Errorf("event already cancelled")
+	}
+	if reason != nil {
+		trimmed := strings.TrimSpace(*reason)
+		reason = &trimmed
+		if trimmed == "" {
+			reason = nil
+		}
+	}
+
+	before := map[string]interface{}{"status": event.Status}
+	now := s.clock.Now()
+
+	// Everyone confirmed at the time of cancelling is told, including members released below
+	var notifyIDs []uuid.UUID
+	err := database.GetDB().Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(event).Updates(map[string]interface{}{
+			"status":              models.EventStatusCancelled,
+			"cancellation_reason": reason,
+			"cancelled_at":        now,
+			"updated_at":          now,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("failed to cancel event: %w", err)
+		}
+
+		// Pending requests can no longer be accepted
+		err = tx.Model(&models.EventMember{}).
+			Where("event_id = ? AND status = ?", event.ID, models.MemberStatusPending).
+			Update("status", models.MemberStatusDeclined).Error
+		if err != nil {
+			return fmt.Errorf("failed to release pending members: %w", err)
+		}
+
+		err = tx.Model(&models.EventMember{}).
+			Where("event_id = ? AND status = ?", event.ID, models.MemberStatusConfirmed).
+			Pluck("user_id", &notifyIDs).Error
+		if err != nil {
+			return fmt.Errorf("failed to get confirmed members: %w", err)
+		}
+		if !releaseConfirmed {
+			return nil
+		}
+
+		// The creator stays confirmed; participants get their time back
+		result := tx.Model(&models.EventMember{}).
+			Where("event_id = ? AND status = ? AND role = ?", event.ID, models.MemberStatusConfirmed, models.MemberRoleParticipant).
+			Updates(map[string]interface{}{
+				"status":  models.MemberStatusLeft,
+				"left_at": now,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to release confirmed members: %w", result.Error)
+		}
+		if result.RowsAffected > 0 {
+			return releaseConfirmedSeats(tx, event.ID, result.RowsAffected)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	event.Status = models.EventStatusCancelled
+	event.CancelReason = reason
+	event.CancelledAt = &now
+
+	eventID := event.ID.String()
+	s.auditLogger.LogAction(&actorID, "events", &eventID, auditAction, before, map[string]interface{}{
+		"status":   event.Status,
+		"actor_id": actorID,
+		"reason":   reason,
+	})
+
+	// Notify integrations, e.g. to refund paid events
+	s.dispatchWebhook(models.WebhookEventCancelled, *event, &actorID)
+
+	// Send cancellation notification to all confirmed members
+	notificationService := NewNotificationService()
+	err = notificationService.SendEventCancelledNotification(eventID, notifyIDs)
+	if err != nil {
+		log.Printf("Failed to send cancellation notification for event %s: %v", eventID, err)
+	}
+
+	return nil
+}
+
+// AdminPurgeEvent permanently deletes an event and its stored images.
+// Soft-deleted events can also be purged.
+func (s *EventService) AdminPurgeEvent(eventID, adminID string) error {
+	// Parse event ID
+	eventUUID, err := uuid.Parse(eventID)
+	if err != nil {
+		return fmt.Errorf("invalid event ID")
+	}
+
+	var event models.Event
+	err = database.GetDB().Where("id = ?", eventUUID).First(&event).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return fmt.Errorf("event not found")
+		}
+		return fmt.Errorf("failed to get event: %w", err)
+	}
+
+	var photos []models.EventPhoto
+	err = database.GetDB().Where("event_id = ?", eventUUID).Find(&photos).Error
+	if err != nil {
+		return fmt.Errorf("failed to get event photos: %w", err)
+	}
+
+	// Delete rows first; related tables cascade from events
+	err = database.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("event_id = ?", eventUUID).Delete(&models.EventPhoto{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.Event{}, "id = ?", eventUUID).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to purge event: %w", err)
+	}
+
+	// Log admin purge
+	s.auditLogger.LogAction(&adminID, "events", &eventID, "ADMIN_PURGE", map[string]interface{}{
+		"title":  event.Title,
+		"status": event.Status,
+		"photos": len(photos),
+	}, nil)
+
+	// Remove stored images without blocking on storage
+	urls := make([]string, 0, len(photos)+1)
+	if event.CoverImageURL != nil {
+		urls = append(urls, *event.CoverImageURL)
+	}
+	for _, photo := range photos {
+		urls = append(urls, photo.URL)
+	}
+	s.storageCleanup.RemoveObjects(urls)
+
+	return nil
+}
+
+// getEventForAdmin loads a non-deleted event without ownership checks
+func (s *EventService) getEventForAdmin(eventID string) (*models.Event, error) {
+	// Parse event ID
+	eventUUID, err := uuid.Parse(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event ID")
+	}
+
+	var event models.Event
+	err = database.GetDB().Where("id = ? AND deleted_at IS NULL", eventUUID).First(&event).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("event not found")
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	return &event, nil
+}
+
+// markEventCompleted sets the event status to completed
+func (s *EventService) markEventCompleted(event *models.Event) error {
+	err := database.GetDB().Model(event).Updates(map[string]interface{}{
+		"status":     models.EventStatusCompleted,
+		"updated_at": s.clock.Now(),
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to complete event: %w", err)
+	}
+	event.Status = models.EventStatusCompleted
+
+	return nil
+}
+
+// finalizeCompletedEvent records history for confirmed members and notifies them
+func (s *EventService) finalizeCompletedEvent(event models.Event) {
+	eventID := event.ID.String()
+	now := s.clock.Now()
+
 	// Create history records for all confirmed members
 	var confirmedMembers []models.EventMember
-	err = database.GetDB().Where("event_id = ? AND status = ?", event.ID, models.MemberStatusConfirmed).Find(&confirmedMembers).Error
+	err := database.GetDB().Where("event_id = ? AND status = ?", event.ID, models.MemberStatusConfirmed).Find(&confirmedMembers).Error
 	if err != nil {
-		return fmt.Errorf("failed to get confirmed members: %w", err)
+		log.Printf("Failed to get confirmed members for event %s: %v", eventID, err)
+		return
 	}
 
 	// Create history record for each confirmed member
+	checkinsUsed := anyCheckedIn(confirmedMembers)
 	for _, member := range confirmedMembers {
+		attended := memberAttended(member, checkinsUsed)
+
 		// Check if history already exists
 		var existingHistory models.UserEventHistory
 		err = database.GetDB().Where("event_id = ? AND user_id = ?", event.ID, member.UserID).First(&existingHistory).Error
@@ -1169,6 +1993,7 @@ func (s *EventService) autoCompleteEvent(event models.Event) error {
 			err = database.GetDB().Model(&existingHistory).Updates(map[string]interface{}{
 				"completed":    true,
 				"completed_at": now,
+				"attended":     attended,
 			}).Error
 			if err != nil {
 				log.Printf("Failed to update history for user %s: %v", member.UserID, err)
@@ -1180,6 +2005,7 @@ func (s *EventService) autoCompleteEvent(event models.Event) error {
 				EventID:     event.ID,
 				Completed:   true,
 				CompletedAt: &now,
+				Attended:    attended,
 			}
 
 			err = database.GetDB().Create(history).Error
@@ -1197,8 +2023,22 @@ func (s *EventService) autoCompleteEvent(event models.Event) error {
 	if err != nil {
 		log.Printf("Failed to send completion notification for event %s: %v", eventID, err)
 	}
+}
 
-	return nil
+// anyCheckedIn reports whether any member checked in at the event
+func anyCheckedIn(members []models.EventMember) bool {
+	for _, member := range members {
+		if member.CheckedInAt != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// memberAttended decides whether a confirmed member attended a completed event.
+// Once check-in is used at an event it is the attendance record; otherwise every confirmed member counts.
+func memberAttended(member models.EventMember, checkinsUsed bool) bool {
+	return !checkinsUsed || member.CheckedInAt != nil
 }
 
 // GetEventSuggestions gets event suggestions based on user interests
@@ -1210,53 +2050,1029 @@ func (s *EventService) GetEventSuggestions(userID string, page, limit int) ([]dt
 	return tagService.GetEventSuggestions(userID, page, limit)
 }
 
-// UpdateCoverImageURL sets the cover image URL; only creator can update.
-func (s *EventService) UpdateCoverImageURL(userID string, eventID string, url *string) error {
-	uid, err := uuid.Parse(userID)
+// GetEventMatch explains the user's match score for one event
+func (s *EventService) GetEventMatch(userID, eventID string) (*dto.EventMatchResponse, error) {
+	return NewTagService().GetEventMatch(userID, eventID)
+}
+
+// GetEventCompatibility reports how well the user fits the event's confirmed members
+func (s *EventService) GetEventCompatibility(userID, eventID string) (*dto.EventCompatibilityResponse, error) {
+	return NewTagService().GetEventCompatibility(userID, eventID)
+}
+
+// trendingWindow is how far back activity counts towards trending
+const trendingWindow = 7 * 24 * time.Hour
+
+// GetUpcomingJoinedEvents returns published events the user has joined (pending or confirmed)
+// that have not started yet, soonest first. Events without a start time come last.
+func (s *EventService) GetUpcomingJoinedEvents(userID string, page, limit int) ([]dto.EventResponse, int64, error) {
+	userUUID, err := uuid.Parse(userID)
 	if err != nil {
-		return fmt.Errorf("invalid user id")
+		return nil, 0, fmt.Errorf("invalid user ID")
 	}
-	eid, err := uuid.Parse(eventID)
+
+	db := database.GetDB()
+	joined := db.Model(&models.EventMember{}).Select("event_id").
+		Where("user_id = ? AND status IN ?", userUUID, []models.MemberStatus{models.MemberStatusPending, models.MemberStatusConfirmed})
+	query := db.Model(&models.Event{}).
+		Where("deleted_at IS NULL AND status = ?", models.EventStatusPublished).
+		Where("id IN (?)", joined).
+		Where("start_at IS NULL OR start_at >= ?", s.clock.Now())
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count upcoming events: %w", err)
+	}
+
+	var ids []uuid.UUID
+	err = query.Order("start_at IS NULL").Order("start_at ASC").Order("id ASC").
+		Offset((page-1)*limit).Limit(limit).Pluck("id", &ids).Error
 	if err != nil {
-		return fmt.Errorf("invalid event id")
+		return nil, 0, fmt.Errorf("failed to get upcoming events: %w", err)
 	}
 
-	db := database.GetDB()
-	var ev models.Event
-	if err := db.Where("id = ?", eid).First(&ev).Error; err != nil {
-		return err
+	events, err := loadEventsInOrder(ids, userID)
+	if err != nil {
+		return nil, 0, err
 	}
-	if ev.CreatorID != uid {
-		return fmt.Errorf("permission denied")
+
+	responses := make([]dto.EventResponse, len(events))
+	for i, event := range events {
+		responses[i] = s.convertEventToResponse(event, userID)
 	}
-	ev.CoverImageURL = url
-	return db.Save(&ev).Error
+	return responses, total, nil
 }
 
-// AppendEventPhotos appends photo URLs to event_photos in order.
-func (s *EventService) AppendEventPhotos(userID string, eventID string, urls []string) error {
-	uid, err := uuid.Parse(userID)
+// GetEventsByCreator returns the published events a user created that have not started yet,
+// soonest first, for their public profile. Cancelled, completed and deleted events are left out.
+// Events without a start time come last.
+func (s *EventService) GetEventsByCreator(creatorID, viewerID string, page, limit int) ([]dto.EventResponse, int64, error) {
+	creatorUUID, err := uuid.Parse(creatorID)
 	if err != nil {
-		return fmt.Errorf("invalid user id")
+		return nil, 0, fmt.Errorf("invalid user ID")
 	}
-	eid, err := uuid.Parse(eventID)
+
+	db := database.GetDB()
+	var creator models.User
+	if err := db.Where("id = ? AND deleted_at IS NULL", creatorUUID).First(&creator).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, 0, fmt.Errorf("user not found")
+		}
+		return nil, 0, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	query := db.Model(&models.Event{}).
+		Where("creator_id = ? AND deleted_at IS NULL AND status = ?", creatorUUID, models.EventStatusPublished).
+		Where("start_at IS NULL OR start_at >= ?", s.clock.Now())
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count creator events: %w", err)
+	}
+
+	var ids []uuid.UUID
+	err = query.Order("start_at IS NULL").Order("start_at ASC").Order("id ASC").
+		Offset((page-1)*limit).Limit(limit).Pluck("id", &ids).Error
 	if err != nil {
-		return fmt.Errorf("invalid event id")
+		return nil, 0, fmt.Errorf("failed to get creator events: %w", err)
 	}
 
-	db := database.GetDB()
-	var ev models.Event
-	if err := db.Where("id = ?", eid).First(&ev).Error; err != nil {
-		return err
+	events, err := loadEventsInOrder(ids, viewerID)
+	if err != nil {
+		return nil, 0, err
 	}
-	if ev.CreatorID != uid {
-		return fmt.Errorf("permission denied")
+
+	responses := make([]dto.EventResponse, len(events))
+	for i, event := range events {
+		responses[i] = s.convertEventToResponse(event, viewerID)
 	}
+	return responses, total, nil
+}
 
-	photos := make([]models.EventPhoto, 0, len(urls))
-	for i, u := range urls {
-		order := i
-		photos = append(photos, models.EventPhoto{EventID: eid, URL: u, SortNo: &order})
+// GetCreatedEvents gets the events the user created, newest first, optionally filtered by event status.
+// Unlike GetJoinedEvents it leaves out events the user only joined.
+func (s *EventService) GetCreatedEvents(userID string, page, limit int, status string) ([]dto.EventResponse, int64, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid user ID")
 	}
-	return db.Create(&photos).Error
+
+	query := database.GetDB().Model(&models.Event{}).
+		Where("creator_id = ? AND deleted_at IS NULL", userUUID)
+	if status != "" {
+		if !isEventStatus(status) {
+			return nil, 0, fmt.Errorf("invalid status")
+		}
+		query = query.Where("status = ?", status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count created events: %w", err)
+	}
+
+	var ids []uuid.UUID
+	err = query.Order("created_at DESC").Order("id ASC").
+		Offset((page-1)*limit).Limit(limit).Pluck("id", &ids).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get created events: %w", err)
+	}
+
+	events, err := loadEventsInOrder(ids, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	responses := make([]dto.EventResponse, len(events))
+	for i, event := range events {
+		responses[i] = s.convertEventToResponse(event, userID)
+	}
+	return responses, total, nil
+}
+
+// isEventStatus reports whether status names an event status
+func isEventStatus(status string) bool {
+	for _, known := range models.EventStatuses {
+		if string(known) == status {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTrendingEvents returns published events that have not ended, ranked by recent activity
+// (likes and confirmed participant joins in the last 7 days). Events the user has joined are excluded.
+func (s *EventService) GetTrendingEvents(userID string, page, limit int) ([]dto.EventResponse, int64, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid user ID")
+	}
+
+	db := database.GetDB()
+	since := s.clock.Now().Add(-trendingWindow)
+	joined := db.Model(&models.EventMember{}).Select("event_id").
+		Where("user_id = ? AND status IN ?", userUUID, []models.MemberStatus{models.MemberStatusPending, models.MemberStatusConfirmed})
+	activity := db.Model(&models.Event{}).
+		Select(`events.id, events.created_at,
+			(SELECT COUNT(*) FROM event_swipes WHERE event_swipes.event_id = events.id AND event_swipes.direction = ? AND event_swipes.created_at > ?) +
+			(SELECT COUNT(*) FROM event_members WHERE event_members.event_id = events.id AND event_members.role <> ? AND event_members.status = ? AND event_members.joined_at > ?) AS activity`,
+			models.SwipeDirectionLike, since, models.MemberRoleCreator, models.MemberStatusConfirmed, since).
+		Where("events.deleted_at IS NULL AND events.status = ?", models.EventStatusPublished).
+		Where("events.end_at IS NULL OR events.end_at >= ?", s.clock.Now()).
+		Where("events.id NOT IN (?)", joined)
+	query := db.Table("(?) AS trending", activity).Where("activity > 0")
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count trending events: %w", err)
+	}
+
+	var ids []uuid.UUID
+	err = query.Order("activity DESC").Order("created_at DESC").Order("id ASC").
+		Offset((page-1)*limit).Limit(limit).Pluck("id", &ids).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get trending events: %w", err)
+	}
+
+	events, err := loadEventsInOrder(ids, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	responses := make([]dto.EventResponse, len(events))
+	for i, event := range events {
+		responses[i] = s.convertEventToResponse(event, userID)
+	}
+	return responses, total, nil
+}
+
+// viewerSwipeScope limits a Swipes preload to the viewer's own swipe, the only one a response
+// shows. Popular events have thousands of swipes, so loading them all per page is wasteful.
+func viewerSwipeScope(viewerID string) func(db *gorm.DB) *gorm.DB {
+	viewerUUID, err := uuid.Parse(viewerID)
+	return func(db *gorm.DB) *gorm.DB {
+		if err != nil {
+			// Anonymous viewers have no swipe
+			return db.Where("1 = 0")
+		}
+		return db.Where("user_id = ?", viewerUUID)
+	}
+}
+
+// loadEventsInOrder loads events with their details in one batch, keeping the order of ids.
+// Only the viewer's swipe is loaded.
+func loadEventsInOrder(ids []uuid.UUID, viewerID string) ([]models.Event, error) {
+	if len(ids) == 0 {
+		return []models.Event{}, nil
+	}
+
+	var events []models.Event
+	err := database.GetDB().
+		Preload("Creator").
+		Preload("Photos").
+		Preload("Categories.Tag").
+		Preload("Tags.Tag").
+		Preload("Interests.Interest").
+		Preload("Members", func(db *gorm.DB) *gorm.DB {
+			return db.Preload("User").Preload("User.Profile")
+		}).
+		Preload("Swipes", viewerSwipeScope(viewerID)).
+		Where("id IN ?", ids).
+		Find(&events).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to load events: %w", err)
+	}
+
+	byID := make(map[uuid.UUID]models.Event, len(events))
+	for _, event := range events {
+		byID[event.ID] = event
+	}
+	ordered := make([]models.Event, 0, len(ids))
+	for _, id := range ids {
+		if event, ok := byID[id]; ok {
+			ordered = append(ordered, event)
+		}
+	}
+	return ordered, nil
+}
+
+// Similar event scoring weights (sum to 100)
+const (
+	similarTagWeight      = 40.0
+	similarCategoryWeight = 25.0
+	similarTypeWeight     = 15.0
+	similarDistanceWeight = 10.0
+	similarTimeWeight     = 10.0
+
+	// similarMaxDistanceMeters is the distance beyond which location adds nothing
+	similarMaxDistanceMeters = 50000.0
+	// similarMaxTimeGap is the start time gap beyond which timing adds nothing
+	similarMaxTimeGap = 14 * 24 * time.Hour
+	// similarCandidateLimit caps how many candidates are scored per request
+	similarCandidateLimit = 200
+)
+
+// GetSimilarEvents returns published events similar to the given one, best match first.
+// Events the user has already joined are excluded.
+func (s *EventService) GetSimilarEvents(eventID, userID string, page, limit int) ([]dto.EventSuggestionItem, int64, error) {
+	eventUUID, err := uuid.Parse(eventID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid event ID")
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid user ID")
+	}
+
+	var source models.Event
+	err = database.GetDB().
+		Preload("Tags.Tag").
+		Preload("Categories").
+		Where("id = ? AND deleted_at IS NULL", eventUUID).
+		First(&source).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, 0, fmt.Errorf("event not found")
+		}
+		return nil, 0, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	tagIDs := make([]uuid.UUID, 0, len(source.Tags))
+	for _, tag := range source.Tags {
+		tagIDs = append(tagIDs, tag.TagID)
+	}
+	categoryIDs := make([]uuid.UUID, 0, len(source.Categories))
+	for _, category := range source.Categories {
+		categoryIDs = append(categoryIDs, category.TagID)
+	}
+
+	// Only events sharing a tag, category or type can score, so narrow candidates in the database
+	db := database.GetDB()
+	related := db.Where("event_type = ?", source.EventType)
+	if len(tagIDs) > 0 {
+		related = related.Or("id IN (?)", db.Model(&models.EventTag{}).Select("event_id").Where("tag_id IN ?", tagIDs))
+	}
+	if len(categoryIDs) > 0 {
+		related = related.Or("id IN (?)", db.Model(&models.EventCategory{}).Select("event_id").Where("tag_id IN ?", categoryIDs))
+	}
+
+	joined := db.Model(&models.EventMember{}).Select("event_id").
+		Where("user_id = ? AND status IN ?", userUUID, []models.MemberStatus{models.MemberStatusPending, models.MemberStatusConfirmed})
+
+	var candidates []models.Event
+	err = db.
+		Preload("Tags.Tag").
+		Preload("Categories").
+		Where("deleted_at IS NULL AND status = ? AND id <> ?", models.EventStatusPublished, eventUUID).
+		Where("id NOT IN (?)", joined).
+		Where(related).
+		Order("created_at DESC").
+		Limit(similarCandidateLimit).
+		Find(&candidates).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get candidate events: %w", err)
+	}
+
+	type scoredEvent struct {
+		event       models.Event
+		score       float64
+		matchedTags []dto.TagResponse
+	}
+
+	scored := make([]scoredEvent, 0, len(candidates))
+	for _, candidate := range candidates {
+		score, matchedTags := similarityScore(source, candidate)
+		if score <= 0 {
+			continue
+		}
+		scored = append(scored, scoredEvent{event: candidate, score: score, matchedTags: matchedTags})
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		return scored[i].score > scored[j].score
+	})
+
+	// Apply pagination
+	total := int64(len(scored))
+	offset := (page - 1) * limit
+	if offset >= len(scored) {
+		return []dto.EventSuggestionItem{}, total, nil
+	}
+	end := offset + limit
+	if end > len(scored) {
+		end = len(scored)
+	}
+	scored = scored[offset:end]
+
+	// Load the full details only for the page being returned
+	pageIDs := make([]uuid.UUID, len(scored))
+	for i, item := range scored {
+		pageIDs[i] = item.event.ID
+	}
+	var pageEvents []models.Event
+	err = db.
+		Preload("Creator").
+		Preload("Photos").
+		Preload("Categories.Tag").
+		Preload("Tags.Tag").
+		Preload("Interests.Interest").
+		Preload("Members", func(db *gorm.DB) *gorm.DB {
+			return db.Preload("User").Preload("User.Profile")
+		}).
+		Preload("Swipes", viewerSwipeScope(userID)).
+		Where("id IN ?", pageIDs).
+		Find(&pageEvents).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to load events: %w", err)
+	}
+	byID := make(map[uuid.UUID]models.Event, len(pageEvents))
+	for _, event := range pageEvents {
+		byID[event.ID] = event
+	}
+
+	items := make([]dto.EventSuggestionItem, 0, len(scored))
+	for _, item := range scored {
+		event, ok := byID[item.event.ID]
+		if !ok {
+			continue
+		}
+		items = append(items, dto.EventSuggestionItem{
+			Event:       s.convertEventToResponse(event, userID),
+			MatchScore:  math.Round(item.score*100) / 100,
+			MatchedTags: item.matchedTags,
+		})
+	}
+
+	return items, total, nil
+}
+
+// similarityScore scores how similar candidate is to source (0-100) and returns the shared tags
+func similarityScore(source, candidate models.Event) (float64, []dto.TagResponse) {
+	var score float64
+
+	// Tag overlap (Jaccard)
+	sourceTags := make(map[uuid.UUID]bool, len(source.Tags))
+	for _, tag := range source.Tags {
+		sourceTags[tag.TagID] = true
+	}
+	var matchedTags []dto.TagResponse
+	for _, tag := range candidate.Tags {
+		if sourceTags[tag.TagID] && tag.Tag != nil {
+			matchedTags = append(matchedTags, dto.TagResponse{
+				ID:        tag.Tag.ID.String(),
+				Name:      tag.Tag.Name,
+				Kind:      tag.Tag.Kind,
+				CreatedAt: tag.Tag.CreatedAt,
+			})
+		}
+	}
+	if union := len(source.Tags) + len(candidate.Tags) - len(matchedTags); union > 0 {
+		score += similarTagWeight * float64(len(matchedTags)) / float64(union)
+	}
+
+	// Category overlap (Jaccard)
+	sourceCategories := make(map[uuid.UUID]bool, len(source.Categories))
+	for _, category := range source.Categories {
+		sourceCategories[category.TagID] = true
+	}
+	sharedCategories := 0
+	for _, category := range candidate.Categories {
+		if sourceCategories[category.TagID] {
+			sharedCategories++
+		}
+	}
+	if union := len(source.Categories) + len(candidate.Categories) - sharedCategories; union > 0 {
+		score += similarCategoryWeight * float64(sharedCategories) / float64(union)
+	}
+
+	if source.EventType == candidate.EventType {
+		score += similarTypeWeight
+	}
+
+	// Nearby events score higher
+	if source.Lat != nil && source.Lng != nil && candidate.Lat != nil && candidate.Lng != nil {
+		distance := utils.DistanceMeters(*source.Lat, *source.Lng, *candidate.Lat, *candidate.Lng)
+		if distance < similarMaxDistanceMeters {
+			score += similarDistanceWeight * (1 - distance/similarMaxDistanceMeters)
+		}
+	}
+
+	// Events around the same time score higher
+	if source.StartAt != nil && candidate.StartAt != nil {
+		gap := source.StartAt.Sub(*candidate.StartAt)
+		if gap < 0 {
+			gap = -gap
+		}
+		if gap < similarMaxTimeGap {
+			score += similarTimeWeight * (1 - float64(gap)/float64(similarMaxTimeGap))
+		}
+	}
+
+	return score, matchedTags
+}
+
+// UpdateCoverImageURL sets the cover image URL; only creator can update.
+func (s *EventService) UpdateCoverImageURL(userID string, eventID string, url *string) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user id")
+	}
+	eid, err := uuid.Parse(eventID)
+	if err != nil {
+		return fmt.Errorf("invalid event id")
+	}
+
+	db := database.GetDB()
+	var ev models.Event
+	if err := db.Where("id = ?", eid).First(&ev).Error; err != nil {
+		return err
+	}
+	if ev.CreatorID != uid {
+		return fmt.Errorf("permission denied")
+	}
+	previous := ev.CoverImageURL
+	ev.CoverImageURL = url
+	if err := db.Save(&ev).Error; err != nil {
+		return err
+	}
+
+	// Remove the replaced cover unless a gallery photo still uses it
+	if previous != nil && (url == nil || *previous != *url) {
+		var inUse int64
+		db.Model(&models.EventPhoto{}).Where("event_id = ? AND url = ?", eid, *previous).Count(&inUse)
+		if inUse == 0 {
+			s.storageCleanup.RemoveObjects([]string{*previous})
+		}
+	}
+	return nil
+}
+
+// SetCoverFromPhoto makes an existing gallery photo the event cover; only creator can update.
+// It returns the new cover URL.
+func (s *EventService) SetCoverFromPhoto(userID string, eventID string, photoID string) (string, error) {
+	ev, err := s.getCreatorEvent(userID, eventID)
+	if err != nil {
+		return "", err
+	}
+	pid, err := uuid.Parse(photoID)
+	if err != nil {
+		return "", fmt.Errorf("invalid photo id")
+	}
+
+	var photo models.EventPhoto
+	if err := database.GetDB().Where("id = ? AND event_id = ?", pid, ev.ID).First(&photo).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return "", fmt.Errorf("photo not found")
+		}
+		return "", fmt.Errorf("failed to get photo: %w", err)
+	}
+
+	url := photo.URL
+	if err := s.UpdateCoverImageURL(userID, eventID, &url); err != nil {
+		return "", err
+	}
+	return url, nil
+}
+
+// RemoveEventPhoto deletes a gallery photo and its stored image; only creator can remove.
+func (s *EventService) RemoveEventPhoto(userID string, eventID string, photoID string) error {
+	ev, err := s.getCreatorEvent(userID, eventID)
+	if err != nil {
+		return err
+	}
+	pid, err := uuid.Parse(photoID)
+	if err != nil {
+		return fmt.Errorf("invalid photo id")
+	}
+
+	db := database.GetDB()
+	var photo models.EventPhoto
+	if err := db.Where("id = ? AND event_id = ?", pid, ev.ID).First(&photo).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return fmt.Errorf("photo not found")
+		}
+		return fmt.Errorf("failed to get photo: %w", err)
+	}
+
+	if err := db.Delete(&photo).Error; err != nil {
+		return fmt.Errorf("failed to delete photo: %w", err)
+	}
+
+	// Keep the stored image if it is also the cover
+	if ev.CoverImageURL == nil || *ev.CoverImageURL != photo.URL {
+		s.storageCleanup.RemoveObjects([]string{photo.URL})
+	}
+
+	return nil
+}
+
+// TransferOwnership hands the event over to another confirmed member (creator only).
+// The previous creator stays on as a confirmed participant.
+func (s *EventService) TransferOwnership(eventID, userID, newCreatorID string) error {
+	ev, err := s.getCreatorEvent(userID, eventID)
+	if err != nil {
+		return err
+	}
+
+	newCreatorUUID, err := uuid.Parse(newCreatorID)
+	if err != nil {
+		return fmt.Errorf("invalid new creator id")
+	}
+	if newCreatorUUID == ev.CreatorID {
+		return fmt.Errorf("already the creator")
+	}
+	if !ev.IsPublished() {
+		return fmt.Errorf("event is not active")
+	}
+
+	var member models.EventMember
+	err = database.GetDB().Where("event_id = ? AND user_id = ? AND status = ?", ev.ID, newCreatorUUID, models.MemberStatusConfirmed).First(&member).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return fmt.Errorf("new creator must be a confirmed member")
+		}
+		return fmt.Errorf("failed to get member: %w", err)
+	}
+
+	previousCreatorID := ev.CreatorID
+	err = database.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Event{}).Where("id = ?", ev.ID).Updates(map[string]interface{}{
+			"creator_id": newCreatorUUID,
+			"updated_at": s.clock.Now(),
+		}).Error; err != nil {
+			return fmt.Errorf("failed to transfer ownership: %w", err)
+		}
+
+		if err := tx.Model(&models.EventMember{}).
+			Where("event_id = ? AND user_id = ?", ev.ID, previousCreatorID).
+			Update("role", models.MemberRoleParticipant).Error; err != nil {
+			return fmt.Errorf("failed to update previous creator: %w", err)
+		}
+
+		return AddCreatorMember(tx, ev.ID, newCreatorUUID)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.auditLogger.LogAction(&userID, "events", &eventID, "TRANSFER_OWNERSHIP",
+		map[string]string{"creator_id": previousCreatorID.String()},
+		map[string]string{"creator_id": newCreatorID})
+
+	notificationService := NewNotificationService()
+	if err := notificationService.SendEventOwnershipTransferredNotification(eventID, userID); err != nil {
+		log.Printf("Failed to send ownership transfer notification for event %s: %v", eventID, err)
+	}
+
+	return nil
+}
+
+const (
+	// broadcastWindow is the rolling period the broadcast limit applies to
+	broadcastWindow = 24 * time.Hour
+	// defaultBroadcastDailyLimit is used when no limit is configured
+	defaultBroadcastDailyLimit = 3
+)
+
+// BroadcastToMembers sends the creator's message as a notification to all confirmed members
+// and optionally posts it to the event chat as a system message (creator only).
+// Each event may broadcast at most the configured number of times per rolling 24 hours.
+func (s *EventService) BroadcastToMembers(eventID, userID string, req dto.BroadcastRequest) (*dto.BroadcastResponse, error) {
+	ev, err := s.getCreatorEvent(userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if !ev.IsPublished() {
+		return nil, fmt.Errorf("event is not active")
+	}
+
+	title := strings.TrimSpace(req.Title)
+	body := strings.TrimSpace(req.Body)
+	if title == "" || body == "" {
+		return nil, fmt.Errorf("title and body are required")
+	}
+
+	limit := broadcastDailyLimit()
+	var sentRecently int64
+	err = database.GetDB().Model(&models.EventBroadcast{}).
+		Where("event_id = ? AND created_at > ?", ev.ID, s.clock.Now().Add(-broadcastWindow)).
+		Count(&sentRecently).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to count broadcasts: %w", err)
+	}
+	if int(sentRecently) >= limit {
+		return nil, fmt.Errorf("broadcast limit reached")
+	}
+
+	broadcast := &models.EventBroadcast{
+		EventID:  ev.ID,
+		SenderID: ev.CreatorID,
+		Title:    title,
+		Body:     body,
+	}
+	var chatMessageID *string
+	err = database.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(broadcast).Error; err != nil {
+			return fmt.Errorf("failed to save broadcast: %w", err)
+		}
+		if !req.PostToChat {
+			return nil
+		}
+
+		var room models.ChatRoom
+		if err := tx.Where("event_id = ?", ev.ID).First(&room).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("chat room not found")
+			}
+			return fmt.Errorf("failed to get chat room: %w", err)
+		}
+
+		messageType := string(models.MessageTypeSystem)
+		messageBody := fmt.Sprintf("%s: %s", title, body)
+		message := &models.ChatMessage{
+			RoomID:      room.ID,
+			SenderID:    ev.CreatorID,
+			Body:        &messageBody,
+			MessageType: &messageType,
+		}
+		if err := tx.Create(message).Error; err != nil {
+			return fmt.Errorf("failed to post chat message: %w", err)
+		}
+		id := message.ID.String()
+		chatMessageID = &id
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notificationService := NewNotificationService()
+	recipients, err := notificationService.SendEventBroadcast(eventID, userID, title, body)
+	if err != nil {
+		log.Printf("Failed to send broadcast for event %s: %v", eventID, err)
+	}
+	if err := database.GetDB().Model(broadcast).Update("recipients", recipients).Error; err != nil {
+		log.Printf("Failed to record broadcast recipients for event %s: %v", eventID, err)
+	}
+
+	s.auditLogger.LogAction(&userID, "events", &eventID, "BROADCAST", nil,
+		map[string]interface{}{"title": title, "recipients": recipients, "post_to_chat": req.PostToChat})
+
+	return &dto.BroadcastResponse{
+		EventID:        eventID,
+		Recipients:     recipients,
+		ChatMessageID:  chatMessageID,
+		RemainingToday: limit - int(sentRecently) - 1,
+	}, nil
+}
+
+// eventsRequireCategory reports whether new events must have at least one category
+func eventsRequireCategory() bool {
+	return config.AppConfig != nil && config.AppConfig.Event.RequireCategory
+}
+
+// broadcastDailyLimit returns the configured number of broadcasts allowed per event per day
+func broadcastDailyLimit() int {
+	if config.AppConfig != nil && config.AppConfig.Broadcast.DailyLimit > 0 {
+		return config.AppConfig.Broadcast.DailyLimit
+	}
+	return defaultBroadcastDailyLimit
+}
+
+// eventSettingsResponse returns the effective settings of an event
+func eventSettingsResponse(settings models.EventSettings) dto.EventSettingsResponse {
+	return dto.EventSettingsResponse{
+		AllowMemberInvites: settings.MembersCanInvite(),
+		PendingChatAccess:  settings.PendingMembersCanChat(),
+		MembersVisible:     settings.MemberListVisible(),
+	}
+}
+
+// applyEventSettings copies the settings provided in the request onto the event
+func applyEventSettings(settings *models.EventSettings, req *dto.EventSettingsRequest) {
+	if req == nil {
+		return
+	}
+	if req.AllowMemberInvites != nil {
+		settings.AllowMemberInvites = req.AllowMemberInvites
+	}
+	if req.PendingChatAccess != nil {
+		settings.PendingChatAccess = req.PendingChatAccess
+	}
+	if req.MembersVisible != nil {
+		settings.MembersVisible = req.MembersVisible
+	}
+}
+
+// visibleMembers returns the members the viewer may see.
+// When the creator hides the member list, others only see the creator and themselves.
+func visibleMembers(event models.Event, viewerID string) []models.EventMember {
+	if event.Settings.MemberListVisible() || viewerID == event.CreatorID.String() {
+		return event.Members
+	}
+
+	members := make([]models.EventMember, 0, 2)
+	for _, member := range event.Members {
+		if member.UserID == event.CreatorID || member.UserID.String() == viewerID {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+// maxMemberNoteLength caps a member note, in characters
+const maxMemberNoteLength = 500
+
+// visibleMemberNote returns the member's note if the viewer may read it: the creator sees every
+// note and members see their own. Everyone else, including public viewers, gets nil.
+func visibleMemberNote(event models.Event, member models.EventMember, viewerID string) *string {
+	if viewerID == "" {
+		return nil
+	}
+	if viewerID == event.CreatorID.String() || viewerID == member.UserID.String() {
+		return member.Note
+	}
+	return nil
+}
+
+// SetMemberNote sets the note on the user's own membership, e.g. dietary needs.
+// An empty note clears it. Returns the stored note.
+func (s *EventService) SetMemberNote(eventID, userID string, note *string) (*string, error) {
+	access, err := LoadEventAccess(eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !access.IsMember() {
+		return nil, fmt.Errorf("member not found")
+	}
+
+	var stored *string
+	if note != nil {
+		trimmed := strings.TrimSpace(*note)
+		if utf8.RuneCountInString(trimmed) > maxMemberNoteLength {
+			return nil, fmt.Errorf("note is too long")
+		}
+		if trimmed != "" {
+			stored = &trimmed
+		}
+	}
+
+	err = database.GetDB().Model(&models.EventMember{}).
+		Where("event_id = ? AND user_id = ?", access.Event.ID, access.UserID).
+		Update("note", stored).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to update note: %w", err)
+	}
+	return stored, nil
+}
+
+// InviteToEvent sends an event invitation to another user.
+// The creator can always invite; confirmed participants only when the event allows member invites.
+func (s *EventService) InviteToEvent(eventID, userID, inviteeID string) error {
+	eventUUID, err := uuid.Parse(eventID)
+	if err != nil {
+		return fmt.Errorf("invalid event id")
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user id")
+	}
+	inviteeUUID, err := uuid.Parse(inviteeID)
+	if err != nil {
+		return fmt.Errorf("invalid invitee id")
+	}
+	if inviteeUUID == userUUID {
+		return fmt.Errorf("cannot invite yourself")
+	}
+
+	var event models.Event
+	err = database.GetDB().Where("id = ? AND deleted_at IS NULL", eventUUID).First(&event).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return fmt.Errorf("event not found")
+		}
+		return fmt.Errorf("database error: %w", err)
+	}
+
+	// Check membership first so outsiders learn nothing about the event's state
+	if event.CreatorID != userUUID {
+		var member models.EventMember
+		err = database.GetDB().Where("event_id = ? AND user_id = ? AND status = ?", eventUUID, userUUID, models.MemberStatusConfirmed).First(&member).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("not a member")
+			}
+			return fmt.Errorf("database error: %w", err)
+		}
+	}
+	if !event.IsPublished() {
+		return fmt.Errorf("event is not active")
+	}
+	if event.CreatorID != userUUID && !event.Settings.MembersCanInvite() {
+		return fmt.Errorf("invites are disabled")
+	}
+
+	var invitee models.User
+	err = database.GetDB().Where("id = ? AND deleted_at IS NULL", inviteeUUID).First(&invitee).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return fmt.Errorf("user not found")
+		}
+		return fmt.Errorf("database error: %w", err)
+	}
+
+	var existing int64
+	err = database.GetDB().Model(&models.EventMember{}).
+		Where("event_id = ? AND user_id = ? AND status IN ?", eventUUID, inviteeUUID,
+			[]models.MemberStatus{models.MemberStatusPending, models.MemberStatusConfirmed}).
+		Count(&existing).Error
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if existing > 0 {
+		return fmt.Errorf("already a member")
+	}
+
+	notificationService := NewNotificationService()
+	return notificationService.SendEventInvitationNotification(eventID, userID, inviteeID)
+}
+
+// attendeeCSVHeader lists the columns of the attendee export
+var attendeeCSVHeader = []string{"display_name", "role", "joined_at", "confirmed_at", "checked_in_at"}
+
+// ExportAttendeesCSV writes the confirmed attendees of the event as CSV (creator only).
+// Rows are streamed from the database so large events are not buffered in memory.
+// Contact details are not exported.
+func (s *EventService) ExportAttendeesCSV(eventID, userID string, w io.Writer) error {
+	ev, err := s.getCreatorEvent(userID, eventID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := database.GetDB().Table("event_members AS em").
+		Select("u.display_name, em.role, em.joined_at, em.confirmed_at, em.checked_in_at").
+		Joins("JOIN users u ON u.id = em.user_id").
+		Where("em.event_id = ? AND em.status = ? AND u.deleted_at IS NULL", ev.ID, models.MemberStatusConfirmed).
+		Order("em.joined_at ASC").
+		Rows()
+	if err != nil {
+		return fmt.Errorf("failed to get attendees: %w", err)
+	}
+	defer rows.Close()
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(attendeeCSVHeader); err != nil {
+		return err
+	}
+
+	for rows.Next() {
+		var (
+			displayName *string
+			role        string
+			joinedAt    time.Time
+			confirmedAt *time.Time
+			checkedInAt *time.Time
+		)
+		if err := rows.Scan(&displayName, &role, &joinedAt, &confirmedAt, &checkedInAt); err != nil {
+			return fmt.Errorf("failed to read attendee: %w", err)
+		}
+
+		name := "Unknown User"
+		if displayName != nil && *displayName != "" {
+			name = *displayName
+		}
+		record := []string{escapeCSVFormula(name), role, formatCSVTime(&joinedAt), formatCSVTime(confirmedAt), formatCSVTime(checkedInAt)}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read attendees: %w", err)
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// escapeCSVFormula prefixes a user-controlled cell with ' when a spreadsheet would read it as a
+// formula, so opening the export can't run e.g. =HYPERLINK(...)
+func escapeCSVFormula(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// formatCSVTime formats an optional time as RFC3339 in UTC
+func formatCSVTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// VerifyEventCreator checks that the user created the event.
+func (s *EventService) VerifyEventCreator(userID string, eventID string) error {
+	_, err := s.getCreatorEvent(userID, eventID)
+	return err
+}
+
+// getCreatorEvent loads an event and checks that the user created it.
+func (s *EventService) getCreatorEvent(userID string, eventID string) (*models.Event, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id")
+	}
+	eid, err := uuid.Parse(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event id")
+	}
+
+	var ev models.Event
+	if err := database.GetDB().Where("id = ?", eid).First(&ev).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("event not found")
+		}
+		return nil, err
+	}
+	if ev.CreatorID != uid {
+		return nil, fmt.Errorf("permission denied")
+	}
+	return &ev, nil
+}
+
+// AppendEventPhotos appends photo URLs to event_photos in order, after the event's existing photos.
+func (s *EventService) AppendEventPhotos(userID string, eventID string, urls []string) error {
+	ev, err := s.getCreatorEvent(userID, eventID)
+	if err != nil {
+		return err
+	}
+	if len(urls) == 0 {
+		return nil
+	}
+	eid := ev.ID
+
+	return database.GetDB().Transaction(func(tx *gorm.DB) error {
+		// Lock the event so concurrent appends do not read the same max sort index
+		var locked models.Event
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where("id = ?", eid).First(&locked).Error; err != nil {
+			return fmt.Errorf("failed to lock event: %w", err)
+		}
+
+		var maxSortNo *int
+		if err := tx.Model(&models.EventPhoto{}).Where("event_id = ?", eid).Select("MAX(sort_no)").Scan(&maxSortNo).Error; err != nil {
+			return fmt.Errorf("failed to get photo order: %w", err)
+		}
+		next := 0
+		if maxSortNo != nil {
+			next = *maxSortNo + 1
+		}
+
+		photos := make([]models.EventPhoto, 0, len(urls))
+		for i, u := range urls {
+			order := next + i
+			photos = append(photos, models.EventPhoto{EventID: eid, URL: u, SortNo: &order})
+		}
+		return tx.Create(&photos).Error
+	})
 }
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"TinderTrip-Backend/pkg/config"
+)
+
+// Used when EVENTS_TITLE_MAX_LENGTH / EVENTS_DESCRIPTION_MAX_LENGTH are not configured
+const (
+	defaultEventTitleMaxLength       = 150
+	defaultEventDescriptionMaxLength = 5000
+)
+
+// eventTitleMaxLength returns the configured max characters in an event title
+func eventTitleMaxLength() int {
+	if config.AppConfig != nil && config.AppConfig.Event.TitleMaxLength > 0 {
+		return config.AppConfig.Event.TitleMaxLength
+	}
+	return defaultEventTitleMaxLength
+}
+
+// eventDescriptionMaxLength returns the configured max characters in an event description
+func eventDescriptionMaxLength() int {
+	if config.AppConfig != nil && config.AppConfig.Event.DescriptionMaxLength > 0 {
+		return config.AppConfig.Event.DescriptionMaxLength
+	}
+	return defaultEventDescriptionMaxLength
+}
+
+// normalizeEventTitle cleans up a title and checks it against the length limit. Titles are one
+// line: control characters are dropped and runs of whitespace become a single space.
+func normalizeEventTitle(title string) (string, error) {
+	title = strings.Join(strings.Fields(stripControlChars(title, false)), " ")
+	if title == "" {
+		return "", fmt.Errorf("title is required")
+	}
+	if max := eventTitleMaxLength(); utf8.RuneCountInString(title) > max {
+		return "", fmt.Errorf("title must be at most %d characters", max)
+	}
+	return title, nil
+}
+
+// normalizeEventDescription cleans up a description and checks it against the length limit.
+// Line breaks and tabs are kept; other control characters are dropped. A description that is
+// empty once trimmed becomes nil.
+func normalizeEventDescription(description *string) (*string, error) {
+	if description == nil {
+		return nil, nil
+	}
+	cleaned := strings.ReplaceAll(*description, "\r\n", "\n")
+	cleaned = strings.TrimSpace(stripControlChars(cleaned, true))
+	if cleaned == "" {
+		return nil, nil
+	}
+	if max := eventDescriptionMaxLength(); utf8.RuneCountInString(cleaned) > max {
+		return nil, fmt.Errorf("description must be at most %d characters", max)
+	}
+	return &cleaned, nil
+}
+
+// stripControlChars removes control and invalid characters, keeping newlines and tabs when
+// multiline is set. Without multiline they become spaces so words stay apart.
+func stripControlChars(s string, multiline bool) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '\n' || r == '\t' || r == '\r':
+			if multiline && r != '\r' {
+				return r
+			}
+			return ' '
+		case r == utf8.RuneError, unicode.IsControl(r):
+			return -1
+		}
+		return r
+	}, s)
+}
@@ -7,12 +7,14 @@ import (
 	"fmt"
 	"io"
 	"mime"
+	"mime/multipart"
 	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"TinderTrip-Backend/internal/dto"
 	"TinderTrip-Backend/internal/service/storage"
 	"TinderTrip-Backend/internal/utils"
 
@@ -32,6 +34,11 @@ func NewFileService() (*FileService, error) {
 		return nil, fmt.Errorf("failed to initialize storage uploader: %w", err)
 	}
 
+	return NewFileServiceWithUploader(up)
+}
+
+// NewFileServiceWithUploader creates a file service backed by the given uploader
+func NewFileServiceWithUploader(up storage.Uploader) (*FileService, error) {
 	maxMB := int64(10)
 	if v := os.Getenv("MAX_UPLOAD_MB"); v != "" {
 		if _, err := fmt.Sscanf(v, "%d", &maxMB); err != nil {
@@ -66,6 +73,46 @@ func NewFileService() (*FileService, error) {
 	}, nil
 }
 
+// UploadImages uploads every file and reports a result per file.
+// A failed file does not stop the remaining uploads.
+func (s *FileService) UploadImages(ctx context.Context, folder string, files []*multipart.FileHeader) *dto.PhotoUploadResponse {
+	response := &dto.PhotoUploadResponse{
+		URLs:    []string{},
+		Results: make([]dto.PhotoUploadResult, 0, len(files)),
+	}
+
+	for _, f := range files {
+		result := dto.PhotoUploadResult{Filename: f.Filename}
+
+		url, err := s.uploadFileHeader(ctx, folder, f)
+		if err != nil {
+			msg := err.Error()
+			result.Error = &msg
+			response.Failed++
+		} else {
+			result.URL = &url
+			response.URLs = append(response.URLs, url)
+			response.Uploaded++
+		}
+
+		response.Results = append(response.Results, result)
+	}
+
+	return response
+}
+
+// uploadFileHeader opens a multipart file and uploads it as an image
+func (s *FileService) uploadFileHeader(ctx context.Context, folder string, f *multipart.FileHeader) (string, error) {
+	src, err := f.Open()
+	if err != nil {
+		return "", fmt.Errorf("invalid file")
+	}
+	defer src.Close()
+
+	_, url, _, _, _, err := s.UploadImage(ctx, folder, f.Filename, src)
+	return url, err
+}
+
 func detectContentType(head []byte) string {
 	return http.DetectContentType(head)
 }
@@ -90,6 +90,7 @@ func (s *FoodPreferenceService) UpdateFoodPreference(userID string, req dto.Upda
 	if err != nil {
 		return fmt.Errorf("failed to update food preference: %w", err)
 	}
+	InvalidatePreferenceProfile(userUUID)
 
 	return nil
 }
@@ -153,6 +154,7 @@ func (s *FoodPreferenceService) UpdateAllFoodPreferences(userID string, req dto.
 	if err != nil {
 		return fmt.Errorf("failed to commit food preferences update: %w", err)
 	}
+	InvalidatePreferenceProfile(userUUID)
 
 	return nil
 }
@@ -312,6 +314,7 @@ func (s *FoodPreferenceService) DeleteFoodPreference(userID, foodCategory string
 	if err != nil {
 		return fmt.Errorf("failed to delete food preference: %w", err)
 	}
+	InvalidatePreferenceProfile(userUUID)
 
 	return nil
 }
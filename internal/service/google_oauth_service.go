@@ -8,9 +8,11 @@ import (
 	"time"
 
 	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
 	"TinderTrip-Backend/pkg/config"
 	"TinderTrip-Backend/pkg/database"
 
+	"github.com/google/uuid"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 	"gorm.io/gorm"
@@ -18,9 +20,13 @@ import (
 
 // GoogleOAuthService handles Google OAuth authentication
 type GoogleOAuthService struct {
-	config *oauth2.Config
+	config      *oauth2.Config
+	userInfoURL string
 }
 
+// googleUserInfoURL is where the signed-in user's profile is fetched from
+const googleUserInfoURL = "https://www.googleapis.com/oauth2/v2/userinfo"
+
 // GoogleUserInfo represents the user info from Google
 type GoogleUserInfo struct {
 	ID            string `json:"id"`
@@ -58,10 +64,20 @@ func NewGoogleOAuthService() *GoogleOAuthService {
 	}
 
 	return &GoogleOAuthService{
-		config: config,
+		config:      config,
+		userInfoURL: googleUserInfoURL,
 	}
 }
 
+// NewGoogleOAuthServiceWithEndpoints creates a Google OAuth service that exchanges codes at
+// endpoint and fetches profiles from userInfoURL (for testing purposes)
+func NewGoogleOAuthServiceWithEndpoints(endpoint oauth2.Endpoint, userInfoURL string) *GoogleOAuthService {
+	s := NewGoogleOAuthService()
+	s.config.Endpoint = endpoint
+	s.userInfoURL = userInfoURL
+	return s
+}
+
 // GetAuthURL returns the Google OAuth authorization URL
 func (s *GoogleOAuthService) GetAuthURL(state string) string {
 	return s.config.AuthCodeURL(state, oauth2.AccessTypeOffline)
@@ -80,7 +96,7 @@ func (s *GoogleOAuthService) ExchangeCodeForToken(ctx context.Context, code stri
 func (s *GoogleOAuthService) GetUserInfo(ctx context.Context, token *oauth2.Token) (*GoogleUserInfo, error) {
 	client := s.config.Client(ctx, token)
 
-	resp, err := client.Get("https://www.googleapis.com/oauth2/v2/userinfo")
+	resp, err := client.Get(s.userInfoURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user info: %w", err)
 	}
@@ -111,30 +127,44 @@ func (s *GoogleOAuthService) CreateOrUpdateUser(ctx context.Context, userInfo *G
 			// User doesn't exist, create new user
 			isNewUser = true
 
-			// Check if display_name already exists
-			var existingDisplayName models.User
-			err = database.GetDB().Where("display_name = ? AND deleted_at IS NULL", userInfo.Name).First(&existingDisplayName).Error
-			if err == nil {
-				// Display name already taken, append Google ID suffix to make it unique
-				uniqueDisplayName := userInfo.Name + "_" + userInfo.ID[:8]
-				userInfo.Name = uniqueDisplayName
-			} else if err != gorm.ErrRecordNotFound {
-				return nil, false, fmt.Errorf("failed to check display name: %w", err)
+			if displayNameMode() == config.DisplayNameUnique {
+				// Check if display_name already exists
+				var existingDisplayName models.User
+				err = database.GetDB().Where("display_name = ? AND deleted_at IS NULL", userInfo.Name).First(&existingDisplayName).Error
+				if err == nil {
+					// Display name already taken, append Google ID suffix to make it unique
+					uniqueDisplayName := userInfo.Name + "_" + userInfo.ID[:8]
+					userInfo.Name = uniqueDisplayName
+				} else if err != gorm.ErrRecordNotFound {
+					return nil, false, fmt.Errorf("failed to check display name: %w", err)
+				}
+			} else {
+				userInfo.Name, err = resolveDisplayName(userInfo.Name, uuid.Nil)
+				if err != nil {
+					return nil, false, err
+				}
+			}
+			handle, err := newUserHandle(userInfo.Name)
+			if err != nil {
+				return nil, false, err
 			}
 
 			user = models.User{
-				Email:         &userInfo.Email,
-				Provider:      models.AuthProviderGoogle,
-				GoogleID:      &userInfo.ID,
-				DisplayName:   &userInfo.Name,
-				EmailVerified: true, // Google OAuth users are automatically verified
-				LastLoginAt:   &[]time.Time{time.Now()}[0],
+				Email:             &userInfo.Email,
+				Provider:          models.AuthProviderGoogle,
+				GoogleID:          &userInfo.ID,
+				DisplayName:       &userInfo.Name,
+				DisplayNameShared: displayNameShared(),
+				Handle:            handle,
+				EmailVerified:     true, // Google OAuth users are automatically verified
+				LastLoginAt:       &[]time.Time{time.Now()}[0],
 			}
 
 			err = database.GetDB().Create(&user).Error
 			if err != nil {
 				return nil, false, fmt.Errorf("failed to create user: %w", err)
 			}
+			queueSignupWelcomeEmail(&user, utils.RealClock{})
 		} else {
 			return nil, false, fmt.Errorf("failed to check user existence: %w", err)
 		}
@@ -158,6 +188,7 @@ func (s *GoogleOAuthService) CreateOrUpdateUser(ctx context.Context, userInfo *G
 				// Display name is available, update it
 				user.DisplayName = &userInfo.Name
 			}
+			user.DisplayNameShared = displayNameShared()
 		}
 
 		user.EmailVerified = true // Ensure Google OAuth users are always verified
@@ -175,7 +206,7 @@ func (s *GoogleOAuthService) CreateOrUpdateUser(ctx context.Context, userInfo *G
 func (s *GoogleOAuthService) ValidateToken(ctx context.Context, token *oauth2.Token) (bool, error) {
 	client := s.config.Client(ctx, token)
 
-	resp, err := client.Get("https://www.googleapis.com/oauth2/v2/userinfo")
+	resp, err := client.Get(s.userInfoURL)
 	if err != nil {
 		return false, fmt.Errorf("failed to validate token: %w", err)
 	}
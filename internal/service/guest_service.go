@@ -0,0 +1,227 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// defaultGuestExpireDays is used when GUEST_TOKEN_EXPIRE_DAYS is not configured
+const defaultGuestExpireDays = 30
+
+// GuestService handles guest browsing tokens
+type GuestService struct {
+	clock utils.Clock
+}
+
+// NewGuestService creates a new guest service
+func NewGuestService() *GuestService {
+	return NewGuestServiceWithClock(utils.RealClock{})
+}
+
+// NewGuestServiceWithClock creates a guest service that reads the time from clock
+func NewGuestServiceWithClock(clock utils.Clock) *GuestService {
+	return &GuestService{clock: clock}
+}
+
+// guestTokenLifetime returns how long a guest token stays valid
+func guestTokenLifetime() time.Duration {
+	days := defaultGuestExpireDays
+	if config.AppConfig != nil && config.AppConfig.JWT.GuestExpireDays > 0 {
+		days = config.AppConfig.JWT.GuestExpireDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// IssueGuestToken starts a guest session and returns its browsing token
+func (s *GuestService) IssueGuestToken() (*dto.GuestTokenResponse, error) {
+	now := s.clock.Now()
+	guest := models.GuestSession{
+		ID:         uuid.New(),
+		CreatedAt:  now,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(guestTokenLifetime()),
+	}
+	if err := database.GetDB().Create(&guest).Error; err != nil {
+		return nil, fmt.Errorf("failed to create guest session: %w", err)
+	}
+
+	token, err := utils.GenerateGuestToken(guest.ID.String(), guest.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GuestTokenResponse{
+		GuestToken: token,
+		ExpiresAt:  guest.ExpiresAt,
+	}, nil
+}
+
+// CleanupExpiredGuestSessions deletes guest sessions whose token has expired, with the events
+// they viewed. It returns how many sessions were deleted.
+func (s *GuestService) CleanupExpiredGuestSessions() (int64, error) {
+	var deleted int64
+	err := database.GetDB().Transaction(func(tx *gorm.DB) error {
+		expired := tx.Model(&models.GuestSession{}).Select("id").Where("expires_at < ?", s.clock.Now())
+		if err := tx.Where("guest_id IN (?)", expired).Delete(&models.GuestEventView{}).Error; err != nil {
+			return fmt.Errorf("failed to delete expired guest views: %w", err)
+		}
+		result := tx.Where("expires_at < ?", s.clock.Now()).Delete(&models.GuestSession{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete expired guest sessions: %w", result.Error)
+		}
+		deleted = result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return deleted, nil
+}
+
+// RecordGuestViews notes that the guest has seen the events. Converted or expired guests are
+// ignored, and failures are only logged so they never break public browsing.
+func (s *GuestService) RecordGuestViews(guestID string, eventIDs []string) {
+	guestUUID, err := uuid.Parse(guestID)
+	if err != nil || len(eventIDs) == 0 {
+		return
+	}
+
+	now := s.clock.Now()
+	result := database.GetDB().Model(&models.GuestSession{}).
+		Where("id = ? AND converted_at IS NULL AND expires_at > ?", guestUUID, now).
+		Update("last_seen_at", now)
+	if result.Error != nil {
+		utils.Logger().WithField("error", result.Error).Warn("Failed to update guest session")
+		return
+	}
+	if result.RowsAffected == 0 {
+		return
+	}
+
+	views := make([]models.GuestEventView, 0, len(eventIDs))
+	for _, eventID := range eventIDs {
+		eventUUID, err := uuid.Parse(eventID)
+		if err != nil {
+			continue
+		}
+		views = append(views, models.GuestEventView{
+			GuestID:     guestUUID,
+			EventID:     eventUUID,
+			FirstSeenAt: now,
+			LastSeenAt:  now,
+			SeenCount:   1,
+		})
+	}
+	if len(views) == 0 {
+		return
+	}
+
+	err = database.GetDB().Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "guest_id"}, {Name: "event_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"last_seen_at": gorm.Expr("excluded.last_seen_at"),
+			"seen_count":   gorm.Expr("guest_event_views.seen_count + 1"),
+		}),
+	}).Create(&views).Error
+	if err != nil {
+		utils.Logger().WithField("error", err).Warn("Failed to record guest views")
+	}
+}
+
+// ConvertGuest carries the guest's browsing over to the user, so events seen as a guest count
+// as served to them. A guest can only be converted once; converting again as the same user is
+// a no-op.
+func (s *GuestService) ConvertGuest(userID, guestToken string) (*dto.ConvertGuestResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID")
+	}
+
+	claims, err := utils.ValidateGuestToken(guestToken)
+	if err != nil {
+		return nil, fmt.Errorf("invalid guest token")
+	}
+	guestUUID, err := uuid.Parse(claims.GuestID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid guest token")
+	}
+
+	response := &dto.ConvertGuestResponse{}
+	now := s.clock.Now()
+	err = database.GetDB().Transaction(func(tx *gorm.DB) error {
+		var guest models.GuestSession
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", guestUUID).First(&guest).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("invalid guest token")
+			}
+			return fmt.Errorf("database error: %w", err)
+		}
+
+		if guest.IsConverted() {
+			if guest.ConvertedUserID != nil && *guest.ConvertedUserID == userUUID {
+				return nil
+			}
+			return fmt.Errorf("guest already converted")
+		}
+
+		var views []models.GuestEventView
+		if err := tx.Where("guest_id = ?", guestUUID).Find(&views).Error; err != nil {
+			return fmt.Errorf("database error: %w", err)
+		}
+
+		if len(views) > 0 {
+			impressions := make([]models.EventImpression, 0, len(views))
+			for _, view := range views {
+				impressions = append(impressions, models.EventImpression{
+					EventID:       view.EventID,
+					UserID:        userUUID,
+					FirstServedAt: view.FirstSeenAt,
+					LastServedAt:  view.LastSeenAt,
+					ServedCount:   view.SeenCount,
+				})
+			}
+			// Events the user was already served keep their times and add the guest's count
+			err = tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "event_id"}, {Name: "user_id"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					"served_count": gorm.Expr("event_impressions.served_count + excluded.served_count"),
+				}),
+			}).Create(&impressions).Error
+			if err != nil {
+				return fmt.Errorf("failed to carry over seen events: %w", err)
+			}
+
+			if err := tx.Where("guest_id = ?", guestUUID).Delete(&models.GuestEventView{}).Error; err != nil {
+				return fmt.Errorf("failed to clear guest views: %w", err)
+			}
+		}
+
+		err = tx.Model(&models.GuestSession{}).Where("id = ?", guestUUID).Updates(map[string]interface{}{
+			"converted_user_id": userUUID,
+			"converted_at":      now,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("failed to convert guest: %w", err)
+		}
+
+		response.SeenEvents = int64(len(views))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
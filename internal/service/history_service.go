@@ -265,6 +265,7 @@ func (s *HistoryService) convertHistoryToResponse(history models.UserEventHistor
 			StartAt:       history.Event.StartAt,
 			EndAt:         history.Event.EndAt,
 			Capacity:      history.Event.Capacity,
+			MinAttendees:  history.Event.MinAttendees,
 			BudgetMin:     history.Event.BudgetMin,
 			BudgetMax:     history.Event.BudgetMax,
 			Currency:      history.Event.Currency,
@@ -280,6 +281,7 @@ func (s *HistoryService) convertHistoryToResponse(history models.UserEventHistor
 				ID:          history.Event.Creator.ID.String(),
 				Email:       *history.Event.Creator.Email,
 				DisplayName: history.Event.Creator.GetDisplayName(),
+				Handle:      history.Event.Creator.GetHandle(),
 				Provider:    string(history.Event.Creator.Provider),
 				CreatedAt:   history.Event.Creator.CreatedAt,
 			}
@@ -310,6 +312,7 @@ func (s *HistoryService) convertHistoryToResponse(history models.UserEventHistor
 			ID:          history.User.ID.String(),
 			Email:       *history.User.Email,
 			DisplayName: history.User.GetDisplayName(),
+			Handle:      history.User.GetHandle(),
 			Provider:    string(history.User.Provider),
 			CreatedAt:   history.User.CreatedAt,
 		}
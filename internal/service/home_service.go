@@ -0,0 +1,101 @@
+package service
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"TinderTrip-Backend/internal/dto"
+)
+
+// homePageTokenPrefix marks a home feed page token
+const homePageTokenPrefix = "page:"
+
+// HomeService composes the home screen feed from the event services
+type HomeService struct {
+	eventService *EventService
+	tagService   *TagService
+}
+
+// NewHomeService creates a new home service
+func NewHomeService() *HomeService {
+	return &HomeService{
+		eventService: NewEventService(),
+		tagService:   NewTagService(),
+	}
+}
+
+// GetHomeFeed returns suggested, upcoming joined and trending events in one payload.
+// Each section returns up to limit items and its own token for the next page.
+func (s *HomeService) GetHomeFeed(userID string, limit int, tokens dto.HomeFeedTokens) (*dto.HomeFeedResponse, error) {
+	suggestionsPage, err := decodeHomePageToken(tokens.Suggestions)
+	if err != nil {
+		return nil, err
+	}
+	upcomingPage, err := decodeHomePageToken(tokens.Upcoming)
+	if err != nil {
+		return nil, err
+	}
+	trendingPage, err := decodeHomePageToken(tokens.Trending)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions, suggestionsTotal, err := s.tagService.GetEventSuggestions(userID, suggestionsPage, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get suggestions: %w", err)
+	}
+	upcoming, upcomingTotal, err := s.eventService.GetUpcomingJoinedEvents(userID, upcomingPage, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upcoming events: %w", err)
+	}
+	trending, trendingTotal, err := s.eventService.GetTrendingEvents(userID, trendingPage, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trending events: %w", err)
+	}
+
+	return &dto.HomeFeedResponse{
+		Suggestions: dto.HomeSuggestionSection{
+			Items:     suggestions,
+			Total:     suggestionsTotal,
+			NextToken: nextHomePageToken(suggestionsPage, limit, suggestionsTotal),
+		},
+		Upcoming: dto.HomeEventSection{
+			Items:     upcoming,
+			Total:     upcomingTotal,
+			NextToken: nextHomePageToken(upcomingPage, limit, upcomingTotal),
+		},
+		Trending: dto.HomeEventSection{
+			Items:     trending,
+			Total:     trendingTotal,
+			NextToken: nextHomePageToken(trendingPage, limit, trendingTotal),
+		},
+	}, nil
+}
+
+// nextHomePageToken returns the token for the page after page, or nil on the last page
+func nextHomePageToken(page, limit int, total int64) *string {
+	if int64(page*limit) >= total {
+		return nil
+	}
+	token := base64.RawURLEncoding.EncodeToString([]byte(homePageTokenPrefix + strconv.Itoa(page+1)))
+	return &token
+}
+
+// decodeHomePageToken returns the page a token points to; an empty token is the first page
+func decodeHomePageToken(token string) (int, error) {
+	if token == "" {
+		return 1, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || !strings.HasPrefix(string(raw), homePageTokenPrefix) {
+		return 0, fmt.Errorf("invalid page token")
+	}
+	page, err := strconv.Atoi(strings.TrimPrefix(string(raw), homePageTokenPrefix))
+	if err != nil || page < 1 {
+		return 0, fmt.Errorf("invalid page token")
+	}
+	return page, nil
+}
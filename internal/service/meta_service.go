@@ -0,0 +1,188 @@
+package service
+
+import (
+	"strings"
+	"sync"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+)
+
+// DefaultLocale is used when the client asks for no or an unsupported locale
+const DefaultLocale = "en"
+
+// enumLabels holds display labels per locale, enum and value.
+// Values without a label in a locale fall back to the default locale.
+var enumLabels = map[string]map[string]map[string]string{
+	"en": {
+		"event_types": {
+			"meal": "Meal", "one_day_trip": "Day Trip", "overnight": "Overnight Trip",
+		},
+		"event_statuses": {
+			"published": "Published", "cancelled": "Cancelled", "completed": "Completed",
+		},
+		"member_roles": {
+			"creator": "Creator", "participant": "Participant",
+		},
+		"member_statuses": {
+			"pending": "Pending", "confirmed": "Confirmed", "declined": "Declined",
+			"kicked": "Removed", "left": "Left",
+		},
+		"tag_kinds": {
+			"interest": "Interest", "category": "Category", "activity": "Activity", "location": "Location",
+			"food": "Food", "transport": "Transport", "accommodation": "Accommodation",
+		},
+		"genders": {
+			"male": "Male", "female": "Female", "nonbinary": "Non-binary", "prefer_not_say": "Prefer not to say",
+		},
+		"smoking_options": {
+			"no": "Non-smoker", "yes": "Smoker", "occasionally": "Occasionally",
+		},
+		"swipe_directions": {
+			"like": "Like", "pass": "Pass",
+		},
+		"travel_styles": {
+			"cafe_dessert": "Cafe & Dessert", "bubble_tea": "Bubble Tea", "bakery_cake": "Bakery / Cake",
+			"bingsu_ice_cream": "Bingsu / Ice Cream", "coffee": "Coffee", "matcha": "Matcha",
+			"pancakes": "Pancakes", "social_activity": "Social Activity", "karaoke": "Karaoke",
+			"gaming": "Gaming", "movie": "Movie", "board_game": "Board Game",
+			"outdoor_activity": "Outdoor Activity", "party_celebration": "Party / Celebration",
+			"swimming": "Swimming", "skateboarding": "Skateboarding",
+		},
+		"food_categories": {
+			"thai_food": "Thai Food", "japanese_food": "Japanese Food", "chinese_food": "Chinese Food",
+			"international_food": "International Food", "halal_food": "Halal Food",
+			"buffet": "Buffet", "bbq_grill": "BBQ / Grill",
+		},
+	},
+	"th": {
+		"event_types": {
+			"meal": "กินข้าว", "one_day_trip": "เที่ยวไปกลับ", "overnight": "เที่ยวค้างคืน",
+		},
+		"event_statuses": {
+			"published": "เปิดรับ", "cancelled": "ยกเลิกแล้ว", "completed": "จบแล้ว",
+		},
+		"member_roles": {
+			"creator": "ผู้สร้าง", "participant": "ผู้เข้าร่วม",
+		},
+		"member_statuses": {
+			"pending": "รอยืนยัน", "confirmed": "ยืนยันแล้ว", "declined": "ปฏิเสธ",
+			"kicked": "ถูกนำออก", "left": "ออกแล้ว",
+		},
+		"tag_kinds": {
+			"interest": "ความสนใจ", "category": "หมวดหมู่", "activity": "กิจกรรม", "location": "สถานที่",
+			"food": "อาหาร", "transport": "การเดินทาง", "accommodation": "ที่พัก",
+		},
+		"genders": {
+			"male": "ชาย", "female": "หญิง", "nonbinary": "นอนไบนารี", "prefer_not_say": "ไม่ระบุ",
+		},
+		"smoking_options": {
+			"no": "ไม่สูบบุหรี่", "yes": "สูบบุหรี่", "occasionally": "สูบบ้างบางครั้ง",
+		},
+		"swipe_directions": {
+			"like": "ถูกใจ", "pass": "ข้าม",
+		},
+		"travel_styles": {
+			"cafe_dessert": "คาเฟ่และของหวาน", "bubble_tea": "ชานมไข่มุก", "bakery_cake": "เบเกอรี่ / เค้ก",
+			"bingsu_ice_cream": "บิงซู / ไอศกรีม", "coffee": "กาแฟ", "matcha": "มัทฉะ",
+			"pancakes": "แพนเค้ก", "social_activity": "กิจกรรมสังสรรค์", "karaoke": "คาราโอเกะ",
+			"gaming": "เล่นเกม", "movie": "ดูหนัง", "board_game": "บอร์ดเกม",
+			"outdoor_activity": "กิจกรรมกลางแจ้ง", "party_celebration": "ปาร์ตี้ / ฉลอง",
+			"swimming": "ว่ายน้ำ", "skateboarding": "สเก็ตบอร์ด",
+		},
+		"food_categories": {
+			"thai_food": "อาหารไทย", "japanese_food": "อาหารญี่ปุ่น", "chinese_food": "อาหารจีน",
+			"international_food": "อาหารนานาชาติ", "halal_food": "อาหารฮาลาล",
+			"buffet": "บุฟเฟต์", "bbq_grill": "บาร์บีคิว / ปิ้งย่าง",
+		},
+	},
+}
+
+// MetaService serves static metadata about the API such as enum values
+type MetaService struct {
+	mu    sync.RWMutex
+	cache map[string]*dto.EnumsResponse
+}
+
+// NewMetaService creates a new meta service
+func NewMetaService() *MetaService {
+	return &MetaService{
+		cache: make(map[string]*dto.EnumsResponse),
+	}
+}
+
+// ResolveLocale picks the first supported locale from an explicit lang value or an
+// Accept-Language header, falling back to the default locale
+func ResolveLocale(lang, acceptLanguage string) string {
+	candidates := []string{lang}
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		candidates = append(candidates, strings.SplitN(part, ";", 2)[0])
+	}
+
+	for _, candidate := range candidates {
+		// Only the primary subtag matters, e.g. "th-TH" -> "th"
+		primary := strings.ToLower(strings.TrimSpace(strings.SplitN(candidate, "-", 2)[0]))
+		if _, ok := enumLabels[primary]; ok {
+			return primary
+		}
+	}
+	return DefaultLocale
+}
+
+// GetEnums returns all enum values with labels in the given locale.
+// Enums are static for the lifetime of the process, so each locale is built once.
+func (s *MetaService) GetEnums(locale string) *dto.EnumsResponse {
+	if _, ok := enumLabels[locale]; !ok {
+		locale = DefaultLocale
+	}
+
+	s.mu.RLock()
+	cached, ok := s.cache[locale]
+	s.mu.RUnlock()
+	if ok {
+		return cached
+	}
+
+	response := &dto.EnumsResponse{
+		Locale:          locale,
+		EventTypes:      enumOptions(locale, "event_types", models.EventTypes),
+		EventStatuses:   enumOptions(locale, "event_statuses", models.EventStatuses),
+		MemberRoles:     enumOptions(locale, "member_roles", models.MemberRoles),
+		MemberStatuses:  enumOptions(locale, "member_statuses", models.MemberStatuses),
+		TagKinds:        enumOptions(locale, "tag_kinds", models.TagKinds),
+		Genders:         enumOptions(locale, "genders", models.Genders),
+		SmokingOptions:  enumOptions(locale, "smoking_options", models.SmokingOptions),
+		SwipeDirections: enumOptions(locale, "swipe_directions", models.SwipeDirections),
+		TravelStyles:    enumOptions(locale, "travel_styles", models.TravelStyles),
+		FoodCategories:  enumOptions(locale, "food_categories", models.FoodCategories),
+	}
+
+	s.mu.Lock()
+	s.cache[locale] = response
+	s.mu.Unlock()
+
+	return response
+}
+
+// enumOptions maps enum values to options labelled in the given locale
+func enumOptions[T ~string](locale, enum string, values []T) []dto.EnumOption {
+	options := make([]dto.EnumOption, 0, len(values))
+	for _, value := range values {
+		options = append(options, dto.EnumOption{
+			Value: string(value),
+			Label: enumLabel(locale, enum, string(value)),
+		})
+	}
+	return options
+}
+
+// enumLabel returns the label of a value, falling back to the default locale and then the raw value
+func enumLabel(locale, enum, value string) string {
+	if label, ok := enumLabels[locale][enum][value]; ok {
+		return label
+	}
+	if label, ok := enumLabels[DefaultLocale][enum][value]; ok {
+		return label
+	}
+	return value
+}
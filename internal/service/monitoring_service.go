@@ -34,6 +34,14 @@ var (
 		[]string{"method", "endpoint"},
 	)
 
+	httpPanicsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "http_panics_total",
+			Help: "Total number of handler panics recovered",
+		},
+		[]string{"method", "endpoint"},
+	)
+
 	// Database metrics
 	dbConnectionsActive = promauto.NewGauge(
 		prometheus.GaugeOpts{
@@ -93,6 +101,7 @@ type MonitoringService struct {
 	healthServer  *http.Server
 	db            *gorm.DB
 	startTime     time.Time
+	readiness     gin.HandlerFunc
 }
 
 func NewMonitoringService(db *gorm.DB) *MonitoringService {
@@ -102,6 +111,12 @@ func NewMonitoringService(db *gorm.DB) *MonitoringService {
 	}
 }
 
+// SetReadinessHandler serves the API's readiness probe on the health server too, so both ports
+// answer /ready the same way. Call it before Start.
+func (ms *MonitoringService) SetReadinessHandler(handler gin.HandlerFunc) {
+	ms.readiness = handler
+}
+
 func (ms *MonitoringService) Start() error {
 	if !config.AppConfig.Monitoring.Enabled {
 		utils.Logger().Info("Monitoring is disabled")
@@ -148,7 +163,9 @@ func (ms *MonitoringService) startHealthServer() {
 
 	// Health check endpoints
 	router.GET("/health", ms.healthCheck)
-	router.GET("/ready", ms.readinessCheck)
+	if ms.readiness != nil {
+		router.GET("/ready", ms.readiness)
+	}
 	router.GET("/live", ms.livenessCheck)
 
 	ms.healthServer = &http.Server{
@@ -208,35 +225,6 @@ func (ms *MonitoringService) healthCheck(c *gin.Context) {
 	c.JSON(http.StatusOK, status)
 }
 
-func (ms *MonitoringService) readinessCheck(c *gin.Context) {
-	// Check database connectivity
-	if ms.db != nil {
-		sqlDB, err := ms.db.DB()
-		if err != nil {
-			c.JSON(http.StatusServiceUnavailable, gin.H{
-				"status": "not ready",
-				"error":  "database connection error",
-			})
-			return
-		}
-
-		if err := sqlDB.Ping(); err != nil {
-			c.JSON(http.StatusServiceUnavailable, gin.H{
-				"status": "not ready",
-				"error":  "database ping failed",
-			})
-			return
-		}
-	}
-
-	c.JSON(http.StatusOK, gin.H{
-		"status": "ready",
-		"checks": gin.H{
-			"database": "ok",
-		},
-	})
-}
-
 func (ms *MonitoringService) livenessCheck(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{
 		"status": "alive",
@@ -270,6 +258,10 @@ func (ms *MonitoringService) RecordHTTPRequest(method, endpoint, statusCode stri
 	httpRequestDuration.WithLabelValues(method, endpoint).Observe(duration)
 }
 
+func (ms *MonitoringService) RecordPanic(method, endpoint string) {
+	httpPanicsTotal.WithLabelValues(method, endpoint).Inc()
+}
+
 func (ms *MonitoringService) RecordUserRegistration() {
 	userRegistrationsTotal.Inc()
 }
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Overall delivery status of a notification, derived from its deliveries
+const (
+	NotificationDeliveryStatusSent    = "sent"
+	NotificationDeliveryStatusFailed  = "failed"
+	NotificationDeliveryStatusSkipped = "skipped"
+	NotificationDeliveryStatusUnknown = "unknown"
+)
+
+// NotificationHistoryQuery holds the filters for a user's notification history
+type NotificationHistoryQuery struct {
+	Type *string // the type in the notification's data, e.g. event_reminder
+	From *time.Time
+	To   *time.Time
+}
+
+// recordDelivery stores the outcome of delivering a notification through a channel. Failing to
+// record it is only logged; it never affects the delivery itself.
+func (s *NotificationService) recordDelivery(notificationID uuid.UUID, channel models.NotificationChannel, status models.NotificationDeliveryStatus, detail string) {
+	delivery := &models.NotificationDelivery{
+		ID:             uuid.New(),
+		NotificationID: notificationID,
+		Channel:        channel,
+		Status:         status,
+		CreatedAt:      s.clock.Now(),
+	}
+	if detail != "" {
+		delivery.Detail = &detail
+	}
+	if err := database.GetDB().Create(delivery).Error; err != nil {
+		log.Printf("Failed to record %s delivery of notification %s: %v", channel, notificationID, err)
+	}
+}
+
+// GetUserNotificationHistory retrieves a page of a user's notifications, newest first, with every
+// recorded delivery attempt. It is for support, so deleted accounts are included.
+func (s *NotificationService) GetUserNotificationHistory(userID string, query NotificationHistoryQuery, page, limit int) ([]dto.AdminNotificationResponse, int64, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid user ID")
+	}
+	if query.From != nil && query.To != nil && query.To.Before(*query.From) {
+		return nil, 0, fmt.Errorf("invalid time range")
+	}
+
+	var user models.User
+	if err := database.GetDB().Select("id").Where("id = ?", userUUID).First(&user).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, 0, fmt.Errorf("user not found")
+		}
+		return nil, 0, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	db := database.GetDB().Model(&models.Notification{}).Where("user_id = ?", userUUID)
+	if query.Type != nil {
+		db = db.Where("data->>'type' = ?", *query.Type)
+	}
+	if query.From != nil {
+		db = db.Where("created_at >= ?", *query.From)
+	}
+	if query.To != nil {
+		db = db.Where("created_at <= ?", *query.To)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	var notifications []models.Notification
+	offset := (page - 1) * limit
+	if err := db.Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&notifications).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to get notifications: %w", err)
+	}
+
+	notificationIDs := make([]uuid.UUID, len(notifications))
+	for i, notification := range notifications {
+		notificationIDs[i] = notification.ID
+	}
+	var deliveries []models.NotificationDelivery
+	if len(notificationIDs) > 0 {
+		err := database.GetDB().Where("notification_id IN ?", notificationIDs).
+			Order("created_at, id").Find(&deliveries).Error
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get notification deliveries: %w", err)
+		}
+	}
+	byNotification := make(map[uuid.UUID][]models.NotificationDelivery)
+	for _, delivery := range deliveries {
+		byNotification[delivery.NotificationID] = append(byNotification[delivery.NotificationID], delivery)
+	}
+
+	responses := make([]dto.AdminNotificationResponse, len(notifications))
+	for i, notification := range notifications {
+		responses[i] = s.convertNotificationHistory(notification, byNotification[notification.ID])
+	}
+
+	return responses, total, nil
+}
+
+// convertNotificationHistory converts a notification and its deliveries to the support view
+func (s *NotificationService) convertNotificationHistory(notification models.Notification, deliveries []models.NotificationDelivery) dto.AdminNotificationResponse {
+	response := dto.AdminNotificationResponse{
+		NotificationResponse: s.convertNotificationToResponse(notification),
+		DeliveryStatus:       NotificationDeliveryStatusUnknown,
+		Channels:             []string{},
+		Deliveries:           make([]dto.NotificationDeliveryResponse, len(deliveries)),
+	}
+
+	delivered := make(map[models.NotificationChannel]bool)
+	failed, skipped := false, false
+	for i, delivery := range deliveries {
+		response.Deliveries[i] = dto.NotificationDeliveryResponse{
+			Channel:   string(delivery.Channel),
+			Status:    string(delivery.Status),
+			Detail:    delivery.Detail,
+			CreatedAt: delivery.CreatedAt,
+		}
+		switch delivery.Status {
+		case models.NotificationDeliverySent:
+			delivered[delivery.Channel] = true
+		case models.NotificationDeliveryFailed:
+			failed = true
+		case models.NotificationDeliverySkipped:
+			skipped = true
+		}
+	}
+
+	// Channels it reached, in a fixed order
+	for _, channel := range []models.NotificationChannel{models.NotificationChannelPush, models.NotificationChannelEmail} {
+		if delivered[channel] {
+			response.Channels = append(response.Channels, string(channel))
+		}
+	}
+
+	switch {
+	case len(response.Channels) > 0:
+		response.DeliveryStatus = NotificationDeliveryStatusSent
+	case failed:
+		response.DeliveryStatus = NotificationDeliveryStatusFailed
+	case skipped:
+		response.DeliveryStatus = NotificationDeliveryStatusSkipped
+	}
+
+	return response
+}
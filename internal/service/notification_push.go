@@ -0,0 +1,73 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/pkg/database"
+	"TinderTrip-Backend/pkg/push"
+)
+
+// pushToDevices sends the saved notification to every device the user has registered and records
+// each attempt. Tokens FCM reports as invalid or expired are removed.
+func (s *NotificationService) pushToDevices(notification *models.Notification) {
+	var devices []models.DeviceToken
+	err := database.GetDB().Where("user_id = ?", notification.UserID).Find(&devices).Error
+	if err != nil {
+		log.Printf("Failed to get devices for push notification: %v", err)
+		s.recordDelivery(notification.ID, models.NotificationChannelPush, models.NotificationDeliveryFailed, "failed to get devices: "+err.Error())
+		return
+	}
+	if len(devices) == 0 {
+		s.recordDelivery(notification.ID, models.NotificationChannelPush, models.NotificationDeliverySkipped, "no registered devices")
+		return
+	}
+
+	message := &push.Message{
+		Title: notification.Title,
+		Body:  notification.Body,
+		Data:  pushData(notification),
+	}
+	for _, device := range devices {
+		err := s.pushSender.SendPush(device.Token, message)
+		if err == nil {
+			s.recordDelivery(notification.ID, models.NotificationChannelPush, models.NotificationDeliverySent, "")
+			continue
+		}
+		s.recordDelivery(notification.ID, models.NotificationChannelPush, models.NotificationDeliveryFailed,
+			fmt.Sprintf("%s device: %v", device.Platform, err))
+		if errors.Is(err, push.ErrInvalidToken) {
+			if err := database.GetDB().Delete(&models.DeviceToken{}, "id = ?", device.ID).Error; err != nil {
+				log.Printf("Failed to remove invalid device token for user %s: %v", notification.UserID, err)
+			}
+			continue
+		}
+		log.Printf("Failed to send push notification to user %s: %v", notification.UserID, err)
+	}
+}
+
+// pushData converts the notification's data to the string map FCM expects. Strings are
+// passed through and other values are JSON encoded. The notification ID is added so the
+// app can act on the stored notification, e.g. to snooze it.
+func pushData(notification *models.Notification) map[string]string {
+	data := map[string]string{"notification_id": notification.ID.String()}
+	for key, value := range notification.Data {
+		switch v := value.(type) {
+		case string:
+			data[key] = v
+		case nil:
+			continue
+		default:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				data[key] = fmt.Sprint(v)
+				continue
+			}
+			data[key] = string(encoded)
+		}
+	}
+	return data
+}
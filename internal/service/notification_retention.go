@@ -0,0 +1,88 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultNotificationRetentionDays is used when NOTIFICATION_RETENTION_DAYS is not configured
+	defaultNotificationRetentionDays = 90
+	// defaultMaxNotificationsPerUser is used when NOTIFICATION_MAX_PER_USER is not configured
+	defaultMaxNotificationsPerUser = 500
+)
+
+// notificationRetention returns how long read notifications are kept
+func notificationRetention() time.Duration {
+	days := defaultNotificationRetentionDays
+	if config.AppConfig != nil && config.AppConfig.Notification.RetentionDays > 0 {
+		days = config.AppConfig.Notification.RetentionDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// maxNotificationsPerUser returns how many notifications each user keeps
+func maxNotificationsPerUser() int {
+	if config.AppConfig != nil && config.AppConfig.Notification.MaxPerUser > 0 {
+		return config.AppConfig.Notification.MaxPerUser
+	}
+	return defaultMaxNotificationsPerUser
+}
+
+// CleanupNotifications deletes read notifications older than the retention, then trims every
+// user to their newest notifications. It returns how many were deleted.
+func (s *NotificationService) CleanupNotifications() (int64, error) {
+	cutoff := s.clock.Now().Add(-notificationRetention())
+	result := database.GetDB().Where("read = ? AND created_at < ?", true, cutoff).Delete(&models.Notification{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete old notifications: %w", result.Error)
+	}
+	deleted := result.RowsAffected
+
+	trimmed, err := s.trimNotificationsPerUser(maxNotificationsPerUser())
+	if err != nil {
+		return deleted, err
+	}
+
+	return deleted + trimmed, nil
+}
+
+// trimNotificationsPerUser deletes each user's oldest notifications beyond the newest limit
+func (s *NotificationService) trimNotificationsPerUser(limit int) (int64, error) {
+	var userIDs []uuid.UUID
+	err := database.GetDB().Model(&models.Notification{}).
+		Group("user_id").Having("COUNT(*) > ?", limit).
+		Pluck("user_id", &userIDs).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to find users over the notification cap: %w", err)
+	}
+
+	var deleted int64
+	for _, userID := range userIDs {
+		// The oldest notification the user keeps; everything older goes
+		var oldestKept models.Notification
+		err := database.GetDB().Where("user_id = ?", userID).
+			Order("created_at DESC, id DESC").Offset(limit - 1).Limit(1).
+			First(&oldestKept).Error
+		if err != nil {
+			return deleted, fmt.Errorf("failed to find oldest kept notification: %w", err)
+		}
+
+		result := database.GetDB().
+			Where("user_id = ? AND (created_at < ? OR (created_at = ? AND id < ?))",
+				userID, oldestKept.CreatedAt, oldestKept.CreatedAt, oldestKept.ID).
+			Delete(&models.Notification{})
+		if result.Error != nil {
+			return deleted, fmt.Errorf("failed to trim notifications: %w", result.Error)
+		}
+		deleted += result.RowsAffected
+	}
+
+	return deleted, nil
+}
@@ -1,30 +1,82 @@
 package service
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
-	"time"
+	"sync"
 
+	"TinderTrip-Backend/internal/dto"
 	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
 	"TinderTrip-Backend/pkg/database"
 	"TinderTrip-Backend/pkg/email"
+	"TinderTrip-Backend/pkg/push"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
+// Limits on a notification's data map. FCM rejects data payloads over 4KB, and
+// the map is stored as-is on every notification row.
+const (
+	MaxNotificationDataBytes = 4096
+	MaxNotificationDataKeys  = 30
+)
+
+// backgroundSends tracks notifications still being sent in the background
+var backgroundSends sync.WaitGroup
+
+// sendInBackground runs send in its own goroutine, so the caller doesn't wait on delivery
+func sendInBackground(send func()) {
+	backgroundSends.Add(1)
+	go func() {
+		defer backgroundSends.Done()
+		send()
+	}()
+}
+
+// WaitForBackgroundSends blocks until every notification sent in the background has been
+// delivered or has failed, e.g. at shutdown or before a test swaps the database out
+func WaitForBackgroundSends() {
+	backgroundSends.Wait()
+}
+
 // NotificationService handles notifications
 type NotificationService struct {
 	emailService *EmailService
+	pushSender   push.PushSender
+	clock        utils.Clock
 }
 
 // NewNotificationService creates a new notification service
 func NewNotificationService() *NotificationService {
+	return NewNotificationServiceWithPushSender(push.NewPushSender())
+}
+
+// NewNotificationServiceWithPushSender creates a notification service that pushes to devices
+// through the given sender. With a nil sender notifications are only saved and emailed.
+func NewNotificationServiceWithPushSender(sender push.PushSender) *NotificationService {
+	return NewNotificationServiceWithSenders(sender, email.NewSMTPClient())
+}
+
+// NewNotificationServiceWithSenders creates a notification service that pushes through sender
+// and emails through client
+func NewNotificationServiceWithSenders(sender push.PushSender, client *email.SMTPClient) *NotificationService {
 	return &NotificationService{
-		emailService: NewEmailService(),
+		emailService: NewEmailServiceWithClient(client),
+		pushSender:   sender,
+		clock:        utils.RealClock{},
 	}
 }
 
+// NewNotificationServiceWithClock creates a notification service that reads the time from the given clock
+func NewNotificationServiceWithClock(clock utils.Clock) *NotificationService {
+	service := NewNotificationService()
+	service.clock = clock
+	return service
+}
+
 // SendPushNotification sends a push notification
 func (s *NotificationService) SendPushNotification(userID, title, body string, data map[string]interface{}) error {
 	// Parse user ID
@@ -33,9 +85,10 @@ func (s *NotificationService) SendPushNotification(userID, title, body string, d
 		return fmt.Errorf("invalid user ID: %w", err)
 	}
 
-	// TODO: Implement actual push notification sending
-	// This would integrate with Firebase Cloud Messaging or similar service
-	log.Printf("Sending push notification to user %s: %s - %s", userID, title, body)
+	data, err = SanitizeNotificationData(data)
+	if err != nil {
+		return err
+	}
 
 	// Save notification to database
 	notification := &models.Notification{
@@ -46,7 +99,7 @@ func (s *NotificationService) SendPushNotification(userID, title, body string, d
 		Type:      "push",
 		Data:      data,
 		Read:      false,
-		CreatedAt: time.Now(),
+		CreatedAt: s.clock.Now(),
 	}
 
 	err = database.GetDB().Create(notification).Error
@@ -54,19 +107,28 @@ func (s *NotificationService) SendPushNotification(userID, title, body string, d
 		return fmt.Errorf("failed to save notification: %w", err)
 	}
 
+	// Push to the user's devices in background
+	if s.pushSender != nil {
+		sendInBackground(func() { s.pushToDevices(notification) })
+	} else {
+		s.recordDelivery(notification.ID, models.NotificationChannelPush, models.NotificationDeliverySkipped, "push is not configured")
+	}
+
 	// Send email notification in background (don't block on error)
-	go func() {
+	sendInBackground(func() {
 		// Get user email
 		var user models.User
 		err := database.GetDB().Where("id = ?", userUUID).First(&user).Error
 		if err != nil {
 			log.Printf("Failed to get user for email notification: %v", err)
+			s.recordDelivery(notification.ID, models.NotificationChannelEmail, models.NotificationDeliveryFailed, "failed to get user: "+err.Error())
 			return
 		}
 
 		// Only send email if user has email address
 		if user.Email == nil || *user.Email == "" {
 			log.Printf("User %s has no email address, skipping email notification", userID)
+			s.recordDelivery(notification.ID, models.NotificationChannelEmail, models.NotificationDeliverySkipped, "user has no email address")
 			return
 		}
 
@@ -75,14 +137,208 @@ func (s *NotificationService) SendPushNotification(userID, title, body string, d
 		err = s.sendNotificationEmail(*user.Email, user.GetDisplayName(), title, body, data)
 		if err != nil {
 			log.Printf("Failed to send email notification to %s: %v", *user.Email, err)
+			s.recordDelivery(notification.ID, models.NotificationChannelEmail, models.NotificationDeliveryFailed, err.Error())
 		} else {
 			log.Printf("Successfully sent email notification to %s for user %s", *user.Email, userID)
+			s.recordDelivery(notification.ID, models.NotificationChannelEmail, models.NotificationDeliverySent, "")
 		}
-	}()
+	})
 
 	return nil
 }
 
+// SanitizeNotificationData makes a notification's data map safe to store and push.
+// Errors become their message, values that cannot be encoded as JSON fall back to
+// their String() form or are dropped, and the result must stay within
+// MaxNotificationDataKeys keys and MaxNotificationDataBytes of JSON.
+func SanitizeNotificationData(data map[string]interface{}) (map[string]interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+	if len(data) > MaxNotificationDataKeys {
+		return nil, fmt.Errorf("notification data has too many keys: %d (max %d)", len(data), MaxNotificationDataKeys)
+	}
+
+	sanitized := make(map[string]interface{}, len(data))
+	for key, value := range data {
+		if e, ok := value.(error); ok {
+			value = e.Error()
+		}
+		if _, err := json.Marshal(value); err != nil {
+			if stringer, ok := value.(fmt.Stringer); ok {
+				value = stringer.String()
+			} else {
+				log.Printf("Dropping notification data key %q: %v", key, err)
+				continue
+			}
+		}
+		sanitized[key] = value
+	}
+
+	encoded, err := json.Marshal(sanitized)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode notification data: %w", err)
+	}
+	if len(encoded) > MaxNotificationDataBytes {
+		return nil, fmt.Errorf("notification data too large: %d bytes (max %d)", len(encoded), MaxNotificationDataBytes)
+	}
+
+	return sanitized, nil
+}
+
+// GetNotificationsByCursor retrieves a user's notifications after the given cursor, newest first,
+// optionally only read or unread ones. It returns the cursor for the next page, or nil when there
+// are no more rows.
+func (s *NotificationService) GetNotificationsByCursor(userID string, cursor *utils.Cursor, limit int, read *bool) ([]dto.NotificationResponse, *string, error) {
+	// Parse user ID
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	// Fetch one extra row to know whether another page exists
+	var notifications []models.Notification
+	query := database.GetDB().Model(&models.Notification{}).Where("user_id = ?", userUUID)
+	if read != nil {
+		query = query.Where("read = ?", *read)
+	}
+	if err := utils.ApplyCursor(query, cursor).Limit(limit + 1).Find(&notifications).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to get notifications: %w", err)
+	}
+
+	var nextCursor *string
+	if len(notifications) > limit {
+		notifications = notifications[:limit]
+		last := notifications[len(notifications)-1]
+		next := utils.EncodeCursor(last.CreatedAt, last.ID.String())
+		nextCursor = &next
+	}
+
+	responses := make([]dto.NotificationResponse, len(notifications))
+	for i, notification := range notifications {
+		responses[i] = s.convertNotificationToResponse(notification)
+	}
+
+	return responses, nextCursor, nil
+}
+
+// MarkEventNotificationsRead marks all of the user's unread notifications about the event as read
+// and returns how many changed
+func (s *NotificationService) MarkEventNotificationsRead(userID, eventID string) (int64, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid user ID")
+	}
+	eventUUID, err := uuid.Parse(eventID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid event ID")
+	}
+
+	// Event notifications carry the event ID in their data
+	result := database.GetDB().Model(&models.Notification{}).
+		Where("user_id = ? AND read = ? AND data->>'event_id' = ?", userUUID, false, eventUUID.String()).
+		Updates(map[string]interface{}{
+			"read":    true,
+			"read_at": s.clock.Now(),
+		})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to mark notifications as read: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+// MarkNotificationRead marks one of the user's notifications as read. Another user's
+// notification is reported as not found.
+func (s *NotificationService) MarkNotificationRead(userID, notificationID string) (*dto.NotificationResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID")
+	}
+	notificationUUID, err := uuid.Parse(notificationID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid notification ID")
+	}
+
+	var notification models.Notification
+	err = database.GetDB().Where("id = ? AND user_id = ?", notificationUUID, userUUID).First(&notification).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("notification not found")
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	// Already read keeps its original read_at
+	if !notification.Read {
+		now := s.clock.Now()
+		err = database.GetDB().Model(&models.Notification{}).
+			Where("id = ? AND read = ?", notification.ID, false).
+			Updates(map[string]interface{}{"read": true, "read_at": now}).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to mark notification as read: %w", err)
+		}
+		notification.Read = true
+		notification.ReadAt = &now
+	}
+
+	response := s.convertNotificationToResponse(notification)
+	return &response, nil
+}
+
+// MarkAllNotificationsRead marks all of the user's unread notifications as read and returns how many changed
+func (s *NotificationService) MarkAllNotificationsRead(userID string) (int64, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid user ID")
+	}
+
+	result := database.GetDB().Model(&models.Notification{}).
+		Where("user_id = ? AND read = ?", userUUID, false).
+		Updates(map[string]interface{}{
+			"read":    true,
+			"read_at": s.clock.Now(),
+		})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to mark notifications as read: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+// GetUnreadCount returns how many unread notifications the user has
+func (s *NotificationService) GetUnreadCount(userID string) (int64, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid user ID")
+	}
+
+	var count int64
+	err = database.GetDB().Model(&models.Notification{}).
+		Where("user_id = ? AND read = ?", userUUID, false).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+
+	return count, nil
+}
+
+// convertNotificationToResponse converts a notification model to response format
+func (s *NotificationService) convertNotificationToResponse(notification models.Notification) dto.NotificationResponse {
+	return dto.NotificationResponse{
+		ID:        notification.ID.String(),
+		UserID:    notification.UserID.String(),
+		Title:     notification.Title,
+		Body:      notification.Body,
+		Type:      notification.Type,
+		Data:      notification.Data,
+		Read:      notification.Read,
+		CreatedAt: notification.CreatedAt,
+		ReadAt:    notification.ReadAt,
+	}
+}
+
 // SendEventNotification sends an event-related notification
 func (s *NotificationService) SendEventNotification(eventID, userID, title, body string, data map[string]interface{}) error {
 	// Add event data
@@ -160,29 +416,62 @@ func (s *NotificationService) SendEventUpdate(eventID, title, body string) error
 		return fmt.Errorf("invalid event ID: %w", err)
 	}
 
+	data := map[string]interface{}{
+		"event_id": eventID,
+		"type":     "event_update",
+	}
+
+	_, err = s.notifyConfirmedMembers(eventUUID, uuid.Nil, title, body, data)
+	return err
+}
+
+// SendEventBroadcast sends a creator's message to every confirmed member except the sender.
+// It returns the number of members notified.
+func (s *NotificationService) SendEventBroadcast(eventID, senderID, title, body string) (int, error) {
+	// Parse IDs
+	eventUUID, err := uuid.Parse(eventID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid event ID: %w", err)
+	}
+	senderUUID, err := uuid.Parse(senderID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	data := map[string]interface{}{
+		"event_id":  eventID,
+		"sender_id": senderID,
+		"type":      "event_broadcast",
+	}
+
+	return s.notifyConfirmedMembers(eventUUID, senderUUID, title, body, data)
+}
+
+// notifyConfirmedMembers sends the same notification to all confirmed members of an event,
+// skipping excludeUserID when set. It returns the number of members notified.
+func (s *NotificationService) notifyConfirmedMembers(eventID, excludeUserID uuid.UUID, title, body string, data map[string]interface{}) (int, error) {
 	// Get event members
 	var members []models.EventMember
-	err = database.GetDB().Preload("User").Where("event_id = ? AND status = ?", eventUUID, models.MemberStatusConfirmed).Find(&members).Error
+	err := database.GetDB().Preload("User").Where("event_id = ? AND status = ?", eventID, models.MemberStatusConfirmed).Find(&members).Error
 	if err != nil {
-		return fmt.Errorf("failed to get event members: %w", err)
+		return 0, fmt.Errorf("failed to get event members: %w", err)
 	}
 
-	// Send update to each member
+	sent := 0
 	for _, member := range members {
-		if member.User != nil {
-			data := map[string]interface{}{
-				"event_id": eventID,
-				"type":     "event_update",
-			}
+		if member.User == nil || member.UserID == excludeUserID {
+			continue
+		}
 
-			err := s.SendPushNotification(member.User.ID.String(), title, body, data)
-			if err != nil {
-				log.Printf("Error sending update to user %s: %v", member.User.ID, err)
-			}
+		err := s.SendPushNotification(member.User.ID.String(), title, body, data)
+		if err != nil {
+			log.Printf("Error sending %v notification to user %s: %v", data["type"], member.User.ID, err)
+			continue
 		}
+		sent++
 	}
 
-	return nil
+	return sent, nil
 }
 
 // SendWelcomeNotification sends a welcome notification
@@ -296,8 +585,8 @@ func (s *NotificationService) SendUserLeftEventNotification(eventID, userID stri
 	return nil
 }
 
-// SendEventCancelledNotification sends notification when event is cancelled
-func (s *NotificationService) SendEventCancelledNotification(eventID string) error {
+// SendEventCancelledNotification sends notification to the given members when event is cancelled
+func (s *NotificationService) SendEventCancelledNotification(eventID string, userIDs []uuid.UUID) error {
 	// Parse event ID
 	eventUUID, err := uuid.Parse(eventID)
 	if err != nil {
@@ -313,7 +602,10 @@ func (s *NotificationService) SendEventCancelledNotification(eventID string) err
 
 	// Get event members
 	var members []models.EventMember
-	err = database.GetDB().Preload("User").Where("event_id = ? AND status = ?", eventUUID, models.MemberStatusConfirmed).Find(&members).Error
+	if len(userIDs) == 0 {
+		return nil
+	}
+	err = database.GetDB().Preload("User").Where("event_id = ? AND user_id IN ?", eventUUID, userIDs).Find(&members).Error
 	if err != nil {
 		return fmt.Errorf("failed to get event members: %w", err)
 	}
@@ -327,6 +619,10 @@ func (s *NotificationService) SendEventCancelledNotification(eventID string) err
 				"event_id": eventID,
 				"type":     "event_cancelled",
 			}
+			if event.CancelReason != nil {
+				body = fmt.Sprintf("%s Reason: %s", body, *event.CancelReason)
+				data["reason"] = *event.CancelReason
+			}
 
 			err := s.SendPushNotification(member.User.ID.String(), title, body, data)
 			if err != nil {
@@ -338,6 +634,129 @@ func (s *NotificationService) SendEventCancelledNotification(eventID string) err
 	return nil
 }
 
+// SendEventOwnershipTransferredNotification tells confirmed members that the event has a new host
+func (s *NotificationService) SendEventOwnershipTransferredNotification(eventID, previousCreatorID string) error {
+	// Parse event ID
+	eventUUID, err := uuid.Parse(eventID)
+	if err != nil {
+		return fmt.Errorf("invalid event ID: %w", err)
+	}
+
+	// Get event with its new creator
+	var event models.Event
+	err = database.GetDB().Preload("Creator").Where("id = ?", eventUUID).First(&event).Error
+	if err != nil {
+		return fmt.Errorf("failed to get event: %w", err)
+	}
+
+	hostName := "A new host"
+	if event.Creator != nil {
+		hostName = event.Creator.GetDisplayName()
+	}
+
+	// Get event members
+	var members []models.EventMember
+	err = database.GetDB().Where("event_id = ? AND status = ?", eventUUID, models.MemberStatusConfirmed).Find(&members).Error
+	if err != nil {
+		return fmt.Errorf("failed to get event members: %w", err)
+	}
+
+	for _, member := range members {
+		// The previous creator made the change and needs no notification
+		if member.UserID.String() == previousCreatorID {
+			continue
+		}
+
+		title := "New Event Host"
+		body := fmt.Sprintf("%s is now hosting '%s'.", hostName, event.Title)
+		if member.UserID == event.CreatorID {
+			body = fmt.Sprintf("You are now the host of '%s'.", event.Title)
+		}
+		data := map[string]interface{}{
+			"event_id":   eventID,
+			"creator_id": event.CreatorID.String(),
+			"type":       "event_ownership_transferred",
+		}
+
+		err := s.SendPushNotification(member.UserID.String(), title, body, data)
+		if err != nil {
+			log.Printf("Error sending ownership transfer notification to user %s: %v", member.UserID, err)
+		}
+	}
+
+	return nil
+}
+
+// SendEventInvitationNotification tells a user that someone invited them to an event
+func (s *NotificationService) SendEventInvitationNotification(eventID, inviterID, inviteeID string) error {
+	// Get event and inviter
+	var event models.Event
+	err := database.GetDB().Where("id = ?", eventID).First(&event).Error
+	if err != nil {
+		return fmt.Errorf("failed to get event: %w", err)
+	}
+	var inviter models.User
+	err = database.GetDB().Where("id = ?", inviterID).First(&inviter).Error
+	if err != nil {
+		return fmt.Errorf("failed to get inviter: %w", err)
+	}
+
+	title := "Event Invitation"
+	body := fmt.Sprintf("%s invited you to '%s'.", inviter.GetDisplayName(), event.Title)
+	data := map[string]interface{}{
+		"event_id":   eventID,
+		"inviter_id": inviterID,
+		"type":       "event_invitation",
+	}
+
+	return s.SendPushNotification(inviteeID, title, body, data)
+}
+
+// SendPendingMembershipExpiredNotification tells a member that their unconfirmed spot was released
+func (s *NotificationService) SendPendingMembershipExpiredNotification(eventID, userID string) error {
+	var event models.Event
+	err := database.GetDB().Where("id = ?", eventID).First(&event).Error
+	if err != nil {
+		return fmt.Errorf("failed to get event: %w", err)
+	}
+
+	title := "Spot Released"
+	body := fmt.Sprintf("You didn't confirm your spot for '%s' in time, so it was released.", event.Title)
+	data := map[string]interface{}{
+		"event_id":    eventID,
+		"type":        "pending_membership_expired",
+		"event_title": event.Title,
+	}
+
+	return s.SendPushNotification(userID, title, body, data)
+}
+
+// SendChatMentionNotification tells a member that someone mentioned them in the event chat
+func (s *NotificationService) SendChatMentionNotification(message models.ChatMessage, eventID, userID string) error {
+	var event models.Event
+	err := database.GetDB().Where("id = ?", eventID).First(&event).Error
+	if err != nil {
+		return fmt.Errorf("failed to get event: %w", err)
+	}
+
+	senderName := "Someone"
+	if message.Sender != nil {
+		senderName = message.Sender.GetDisplayName()
+	}
+
+	title := "New Mention"
+	body := fmt.Sprintf("%s mentioned you in '%s'.", senderName, event.Title)
+	data := map[string]interface{}{
+		"event_id":    eventID,
+		"room_id":     message.RoomID.String(),
+		"message_id":  message.ID.String(),
+		"type":        "chat_mention",
+		"event_title": event.Title,
+	}
+
+	return s.SendPushNotification(userID, title, body, data)
+}
+
 // SendEventCompletedNotification sends notification when event is completed
 func (s *NotificationService) SendEventCompletedNotification(eventID string) error {
 	// Parse event ID
@@ -417,8 +836,7 @@ func (s *NotificationService) sendNotificationEmail(to, name, title, body string
 		HTML:    htmlBody,
 	}
 
-	smtpClient := email.NewSMTPClient()
-	return smtpClient.SendEmail(message)
+	return s.emailService.smtpClient.SendEmail(message)
 }
 
 // createGenericNotificationEmailHTML creates HTML for generic notifications
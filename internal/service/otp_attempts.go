@@ -0,0 +1,111 @@
+package service
+
+import (
+	"crypto/subtle"
+	"fmt"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// maxOTPAttempts is how many codes may be tried against one issued OTP. Once they are used up the
+// OTP is dead until a new one is sent, which bounds guessing at 5 in a million per OTP.
+const maxOTPAttempts = 5
+
+// useOTPAttempt takes one attempt from the OTP record, reporting false when none are left.
+// The attempt is taken before the code is compared so concurrent guesses can't exceed the limit.
+func useOTPAttempt(model interface{}, id uuid.UUID) (bool, error) {
+	result := database.GetDB().Model(model).
+		Where("id = ? AND attempts < ?", id, maxOTPAttempts).
+		UpdateColumn("attempts", gorm.Expr("attempts + 1"))
+	if result.Error != nil {
+		return false, fmt.Errorf("database error: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// otpMismatchError is the error for a wrong code, given the attempts used before this one
+func otpMismatchError(previousAttempts int) error {
+	if previousAttempts+1 >= maxOTPAttempts {
+		return fmt.Errorf("too many attempts")
+	}
+	return fmt.Errorf("invalid or expired OTP")
+}
+
+// otpMatches compares a code's hash with the stored one in constant time
+func (s *AuthService) otpMatches(otp, storedHash string) bool {
+	return otpHashMatches(otp, storedHash)
+}
+
+// otpHashMatches compares a code's hash with the stored one in constant time
+func otpHashMatches(otp, storedHash string) bool {
+	return subtle.ConstantTimeCompare([]byte(utils.HashOTP(otp, otpPepper())), []byte(storedHash)) == 1
+}
+
+// checkEmailVerificationOTP returns the email's active verification if otp is its code,
+// counting the attempt against it
+func (s *AuthService) checkEmailVerificationOTP(email, otp string) (*models.EmailVerification, error) {
+	var verification models.EmailVerification
+	err := database.GetDB().Where("email = ? AND expires_at > ?", email, s.clock.Now()).
+		Order("created_at DESC").First(&verification).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("invalid or expired OTP")
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	ok, err := useOTPAttempt(&models.EmailVerification{}, verification.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("too many attempts")
+	}
+	if !s.otpMatches(otp, verification.OTP) {
+		return nil, otpMismatchError(verification.Attempts)
+	}
+
+	return &verification, nil
+}
+
+// checkPasswordResetOTP returns the active password reset of the user with the email if otp is
+// its code, counting the attempt against it
+func (s *AuthService) checkPasswordResetOTP(email, otp string) (*models.PasswordReset, error) {
+	var user models.User
+	err := database.GetDB().Where("email = ?", email).First(&user).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			// Don't reveal if user exists or not
+			return nil, fmt.Errorf("invalid or expired OTP")
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	var passwordReset models.PasswordReset
+	err = database.GetDB().Where("user_id = ? AND expires_at > ?", user.ID, s.clock.Now()).
+		Order("created_at DESC").First(&passwordReset).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("invalid or expired OTP")
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	ok, err := useOTPAttempt(&models.PasswordReset{}, passwordReset.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("too many attempts")
+	}
+	if !s.otpMatches(otp, passwordReset.Token) {
+		return nil, otpMismatchError(passwordReset.Attempts)
+	}
+
+	return &passwordReset, nil
+}
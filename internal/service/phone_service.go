@@ -0,0 +1,166 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/database"
+	"TinderTrip-Backend/pkg/sms"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// phoneOTPExpiry is how long a phone verification code stays valid
+const phoneOTPExpiry = 10 * time.Minute
+
+// PhoneService handles phone number registration and verification
+type PhoneService struct {
+	smsSender sms.SMSSender
+	clock     utils.Clock
+}
+
+// NewPhoneService creates a new phone service
+func NewPhoneService() *PhoneService {
+	return NewPhoneServiceWithSMSSender(sms.NewSMSSender())
+}
+
+// NewPhoneServiceWithSMSSender creates a phone service that sends codes through the given sender
+func NewPhoneServiceWithSMSSender(sender sms.SMSSender) *PhoneService {
+	return &PhoneService{smsSender: sender, clock: utils.RealClock{}}
+}
+
+// NewPhoneServiceWithClock creates a phone service that reads the time from clock.
+// Verification codes expire against it.
+func NewPhoneServiceWithClock(sender sms.SMSSender, clock utils.Clock) *PhoneService {
+	service := NewPhoneServiceWithSMSSender(sender)
+	service.clock = clock
+	return service
+}
+
+// RequestPhoneVerification normalizes the phone number and sends a verification code to it.
+// The user's phone is only changed once the code is verified.
+func (s *PhoneService) RequestPhoneVerification(userID, rawPhone string) (string, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return "", fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	phone, err := utils.NormalizePhone(rawPhone)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.ensurePhoneAvailable(database.GetDB(), userUUID, phone); err != nil {
+		return "", err
+	}
+
+	if s.smsSender == nil {
+		return "", fmt.Errorf("SMS delivery is not configured")
+	}
+
+	otp, err := generateNumericOTP()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate OTP: %w", err)
+	}
+
+	// Replace any pending verification for this user
+	database.GetDB().Where("user_id = ?", userUUID).Delete(&models.PhoneVerification{})
+	database.GetDB().Where("expires_at < ?", s.clock.Now()).Delete(&models.PhoneVerification{})
+
+	verification := &models.PhoneVerification{
+		UserID:    userUUID,
+		Phone:     phone,
+		OTP:       utils.HashOTP(otp, otpPepper()),
+		ExpiresAt: s.clock.Now().Add(phoneOTPExpiry),
+	}
+	if err := database.GetDB().Create(verification).Error; err != nil {
+		return "", fmt.Errorf("failed to create phone verification: %w", err)
+	}
+
+	message := fmt.Sprintf("Your TinderTrip verification code is %s. It expires in 10 minutes.", otp)
+	if err := s.smsSender.SendSMS(phone, message); err != nil {
+		return "", fmt.Errorf("failed to send verification SMS: %w", err)
+	}
+
+	return phone, nil
+}
+
+// VerifyPhone checks the code, counting the attempt against it, and stores the verified phone
+// number on the user
+func (s *PhoneService) VerifyPhone(userID, otp string) (string, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return "", fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	var verification models.PhoneVerification
+	err = database.GetDB().Where("user_id = ? AND expires_at > ?", userUUID, s.clock.Now()).
+		Order("created_at DESC").First(&verification).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return "", fmt.Errorf("invalid or expired OTP")
+		}
+		return "", fmt.Errorf("database error: %w", err)
+	}
+
+	ok, err := useOTPAttempt(&models.PhoneVerification{}, verification.ID)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", fmt.Errorf("too many attempts")
+	}
+	if !otpHashMatches(otp, verification.OTP) {
+		return "", otpMismatchError(verification.Attempts)
+	}
+
+	err = database.GetDB().Transaction(func(tx *gorm.DB) error {
+		// Another user may have verified the same number in the meantime
+		if err := s.ensurePhoneAvailable(tx, userUUID, verification.Phone); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.User{}).Where("id = ?", userUUID).Updates(map[string]interface{}{
+			"phone":          verification.Phone,
+			"phone_verified": true,
+		}).Error; err != nil {
+			// Another user verified the number since the check above
+			errStr := strings.ToLower(err.Error())
+			if strings.Contains(errStr, "ux_users_phone") || strings.Contains(errStr, "users.phone") {
+				return fmt.Errorf("phone number already in use")
+			}
+			return fmt.Errorf("failed to update phone: %w", err)
+		}
+
+		return tx.Where("user_id = ?", userUUID).Delete(&models.PhoneVerification{}).Error
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return verification.Phone, nil
+}
+
+// ensurePhoneAvailable returns an error if another active user already owns the phone number
+func (s *PhoneService) ensurePhoneAvailable(db *gorm.DB, userID uuid.UUID, phone string) error {
+	var count int64
+	err := db.Model(&models.User{}).
+		Where("phone = ? AND id <> ? AND deleted_at IS NULL", phone, userID).
+		Count(&count).Error
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("phone number already in use")
+	}
+	return nil
+}
+
+// generateNumericOTP generates a random 6-digit code
+func generateNumericOTP() (string, error) {
+	return utils.GenerateOTP(otpLength)
+}
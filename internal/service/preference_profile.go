@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// preferenceProfileTTL bounds how stale a cached profile can get if an invalidation is missed
+const preferenceProfileTTL = 30 * time.Minute
+
+// PreferenceProfile is the compact form of a user's budget, travel and food preferences that
+// GetEventMatch scores with, so explaining a match does not read the preference tables each time.
+// Suggestions rank by interests alone and do not read preferences; once a preference component
+// gets a weight there, load it through LoadPreferenceProfile too.
+type PreferenceProfile struct {
+	Budget       *models.PrefBudget `json:"budget,omitempty"`
+	TravelStyles []string           `json:"travel_styles"`
+	FoodLevels   map[string]int     `json:"food_levels"` // food_category -> preference_level
+}
+
+// localPreferenceProfiles caches profiles in process while Redis is not connected
+var localPreferenceProfiles = struct {
+	sync.Mutex
+	entries map[uuid.UUID]localPreferenceProfile
+}{entries: make(map[uuid.UUID]localPreferenceProfile)}
+
+type localPreferenceProfile struct {
+	profile   *PreferenceProfile
+	expiresAt time.Time
+}
+
+// preferenceProfileKey is the Redis key of a user's cached profile
+func preferenceProfileKey(userUUID uuid.UUID) string {
+	return "pref_profile:" + userUUID.String()
+}
+
+// LoadPreferenceProfile returns the user's preference profile from the cache, building it from
+// the preference tables and caching it on a miss
+func LoadPreferenceProfile(userUUID uuid.UUID) (*PreferenceProfile, error) {
+	if profile := cachedPreferenceProfile(userUUID); profile != nil {
+		return profile, nil
+	}
+
+	profile, err := buildPreferenceProfile(userUUID)
+	if err != nil {
+		return nil, err
+	}
+	storePreferenceProfile(userUUID, profile)
+	return profile, nil
+}
+
+// InvalidatePreferenceProfile drops the user's cached profile; call it whenever their budget,
+// travel or food preferences change
+func InvalidatePreferenceProfile(userUUID uuid.UUID) {
+	if client := database.GetRedisClient(); client != nil {
+		if err := client.Del(context.Background(), preferenceProfileKey(userUUID)).Err(); err != nil {
+			utils.Logger().WithField("error", err).Warn("Failed to invalidate cached preference profile")
+		}
+	}
+
+	// Always clear the local entry too, in case it was cached before Redis connected
+	localPreferenceProfiles.Lock()
+	delete(localPreferenceProfiles.entries, userUUID)
+	localPreferenceProfiles.Unlock()
+}
+
+// buildPreferenceProfile reads the user's preference rows
+func buildPreferenceProfile(userUUID uuid.UUID) (*PreferenceProfile, error) {
+	profile := &PreferenceProfile{
+		TravelStyles: []string{},
+		FoodLevels:   make(map[string]int),
+	}
+
+	var budget models.PrefBudget
+	err := database.GetDB().Where("user_id = ?", userUUID).First(&budget).Error
+	if err == nil {
+		profile.Budget = &budget
+	} else if err != gorm.ErrRecordNotFound {
+		return nil, fmt.Errorf("failed to get budget preference: %w", err)
+	}
+
+	var travelPrefs []models.TravelPreference
+	if err := database.GetDB().Where("user_id = ?", userUUID).Find(&travelPrefs).Error; err != nil {
+		return nil, fmt.Errorf("failed to get travel preferences: %w", err)
+	}
+	for _, pref := range travelPrefs {
+		profile.TravelStyles = append(profile.TravelStyles, pref.TravelStyle)
+	}
+
+	var foodPrefs []models.FoodPreference
+	if err := database.GetDB().Where("user_id = ?", userUUID).Find(&foodPrefs).Error; err != nil {
+		return nil, fmt.Errorf("failed to get food preferences: %w", err)
+	}
+	for _, pref := range foodPrefs {
+		profile.FoodLevels[pref.FoodCategory] = pref.PreferenceLevel
+	}
+
+	return profile, nil
+}
+
+// cachedPreferenceProfile returns the cached profile, or nil on a miss or cache error
+func cachedPreferenceProfile(userUUID uuid.UUID) *PreferenceProfile {
+	if client := database.GetRedisClient(); client != nil {
+		data, err := client.Get(context.Background(), preferenceProfileKey(userUUID)).Bytes()
+		if err != nil {
+			if err != redis.Nil {
+				utils.Logger().WithField("error", err).Warn("Failed to read cached preference profile")
+			}
+			return nil
+		}
+		var profile PreferenceProfile
+		if err := json.Unmarshal(data, &profile); err != nil {
+			return nil
+		}
+		return &profile
+	}
+
+	localPreferenceProfiles.Lock()
+	defer localPreferenceProfiles.Unlock()
+	entry, ok := localPreferenceProfiles.entries[userUUID]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil
+	}
+	return entry.profile
+}
+
+// storePreferenceProfile caches a freshly built profile
+func storePreferenceProfile(userUUID uuid.UUID, profile *PreferenceProfile) {
+	if client := database.GetRedisClient(); client != nil {
+		data, err := json.Marshal(profile)
+		if err == nil {
+			err = client.Set(context.Background(), preferenceProfileKey(userUUID), data, preferenceProfileTTL).Err()
+		}
+		if err != nil {
+			utils.Logger().WithField("error", err).Warn("Failed to cache preference profile")
+		}
+		return
+	}
+
+	localPreferenceProfiles.Lock()
+	localPreferenceProfiles.entries[userUUID] = localPreferenceProfile{
+		profile:   profile,
+		expiresAt: time.Now().Add(preferenceProfileTTL),
+	}
+	localPreferenceProfiles.Unlock()
+}
@@ -177,6 +177,7 @@ func (s *PreferenceService) GetBudget(userID string) (*dto.PrefBudgetResponse, e
 			if err != nil {
 				return nil, fmt.Errorf("failed to create default budget: %w", err)
 			}
+			InvalidatePreferenceProfile(userUUID)
 		} else {
 			return nil, fmt.Errorf("database error: %w", err)
 		}
@@ -274,6 +275,7 @@ func (s *PreferenceService) UpdateBudget(userID string, req dto.UpdatePrefBudget
 	if err != nil {
 		return nil, fmt.Errorf("failed to update budget: %w", err)
 	}
+	InvalidatePreferenceProfile(userUUID)
 
 	// Convert to response DTO
 	response := &dto.PrefBudgetResponse{
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// defaultRefreshTokenDays is the refresh token lifetime when JWT_REFRESH_EXPIRE_DAYS is not loaded
+const defaultRefreshTokenDays = 30
+
+// IssueRefreshToken issues a refresh token for the user, starting a new token family. Only its
+// hash is stored; the token itself is returned once, to be handed to the client.
+func (s *AuthService) IssueRefreshToken(userID uuid.UUID) (string, error) {
+	token, record, err := s.newRefreshToken(userID, uuid.New())
+	if err != nil {
+		return "", err
+	}
+	if err := database.GetDB().Create(record).Error; err != nil {
+		return "", fmt.Errorf("failed to store refresh token: %w", err)
+	}
+	return token, nil
+}
+
+// RotateRefreshToken exchanges a refresh token for its replacement and returns the user it
+// belongs to. The token used is revoked. Presenting a token that was already exchanged means it
+// has leaked, so every token in its family is revoked and the holder has to log in again.
+func (s *AuthService) RotateRefreshToken(token string) (*models.User, string, error) {
+	var user models.User
+	var newToken string
+	var reusedFamily *uuid.UUID
+	err := database.GetDB().Transaction(func(tx *gorm.DB) error {
+		var current models.RefreshToken
+		err := tx.Where("token_hash = ?", hashRefreshToken(token)).First(&current).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("invalid or expired refresh token")
+			}
+			return fmt.Errorf("database error: %w", err)
+		}
+
+		now := s.clock.Now()
+		if current.RevokedAt != nil {
+			reusedFamily = &current.FamilyID
+			return nil
+		}
+		if !now.Before(current.ExpiresAt) {
+			return fmt.Errorf("invalid or expired refresh token")
+		}
+
+		err = tx.Where("id = ? AND deleted_at IS NULL", current.UserID).First(&user).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("invalid or expired refresh token")
+			}
+			return fmt.Errorf("database error: %w", err)
+		}
+
+		var replacement *models.RefreshToken
+		newToken, replacement, err = s.newRefreshToken(current.UserID, current.FamilyID)
+		if err != nil {
+			return err
+		}
+
+		// Only one exchange of a token can succeed; whoever loses a race presented a used token
+		result := tx.Model(&models.RefreshToken{}).
+			Where("id = ? AND revoked_at IS NULL", current.ID).
+			Updates(map[string]interface{}{"revoked_at": now, "replaced_by": replacement.ID})
+		if result.Error != nil {
+			return fmt.Errorf("failed to revoke refresh token: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			reusedFamily = &current.FamilyID
+			return nil
+		}
+
+		if err := tx.Create(replacement).Error; err != nil {
+			return fmt.Errorf("failed to store refresh token: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if reusedFamily != nil {
+		if err := s.revokeRefreshTokenFamily(*reusedFamily); err != nil {
+			return nil, "", err
+		}
+		return nil, "", fmt.Errorf("refresh token reused")
+	}
+	return &user, newToken, nil
+}
+
+// RevokeUserRefreshTokens revokes every refresh token the user holds
+func (s *AuthService) RevokeUserRefreshTokens(userID uuid.UUID) error {
+	return revokeUserRefreshTokens(userID, s.clock.Now())
+}
+
+// revokeUserRefreshTokens revokes every refresh token the user holds as of now
+func revokeUserRefreshTokens(userID uuid.UUID, now time.Time) error {
+	err := database.GetDB().Model(&models.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", now).Error
+	if err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+	return nil
+}
+
+// RevokeRefreshToken ends the login a refresh token came from by revoking every token in its
+// family. Tokens that are unknown or belong to another user are ignored.
+func (s *AuthService) RevokeRefreshToken(userID uuid.UUID, token string) error {
+	var current models.RefreshToken
+	err := database.GetDB().Where("token_hash = ? AND user_id = ?", hashRefreshToken(token), userID).First(&current).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil
+		}
+		return fmt.Errorf("database error: %w", err)
+	}
+	return s.revokeRefreshTokenFamily(current.FamilyID)
+}
+
+// revokeRefreshTokenFamily revokes every token descended from the same login
+func (s *AuthService) revokeRefreshTokenFamily(familyID uuid.UUID) error {
+	err := database.GetDB().Model(&models.RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", s.clock.Now()).Error
+	if err != nil {
+		return fmt.Errorf("failed to revoke refresh token family: %w", err)
+	}
+	return nil
+}
+
+// newRefreshToken generates a token for the user in the given family, with the record to store
+func (s *AuthService) newRefreshToken(userID, familyID uuid.UUID) (string, *models.RefreshToken, error) {
+	token, err := s.generateResetToken()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+	return token, &models.RefreshToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: hashRefreshToken(token),
+		ExpiresAt: s.clock.Now().Add(refreshTokenLifetime()),
+	}, nil
+}
+
+// refreshTokenLifetime returns how long a refresh token stays valid after it is issued
+func refreshTokenLifetime() time.Duration {
+	days := defaultRefreshTokenDays
+	if config.AppConfig != nil && config.AppConfig.JWT.RefreshExpireDays > 0 {
+		days = config.AppConfig.JWT.RefreshExpireDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// hashRefreshToken returns the form a refresh token is stored and looked up in. Tokens are 256
+// random bits, so an unsalted hash is enough to make a leaked table useless.
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
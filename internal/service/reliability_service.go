@@ -0,0 +1,206 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/audit"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// noShowReportWindow is how long after an event ends members may report no-shows
+	noShowReportWindow = 7 * 24 * time.Hour
+	// noShowMemberQuorum is how many members must agree for a no-show not reported by the creator to count
+	noShowMemberQuorum = 2
+)
+
+// ReliabilityService handles no-show reports and attendance reliability
+type ReliabilityService struct {
+	auditLogger *audit.AuditLogger
+	clock       utils.Clock
+}
+
+// NewReliabilityService creates a new reliability service
+func NewReliabilityService() *ReliabilityService {
+	return NewReliabilityServiceWithClock(utils.RealClock{})
+}
+
+// NewReliabilityServiceWithClock creates a reliability service that reads the time from clock;
+// the no-show report window is measured against it
+func NewReliabilityServiceWithClock(clock utils.Clock) *ReliabilityService {
+	return &ReliabilityService{
+		auditLogger: audit.NewAuditLogger(),
+		clock:       clock,
+	}
+}
+
+// ReportNoShow records that a confirmed member did not attend a completed event.
+// Only the creator and confirmed members may report, and only within the report window.
+func (s *ReliabilityService) ReportNoShow(eventID, reporterID, reportedUserID string) error {
+	eventUUID, err := uuid.Parse(eventID)
+	if err != nil {
+		return fmt.Errorf("invalid event ID")
+	}
+	reporterUUID, err := uuid.Parse(reporterID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID")
+	}
+	reportedUUID, err := uuid.Parse(reportedUserID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID")
+	}
+
+	if reporterUUID == reportedUUID {
+		return fmt.Errorf("cannot report yourself")
+	}
+
+	var event models.Event
+	err = database.GetDB().Where("id = ? AND deleted_at IS NULL", eventUUID).First(&event).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return fmt.Errorf("event not found")
+		}
+		return fmt.Errorf("failed to get event: %w", err)
+	}
+
+	// Check the reporter first so outsiders learn nothing about the event's state
+	if !s.isConfirmedMember(eventUUID, reporterUUID) {
+		return fmt.Errorf("not authorized")
+	}
+
+	if !event.IsCompleted() {
+		return fmt.Errorf("event is not completed")
+	}
+
+	// Reports are only accepted shortly after the event
+	endedAt := event.UpdatedAt
+	if event.EndAt != nil {
+		endedAt = *event.EndAt
+	}
+	if s.clock.Now().Sub(endedAt) > noShowReportWindow {
+		return fmt.Errorf("no-show report window has closed")
+	}
+
+	if !s.isConfirmedMember(eventUUID, reportedUUID) {
+		return fmt.Errorf("member not found")
+	}
+
+	report := &models.EventNoShow{
+		EventID:        eventUUID,
+		ReportedUserID: reportedUUID,
+		ReporterID:     reporterUUID,
+	}
+	result := database.GetDB().Where(report).FirstOrCreate(report)
+	if result.Error != nil {
+		return fmt.Errorf("failed to report no-show: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("already reported")
+	}
+
+	s.auditLogger.LogAction(&reporterID, "event_no_shows", &eventID, "REPORT_NO_SHOW", nil, map[string]string{
+		"event_id":         eventID,
+		"reported_user_id": reportedUserID,
+	})
+
+	return nil
+}
+
+// GetUserReliability returns the attendance reliability of a user across completed events
+func (s *ReliabilityService) GetUserReliability(userID string) (*dto.ReliabilityResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID")
+	}
+
+	var user models.User
+	err = database.GetDB().Where("id = ? AND deleted_at IS NULL", userUUID).First(&user).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("user not found")
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	var completed int64
+	err = database.GetDB().Model(&models.UserEventHistory{}).
+		Where("user_id = ? AND completed = ?", userUUID, true).
+		Count(&completed).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to count completed events: %w", err)
+	}
+
+	noShows, err := s.countNoShows(userUUID)
+	if err != nil {
+		return nil, err
+	}
+	if noShows > completed {
+		noShows = completed
+	}
+
+	response := &dto.ReliabilityResponse{
+		UserID:          userID,
+		CompletedEvents: completed,
+		NoShows:         noShows,
+	}
+
+	// New users have no score rather than a perfect one
+	if completed > 0 {
+		rate := float64(noShows) / float64(completed)
+		score := int(math.Round((1 - rate) * 100))
+		rate = math.Round(rate*100) / 100
+		response.NoShowRate = &rate
+		response.ReliabilityScore = &score
+	}
+
+	return response, nil
+}
+
+// countNoShows counts completed events the user missed: a no-show against them was upheld
+// (reported by the event creator, or by at least noShowMemberQuorum members), or the event
+// used check-in and they never checked in. An event counts once even when both apply.
+func (s *ReliabilityService) countNoShows(userID uuid.UUID) (int64, error) {
+	var reportedIDs []string
+	err := database.GetDB().Table("event_no_shows AS ns").
+		Select("ns.event_id").
+		Joins("JOIN events e ON e.id = ns.event_id").
+		Where("ns.reported_user_id = ? AND e.deleted_at IS NULL", userID).
+		Group("ns.event_id, e.creator_id").
+		Having("COUNT(*) >= ? OR SUM(CASE WHEN ns.reporter_id = e.creator_id THEN 1 ELSE 0 END) > 0", noShowMemberQuorum).
+		Pluck("ns.event_id", &reportedIDs).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count no-shows: %w", err)
+	}
+
+	var missedIDs []string
+	err = database.GetDB().Model(&models.UserEventHistory{}).
+		Where("user_id = ? AND completed = ? AND attended = ?", userID, true, false).
+		Pluck("event_id", &missedIDs).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count missed check-ins: %w", err)
+	}
+
+	eventIDs := make(map[string]struct{}, len(reportedIDs)+len(missedIDs))
+	for _, id := range append(reportedIDs, missedIDs...) {
+		eventIDs[id] = struct{}{}
+	}
+
+	return int64(len(eventIDs)), nil
+}
+
+// isConfirmedMember reports whether the user is a confirmed member (or the creator) of the event
+func (s *ReliabilityService) isConfirmedMember(eventID, userID uuid.UUID) bool {
+	var count int64
+	database.GetDB().Model(&models.EventMember{}).
+		Where("event_id = ? AND user_id = ? AND status = ?", eventID, userID, models.MemberStatusConfirmed).
+		Count(&count)
+	return count > 0
+}
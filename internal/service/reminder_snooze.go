@@ -0,0 +1,155 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// snoozableNotificationTypes are the notification types that can be snoozed
+var snoozableNotificationTypes = map[string]bool{
+	"event_reminder": true,
+}
+
+// SnoozeNotification marks a reminder as handled and queues it to be sent again after delay.
+// The event must still be upcoming when the snooze ends.
+func (s *NotificationService) SnoozeNotification(userID, notificationID string, delay time.Duration) (*dto.SnoozeNotificationResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID")
+	}
+	notificationUUID, err := uuid.Parse(notificationID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid notification ID")
+	}
+
+	var notification models.Notification
+	err = database.GetDB().Where("id = ? AND user_id = ?", notificationUUID, userUUID).First(&notification).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("notification not found")
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	notificationType, _ := notification.Data["type"].(string)
+	eventIDValue, _ := notification.Data["event_id"].(string)
+	eventUUID, err := uuid.Parse(eventIDValue)
+	if !snoozableNotificationTypes[notificationType] || err != nil {
+		return nil, fmt.Errorf("notification cannot be snoozed")
+	}
+
+	now := s.clock.Now()
+	dueAt := now.Add(delay)
+	var event models.Event
+	err = database.GetDB().Where("id = ? AND status = ? AND deleted_at IS NULL", eventUUID, models.EventStatusPublished).
+		First(&event).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("event is no longer upcoming")
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	if event.StartAt != nil && !event.StartAt.After(dueAt) {
+		return nil, fmt.Errorf("snooze ends after the event starts")
+	}
+
+	var snoozed int64
+	err = database.GetDB().Model(&models.ReminderSnooze{}).Where("notification_id = ?", notificationUUID).Count(&snoozed).Error
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	if snoozed > 0 {
+		return nil, fmt.Errorf("notification already snoozed")
+	}
+
+	err = database.GetDB().Transaction(func(tx *gorm.DB) error {
+		snooze := &models.ReminderSnooze{
+			UserID:         userUUID,
+			EventID:        eventUUID,
+			NotificationID: notificationUUID,
+			DueAt:          dueAt,
+		}
+		if err := tx.Create(snooze).Error; err != nil {
+			return fmt.Errorf("failed to snooze notification: %w", err)
+		}
+
+		// The original reminder has been dealt with
+		return tx.Model(&models.Notification{}).Where("id = ? AND read = ?", notificationUUID, false).
+			Updates(map[string]interface{}{
+				"read":    true,
+				"read_at": now,
+			}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.SnoozeNotificationResponse{
+		NotificationID: notificationUUID.String(),
+		EventID:        eventUUID.String(),
+		RemindAt:       dueAt,
+	}, nil
+}
+
+// ProcessDueReminderSnoozes sends the snoozed reminders that are due and returns how many were
+// handled. Reminders for events that were cancelled, started or that the user left are dropped.
+func (s *NotificationService) ProcessDueReminderSnoozes(batchSize int) (int, error) {
+	var snoozes []models.ReminderSnooze
+	err := database.GetDB().
+		Where("due_at <= ?", s.clock.Now()).
+		Order("due_at ASC").
+		Limit(batchSize).
+		Find(&snoozes).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to get due reminder snoozes: %w", err)
+	}
+
+	for _, snooze := range snoozes {
+		if err := s.sendSnoozedReminder(snooze); err != nil {
+			// Left in the queue to be tried again
+			log.Printf("Failed to send snoozed reminder %s: %v", snooze.ID, err)
+			continue
+		}
+		if err := database.GetDB().Delete(&models.ReminderSnooze{}, "id = ?", snooze.ID).Error; err != nil {
+			log.Printf("Failed to remove snoozed reminder %s: %v", snooze.ID, err)
+		}
+	}
+
+	return len(snoozes), nil
+}
+
+// sendSnoozedReminder sends the reminder again if the user is still going to the upcoming event
+func (s *NotificationService) sendSnoozedReminder(snooze models.ReminderSnooze) error {
+	var event models.Event
+	err := database.GetDB().
+		Joins("JOIN event_members ON event_members.event_id = events.id").
+		Where("events.id = ? AND events.status = ? AND events.deleted_at IS NULL", snooze.EventID, models.EventStatusPublished).
+		Where("event_members.user_id = ? AND event_members.status = ?", snooze.UserID, models.MemberStatusConfirmed).
+		First(&event).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil
+		}
+		return fmt.Errorf("database error: %w", err)
+	}
+	if event.StartAt != nil && !event.StartAt.After(s.clock.Now()) {
+		return nil
+	}
+
+	title := "Event Reminder"
+	body := fmt.Sprintf("Don't forget! %s is starting soon.", event.Title)
+	data := map[string]interface{}{
+		"event_id": event.ID.String(),
+		"type":     "event_reminder",
+		"snoozed":  true,
+	}
+	return s.SendPushNotification(snooze.UserID.String(), title, body, data)
+}
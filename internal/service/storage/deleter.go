@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// Deleter removes objects previously stored by an Uploader
+type Deleter interface {
+	Delete(ctx context.Context, key string) error
+	// KeyFromURL maps a public URL back to its storage key.
+	// It reports false for URLs that do not belong to this storage.
+	KeyFromURL(rawURL string) (string, bool)
+}
+
+// Delete removes the object at key. Missing objects are treated as deleted.
+func (w *WebDAVUploader) Delete(ctx context.Context, key string) error {
+	if key == "" {
+		return fmt.Errorf("key cannot be empty")
+	}
+
+	target, err := w.fullURL(key)
+	if err != nil {
+		return fmt.Errorf("failed to build target URL: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, target, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.SetBasicAuth(w.user, w.pass)
+	req.Header.Set("User-Agent", "TinderTrip-Backend/1.0")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 200))
+		return fmt.Errorf("webdav delete failed: %s. Response: %s", resp.Status, string(body))
+	}
+}
+
+// KeyFromURL maps a URL returned by Upload back to its key
+func (w *WebDAVUploader) KeyFromURL(rawURL string) (string, bool) {
+	root, err := w.fullURL("")
+	if err != nil {
+		return "", false
+	}
+	base, err := url.Parse(root)
+	if err != nil {
+		return "", false
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != base.Scheme || u.Host != base.Host {
+		return "", false
+	}
+
+	key, ok := w.keyFromPath(u.Path)
+	if !ok || key == "" {
+		return "", false
+	}
+	return key, true
+}
@@ -13,3 +13,21 @@ func NewUploader() (Uploader, error) {
 		return nil, fmt.Errorf("unsupported STORAGE_PROVIDER: %s", os.Getenv("STORAGE_PROVIDER"))
 	}
 }
+
+func NewDeleter() (Deleter, error) {
+	switch os.Getenv("STORAGE_PROVIDER") {
+	case "webdav", "":
+		return NewWebDAVUploader()
+	default:
+		return nil, fmt.Errorf("unsupported STORAGE_PROVIDER: %s", os.Getenv("STORAGE_PROVIDER"))
+	}
+}
+
+func NewLister() (Lister, error) {
+	switch os.Getenv("STORAGE_PROVIDER") {
+	case "webdav", "":
+		return NewWebDAVUploader()
+	default:
+		return nil, fmt.Errorf("unsupported STORAGE_PROVIDER: %s", os.Getenv("STORAGE_PROVIDER"))
+	}
+}
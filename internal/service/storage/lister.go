@@ -0,0 +1,167 @@
+package storage
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Object describes a stored object
+type Object struct {
+	Key        string
+	URL        string
+	Size       int64
+	ModifiedAt time.Time
+}
+
+// Lister enumerates stored objects
+type Lister interface {
+	// List returns every object under prefix, recursively
+	List(ctx context.Context, prefix string) ([]Object, error)
+}
+
+// propfindBody requests only the properties needed for listing
+const propfindBody = `<?xml version="1.0" encoding="utf-8"?>
+<d:propfind xmlns:d="DAV:"><d:prop><d:resourcetype/><d:getcontentlength/><d:getlastmodified/></d:prop></d:propfind>`
+
+type davMultistatus struct {
+	Responses []davResponse `xml:"response"`
+}
+
+type davResponse struct {
+	Href     string        `xml:"href"`
+	Propstat []davPropstat `xml:"propstat"`
+}
+
+type davPropstat struct {
+	Prop struct {
+		ResourceType struct {
+			Collection *struct{} `xml:"collection"`
+		} `xml:"resourcetype"`
+		ContentLength int64  `xml:"getcontentlength"`
+		LastModified  string `xml:"getlastmodified"`
+	} `xml:"prop"`
+	Status string `xml:"status"`
+}
+
+// List walks the collection at prefix with Depth: 1 requests
+func (w *WebDAVUploader) List(ctx context.Context, prefix string) ([]Object, error) {
+	var objects []Object
+	pending := []string{strings.Trim(prefix, "/")}
+	visited := map[string]bool{}
+
+	for len(pending) > 0 {
+		dir := pending[0]
+		pending = pending[1:]
+		if visited[dir] {
+			continue
+		}
+		visited[dir] = true
+
+		entries, err := w.propfind(ctx, dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			key, ok := w.keyFromPath(entry.Href)
+			if !ok || strings.Trim(key, "/") == dir {
+				continue
+			}
+			if entry.isCollection() {
+				pending = append(pending, strings.Trim(key, "/"))
+				continue
+			}
+
+			objectURL, err := w.fullURL(key)
+			if err != nil {
+				continue
+			}
+			object := Object{Key: key, URL: objectURL}
+			for _, ps := range entry.Propstat {
+				if ps.Prop.ContentLength > 0 {
+					object.Size = ps.Prop.ContentLength
+				}
+				if t, err := http.ParseTime(ps.Prop.LastModified); err == nil {
+					object.ModifiedAt = t
+				}
+			}
+			objects = append(objects, object)
+		}
+	}
+
+	return objects, nil
+}
+
+// propfind lists the direct children of a collection
+func (w *WebDAVUploader) propfind(ctx context.Context, dir string) ([]davResponse, error) {
+	target, err := w.fullURL(dir + "/")
+	if err != nil {
+		return nil, fmt.Errorf("failed to build target URL: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PROPFIND", target+"/", strings.NewReader(propfindBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PROPFIND request: %w", err)
+	}
+	req.SetBasicAuth(w.user, w.pass)
+	req.Header.Set("Depth", "1")
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("User-Agent", "TinderTrip-Backend/1.0")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute PROPFIND request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusMultiStatus {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 200))
+		return nil, fmt.Errorf("webdav propfind failed: %s. Response: %s", resp.Status, string(body))
+	}
+
+	var result davMultistatus
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse PROPFIND response: %w", err)
+	}
+	return result.Responses, nil
+}
+
+// isCollection reports whether the entry is a directory
+func (r davResponse) isCollection() bool {
+	for _, ps := range r.Propstat {
+		if ps.Prop.ResourceType.Collection != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// keyFromPath maps an href path returned by PROPFIND to a storage key
+func (w *WebDAVUploader) keyFromPath(href string) (string, bool) {
+	u, err := url.Parse(href)
+	if err != nil {
+		return "", false
+	}
+	root, err := w.fullURL("")
+	if err != nil {
+		return "", false
+	}
+	base, err := url.Parse(root)
+	if err != nil {
+		return "", false
+	}
+
+	prefix := strings.TrimRight(base.Path, "/") + "/"
+	if !strings.HasPrefix(u.Path, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(u.Path, prefix), true
+}
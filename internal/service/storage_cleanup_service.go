@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service/storage"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/database"
+)
+
+const (
+	storageDeletionMaxAttempts = 8
+	storageDeletionBaseDelay   = 1 * time.Minute
+	storageDeletionMaxDelay    = 6 * time.Hour
+	storageDeletionTimeout     = 30 * time.Second
+)
+
+// StorageCleanupService deletes storage objects that are no longer referenced
+type StorageCleanupService struct {
+	deleter storage.Deleter
+	clock   utils.Clock
+}
+
+// NewStorageCleanupService creates a new storage cleanup service
+func NewStorageCleanupService() *StorageCleanupService {
+	// Storage may not be configured; deletes are then queued until it is
+	deleter, _ := storage.NewDeleter()
+	return NewStorageCleanupServiceWithDeleter(deleter)
+}
+
+// NewStorageCleanupServiceWithDeleter creates a storage cleanup service backed by the given deleter
+func NewStorageCleanupServiceWithDeleter(deleter storage.Deleter) *StorageCleanupService {
+	return &StorageCleanupService{
+		deleter: deleter,
+		clock:   utils.RealClock{},
+	}
+}
+
+// NewStorageCleanupServiceWithClock creates a storage cleanup service that reads the time from
+// clock, so retries are scheduled and picked up against it
+func NewStorageCleanupServiceWithClock(clock utils.Clock) *StorageCleanupService {
+	service := NewStorageCleanupService()
+	service.clock = clock
+	return service
+}
+
+// RemoveObjects deletes the objects behind the given URLs.
+// Failed deletes are queued for retry so callers never block on storage.
+func (s *StorageCleanupService) RemoveObjects(urls []string) {
+	for _, url := range urls {
+		if url == "" {
+			continue
+		}
+		if err := s.deleteObject(url); err != nil {
+			log.Printf("Failed to delete storage object %s, queueing retry: %v", url, err)
+			if err := s.enqueue(url, err); err != nil {
+				log.Printf("Failed to queue storage deletion for %s: %v", url, err)
+			}
+		}
+	}
+}
+
+// ProcessPendingDeletions retries due deletions and returns how many were attempted
+func (s *StorageCleanupService) ProcessPendingDeletions(batchSize int) (int, error) {
+	var deletions []models.StorageDeletion
+	err := database.GetDB().
+		Where("status = ? AND next_attempt_at <= ?", models.StorageDeletionPending, s.clock.Now()).
+		Order("next_attempt_at ASC").
+		Limit(batchSize).
+		Find(&deletions).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to get pending deletions: %w", err)
+	}
+
+	for _, deletion := range deletions {
+		if err := s.attemptDeletion(deletion); err != nil {
+			log.Printf("Failed to record storage deletion %s: %v", deletion.ID, err)
+		}
+	}
+
+	return len(deletions), nil
+}
+
+// attemptDeletion retries one deletion, removing the row on success
+func (s *StorageCleanupService) attemptDeletion(deletion models.StorageDeletion) error {
+	deleteErr := s.deleteObject(deletion.ObjectURL)
+	if deleteErr == nil {
+		return database.GetDB().Delete(&models.StorageDeletion{}, "id = ?", deletion.ID).Error
+	}
+
+	attempts := deletion.Attempts + 1
+	updates := map[string]interface{}{
+		"attempts":   attempts,
+		"last_error": deleteErr.Error(),
+		"updated_at": s.clock.Now(),
+	}
+	if attempts >= storageDeletionMaxAttempts {
+		updates["status"] = models.StorageDeletionFailed
+	} else {
+		updates["next_attempt_at"] = s.clock.Now().Add(storageDeletionRetryDelay(attempts))
+	}
+
+	return database.GetDB().Model(&models.StorageDeletion{}).Where("id = ?", deletion.ID).Updates(updates).Error
+}
+
+// deleteObject deletes a single object. URLs outside our storage are ignored.
+func (s *StorageCleanupService) deleteObject(url string) error {
+	if s.deleter == nil {
+		return fmt.Errorf("storage is not configured")
+	}
+
+	key, ok := s.deleter.KeyFromURL(url)
+	if !ok {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), storageDeletionTimeout)
+	defer cancel()
+	return s.deleter.Delete(ctx, key)
+}
+
+// enqueue records a failed deletion for the worker to retry
+func (s *StorageCleanupService) enqueue(url string, cause error) error {
+	lastError := cause.Error()
+	deletion := &models.StorageDeletion{
+		ObjectURL:     url,
+		Status:        models.StorageDeletionPending,
+		Attempts:      1,
+		LastError:     &lastError,
+		NextAttemptAt: s.clock.Now().Add(storageDeletionRetryDelay(1)),
+	}
+	return database.GetDB().Create(deletion).Error
+}
+
+// storageDeletionRetryDelay returns an exponential backoff delay for the given attempt count
+func storageDeletionRetryDelay(attempts int) time.Duration {
+	delay := storageDeletionBaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= storageDeletionMaxDelay {
+			return storageDeletionMaxDelay
+		}
+	}
+	return delay
+}
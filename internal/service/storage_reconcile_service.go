@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service/storage"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/database"
+)
+
+const (
+	// storageRootPrefix is the folder every upload is written under
+	storageRootPrefix = "tindertrip"
+	// maxReportedOrphans bounds the keys listed in a report
+	maxReportedOrphans      = 200
+	storageReconcileTimeout = 10 * time.Minute
+)
+
+// StorageReconcileService finds and removes stored objects that nothing references
+type StorageReconcileService struct {
+	lister  storage.Lister
+	deleter storage.Deleter
+	clock   utils.Clock
+}
+
+// NewStorageReconcileService creates a new storage reconcile service
+func NewStorageReconcileService() *StorageReconcileService {
+	// Storage may not be configured; Reconcile then reports an error
+	lister, _ := storage.NewLister()
+	deleter, _ := storage.NewDeleter()
+	return NewStorageReconcileServiceWithStorage(lister, deleter)
+}
+
+// NewStorageReconcileServiceWithStorage creates a storage reconcile service backed by the given storage
+func NewStorageReconcileServiceWithStorage(lister storage.Lister, deleter storage.Deleter) *StorageReconcileService {
+	return &StorageReconcileService{
+		lister:  lister,
+		deleter: deleter,
+		clock:   utils.RealClock{},
+	}
+}
+
+// NewStorageReconcileServiceWithClock creates a storage reconcile service that reads the time
+// from clock; the grace period is measured against it
+func NewStorageReconcileServiceWithClock(clock utils.Clock) *StorageReconcileService {
+	service := NewStorageReconcileService()
+	service.clock = clock
+	return service
+}
+
+// Reconcile compares stored objects with database references.
+// Objects newer than the grace period are never touched, so in-flight uploads are safe.
+// Orphans are only deleted when dryRun is false.
+func (s *StorageReconcileService) Reconcile(dryRun bool) (*dto.StorageReconcileReport, error) {
+	if s.lister == nil || s.deleter == nil {
+		return nil, fmt.Errorf("storage is not configured")
+	}
+
+	graceHours := storageReconcileGraceHours()
+	report := &dto.StorageReconcileReport{
+		DryRun:       dryRun,
+		StartedAt:    s.clock.Now(),
+		GraceHours:   graceHours,
+		OrphanedKeys: []string{},
+	}
+
+	// Load references first so a DB failure never leads to deletes
+	referenced, err := s.referencedKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), storageReconcileTimeout)
+	defer cancel()
+
+	objects, err := s.lister.List(ctx, storageRootPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list storage objects: %w", err)
+	}
+
+	cutoff := report.StartedAt.Add(-time.Duration(graceHours) * time.Hour)
+	for _, object := range objects {
+		report.Scanned++
+		if referenced[object.Key] {
+			report.Referenced++
+			continue
+		}
+
+		// Unknown modification time counts as recent
+		if object.ModifiedAt.IsZero() || object.ModifiedAt.After(cutoff) {
+			report.InGrace++
+			continue
+		}
+
+		report.Orphaned++
+		if len(report.OrphanedKeys) < maxReportedOrphans {
+			report.OrphanedKeys = append(report.OrphanedKeys, object.Key)
+		}
+
+		if dryRun {
+			continue
+		}
+		if err := s.deleter.Delete(ctx, object.Key); err != nil {
+			log.Printf("Failed to delete orphaned storage object %s: %v", object.Key, err)
+			report.Failed++
+			continue
+		}
+		report.Deleted++
+	}
+
+	return report, nil
+}
+
+// referencedKeys collects storage keys referenced by events, photos, avatars and chat media
+func (s *StorageReconcileService) referencedKeys() (map[string]bool, error) {
+	db := database.GetDB()
+	sources := []struct {
+		model  interface{}
+		column string
+	}{
+		{&models.Event{}, "cover_image_url"},
+		{&models.EventPhoto{}, "url"},
+		{&models.UserProfile{}, "avatar_url"},
+		{&models.ChatMessage{}, "image_url"},
+		{&models.ChatMessage{}, "file_url"},
+	}
+
+	keys := make(map[string]bool)
+	for _, source := range sources {
+		var urls []string
+		// Soft-deleted rows still count as references until purged
+		err := db.Model(source.model).Unscoped().
+			Where(source.column+" IS NOT NULL AND "+source.column+" <> ''").
+			Pluck(source.column, &urls).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %s references: %w", source.column, err)
+		}
+		for _, url := range urls {
+			if key, ok := s.deleter.KeyFromURL(url); ok {
+				keys[key] = true
+			}
+		}
+	}
+
+	return keys, nil
+}
+
+// storageReconcileGraceHours returns the configured grace period
+func storageReconcileGraceHours() int {
+	if config.AppConfig != nil && config.AppConfig.Storage.ReconcileGraceHours > 0 {
+		return config.AppConfig.Storage.ReconcileGraceHours
+	}
+	return 24
+}
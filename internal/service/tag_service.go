@@ -3,23 +3,35 @@ package service
 import (
 	"fmt"
 	"math"
+	"sort"
 	"strings"
+	"unicode"
 
 	"TinderTrip-Backend/internal/dto"
 	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
 	"TinderTrip-Backend/pkg/database"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
+// maxSuggestionCandidates caps how many of the newest eligible events are scored for suggestions
+const maxSuggestionCandidates = 500
+
 // TagService handles tag business logic
 type TagService struct {
+	clock utils.Clock
 }
 
 // NewTagService creates a new tag service
 func NewTagService() *TagService {
-	return &TagService{}
+	return NewTagServiceWithClock(utils.RealClock{})
+}
+
+// NewTagServiceWithClock creates a tag service that reads the time from clock
+func NewTagServiceWithClock(clock utils.Clock) *TagService {
+	return &TagService{clock: clock}
 }
 
 // GetTags gets tags with filtering
@@ -61,35 +73,91 @@ func (s *TagService) GetTags(page, limit int, kind string) ([]dto.TagResponse, i
 	return responses, total, nil
 }
 
-// GetUserTags gets user's tags
-func (s *TagService) GetUserTags(userID string) ([]dto.TagResponse, error) {
-	// Parse user ID
-	userUUID, err := uuid.Parse(userID)
+// GetOnboardingTags suggests tags for new users, grouped by kind in TagKinds order.
+// Tags are ranked by how many users and events use them; tags whose names contain one of the
+// comma separated interests are marked as matched and ranked first within their kind.
+func (s *TagService) GetOnboardingTags(interests string, perKind int) (*dto.OnboardingTagsResponse, error) {
+	var rows []struct {
+		models.Tag
+		Popularity int64
+	}
+	err := database.GetDB().Model(&models.Tag{}).
+		Select("tags.*, " +
+			"(SELECT COUNT(*) FROM user_tags WHERE user_tags.tag_id = tags.id) + " +
+			"(SELECT COUNT(*) FROM event_tags WHERE event_tags.tag_id = tags.id) AS popularity").
+		Scan(&rows).Error
 	if err != nil {
-		return nil, fmt.Errorf("invalid user ID")
+		return nil, fmt.Errorf("failed to get tags: %w", err)
 	}
 
-	// Get user tags
-	var userTags []models.UserTag
-	err = database.GetDB().Preload("Tag").Where("user_id = ?", userUUID).Find(&userTags).Error
-	if err != nil {
-		return nil, fmt.Errorf("failed to get user tags: %w", err)
+	var terms []string
+	for _, term := range strings.Split(interests, ",") {
+		if term = strings.ToLower(strings.TrimSpace(term)); term != "" {
+			terms = append(terms, term)
+		}
 	}
 
-	// Convert to response DTOs
-	responses := make([]dto.TagResponse, len(userTags))
-	for i, userTag := range userTags {
-		if userTag.Tag != nil {
-			responses[i] = dto.TagResponse{
-				ID:        userTag.Tag.ID.String(),
-				Name:      userTag.Tag.Name,
-				Kind:      userTag.Tag.Kind,
-				CreatedAt: userTag.Tag.CreatedAt,
+	byKind := make(map[string][]dto.OnboardingTagResponse)
+	for _, row := range rows {
+		name := strings.ToLower(row.Name)
+		matched := false
+		for _, term := range terms {
+			if strings.Contains(name, term) {
+				matched = true
+				break
 			}
 		}
+		byKind[row.Kind] = append(byKind[row.Kind], dto.OnboardingTagResponse{
+			TagResponse: dto.TagResponse{
+				ID:        row.ID.String(),
+				Name:      row.Name,
+				Kind:      row.Kind,
+				CreatedAt: row.CreatedAt,
+			},
+			Popularity: row.Popularity,
+			Matched:    matched,
+		})
+	}
+
+	response := &dto.OnboardingTagsResponse{Groups: []dto.OnboardingTagGroup{}}
+	for _, kind := range models.TagKinds {
+		tags := byKind[string(kind)]
+		if len(tags) == 0 {
+			continue
+		}
+		sort.SliceStable(tags, func(i, j int) bool {
+			if tags[i].Matched != tags[j].Matched {
+				return tags[i].Matched
+			}
+			if tags[i].Popularity != tags[j].Popularity {
+				return tags[i].Popularity > tags[j].Popularity
+			}
+			return tags[i].Name < tags[j].Name
+		})
+		if perKind > 0 && len(tags) > perKind {
+			tags = tags[:perKind]
+		}
+		response.Groups = append(response.Groups, dto.OnboardingTagGroup{Kind: string(kind), Tags: tags})
 	}
 
-	return responses, nil
+	return response, nil
+}
+
+// GetUserTags gets user's tags, optionally filtered by kind.
+// A limit of 0 returns all tags; otherwise the page of that size is returned.
+func (s *TagService) GetUserTags(userID string, page, limit int, kind string) ([]dto.TagResponse, int64, error) {
+	// Parse user ID
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid user ID")
+	}
+
+	// Get user tags
+	query := database.GetDB().Model(&models.Tag{}).
+		Joins("JOIN user_tags ON user_tags.tag_id = tags.id").
+		Where("user_tags.user_id = ?", userUUID)
+
+	return s.findTagPage(query, page, limit, kind, "user tags")
 }
 
 // AddUserTag adds a tag to user
@@ -139,6 +207,217 @@ func (s *TagService) AddUserTag(userID, tagID string) error {
 	return nil
 }
 
+// interestNoteNegations are words that, just before a tag name, mean the user does not want it
+var interestNoteNegations = map[string]bool{
+	"no": true, "not": true, "never": true, "don't": true, "dont": true,
+	"hate": true, "dislike": true, "without": true,
+}
+
+// GetInterestNoteTags returns existing tags named in the user's profile interests note that
+// the user has not picked yet. Nothing is saved; the user confirms with AddInterestNoteTags.
+func (s *TagService) GetInterestNoteTags(userID string) ([]dto.TagResponse, error) {
+	matches, err := s.findInterestNoteTags(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.TagResponse, len(matches))
+	for i, tag := range matches {
+		responses[i] = dto.TagResponse{
+			ID:        tag.ID.String(),
+			Name:      tag.Name,
+			Kind:      tag.Kind,
+			CreatedAt: tag.CreatedAt,
+		}
+	}
+	return responses, nil
+}
+
+// AddInterestNoteTags adds the confirmed tags to the user. Every ID must be one of the tags
+// currently suggested by GetInterestNoteTags, so a stale or tampered list adds nothing.
+func (s *TagService) AddInterestNoteTags(userID string, tagIDs []string) ([]dto.TagResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID")
+	}
+
+	matches, err := s.findInterestNoteTags(userID)
+	if err != nil {
+		return nil, err
+	}
+	suggested := make(map[uuid.UUID]models.Tag, len(matches))
+	for _, tag := range matches {
+		suggested[tag.ID] = tag
+	}
+
+	var confirmed []models.UserTag
+	responses := make([]dto.TagResponse, 0, len(tagIDs))
+	seen := make(map[uuid.UUID]bool, len(tagIDs))
+	for _, tagID := range tagIDs {
+		tagUUID, err := uuid.Parse(tagID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag ID")
+		}
+		tag, ok := suggested[tagUUID]
+		if !ok {
+			return nil, fmt.Errorf("tag not suggested")
+		}
+		if seen[tagUUID] {
+			continue
+		}
+		seen[tagUUID] = true
+		confirmed = append(confirmed, models.UserTag{UserID: userUUID, TagID: tagUUID})
+		responses = append(responses, dto.TagResponse{
+			ID:        tag.ID.String(),
+			Name:      tag.Name,
+			Kind:      tag.Kind,
+			CreatedAt: tag.CreatedAt,
+		})
+	}
+
+	if len(confirmed) > 0 {
+		if err := database.GetDB().Create(&confirmed).Error; err != nil {
+			return nil, fmt.Errorf("failed to add user tags: %w", err)
+		}
+	}
+	return responses, nil
+}
+
+// findInterestNoteTags matches the user's interests note against tag names, leaving out tags the
+// user already has
+func (s *TagService) findInterestNoteTags(userID string) ([]models.Tag, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID")
+	}
+
+	var profile models.UserProfile
+	err = database.GetDB().Where("user_id = ? AND deleted_at IS NULL", userUUID).First(&profile).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+	if profile.InterestsNote == nil || strings.TrimSpace(*profile.InterestsNote) == "" {
+		return []models.Tag{}, nil
+	}
+
+	var tags []models.Tag
+	err = database.GetDB().
+		Where("id NOT IN (?)", database.GetDB().Model(&models.UserTag{}).Select("tag_id").Where("user_id = ?", userUUID)).
+		Order("name ASC").
+		Find(&tags).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+
+	return matchNoteTags(*profile.InterestsNote, tags), nil
+}
+
+// matchNoteTags returns the tags whose whole name appears as consecutive words in the note.
+// Matching is deliberately conservative: case is ignored and a plural "s" or "es" is allowed on
+// the last word, but partial words never match, names shorter than three letters are skipped
+// and a name right after a negation such as "no" or "don't" is ignored.
+func matchNoteTags(note string, tags []models.Tag) []models.Tag {
+	words := noteWords(note)
+	matches := make([]models.Tag, 0)
+	for _, tag := range tags {
+		name := noteWords(tag.Name)
+		if len(name) == 0 || len([]rune(strings.Join(name, " "))) < 3 {
+			continue
+		}
+		for start := 0; start+len(name) <= len(words); start++ {
+			if start > 0 && interestNoteNegations[words[start-1]] {
+				continue
+			}
+			if wordsMatchName(words[start:start+len(name)], name) {
+				matches = append(matches, tag)
+				break
+			}
+		}
+	}
+	return matches
+}
+
+// wordsMatchName compares note words to tag name words, allowing a plural last word
+func wordsMatchName(words, name []string) bool {
+	last := len(name) - 1
+	for i := range name {
+		if words[i] == name[i] {
+			continue
+		}
+		if i == last && (words[i] == name[i]+"s" || words[i] == name[i]+"es") {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// noteWords lowercases text and splits it into words, keeping apostrophes inside words
+func noteWords(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '\''
+	})
+	words := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if word := strings.Trim(field, "'"); word != "" {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+// maxEventTagSuggestions caps how many tags are suggested for a draft event
+const maxEventTagSuggestions = 10
+
+// SuggestEventTags returns existing tags named in a draft event's title or description, for the
+// creator to pick into tag_ids. Matching is the same as for interests notes; title matches come
+// first. Category tags are left out since they are chosen separately as category_ids.
+func (s *TagService) SuggestEventTags(title, description string) ([]dto.TagResponse, error) {
+	var texts []string
+	if strings.TrimSpace(title) != "" {
+		normalized, err := normalizeEventTitle(title)
+		if err != nil {
+			return nil, err
+		}
+		texts = append(texts, normalized)
+	}
+	normalized, err := normalizeEventDescription(&description)
+	if err != nil {
+		return nil, err
+	}
+	if normalized != nil {
+		texts = append(texts, *normalized)
+	}
+
+	responses := make([]dto.TagResponse, 0)
+	if len(texts) == 0 {
+		return responses, nil
+	}
+
+	var tags []models.Tag
+	err = database.GetDB().Where("kind <> ?", models.TagKindCategory).Order("name ASC").Find(&tags).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	for _, text := range texts {
+		for _, tag := range matchNoteTags(text, tags) {
+			if seen[tag.ID] || len(responses) == maxEventTagSuggestions {
+				continue
+			}
+			seen[tag.ID] = true
+			responses = append(responses, dto.TagResponse{
+				ID:        tag.ID.String(),
+				Name:      tag.Name,
+				Kind:      tag.Kind,
+				CreatedAt: tag.CreatedAt,
+			})
+		}
+	}
+	return responses, nil
+}
+
 // RemoveUserTag removes a tag from user
 func (s *TagService) RemoveUserTag(userID, tagID string) error {
 	// Parse IDs
@@ -171,12 +450,13 @@ func (s *TagService) RemoveUserTag(userID, tagID string) error {
 	return nil
 }
 
-// GetEventTags gets event's tags
-func (s *TagService) GetEventTags(eventID string) ([]dto.TagResponse, error) {
+// GetEventTags gets event's tags, optionally filtered by kind.
+// A limit of 0 returns all tags; otherwise the page of that size is returned.
+func (s *TagService) GetEventTags(eventID string, page, limit int, kind string) ([]dto.TagResponse, int64, error) {
 	// Parse event ID
 	eventUUID, err := uuid.Parse(eventID)
 	if err != nil {
-		return nil, fmt.Errorf("invalid event ID")
+		return nil, 0, fmt.Errorf("invalid event ID")
 	}
 
 	// Check if event exists
@@ -184,32 +464,55 @@ func (s *TagService) GetEventTags(eventID string) ([]dto.TagResponse, error) {
 	err = database.GetDB().Where("id = ? AND deleted_at IS NULL", eventUUID).First(&event).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("event not found")
+			return nil, 0, fmt.Errorf("event not found")
 		}
-		return nil, fmt.Errorf("failed to get event: %w", err)
+		return nil, 0, fmt.Errorf("failed to get event: %w", err)
 	}
 
 	// Get event tags
-	var eventTags []models.EventTag
-	err = database.GetDB().Preload("Tag").Where("event_id = ?", eventUUID).Find(&eventTags).Error
+	query := database.GetDB().Model(&models.Tag{}).
+		Joins("JOIN event_tags ON event_tags.tag_id = tags.id").
+		Where("event_tags.event_id = ?", eventUUID)
+
+	return s.findTagPage(query, page, limit, kind, "event tags")
+}
+
+// findTagPage applies the kind filter and optional pagination to a tag query
+func (s *TagService) findTagPage(query *gorm.DB, page, limit int, kind, label string) ([]dto.TagResponse, int64, error) {
+	// Apply kind filter
+	if kind != "" {
+		query = query.Where("tags.kind = ?", kind)
+	}
+
+	// Get total count
+	var total int64
+	err := query.Count(&total).Error
 	if err != nil {
-		return nil, fmt.Errorf("failed to get event tags: %w", err)
+		return nil, 0, fmt.Errorf("failed to count %s: %w", label, err)
+	}
+
+	// Get tags, paged only when a limit is given
+	if limit > 0 {
+		query = query.Offset((page - 1) * limit).Limit(limit)
+	}
+	var tags []models.Tag
+	err = query.Order("tags.name ASC").Find(&tags).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get %s: %w", label, err)
 	}
 
 	// Convert to response DTOs
-	responses := make([]dto.TagResponse, len(eventTags))
-	for i, eventTag := range eventTags {
-		if eventTag.Tag != nil {
-			responses[i] = dto.TagResponse{
-				ID:        eventTag.Tag.ID.String(),
-				Name:      eventTag.Tag.Name,
-				Kind:      eventTag.Tag.Kind,
-				CreatedAt: eventTag.Tag.CreatedAt,
-			}
+	responses := make([]dto.TagResponse, len(tags))
+	for i, tag := range tags {
+		responses[i] = dto.TagResponse{
+			ID:        tag.ID.String(),
+			Name:      tag.Name,
+			Kind:      tag.Kind,
+			CreatedAt: tag.CreatedAt,
 		}
 	}
 
-	return responses, nil
+	return responses, total, nil
 }
 
 // AddEventTag adds a tag to event
@@ -330,18 +633,85 @@ func (s *TagService) GetEventSuggestions(userID string, page, limit int) ([]dto.
 	}
 
 	// Get user interests (unified preferences)
+	userInterests, err := s.loadUserInterests(userUUID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Get the newest published events the user hasn't acted on (sorted by match score later)
+	events, err := s.loadUserSuggestionCandidates(userUUID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	suggestions := s.scoreSuggestions(userID, userInterests, events)
+
+	// Apply pagination
+	total := int64(len(suggestions))
+	offset := (page - 1) * limit
+	if offset >= len(suggestions) {
+		return []dto.EventSuggestionItem{}, total, nil
+	}
+
+	end := offset + limit
+	if end > len(suggestions) {
+		end = len(suggestions)
+	}
+
+	// Recorded in the background so the feed doesn't wait on the upsert
+	served, now := servedEventIDs(suggestions[offset:end]), s.clock.Now()
+	sendInBackground(func() { recordEventImpressions(userUUID, served, now) })
+
+	return suggestions[offset:end], total, nil
+}
+
+// loadUserInterests gets the user's interests with their categories for scoring
+func (s *TagService) loadUserInterests(userUUID uuid.UUID) ([]models.UserInterest, error) {
 	var userInterests []models.UserInterest
-	err = database.GetDB().
+	err := database.GetDB().
 		Preload("Interest").
 		Where("user_id = ?", userUUID).
 		Find(&userInterests).Error
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get user interests: %w", err)
+		return nil, fmt.Errorf("failed to get user interests: %w", err)
 	}
+	return userInterests, nil
+}
 
-	// Get all published events (will be sorted by match score later)
+// loadSuggestionCandidates gets every published event with what scoring and responses need
+func (s *TagService) loadSuggestionCandidates() ([]models.Event, error) {
 	var events []models.Event
-	err = database.GetDB().
+	if err := suggestionCandidatesQuery().Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+	return events, nil
+}
+
+// loadUserSuggestionCandidates gets the newest published events the user didn't create, hasn't
+// joined and hasn't swiped, capped so scoring never runs over the whole table
+func (s *TagService) loadUserSuggestionCandidates(userUUID uuid.UUID) ([]models.Event, error) {
+	db := database.GetDB()
+	joined := db.Model(&models.EventMember{}).Select("event_id").
+		Where("user_id = ? AND status IN ?", userUUID, []models.MemberStatus{models.MemberStatusPending, models.MemberStatusConfirmed})
+	swiped := db.Model(&models.EventSwipe{}).Select("event_id").Where("user_id = ?", userUUID)
+
+	var events []models.Event
+	err := suggestionCandidatesQuery().
+		Where("creator_id <> ?", userUUID).
+		Where("id NOT IN (?)", joined).
+		Where("id NOT IN (?)", swiped).
+		Limit(maxSuggestionCandidates).
+		Find(&events).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+	return events, nil
+}
+
+// suggestionCandidatesQuery selects published events, newest first, with what scoring and
+// responses need
+func suggestionCandidatesQuery() *gorm.DB {
+	return database.GetDB().
 		Preload("Creator").
 		Preload("Photos").
 		Preload("Categories.Tag").
@@ -351,21 +721,17 @@ func (s *TagService) GetEventSuggestions(userID string, page, limit int) ([]dto.
 			return db.Preload("User").Preload("User.Profile")
 		}).
 		Where("deleted_at IS NULL AND status = ?", models.EventStatusPublished).
-		Order("created_at DESC").
-		Find(&events).Error
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get events: %w", err)
-	}
+		Order("created_at DESC")
+}
 
+// scoreSuggestions scores events for the user, best match first
+func (s *TagService) scoreSuggestions(userID string, userInterests []models.UserInterest, events []models.Event) []dto.EventSuggestionItem {
 	// Calculate match scores
 	suggestions := make([]dto.EventSuggestionItem, len(events))
 	for i, event := range events {
-		// Calculate interests match score (100% weight)
+		// Calculate interests match score (the only weighted component)
 		interestsScore, matchedInterests := s.calculateInterestsMatchScore(userInterests, event)
-
-		// Combined score: 100% interests
-		// Interests are the primary matching factor
-		combinedScore := interestsScore
+		combinedScore := combineMatchScores(map[string]float64{MatchComponentInterests: interestsScore})
 
 		// Convert event to response
 		eventResponse := s.convertEventToResponse(event, userID)
@@ -385,34 +751,128 @@ func (s *TagService) GetEventSuggestions(userID string, page, limit int) ([]dto.
 
 		suggestions[i] = dto.EventSuggestionItem{
 			Event:            eventResponse,
-			MatchScore:       math.Round(combinedScore*100) / 100,
+			MatchScore:       combinedScore,
 			MatchedTags:      matchedTags,
 			MatchedInterests: matchedInterests,
 		}
 	}
 
-	// Sort by match score (highest first)
-	for i := 0; i < len(suggestions)-1; i++ {
-		for j := i + 1; j < len(suggestions); j++ {
-			if suggestions[i].MatchScore < suggestions[j].MatchScore {
-				suggestions[i], suggestions[j] = suggestions[j], suggestions[i]
-			}
+	// Sort by match score (highest first), newest first on ties so pages don't shift
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].MatchScore != suggestions[j].MatchScore {
+			return suggestions[i].MatchScore > suggestions[j].MatchScore
 		}
+		return suggestions[i].Event.CreatedAt.After(suggestions[j].Event.CreatedAt)
+	})
+
+	return suggestions
+}
+
+// Match score components, each scored 0-100
+const (
+	MatchComponentInterests         = "interests"
+	MatchComponentTags              = "tags"
+	MatchComponentBudget            = "budget"
+	MatchComponentTravelPreferences = "travel_preferences"
+	MatchComponentFoodPreferences   = "food_preferences"
+	MatchComponentEventType         = "event_type"
+)
+
+// matchScoreWeights are how much each component counts towards the combined match score.
+// Suggestions currently rank by interests alone, so the suggestion and feed paths read no
+// preferences; the other components are still reported by GetEventMatch (from the cached
+// PreferenceProfile) so QA can see how they would change the ranking.
+var matchScoreWeights = map[string]float64{
+	MatchComponentInterests:         1.0,
+	MatchComponentTags:              0,
+	MatchComponentBudget:            0,
+	MatchComponentTravelPreferences: 0,
+	MatchComponentFoodPreferences:   0,
+	MatchComponentEventType:         0,
+}
+
+// combineMatchScores weights component scores into the combined match score, rounded to 2 decimals
+func combineMatchScores(components map[string]float64) float64 {
+	var combined float64
+	for component, score := range components {
+		combined += matchScoreWeights[component] * score
 	}
+	return math.Round(combined*100) / 100
+}
 
-	// Apply pagination
-	total := int64(len(suggestions))
-	offset := (page - 1) * limit
-	if offset >= len(suggestions) {
-		return []dto.EventSuggestionItem{}, total, nil
+// GetEventMatch scores one event for the user with the same functions and weights as the
+// suggestion list, and reports every component so the ranking can be explained
+func (s *TagService) GetEventMatch(userID, eventID string) (*dto.EventMatchResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID")
+	}
+	eventUUID, err := uuid.Parse(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event ID")
 	}
 
-	end := offset + limit
-	if end > len(suggestions) {
-		end = len(suggestions)
+	var event models.Event
+	err = database.GetDB().
+		Preload("Tags.Tag").
+		Preload("Interests.Interest").
+		Where("id = ? AND deleted_at IS NULL", eventUUID).
+		First(&event).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("event not found")
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
 	}
 
-	return suggestions[offset:end], total, nil
+	// Load everything the scoring functions look at
+	userInterests, err := s.loadUserInterests(userUUID)
+	if err != nil {
+		return nil, err
+	}
+	var userTags []models.UserTag
+	if err := database.GetDB().Preload("Tag").Where("user_id = ?", userUUID).Find(&userTags).Error; err != nil {
+		return nil, fmt.Errorf("failed to get user tags: %w", err)
+	}
+	profile, err := LoadPreferenceProfile(userUUID)
+	if err != nil {
+		return nil, err
+	}
+	var budget models.PrefBudget
+	if profile.Budget != nil {
+		budget = *profile.Budget
+	}
+
+	interestsScore, matchedInterests := s.calculateInterestsMatchScore(userInterests, event)
+	tagsScore, matchedTags := s.calculateTagMatchScore(userTags, event.Tags)
+	components := map[string]float64{
+		MatchComponentInterests:         interestsScore,
+		MatchComponentTags:              tagsScore,
+		MatchComponentBudget:            s.calculateBudgetMatchScore(budget, event),
+		MatchComponentTravelPreferences: s.calculateTravelPreferenceScore(profile, event),
+		MatchComponentFoodPreferences:   s.calculateFoodPreferenceScore(profile, event),
+		MatchComponentEventType:         s.calculateEventTypeScore(profile, event),
+	}
+
+	weights := make(map[string]float64, len(matchScoreWeights))
+	for component, weight := range matchScoreWeights {
+		weights[component] = weight
+	}
+	if matchedInterests == nil {
+		matchedInterests = []dto.InterestResponse{}
+	}
+	if matchedTags == nil {
+		matchedTags = []dto.TagResponse{}
+	}
+
+	return &dto.EventMatchResponse{
+		EventID:          event.ID.String(),
+		MatchScore:       combineMatchScores(components),
+		Components:       components,
+		Weights:          weights,
+		MatchedInterests: matchedInterests,
+		MatchedTags:      matchedTags,
+	}, nil
 }
 
 // calculateTagMatchScore calculates match score between user tags and event tags
@@ -655,8 +1115,8 @@ func (s *TagService) calculateBudgetMatchScore(userBudget models.PrefBudget, eve
 }
 
 // calculateTravelPreferenceScore calculates match score between travel preferences and event tags
-func (s *TagService) calculateTravelPreferenceScore(travelPrefs []models.TravelPreference, event models.Event) float64 {
-	if len(travelPrefs) == 0 {
+func (s *TagService) calculateTravelPreferenceScore(profile *PreferenceProfile, event models.Event) float64 {
+	if len(profile.TravelStyles) == 0 {
 		// No travel preferences = neutral score (50)
 		return 50.0
 	}
@@ -683,8 +1143,8 @@ func (s *TagService) calculateTravelPreferenceScore(travelPrefs []models.TravelP
 
 	// Create map of user travel styles
 	userTravelStyles := make(map[string]bool)
-	for _, pref := range travelPrefs {
-		userTravelStyles[pref.TravelStyle] = true
+	for _, style := range profile.TravelStyles {
+		userTravelStyles[style] = true
 	}
 
 	// Check if event tags match any travel preferences
@@ -728,8 +1188,8 @@ func (s *TagService) calculateTravelPreferenceScore(travelPrefs []models.TravelP
 }
 
 // calculateFoodPreferenceScore calculates match score between food preferences and event tags
-func (s *TagService) calculateFoodPreferenceScore(foodPrefs []models.FoodPreference, event models.Event) float64 {
-	if len(foodPrefs) == 0 {
+func (s *TagService) calculateFoodPreferenceScore(profile *PreferenceProfile, event models.Event) float64 {
+	if len(profile.FoodLevels) == 0 {
 		// No food preferences = neutral score (50)
 		return 50.0
 	}
@@ -745,11 +1205,8 @@ func (s *TagService) calculateFoodPreferenceScore(foodPrefs []models.FoodPrefere
 		"bbq_grill":          {"bbq", "barbecue", "grill", "grilled"},
 	}
 
-	// Create map of user food preferences with levels
-	userFoodPrefs := make(map[string]int) // food_category -> preference_level
-	for _, pref := range foodPrefs {
-		userFoodPrefs[pref.FoodCategory] = pref.PreferenceLevel
-	}
+	// User food preferences with levels (food_category -> preference_level)
+	userFoodPrefs := profile.FoodLevels
 
 	// Check if event tags match any food preferences
 	var totalScore float64
@@ -798,10 +1255,10 @@ func (s *TagService) calculateFoodPreferenceScore(foodPrefs []models.FoodPrefere
 		// Check if user has mostly dislike preferences
 		dislikeCount := 0
 		loveCount := 0
-		for _, pref := range foodPrefs {
-			if pref.PreferenceLevel == 1 {
+		for _, level := range userFoodPrefs {
+			if level == 1 {
 				dislikeCount++
-			} else if pref.PreferenceLevel == 3 {
+			} else if level == 3 {
 				loveCount++
 			}
 		}
@@ -821,7 +1278,7 @@ func (s *TagService) calculateFoodPreferenceScore(foodPrefs []models.FoodPrefere
 }
 
 // calculateEventTypeScore calculates match score based on event type (trip duration preference)
-func (s *TagService) calculateEventTypeScore(userUUID uuid.UUID, event models.Event) float64 {
+func (s *TagService) calculateEventTypeScore(profile *PreferenceProfile, event models.Event) float64 {
 	// For now, we use neutral score (50) as we don't have explicit event type preferences
 	// In the future, we could add:
 	// - User's preferred event types
@@ -829,11 +1286,9 @@ func (s *TagService) calculateEventTypeScore(userUUID uuid.UUID, event models.Ev
 	// - Event type matching with budget preferences (already handled in budget score)
 
 	// Check if user has budget preference for this event type (indirect preference)
-	var userBudget models.PrefBudget
-	err := database.GetDB().Where("user_id = ?", userUUID).First(&userBudget).Error
-	if err == nil {
+	if profile.Budget != nil {
 		// If user has budget preference for this event type, it's a positive signal
-		_, max := userBudget.GetBudgetForEventType(event.EventType)
+		_, max := profile.Budget.GetBudgetForEventType(event.EventType)
 		if max != nil {
 			return 70.0 // User has budget preference for this event type = preference
 		}
@@ -864,11 +1319,15 @@ func (s *TagService) convertEventToResponse(event models.Event, userID string) d
 		StartAt:       event.StartAt,
 		EndAt:         event.EndAt,
 		Capacity:      event.Capacity,
+		MinAttendees:  event.MinAttendees,
 		BudgetMin:     event.BudgetMin,
 		BudgetMax:     event.BudgetMax,
 		Currency:      event.Currency,
 		Status:        string(event.Status),
 		CoverImageURL: publicCoverURL,
+		Settings:      eventSettingsResponse(event.Settings),
+		CancelReason:  event.CancelReason,
+		CancelledAt:   event.CancelledAt,
 		CreatedAt:     event.CreatedAt,
 		UpdatedAt:     event.UpdatedAt,
 	}
@@ -879,6 +1338,7 @@ func (s *TagService) convertEventToResponse(event models.Event, userID string) d
 			ID:          event.Creator.ID.String(),
 			Email:       *event.Creator.Email,
 			DisplayName: *event.Creator.DisplayName,
+			Handle:      event.Creator.GetHandle(),
 			Provider:    string(event.Creator.Provider),
 			CreatedAt:   event.Creator.CreatedAt,
 		}
@@ -928,9 +1388,10 @@ func (s *TagService) convertEventToResponse(event models.Event, userID string) d
 		}
 	}
 
-	// Add members
-	response.Members = make([]dto.EventMemberResponse, len(event.Members))
-	for i, member := range event.Members {
+	// Add members the viewer is allowed to see
+	members := visibleMembers(event, userID)
+	response.Members = make([]dto.EventMemberResponse, len(members))
+	for i, member := range members {
 		displayName := ""
 		var avatarURL *string
 		if member.User != nil {
@@ -948,18 +1409,13 @@ func (s *TagService) convertEventToResponse(event models.Event, userID string) d
 			JoinedAt:    member.JoinedAt,
 			ConfirmedAt: member.ConfirmedAt,
 			LeftAt:      member.LeftAt,
-			Note:        member.Note,
+			CheckedInAt: member.CheckedInAt,
+			Note:        visibleMemberNote(event, member, userID),
 		}
 	}
 
-	// Count confirmed members
-	confirmedCount := 0
-	for _, member := range event.Members {
-		if member.Status == models.MemberStatusConfirmed {
-			confirmedCount++
-		}
-	}
-	response.MemberCount = confirmedCount
+	response.MemberCount = event.ConfirmedMemberCount
+	response.SpotsLeft = spotsLeft(event)
 
 	// Check if user is joined
 	if userID != "" {
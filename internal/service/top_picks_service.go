@@ -0,0 +1,228 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// topPicksSize is how many events are kept per user
+	topPicksSize = 20
+	// topPicksActiveWindow is how recently a user must have logged in to get nightly top picks
+	topPicksActiveWindow = 30 * 24 * time.Hour
+	// topPicksBatchSize is how many users the nightly recompute loads at a time
+	topPicksBatchSize = 100
+)
+
+// TopPicksService precomputes each user's best matching events so they can be served without
+// scoring every published event on the request path
+type TopPicksService struct {
+	tagService *TagService
+	clock      utils.Clock
+}
+
+// NewTopPicksService creates a new top picks service
+func NewTopPicksService() *TopPicksService {
+	return NewTopPicksServiceWithClock(utils.RealClock{})
+}
+
+// NewTopPicksServiceWithClock creates a top picks service that reads the time from clock
+func NewTopPicksServiceWithClock(clock utils.Clock) *TopPicksService {
+	return &TopPicksService{
+		tagService: NewTagServiceWithClock(clock),
+		clock:      clock,
+	}
+}
+
+// GetTopPicks returns the user's stored top picks, best first. Picks the user has since joined,
+// swiped or that are no longer open are left out. Users without stored picks, such as new
+// users, are scored live and their picks stored for next time.
+func (s *TopPicksService) GetTopPicks(userID string) (*dto.TopPicksResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID")
+	}
+
+	var picks []models.UserTopPick
+	err = database.GetDB().Where("user_id = ?", userUUID).Order("rank ASC").Find(&picks).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top picks: %w", err)
+	}
+
+	if len(picks) == 0 {
+		events, err := s.tagService.loadSuggestionCandidates()
+		if err != nil {
+			return nil, err
+		}
+		items, computedAt, err := s.recompute(userUUID, events)
+		if err != nil {
+			return nil, err
+		}
+		return &dto.TopPicksResponse{Picks: items, ComputedAt: computedAt, Live: true}, nil
+	}
+
+	// Load the picked events that are still open
+	eventIDs := make([]uuid.UUID, len(picks))
+	for i, pick := range picks {
+		eventIDs[i] = pick.EventID
+	}
+	var events []models.Event
+	err = database.GetDB().
+		Preload("Creator").
+		Preload("Photos").
+		Preload("Categories.Tag").
+		Preload("Tags.Tag").
+		Preload("Interests.Interest").
+		Preload("Members", func(db *gorm.DB) *gorm.DB {
+			return db.Preload("User").Preload("User.Profile")
+		}).
+		Where("id IN ? AND deleted_at IS NULL AND status = ?", eventIDs, models.EventStatusPublished).
+		Find(&events).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+	byID := make(map[uuid.UUID]models.Event, len(events))
+	for _, event := range events {
+		byID[event.ID] = event
+	}
+
+	excluded, err := s.excludedEventIDs(userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.EventSuggestionItem, 0, len(picks))
+	for _, pick := range picks {
+		event, ok := byID[pick.EventID]
+		if !ok || !topPickEligible(event, userUUID, excluded, s.clock.Now()) {
+			continue
+		}
+		items = append(items, dto.EventSuggestionItem{
+			Event:      s.tagService.convertEventToResponse(event, userID),
+			MatchScore: pick.MatchScore,
+		})
+	}
+
+	return &dto.TopPicksResponse{Picks: items, ComputedAt: picks[0].ComputedAt}, nil
+}
+
+// RecomputeAllTopPicks rebuilds top picks for every user who logged in recently.
+// Candidate events are loaded once and shared across users. Returns how many users were updated.
+func (s *TopPicksService) RecomputeAllTopPicks() (int, error) {
+	events, err := s.tagService.loadSuggestionCandidates()
+	if err != nil {
+		return 0, err
+	}
+
+	since := s.clock.Now().Add(-topPicksActiveWindow)
+	updated := 0
+	var failed error
+	var users []models.User
+	err = database.GetDB().
+		Select("id").
+		Where("deleted_at IS NULL AND last_login_at >= ?", since).
+		FindInBatches(&users, topPicksBatchSize, func(tx *gorm.DB, batch int) error {
+			for _, user := range users {
+				if _, _, err := s.recompute(user.ID, events); err != nil {
+					failed = err
+					continue
+				}
+				updated++
+			}
+			return nil
+		}).Error
+	if err != nil {
+		return updated, fmt.Errorf("failed to get active users: %w", err)
+	}
+	if failed != nil {
+		return updated, fmt.Errorf("failed to recompute some top picks: %w", failed)
+	}
+
+	return updated, nil
+}
+
+// recompute scores the candidate events for one user and replaces their stored picks
+func (s *TopPicksService) recompute(userUUID uuid.UUID, events []models.Event) ([]dto.EventSuggestionItem, time.Time, error) {
+	userInterests, err := s.tagService.loadUserInterests(userUUID)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	excluded, err := s.excludedEventIDs(userUUID)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	eligible := make([]models.Event, 0, len(events))
+	for _, event := range events {
+		if topPickEligible(event, userUUID, excluded, s.clock.Now()) {
+			eligible = append(eligible, event)
+		}
+	}
+	items := s.tagService.scoreSuggestions(userUUID.String(), userInterests, eligible)
+	if len(items) > topPicksSize {
+		items = items[:topPicksSize]
+	}
+
+	computedAt := s.clock.Now()
+	picks := make([]models.UserTopPick, len(items))
+	for i, item := range items {
+		picks[i] = models.UserTopPick{
+			UserID:     userUUID,
+			EventID:    uuid.MustParse(item.Event.ID),
+			Rank:       i + 1,
+			MatchScore: item.MatchScore,
+			ComputedAt: computedAt,
+		}
+	}
+
+	err = database.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userUUID).Delete(&models.UserTopPick{}).Error; err != nil {
+			return err
+		}
+		if len(picks) == 0 {
+			return nil
+		}
+		return tx.Create(&picks).Error
+	})
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to store top picks: %w", err)
+	}
+
+	return items, computedAt, nil
+}
+
+// excludedEventIDs returns events the user already joined, requested or swiped on
+func (s *TopPicksService) excludedEventIDs(userUUID uuid.UUID) (map[uuid.UUID]bool, error) {
+	var memberIDs, swipeIDs []uuid.UUID
+	err := database.GetDB().Model(&models.EventMember{}).Where("user_id = ?", userUUID).Pluck("event_id", &memberIDs).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get memberships: %w", err)
+	}
+	err = database.GetDB().Model(&models.EventSwipe{}).Where("user_id = ?", userUUID).Pluck("event_id", &swipeIDs).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get swipes: %w", err)
+	}
+
+	excluded := make(map[uuid.UUID]bool, len(memberIDs)+len(swipeIDs))
+	for _, id := range append(memberIDs, swipeIDs...) {
+		excluded[id] = true
+	}
+	return excluded, nil
+}
+
+// topPickEligible reports whether an event can be a pick: not the user's own, not already
+// acted on and not yet started
+func topPickEligible(event models.Event, userUUID uuid.UUID, excluded map[uuid.UUID]bool, now time.Time) bool {
+	if event.CreatorID == userUUID || excluded[event.ID] {
+		return false
+	}
+	return event.StartAt == nil || event.StartAt.After(now)
+}
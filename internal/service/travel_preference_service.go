@@ -83,6 +83,7 @@ func (s *TravelPreferenceService) AddTravelPreference(userID string, req dto.Add
 	if err != nil {
 		return fmt.Errorf("failed to add travel preference: %w", err)
 	}
+	InvalidatePreferenceProfile(userUUID)
 
 	return nil
 }
@@ -135,6 +136,7 @@ func (s *TravelPreferenceService) UpdateAllTravelPreferences(userID string, req
 	if err != nil {
 		return fmt.Errorf("failed to commit travel preferences update: %w", err)
 	}
+	InvalidatePreferenceProfile(userUUID)
 
 	return nil
 }
@@ -286,6 +288,7 @@ func (s *TravelPreferenceService) DeleteTravelPreference(userID, travelStyle str
 	if err != nil {
 		return fmt.Errorf("failed to delete travel preference: %w", err)
 	}
+	InvalidatePreferenceProfile(userUUID)
 
 	return nil
 }
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"fmt"
+	mathrand "math/rand"
+	"strings"
+	"time"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// defaultHandleChangeCooldownDays is used when HANDLE_CHANGE_COOLDOWN_DAYS is not configured
+	defaultHandleChangeCooldownDays = 30
+	// maxHandleBaseLength is the longest part of a generated handle taken from the display name
+	maxHandleBaseLength = 20
+	// handleAttempts is how many random handles are tried before giving up
+	handleAttempts = 10
+)
+
+// handleChangeCooldown returns how long a user must wait between handle changes
+func handleChangeCooldown() time.Duration {
+	days := defaultHandleChangeCooldownDays
+	if config.AppConfig != nil && config.AppConfig.User.HandleChangeCooldownDays > 0 {
+		days = config.AppConfig.User.HandleChangeCooldownDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// newUserHandle generates the handle a new user starts with, e.g. "somchai_0427".
+// Users can replace it with one of their own choosing later.
+func newUserHandle(displayName string) (*string, error) {
+	base := handleBase(displayName)
+	for attempt := 0; attempt < handleAttempts; attempt++ {
+		candidate := fmt.Sprintf("%s_%04d", base, mathrand.Intn(10000))
+		taken, err := handleTaken(candidate, uuid.Nil)
+		if err != nil {
+			return nil, err
+		}
+		if !taken {
+			return &candidate, nil
+		}
+	}
+	return nil, fmt.Errorf("failed to generate a unique handle")
+}
+
+// handleBase turns a display name into the URL-safe start of a handle: lowercase ASCII letters
+// and digits, with spaces and punctuation folded into single underscores
+func handleBase(displayName string) string {
+	var b strings.Builder
+	separate := false
+	for _, r := range strings.ToLower(displayName) {
+		if b.Len() >= maxHandleBaseLength {
+			break
+		}
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if separate && b.Len() > 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(r)
+			separate = false
+			continue
+		}
+		separate = true
+	}
+	base := strings.TrimRight(b.String(), "_")
+	if len(base) > maxHandleBaseLength {
+		base = strings.TrimRight(base[:maxHandleBaseLength], "_")
+	}
+	if base == "" {
+		return "user"
+	}
+	return base
+}
+
+// handleTaken reports whether an active user other than excludeUserID has the handle
+func handleTaken(handle string, excludeUserID uuid.UUID) (bool, error) {
+	var count int64
+	err := database.GetDB().Model(&models.User{}).
+		Where("handle = ? AND id != ? AND deleted_at IS NULL", handle, excludeUserID).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("database error: %w", err)
+	}
+	return count > 0, nil
+}
+
+// ChangeHandle replaces the user's handle. The handle a user signed up with can be replaced
+// right away; after that a new handle can be chosen once per cooldown.
+func (s *UserService) ChangeHandle(userID, rawHandle string) (*dto.HandleResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID")
+	}
+
+	handle, err := utils.NormalizeHandle(rawHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	var user models.User
+	err = database.GetDB().Where("id = ? AND deleted_at IS NULL", userUUID).First(&user).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("user not found")
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	// Keeping the current handle is not a change
+	if user.Handle != nil && *user.Handle == handle {
+		return handleResponse(user), nil
+	}
+
+	now := s.clock.Now()
+	cutoff := now.Add(-handleChangeCooldown())
+	if user.HandleChangedAt != nil && user.HandleChangedAt.After(cutoff) {
+		return nil, fmt.Errorf("handle changed recently")
+	}
+
+	taken, err := handleTaken(handle, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return nil, fmt.Errorf("handle already taken")
+	}
+
+	// Conditional on the cooldown so two concurrent changes can't both go through
+	result := database.GetDB().Model(&models.User{}).
+		Where("id = ? AND (handle_changed_at IS NULL OR handle_changed_at <= ?)", userUUID, cutoff).
+		Updates(map[string]interface{}{
+			"handle":            handle,
+			"handle_changed_at": now,
+		})
+	if result.Error != nil {
+		// Another user took the handle since the check above
+		errStr := strings.ToLower(result.Error.Error())
+		if strings.Contains(errStr, "ux_users_handle") || strings.Contains(errStr, "users.handle") {
+			return nil, fmt.Errorf("handle already taken")
+		}
+		return nil, fmt.Errorf("failed to update handle: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, fmt.Errorf("handle changed recently")
+	}
+
+	user.Handle = &handle
+	user.HandleChangedAt = &now
+	return handleResponse(user), nil
+}
+
+// handleResponse reports the user's handle and when it can next be changed
+func handleResponse(user models.User) *dto.HandleResponse {
+	response := &dto.HandleResponse{Handle: user.GetHandle()}
+	if user.HandleChangedAt != nil {
+		next := user.HandleChangedAt.Add(handleChangeCooldown())
+		response.NextChangeAt = &next
+	}
+	return response
+}
+
+// GetPublicProfileByHandle returns the public profile of the user with the handle
+func (s *UserService) GetPublicProfileByHandle(rawHandle string) (*dto.PublicProfileResponse, error) {
+	handle := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(rawHandle), "@"))
+
+	var user models.User
+	err := database.GetDB().Preload("Profile").
+		Where("handle = ? AND deleted_at IS NULL", handle).First(&user).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("user not found")
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	response := &dto.PublicProfileResponse{
+		UserID:    user.ID.String(),
+		Handle:    user.GetHandle(),
+		AvatarURL: buildAvatarURL(&user),
+	}
+	// Not GetDisplayName, which falls back to the email address
+	if user.DisplayName != nil {
+		response.DisplayName = *user.DisplayName
+	}
+	if user.Profile != nil {
+		response.Bio = user.Profile.Bio
+		response.Languages = user.Profile.Languages
+		response.Age = user.Profile.GetAge()
+		response.JobTitle = user.Profile.JobTitle
+		response.HomeLocation = user.Profile.HomeLocation
+	}
+	return response, nil
+}
@@ -7,6 +7,8 @@ import (
 
 	"TinderTrip-Backend/internal/dto"
 	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/audit"
 	"TinderTrip-Backend/pkg/database"
 
 	"github.com/google/uuid"
@@ -15,11 +17,17 @@ import (
 
 // UserService handles user business logic
 type UserService struct {
+	clock utils.Clock
 }
 
 // NewUserService creates a new user service
 func NewUserService() *UserService {
-	return &UserService{}
+	return NewUserServiceWithClock(utils.RealClock{})
+}
+
+// NewUserServiceWithClock creates a user service that reads the time from clock
+func NewUserServiceWithClock(clock utils.Clock) *UserService {
+	return &UserService{clock: clock}
 }
 
 // GetProfile gets user profile
@@ -59,6 +67,7 @@ func (s *UserService) GetProfile(userID string) (*dto.UserProfileResponse, error
 	response := &dto.UserProfileResponse{
 		ID:            profile.ID.String(),
 		UserID:        profile.UserID.String(),
+		Handle:        user.Handle,
 		DisplayName:   user.DisplayName,
 		Bio:           profile.Bio,
 		Languages:     profile.Languages,
@@ -108,18 +117,17 @@ func (s *UserService) UpdateProfile(userID string, req dto.UpdateProfileRequest)
 
 	// Update display_name in users table if provided
 	if req.DisplayName != nil {
-		// Check if display_name already exists (excluding current user)
-		var existingDisplayName models.User
-		err = database.GetDB().Where("display_name = ? AND id != ? AND deleted_at IS NULL", *req.DisplayName, userUUID).First(&existingDisplayName).Error
-		if err == nil {
-			return nil, fmt.Errorf("display name already taken")
-		}
-		if err != gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("database error: %w", err)
+		// Apply the display name mode, ignoring the user's own current name
+		displayName, err := resolveDisplayName(*req.DisplayName, userUUID)
+		if err != nil {
+			return nil, err
 		}
 
 		// Update display_name
-		err = database.GetDB().Model(&models.User{}).Where("id = ?", userUUID).Update("display_name", *req.DisplayName).Error
+		err = database.GetDB().Model(&models.User{}).Where("id = ?", userUUID).Updates(map[string]interface{}{
+			"display_name":        displayName,
+			"display_name_shared": displayNameShared(),
+		}).Error
 		if err != nil {
 			// Check if error is due to unique constraint violation for display_name
 			errStr := strings.ToLower(err.Error())
@@ -199,6 +207,7 @@ func (s *UserService) UpdateProfile(userID string, req dto.UpdateProfileRequest)
 	response := &dto.UserProfileResponse{
 		ID:            profile.ID.String(),
 		UserID:        profile.UserID.String(),
+		Handle:        user.Handle,
 		DisplayName:   user.DisplayName,
 		Bio:           profile.Bio,
 		Languages:     profile.Languages,
@@ -224,7 +233,8 @@ func (s *UserService) UpdateProfile(userID string, req dto.UpdateProfileRequest)
 	return response, nil
 }
 
-// DeleteProfile deletes user profile
+// DeleteProfile deletes the user's account: the profile and the user are soft deleted, the
+// deletion is audited, refresh tokens are revoked and a confirmation email is queued
 func (s *UserService) DeleteProfile(userID string) error {
 	// Parse user ID
 	userUUID, err := uuid.Parse(userID)
@@ -233,13 +243,13 @@ func (s *UserService) DeleteProfile(userID string) error {
 	}
 
 	// Soft delete profile
-	now := time.Now()
+	now := s.clock.Now()
 	err = database.GetDB().Model(&models.UserProfile{}).Where("user_id = ?", userUUID).Update("deleted_at", now).Error
 	if err != nil {
 		return fmt.Errorf("failed to delete profile: %w", err)
 	}
 
-	return nil
+	return deleteUserAccount(userUUID.String(), utils.RealClock{}, audit.NewAuditLogger())
 }
 
 // CheckSetupStatus checks if user has completed initial setup
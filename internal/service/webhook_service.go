@@ -0,0 +1,376 @@
+package service
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// WebhookSignatureHeader carries the HMAC-SHA256 signature of the request body
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	// WebhookEventHeader carries the webhook event name
+	WebhookEventHeader = "X-Webhook-Event"
+	// WebhookDeliveryHeader carries the delivery ID (stable across retries)
+	WebhookDeliveryHeader = "X-Webhook-Delivery"
+
+	webhookBaseRetryDelay = 30 * time.Second
+	webhookMaxRetryDelay  = 1 * time.Hour
+)
+
+// WebhookService handles outbound webhook registration and delivery
+type WebhookService struct {
+	client *http.Client
+	clock  utils.Clock
+}
+
+// NewWebhookService creates a new webhook service
+func NewWebhookService() *WebhookService {
+	timeout := 10 * time.Second
+	if config.AppConfig != nil && config.AppConfig.Webhook.TimeoutSeconds > 0 {
+		timeout = time.Duration(config.AppConfig.Webhook.TimeoutSeconds) * time.Second
+	}
+
+	return &WebhookService{
+		client: &http.Client{Timeout: timeout},
+		clock:  utils.RealClock{},
+	}
+}
+
+// NewWebhookServiceWithClock creates a webhook service that reads the time from clock, so
+// retries are scheduled and picked up against it
+func NewWebhookServiceWithClock(clock utils.Clock) *WebhookService {
+	service := NewWebhookService()
+	service.clock = clock
+	return service
+}
+
+// SignWebhookPayload returns the signature header value for a payload
+func SignWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// CreateWebhook registers a webhook URL
+func (s *WebhookService) CreateWebhook(ownerID string, req dto.CreateWebhookRequest) (*dto.WebhookResponse, error) {
+	// Parse owner ID
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	// Generate secret if not provided
+	secret := ""
+	if req.Secret != nil {
+		secret = *req.Secret
+	} else {
+		buf := make([]byte, 32)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, fmt.Errorf("failed to generate secret: %w", err)
+		}
+		secret = hex.EncodeToString(buf)
+	}
+
+	webhook := &models.Webhook{
+		OwnerUserID: ownerUUID,
+		URL:         req.URL,
+		Secret:      secret,
+		Active:      true,
+	}
+	if len(req.Events) > 0 {
+		events := strings.Join(req.Events, ",")
+		webhook.Events = &events
+	}
+
+	err = database.GetDB().Create(webhook).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	response := s.convertWebhookToResponse(*webhook)
+	response.Secret = &secret
+	return &response, nil
+}
+
+// GetWebhooks lists registered webhooks
+func (s *WebhookService) GetWebhooks(page, limit int) ([]dto.WebhookResponse, int64, error) {
+	query := database.GetDB().Model(&models.Webhook{}).Where("deleted_at IS NULL")
+
+	// Get total count
+	var total int64
+	err := query.Count(&total).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count webhooks: %w", err)
+	}
+
+	var webhooks []models.Webhook
+	offset := (page - 1) * limit
+	err = query.Offset(offset).Limit(limit).Order("created_at DESC").Find(&webhooks).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get webhooks: %w", err)
+	}
+
+	responses := make([]dto.WebhookResponse, len(webhooks))
+	for i, webhook := range webhooks {
+		responses[i] = s.convertWebhookToResponse(webhook)
+	}
+
+	return responses, total, nil
+}
+
+// DeleteWebhook deletes a webhook (soft delete)
+func (s *WebhookService) DeleteWebhook(webhookID string) error {
+	// Parse webhook ID
+	webhookUUID, err := uuid.Parse(webhookID)
+	if err != nil {
+		return fmt.Errorf("invalid webhook ID: %w", err)
+	}
+
+	now := s.clock.Now()
+	result := database.GetDB().Model(&models.Webhook{}).
+		Where("id = ? AND deleted_at IS NULL", webhookUUID).
+		Updates(map[string]interface{}{"deleted_at": now, "active": false})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete webhook: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("webhook not found")
+	}
+
+	return nil
+}
+
+// GetDeliveries gets the delivery log of a webhook
+func (s *WebhookService) GetDeliveries(webhookID string, page, limit int) ([]dto.WebhookDeliveryResponse, int64, error) {
+	// Parse webhook ID
+	webhookUUID, err := uuid.Parse(webhookID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid webhook ID: %w", err)
+	}
+
+	query := database.GetDB().Model(&models.WebhookDelivery{}).Where("webhook_id = ?", webhookUUID)
+
+	// Get total count
+	var total int64
+	err = query.Count(&total).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count deliveries: %w", err)
+	}
+
+	var deliveries []models.WebhookDelivery
+	offset := (page - 1) * limit
+	err = query.Offset(offset).Limit(limit).Order("created_at DESC").Find(&deliveries).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get deliveries: %w", err)
+	}
+
+	responses := make([]dto.WebhookDeliveryResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		responses[i] = dto.WebhookDeliveryResponse{
+			ID:             delivery.ID.String(),
+			WebhookID:      delivery.WebhookID.String(),
+			EventName:      delivery.EventName,
+			Status:         string(delivery.Status),
+			Attempts:       delivery.Attempts,
+			LastStatusCode: delivery.LastStatusCode,
+			LastError:      delivery.LastError,
+			NextAttemptAt:  delivery.NextAttemptAt,
+			DeliveredAt:    delivery.DeliveredAt,
+			CreatedAt:      delivery.CreatedAt,
+		}
+	}
+
+	return responses, total, nil
+}
+
+// Dispatch queues a delivery for every active webhook subscribed to the event name
+func (s *WebhookService) Dispatch(eventName string, data dto.WebhookEventData) error {
+	var webhooks []models.Webhook
+	err := database.GetDB().Where("active = ? AND deleted_at IS NULL", true).Find(&webhooks).Error
+	if err != nil {
+		return fmt.Errorf("failed to get webhooks: %w", err)
+	}
+
+	now := s.clock.Now()
+	for _, webhook := range webhooks {
+		if !webhook.Subscribes(eventName) {
+			continue
+		}
+
+		delivery := &models.WebhookDelivery{
+			ID:            uuid.New(),
+			WebhookID:     webhook.ID,
+			EventName:     eventName,
+			Status:        models.WebhookDeliveryPending,
+			NextAttemptAt: now,
+		}
+		payload, err := json.Marshal(dto.WebhookPayload{
+			ID:        delivery.ID.String(),
+			Event:     eventName,
+			CreatedAt: now,
+			Data:      data,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to encode webhook payload: %w", err)
+		}
+		delivery.Payload = string(payload)
+
+		err = database.GetDB().Create(delivery).Error
+		if err != nil {
+			return fmt.Errorf("failed to queue webhook delivery: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ProcessPendingDeliveries sends due deliveries and returns how many were attempted
+func (s *WebhookService) ProcessPendingDeliveries(batchSize int) (int, error) {
+	var deliveries []models.WebhookDelivery
+	err := database.GetDB().Preload("Webhook").
+		Where("status = ? AND next_attempt_at <= ?", models.WebhookDeliveryPending, s.clock.Now()).
+		Order("next_attempt_at ASC").
+		Limit(batchSize).
+		Find(&deliveries).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to get pending deliveries: %w", err)
+	}
+
+	for _, delivery := range deliveries {
+		if err := s.attemptDelivery(delivery); err != nil {
+			log.Printf("Failed to record webhook delivery %s: %v", delivery.ID, err)
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+// attemptDelivery sends one delivery and records the outcome, scheduling a retry on failure
+func (s *WebhookService) attemptDelivery(delivery models.WebhookDelivery) error {
+	attempts := delivery.Attempts + 1
+	updates := map[string]interface{}{
+		"attempts":   attempts,
+		"updated_at": s.clock.Now(),
+	}
+
+	// Webhook removed or disabled since the delivery was queued
+	if delivery.Webhook == nil || !delivery.Webhook.Active || delivery.Webhook.DeletedAt != nil {
+		updates["status"] = models.WebhookDeliveryFailed
+		updates["last_error"] = "webhook is no longer active"
+		return database.GetDB().Model(&models.WebhookDelivery{}).Where("id = ?", delivery.ID).Updates(updates).Error
+	}
+
+	statusCode, sendErr := s.send(delivery)
+	if statusCode > 0 {
+		updates["last_status_code"] = statusCode
+	}
+
+	if sendErr == nil {
+		now := s.clock.Now()
+		updates["status"] = models.WebhookDeliverySucceeded
+		updates["delivered_at"] = now
+		updates["last_error"] = nil
+	} else {
+		updates["last_error"] = sendErr.Error()
+		if attempts >= webhookMaxAttempts() {
+			updates["status"] = models.WebhookDeliveryFailed
+		} else {
+			updates["next_attempt_at"] = s.clock.Now().Add(webhookRetryDelay(attempts))
+		}
+	}
+
+	return database.GetDB().Model(&models.WebhookDelivery{}).Where("id = ?", delivery.ID).Updates(updates).Error
+}
+
+// send posts the signed payload to the webhook URL
+func (s *WebhookService) send(delivery models.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequest(http.MethodPost, delivery.Webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("invalid webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "TinderTrip-Webhooks/1.0")
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(delivery.Webhook.Secret, body))
+	req.Header.Set(WebhookEventHeader, delivery.EventName)
+	req.Header.Set(WebhookDeliveryHeader, delivery.ID.String())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("receiver responded with status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// webhookMaxAttempts returns the configured number of delivery attempts
+func webhookMaxAttempts() int {
+	if config.AppConfig != nil && config.AppConfig.Webhook.MaxAttempts > 0 {
+		return config.AppConfig.Webhook.MaxAttempts
+	}
+	return 5
+}
+
+// webhookRetryDelay returns an exponential backoff delay for the given attempt count
+func webhookRetryDelay(attempts int) time.Duration {
+	delay := webhookBaseRetryDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= webhookMaxRetryDelay {
+			return webhookMaxRetryDelay
+		}
+	}
+	return delay
+}
+
+// convertWebhookToResponse converts a webhook model to response DTO
+func (s *WebhookService) convertWebhookToResponse(webhook models.Webhook) dto.WebhookResponse {
+	return dto.WebhookResponse{
+		ID:          webhook.ID.String(),
+		OwnerUserID: webhook.OwnerUserID.String(),
+		URL:         webhook.URL,
+		Events:      webhook.GetEventsArray(),
+		Active:      webhook.Active,
+		CreatedAt:   webhook.CreatedAt,
+	}
+}
+
+// webhookEventData builds the webhook snapshot of an event
+func webhookEventData(event models.Event, actorID *string) dto.WebhookEventData {
+	return dto.WebhookEventData{
+		EventID:   event.ID.String(),
+		CreatorID: event.CreatorID.String(),
+		Title:     event.Title,
+		EventType: string(event.EventType),
+		Status:    string(event.Status),
+		StartAt:   event.StartAt,
+		EndAt:     event.EndAt,
+		ActorID:   actorID,
+
+		CancellationReason: event.CancelReason,
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
 	"TinderTrip-Backend/pkg/database"
 )
 
@@ -14,6 +16,7 @@ import (
 type WorkerService struct {
 	ctx    context.Context
 	cancel context.CancelFunc
+	clock  utils.Clock
 }
 
 // NewWorkerService creates a new worker service
@@ -22,9 +25,17 @@ func NewWorkerService() *WorkerService {
 	return &WorkerService{
 		ctx:    ctx,
 		cancel: cancel,
+		clock:  utils.RealClock{},
 	}
 }
 
+// NewWorkerServiceWithClock creates a worker service that reads the time from the given clock
+func NewWorkerServiceWithClock(clock utils.Clock) *WorkerService {
+	service := NewWorkerService()
+	service.clock = clock
+	return service
+}
+
 // Start starts the worker service
 func (s *WorkerService) Start() {
 	log.Println("Starting worker service...")
@@ -41,6 +52,30 @@ func (s *WorkerService) Start() {
 	// Start event auto-complete worker
 	go s.eventAutoCompleteWorker()
 
+	// Start webhook delivery worker
+	go s.webhookWorker()
+
+	// Start storage cleanup worker
+	go s.storageCleanupWorker()
+
+	// Start orphaned storage reconcile worker
+	go s.storageReconcileWorker()
+
+	// Start nightly top picks worker
+	go s.topPicksWorker()
+
+	// Start confirmed member count reconcile worker
+	go s.memberCountReconcileWorker()
+
+	// Start stale pending member expiry worker
+	go s.pendingMemberExpiryWorker()
+
+	// Start min attendees worker
+	go s.minAttendeesWorker()
+
+	// Start snoozed reminder worker
+	go s.reminderSnoozeWorker()
+
 	log.Println("Worker service started")
 }
 
@@ -118,6 +153,18 @@ func (s *WorkerService) performCleanup() {
 		log.Printf("Error cleaning up old audit logs: %v", err)
 	}
 
+	// Clean up old and excess notifications
+	err = s.cleanupNotifications()
+	if err != nil {
+		log.Printf("Error cleaning up notifications: %v", err)
+	}
+
+	// Clean up expired guest sessions
+	err = s.cleanupExpiredGuestSessions()
+	if err != nil {
+		log.Printf("Error cleaning up expired guest sessions: %v", err)
+	}
+
 	// Clean up completed events
 	err = s.cleanupCompletedEvents()
 	if err != nil {
@@ -129,7 +176,7 @@ func (s *WorkerService) performCleanup() {
 
 // cleanupExpiredPasswordResets removes expired password reset tokens
 func (s *WorkerService) cleanupExpiredPasswordResets() error {
-	result := database.GetDB().Where("expires_at < ?", time.Now()).Delete(&models.PasswordReset{})
+	result := database.GetDB().Where("expires_at < ?", s.clock.Now()).Delete(&models.PasswordReset{})
 	if result.Error != nil {
 		return fmt.Errorf("failed to delete expired password resets: %w", result.Error)
 	}
@@ -144,7 +191,7 @@ func (s *WorkerService) cleanupExpiredPasswordResets() error {
 // cleanupOldAPILogs removes old API logs
 func (s *WorkerService) cleanupOldAPILogs() error {
 	// Keep logs for 30 days
-	cutoffDate := time.Now().AddDate(0, 0, -30)
+	cutoffDate := s.clock.Now().AddDate(0, 0, -30)
 	result := database.GetDB().Where("created_at < ?", cutoffDate).Delete(&models.APILog{})
 	if result.Error != nil {
 		return fmt.Errorf("failed to delete old API logs: %w", result.Error)
@@ -160,7 +207,7 @@ func (s *WorkerService) cleanupOldAPILogs() error {
 // cleanupOldAuditLogs removes old audit logs
 func (s *WorkerService) cleanupOldAuditLogs() error {
 	// Keep logs for 90 days
-	cutoffDate := time.Now().AddDate(0, 0, -90)
+	cutoffDate := s.clock.Now().AddDate(0, 0, -90)
 	result := database.GetDB().Where("created_at < ?", cutoffDate).Delete(&models.AuditLog{})
 	if result.Error != nil {
 		return fmt.Errorf("failed to delete old audit logs: %w", result.Error)
@@ -173,10 +220,38 @@ func (s *WorkerService) cleanupOldAuditLogs() error {
 	return nil
 }
 
+// cleanupNotifications removes read notifications past the retention and caps each user's count
+func (s *WorkerService) cleanupNotifications() error {
+	deleted, err := NewNotificationServiceWithClock(s.clock).CleanupNotifications()
+	if err != nil {
+		return err
+	}
+
+	if deleted > 0 {
+		log.Printf("Cleaned up %d notifications", deleted)
+	}
+
+	return nil
+}
+
+// cleanupExpiredGuestSessions removes guest sessions whose token has expired
+func (s *WorkerService) cleanupExpiredGuestSessions() error {
+	deleted, err := NewGuestServiceWithClock(s.clock).CleanupExpiredGuestSessions()
+	if err != nil {
+		return err
+	}
+
+	if deleted > 0 {
+		log.Printf("Cleaned up %d expired guest sessions", deleted)
+	}
+
+	return nil
+}
+
 // cleanupCompletedEvents marks old completed events as archived
 func (s *WorkerService) cleanupCompletedEvents() error {
 	// Mark events as archived if they completed more than 30 days ago
-	cutoffDate := time.Now().AddDate(0, 0, -30)
+	cutoffDate := s.clock.Now().AddDate(0, 0, -30)
 	result := database.GetDB().Model(&models.Event{}).
 		Where("status = ? AND end_at < ?", models.EventStatusCompleted, cutoffDate).
 		Update("status", models.EventStatusCompleted)
@@ -199,7 +274,7 @@ func (s *WorkerService) processNotifications() {
 	// Get events starting soon (within 1 hour)
 	var events []models.Event
 	err := database.GetDB().Where("status = ? AND start_at BETWEEN ? AND ?",
-		models.EventStatusPublished, time.Now(), time.Now().Add(1*time.Hour)).Find(&events).Error
+		models.EventStatusPublished, s.clock.Now(), s.clock.Now().Add(1*time.Hour)).Find(&events).Error
 	if err != nil {
 		log.Printf("Error getting events starting soon: %v", err)
 		return
@@ -214,7 +289,7 @@ func (s *WorkerService) processNotifications() {
 	}
 
 	// Auto-complete expired events (events that have passed their end date)
-	eventService := NewEventService()
+	eventService := NewEventServiceWithClock(s.clock)
 	err = eventService.AutoCompleteExpiredEvents()
 	if err != nil {
 		log.Printf("Error auto-completing expired events: %v", err)
@@ -249,7 +324,7 @@ func (s *WorkerService) processAuditLogs() {
 
 	// Get recent audit logs
 	var logs []models.AuditLog
-	err := database.GetDB().Where("created_at > ?", time.Now().Add(-1*time.Hour)).Find(&logs).Error
+	err := database.GetDB().Where("created_at > ?", s.clock.Now().Add(-1*time.Hour)).Find(&logs).Error
 	if err != nil {
 		log.Printf("Error getting recent audit logs: %v", err)
 		return
@@ -315,10 +390,194 @@ func (w *WorkerService) StartCleanupWorker() {
 	}()
 }
 
-// processEmailQueue processes the email queue
+// StartWebhookWorker starts the webhook delivery worker
+func (w *WorkerService) StartWebhookWorker() {
+	go w.webhookWorker()
+}
+
+// webhookWorker delivers queued webhooks and retries failed ones
+func (s *WorkerService) webhookWorker() {
+	ticker := time.NewTicker(15 * time.Second)
+	defer ticker.Stop()
+
+	webhookService := NewWebhookServiceWithClock(s.clock)
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := webhookService.ProcessPendingDeliveries(50); err != nil {
+				log.Printf("Error processing webhook deliveries: %v", err)
+			}
+		}
+	}
+}
+
+// StartStorageCleanupWorker starts the storage cleanup worker
+func (w *WorkerService) StartStorageCleanupWorker() {
+	go w.storageCleanupWorker()
+}
+
+// storageCleanupWorker retries storage object deletes that failed inline
+func (s *WorkerService) storageCleanupWorker() {
+	ticker := time.NewTicker(5 * time.Minute)
+	defer ticker.Stop()
+
+	storageCleanup := NewStorageCleanupServiceWithClock(s.clock)
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := storageCleanup.ProcessPendingDeletions(50); err != nil {
+				log.Printf("Error processing storage deletions: %v", err)
+			}
+		}
+	}
+}
+
+// StartStorageReconcileWorker starts the orphaned storage reconcile worker
+func (w *WorkerService) StartStorageReconcileWorker() {
+	go w.storageReconcileWorker()
+}
+
+// storageReconcileWorker removes stored objects nothing references.
+// It only reports unless STORAGE_RECONCILE_DELETE is enabled.
+func (s *WorkerService) storageReconcileWorker() {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+
+	reconcileService := NewStorageReconcileServiceWithClock(s.clock)
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			dryRun := config.AppConfig == nil || !config.AppConfig.Storage.ReconcileDelete
+			report, err := reconcileService.Reconcile(dryRun)
+			if err != nil {
+				log.Printf("Error reconciling storage: %v", err)
+				continue
+			}
+			log.Printf("Storage reconcile (dry_run=%t): scanned=%d referenced=%d orphaned=%d in_grace=%d deleted=%d failed=%d",
+				report.DryRun, report.Scanned, report.Referenced, report.Orphaned, report.InGrace, report.Deleted, report.Failed)
+		}
+	}
+}
+
+// StartMemberCountReconcileWorker starts the confirmed member count reconcile worker
+func (w *WorkerService) StartMemberCountReconcileWorker() {
+	go w.memberCountReconcileWorker()
+}
+
+// memberCountReconcileWorker corrects events whose confirmed member counter drifted from their member rows
+func (s *WorkerService) memberCountReconcileWorker() {
+	ticker := time.NewTicker(6 * time.Hour)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			corrected, err := ReconcileConfirmedMemberCounts()
+			if err != nil {
+				log.Printf("Error reconciling confirmed member counts: %v", err)
+				continue
+			}
+			if corrected > 0 {
+				log.Printf("Corrected confirmed member count of %d events", corrected)
+			}
+		}
+	}
+}
+
+// StartPendingMemberExpiryWorker starts the stale pending member expiry worker
+func (w *WorkerService) StartPendingMemberExpiryWorker() {
+	go w.pendingMemberExpiryWorker()
+}
+
+// pendingMemberExpiryWorker declines members who stayed pending longer than the pending TTL
+func (s *WorkerService) pendingMemberExpiryWorker() {
+	ticker := time.NewTicker(1 * time.Hour)
+	defer ticker.Stop()
+
+	eventService := NewEventServiceWithClock(s.clock)
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			expired, err := eventService.ExpireStalePendingMembers()
+			if err != nil {
+				log.Printf("Error expiring stale pending members: %v", err)
+				continue
+			}
+			if expired > 0 {
+				log.Printf("Expired %d stale pending members", expired)
+			}
+		}
+	}
+}
+
+// StartMinAttendeesWorker starts the min attendees worker
+func (w *WorkerService) StartMinAttendeesWorker() {
+	go w.minAttendeesWorker()
+}
+
+// minAttendeesWorker cancels events that are still short of their minimum attendees near the start
+func (s *WorkerService) minAttendeesWorker() {
+	ticker := time.NewTicker(10 * time.Minute)
+	defer ticker.Stop()
+
+	eventService := NewEventServiceWithClock(s.clock)
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			cancelled, err := eventService.CancelUnderfilledEvents()
+			if err != nil {
+				log.Printf("Error cancelling underfilled events: %v", err)
+				continue
+			}
+			if cancelled > 0 {
+				log.Printf("Cancelled %d events below their minimum attendees", cancelled)
+			}
+		}
+	}
+}
+
+// StartTopPicksWorker starts the nightly top picks worker
+func (w *WorkerService) StartTopPicksWorker() {
+	go w.topPicksWorker()
+}
+
+// topPicksWorker recomputes every active user's top picks once a day
+func (s *WorkerService) topPicksWorker() {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+
+	topPicksService := NewTopPicksServiceWithClock(s.clock)
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			updated, err := topPicksService.RecomputeAllTopPicks()
+			if err != nil {
+				log.Printf("Error recomputing top picks: %v", err)
+			}
+			log.Printf("Top picks recomputed for %d users", updated)
+		}
+	}
+}
+
+// processEmailQueue sends due emails from the outbox
 func (w *WorkerService) processEmailQueue() {
-	// TODO: Implement email queue processing
-	log.Println("Processing email queue...")
+	if _, err := NewEmailOutboxServiceWithClock(w.clock).ProcessPendingEmails(50); err != nil {
+		log.Printf("Error processing email queue: %v", err)
+	}
 }
 
 // processNotificationQueue processes the notification queue
@@ -344,9 +603,32 @@ func (s *WorkerService) eventAutoCompleteWorker() {
 
 // autoCompleteExpiredEvents completes events that have passed their end date
 func (s *WorkerService) autoCompleteExpiredEvents() {
-	eventService := NewEventService()
+	eventService := NewEventServiceWithClock(s.clock)
 	err := eventService.AutoCompleteExpiredEvents()
 	if err != nil {
 		log.Printf("Error auto-completing expired events: %v", err)
 	}
 }
+
+// StartReminderSnoozeWorker starts the snoozed reminder worker
+func (w *WorkerService) StartReminderSnoozeWorker() {
+	go w.reminderSnoozeWorker()
+}
+
+// reminderSnoozeWorker sends snoozed event reminders once they are due
+func (s *WorkerService) reminderSnoozeWorker() {
+	ticker := time.NewTicker(1 * time.Minute)
+	defer ticker.Stop()
+
+	notificationService := NewNotificationServiceWithClock(s.clock)
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := notificationService.ProcessDueReminderSnoozes(100); err != nil {
+				log.Printf("Error processing snoozed reminders: %v", err)
+			}
+		}
+	}
+}
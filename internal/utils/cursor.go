@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// Cursor marks a position in a list ordered by created_at DESC, id DESC
+type Cursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// EncodeCursor encodes a created_at/id pair into an opaque cursor string
+func EncodeCursor(createdAt time.Time, id string) string {
+	raw := createdAt.UTC().Format(time.RFC3339Nano) + "|" + id
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor decodes a cursor string produced by EncodeCursor.
+// An empty string returns a nil cursor (first page).
+func DecodeCursor(value string) (*Cursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	parts := strings.SplitN(string(raw), "|", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	return &Cursor{CreatedAt: createdAt, ID: parts[1]}, nil
+}
+
+// ApplyCursor orders the query by created_at DESC, id DESC and skips rows up to the cursor
+func ApplyCursor(query *gorm.DB, cursor *Cursor) *gorm.DB {
+	if cursor != nil {
+		query = query.Where("created_at < ? OR (created_at = ? AND id < ?)", cursor.CreatedAt, cursor.CreatedAt, cursor.ID)
+	}
+	return query.Order("created_at DESC").Order("id DESC")
+}
+
+// CursorResponse sends a cursor paginated success response
+func CursorResponse(c *gin.Context, message string, data interface{}, limit int, nextCursor *string) {
+	meta := &Meta{
+		Limit:      &limit,
+		NextCursor: nextCursor,
+	}
+
+	SuccessWithMetaResponse(c, http.StatusOK, message, data, meta)
+}
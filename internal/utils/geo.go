@@ -0,0 +1,75 @@
+package utils
+
+import "math"
+
+// earthRadiusMeters is the mean radius of the Earth
+const earthRadiusMeters = 6371000.0
+
+// DistanceMeters returns the great-circle distance between two coordinates using the haversine formula
+func DistanceMeters(lat1, lng1, lat2, lng2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(lat2 - lat1)
+	dLng := toRad(lng2 - lng1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return earthRadiusMeters * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// BoundingBox is a latitude/longitude rectangle. A box that crosses the antimeridian has
+// MinLng greater than MaxLng.
+type BoundingBox struct {
+	MinLat float64
+	MaxLat float64
+	MinLng float64
+	MaxLng float64
+}
+
+// BoundingBoxAround returns the smallest box holding every point within radiusMeters of the
+// coordinate, for prefiltering before computing exact distances. Near a pole the box spans
+// all longitudes.
+func BoundingBoxAround(lat, lng, radiusMeters float64) BoundingBox {
+	toDeg := func(rad float64) float64 { return rad * 180 / math.Pi }
+	angular := radiusMeters / earthRadiusMeters
+
+	box := BoundingBox{
+		MinLat: lat - toDeg(angular),
+		MaxLat: lat + toDeg(angular),
+		MinLng: -180,
+		MaxLng: 180,
+	}
+	if box.MinLat <= -90 || box.MaxLat >= 90 {
+		box.MinLat = math.Max(box.MinLat, -90)
+		box.MaxLat = math.Min(box.MaxLat, 90)
+		return box
+	}
+
+	// Longitude degrees shrink towards the poles
+	deltaLng := toDeg(math.Asin(math.Sin(angular) / math.Cos(lat*math.Pi/180)))
+	box.MinLng = lng - deltaLng
+	box.MaxLng = lng + deltaLng
+	if box.MinLng < -180 {
+		box.MinLng += 360
+	}
+	if box.MaxLng > 180 {
+		box.MaxLng -= 360
+	}
+	return box
+}
+
+// CrossesAntimeridian reports whether the box wraps from 180 to -180 longitude
+func (b BoundingBox) CrossesAntimeridian() bool {
+	return b.MinLng > b.MaxLng
+}
+
+// Contains reports whether the coordinate is inside the box
+func (b BoundingBox) Contains(lat, lng float64) bool {
+	if lat < b.MinLat || lat > b.MaxLat {
+		return false
+	}
+	if b.CrossesAntimeridian() {
+		return lng >= b.MinLng || lng <= b.MaxLng
+	}
+	return lng >= b.MinLng && lng <= b.MaxLng
+}
@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// handlePattern allows 3 to 30 lowercase letters, digits and underscores, starting and ending
+// with a letter or digit, so a handle can go into a URL or an @mention as is
+var handlePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_]{1,28}[a-z0-9]$`)
+
+// reservedHandles can't be taken because they would read as the app, its staff or a route
+var reservedHandles = map[string]bool{
+	"admin": true, "administrator": true, "api": true, "app": true, "auth": true,
+	"events": true, "help": true, "me": true, "moderator": true, "null": true,
+	"root": true, "settings": true, "staff": true, "support": true, "system": true,
+	"tindertrip": true, "undefined": true, "users": true, "www": true,
+}
+
+// NormalizeHandle lowercases a handle, drops a leading @ and surrounding spaces, and checks
+// that the result is a valid, unreserved handle
+func NormalizeHandle(raw string) (string, error) {
+	handle := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(raw), "@"))
+	if !handlePattern.MatchString(handle) {
+		return "", fmt.Errorf("invalid handle")
+	}
+	if reservedHandles[handle] {
+		return "", fmt.Errorf("handle is reserved")
+	}
+	return handle, nil
+}
@@ -8,6 +8,7 @@ import (
 	"TinderTrip-Backend/pkg/config"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 // JWTClaims represents the JWT claims
@@ -19,7 +20,17 @@ type JWTClaims struct {
 	jwt.RegisteredClaims
 }
 
-// GenerateToken generates a JWT token for a user
+// guestAudience marks a token as a guest token, which is never accepted as a user's token
+const guestAudience = "guest"
+
+// GuestClaims represents the claims of a guest browsing token
+type GuestClaims struct {
+	GuestID string `json:"guest_id"`
+	jwt.RegisteredClaims
+}
+
+// GenerateToken generates a JWT token for a user. Each token gets a unique ID (jti) so it can be
+// revoked on its own at logout.
 func GenerateToken(userID, email, provider string) (string, error) {
 	cfg := config.AppConfig.JWT
 
@@ -35,6 +46,7 @@ func GenerateToken(userID, email, provider string) (string, error) {
 			NotBefore: jwt.NewNumericDate(time.Now()),
 			Issuer:    "TinderTrip-Backend",
 			Subject:   userID,
+			ID:        uuid.New().String(),
 		},
 	}
 
@@ -78,6 +90,11 @@ func ValidateToken(tokenString string) (*JWTClaims, error) {
 		return nil, errors.New("failed to extract claims")
 	}
 
+	// Guest tokens carry no user
+	if claims.UserID == "" {
+		return nil, errors.New("invalid token")
+	}
+
 	// Check if token is expired
 	if time.Now().Unix() > claims.ExpiresAt {
 		return nil, errors.New("token has expired")
@@ -86,6 +103,51 @@ func ValidateToken(tokenString string) (*JWTClaims, error) {
 	return claims, nil
 }
 
+// GenerateGuestToken generates a browsing token for a guest that expires at expiresAt
+func GenerateGuestToken(guestID string, expiresAt time.Time) (string, error) {
+	claims := GuestClaims{
+		GuestID: guestID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+			Issuer:    "TinderTrip-Backend",
+			Subject:   guestID,
+			Audience:  jwt.ClaimStrings{guestAudience},
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	tokenString, err := token.SignedString([]byte(config.AppConfig.JWT.Secret))
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	return tokenString, nil
+}
+
+// ValidateGuestToken validates a guest token and returns its claims
+func ValidateGuestToken(tokenString string) (*GuestClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &GuestClaims{}, func(token *jwt.Token) (interface{}, error) {
+		// Validate signing method
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(config.AppConfig.JWT.Secret), nil
+	}, jwt.WithAudience(guestAudience))
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+
+	claims, ok := token.Claims.(*GuestClaims)
+	if !ok || !token.Valid || claims.GuestID == "" || claims.ExpiresAt == nil {
+		return nil, errors.New("invalid token")
+	}
+
+	return claims, nil
+}
+
 // RefreshToken generates a new token with extended expiration
 func RefreshToken(tokenString string) (string, error) {
 	// Validate current token
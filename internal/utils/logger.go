@@ -4,6 +4,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 )
 
@@ -41,6 +42,11 @@ func Logger() *logrus.Logger {
 	return logger
 }
 
+// RequestLogger returns a logger entry tagged with the request ID of the current request
+func RequestLogger(c *gin.Context) *logrus.Entry {
+	return Logger().WithField(RequestIDKey, c.GetString(RequestIDKey))
+}
+
 // WithFields creates a logger with fields
 func WithFields(fields map[string]interface{}) *logrus.Entry {
 	return Logger().WithFields(fields)
@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"unicode"
+)
+
+// Mention is an @handle found in a piece of text. Start and Length are in runes and cover the
+// leading @, so clients can highlight the mention without re-parsing the text.
+type Mention struct {
+	Handle string
+	Start  int
+	Length int
+}
+
+// ParseMentions finds the @handle mentions in text, in order. An @ only starts a mention at the
+// beginning of the text or after a character that can't be part of a handle, so email addresses
+// are not mentions. Handles are normalized and invalid or reserved ones are skipped.
+func ParseMentions(text string) []Mention {
+	runes := []rune(text)
+	var mentions []Mention
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '@' || (i > 0 && isHandleRune(runes[i-1])) {
+			continue
+		}
+		end := i + 1
+		for end < len(runes) && isHandleRune(runes[end]) {
+			end++
+		}
+		// Trailing underscores read as punctuation, e.g. "@somchai_"
+		for end > i+1 && runes[end-1] == '_' {
+			end--
+		}
+		handle, err := NormalizeHandle(string(runes[i+1 : end]))
+		if err == nil {
+			mentions = append(mentions, Mention{Handle: handle, Start: i, Length: end - i})
+		}
+		i = end - 1
+	}
+	return mentions
+}
+
+// isHandleRune reports whether r can appear in a handle, in either case
+func isHandleRune(r rune) bool {
+	return r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_')
+}
@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NormalizePhone converts a phone number in international format to E.164 (e.g. +66812345678).
+// Spaces, dashes, dots and parentheses are ignored and a leading "00" is treated as "+".
+// Numbers without a country code are rejected.
+func NormalizePhone(raw string) (string, error) {
+	var b strings.Builder
+	for _, r := range strings.TrimSpace(raw) {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			continue
+		}
+		b.WriteRune(r)
+	}
+	phone := b.String()
+
+	if strings.HasPrefix(phone, "00") {
+		phone = "+" + phone[2:]
+	}
+	if !strings.HasPrefix(phone, "+") {
+		return "", fmt.Errorf("invalid phone number")
+	}
+
+	digits := phone[1:]
+	// E.164 allows at most 15 digits; shorter than 8 is not a real subscriber number
+	if len(digits) < 8 || len(digits) > 15 || digits[0] == '0' {
+		return "", fmt.Errorf("invalid phone number")
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return "", fmt.Errorf("invalid phone number")
+		}
+	}
+
+	return phone, nil
+}
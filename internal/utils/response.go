@@ -46,10 +46,11 @@ type APIResponse struct {
 
 // Meta represents pagination and additional metadata
 type Meta struct {
-	Page       *int   `json:"page,omitempty"`
-	Limit      *int   `json:"limit,omitempty"`
-	Total      *int64 `json:"total,omitempty"`
-	TotalPages *int   `json:"total_pages,omitempty"`
+	Page       *int    `json:"page,omitempty"`
+	Limit      *int    `json:"limit,omitempty"`
+	Total      *int64  `json:"total,omitempty"`
+	TotalPages *int    `json:"total_pages,omitempty"`
+	NextCursor *string `json:"next_cursor,omitempty"`
 }
 
 // ValidationError represents a field-level validation error
@@ -58,6 +59,26 @@ type ValidationError struct {
 	Message string `json:"message"`
 }
 
+// maxRequestIDLength bounds client-supplied request IDs
+const maxRequestIDLength = 128
+
+// IsValidRequestID reports whether a client-supplied request ID is safe to reuse:
+// 1-128 letters, digits, '-', '_', '.' or ':'
+func IsValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 // GetRequestID gets or creates a request ID
 func GetRequestID(c *gin.Context) string {
 	if requestID, exists := c.Get(RequestIDKey); exists {
@@ -157,6 +178,12 @@ func BadRequestResponse(c *gin.Context, message string) {
 	c.JSON(http.StatusBadRequest, response)
 }
 
+// UnprocessableEntityResponse sends an unprocessable entity response for well-formed but invalid values
+func UnprocessableEntityResponse(c *gin.Context, message string) {
+	response := buildResponse(c, false, ErrCodeInvalidInput, message)
+	c.JSON(http.StatusUnprocessableEntity, response)
+}
+
 // ConflictResponse sends a conflict response
 func ConflictResponse(c *gin.Context, message string) {
 	response := buildResponse(c, false, ErrCodeConflict, message)
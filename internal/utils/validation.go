@@ -1,20 +1,54 @@
 package utils
 
-// ValidatePagination validates and normalizes pagination parameters
+import "TinderTrip-Backend/pkg/config"
+
+// Pagination endpoint groups, each with its own default and max page size
+const (
+	PaginationEvents        = "events"
+	PaginationSuggestions   = "suggestions"
+	PaginationHome          = "home"
+	PaginationTags          = "tags"
+	PaginationChat          = "chat"
+	PaginationHistory       = "history"
+	PaginationWebhooks      = "webhooks"
+	PaginationAudit         = "audit"
+	PaginationOnboarding    = "onboarding"
+	PaginationNotifications = "notifications"
+)
+
+// fallbackPageLimit applies to groups without a configured page size
+var fallbackPageLimit = config.PageLimit{Default: 10, Max: 100}
+
+// ValidatePagination validates and normalizes pagination parameters for an endpoint group.
+// A missing or invalid limit uses the group's default; larger limits are capped at its max.
 // Returns normalized page and limit values
-func ValidatePagination(page, limit int) (int, int) {
+func ValidatePagination(group string, page, limit int) (int, int) {
 	// Validate page
 	if page < 1 {
 		page = 1
 	}
 
 	// Validate limit
+	pageLimit := PageLimitFor(group)
 	if limit < 1 {
-		limit = 10 // default limit
+		limit = pageLimit.Default
 	}
-	if limit > 100 {
-		limit = 100 // max limit to prevent abuse
+	if limit > pageLimit.Max {
+		limit = pageLimit.Max // max limit to prevent abuse
 	}
 
 	return page, limit
 }
+
+// PageLimitFor returns the configured page sizes of an endpoint group
+func PageLimitFor(group string) config.PageLimit {
+	if config.AppConfig != nil {
+		if limit, ok := config.AppConfig.Pagination.Limits[group]; ok {
+			return limit
+		}
+	}
+	if limit, ok := config.DefaultPageLimits[group]; ok {
+		return limit
+	}
+	return fallbackPageLimit
+}
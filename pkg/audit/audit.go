@@ -105,6 +105,11 @@ func (a *AuditLogger) LogPasswordReset(actorUserID *string) error {
 	return a.LogAction(actorUserID, "users", actorUserID, "PASSWORD_RESET", nil, nil)
 }
 
+// LogPasswordChange logs a signed-in user changing their password
+func (a *AuditLogger) LogPasswordChange(actorUserID *string) error {
+	return a.LogAction(actorUserID, "users", actorUserID, "PASSWORD_CHANGE", nil, nil)
+}
+
 // LogEventJoin logs an event join action
 func (a *AuditLogger) LogEventJoin(actorUserID *string, eventID string) error {
 	return a.LogAction(actorUserID, "events", &eventID, "JOIN", nil, map[string]string{"event_id": eventID})
@@ -122,36 +127,7 @@ func (a *AuditLogger) LogEventComplete(actorUserID *string, eventID string) erro
 
 // GetAuditLogs retrieves audit logs with pagination
 func (a *AuditLogger) GetAuditLogs(userID *string, entityTable *string, action *string, page, limit int) ([]models.AuditLog, int64, error) {
-	var logs []models.AuditLog
-	var total int64
-
-	query := a.db.Model(&models.AuditLog{})
-
-	// Apply filters
-	if userID != nil {
-		if uuid, err := uuid.Parse(*userID); err == nil {
-			query = query.Where("actor_user_id = ?", uuid)
-		}
-	}
-	if entityTable != nil {
-		query = query.Where("entity_table = ?", *entityTable)
-	}
-	if action != nil {
-		query = query.Where("action = ?", *action)
-	}
-
-	// Get total count
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
-
-	// Get paginated results
-	offset := (page - 1) * limit
-	if err := query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&logs).Error; err != nil {
-		return nil, 0, err
-	}
-
-	return logs, total, nil
+	return a.QueryLogs(LogQuery{UserID: userID, EntityTable: entityTable, Action: action}, page, limit)
 }
 
 // GetEntityAuditHistory gets audit history for a specific entity
@@ -0,0 +1,219 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// maxJSONFilters bounds the number of JSON conditions in one query
+	maxJSONFilters = 5
+	// maxUnscopedRange bounds JSON queries that are not scoped to an entity table
+	maxUnscopedRange = 31 * 24 * time.Hour
+)
+
+// jsonKeyPattern restricts JSON keys to plain identifiers so they are safe to embed in paths
+var jsonKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,62}$`)
+
+// LogQuery holds the filters for querying audit logs
+type LogQuery struct {
+	UserID      *string
+	EntityTable *string
+	EntityID    *string
+	Action      *string
+	From        *time.Time
+	To          *time.Time
+
+	// BeforeContains and AfterContains match rows whose JSON data contains the given object
+	BeforeContains map[string]interface{}
+	AfterContains  map[string]interface{}
+	// Changed matches rows where the listed keys differ between before and after data
+	Changed []string
+}
+
+// hasJSONFilters reports whether the query filters on JSON content
+func (q LogQuery) hasJSONFilters() bool {
+	return len(q.BeforeContains) > 0 || len(q.AfterContains) > 0 || len(q.Changed) > 0
+}
+
+// Validate checks that JSON filters are well formed and bounded
+func (q LogQuery) Validate() error {
+	if q.From != nil && q.To != nil && q.To.Before(*q.From) {
+		return fmt.Errorf("invalid time range")
+	}
+
+	if !q.hasJSONFilters() {
+		return nil
+	}
+
+	if len(q.BeforeContains)+len(q.AfterContains)+len(q.Changed) > maxJSONFilters {
+		return fmt.Errorf("too many JSON filters")
+	}
+
+	for key := range q.BeforeContains {
+		if !jsonKeyPattern.MatchString(key) {
+			return fmt.Errorf("invalid JSON filter key: %s", key)
+		}
+	}
+	for key := range q.AfterContains {
+		if !jsonKeyPattern.MatchString(key) {
+			return fmt.Errorf("invalid JSON filter key: %s", key)
+		}
+	}
+	for _, key := range q.Changed {
+		if !jsonKeyPattern.MatchString(key) {
+			return fmt.Errorf("invalid JSON filter key: %s", key)
+		}
+	}
+
+	// JSON filters must be narrowed by an indexed column to avoid full scans
+	if q.EntityTable == nil && q.EntityID == nil {
+		if q.From == nil || q.To == nil {
+			return fmt.Errorf("JSON filters require entity_table or a from/to range")
+		}
+		if q.To.Sub(*q.From) > maxUnscopedRange {
+			return fmt.Errorf("time range too large for JSON filters")
+		}
+	}
+
+	return nil
+}
+
+// QueryLogs retrieves audit logs matching the query with pagination
+func (a *AuditLogger) QueryLogs(q LogQuery, page, limit int) ([]models.AuditLog, int64, error) {
+	var logs []models.AuditLog
+	var total int64
+
+	query, err := a.applyLogQuery(a.db.Model(&models.AuditLog{}), q)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Get total count
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Get paginated results
+	offset := (page - 1) * limit
+	if err := query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&logs).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return logs, total, nil
+}
+
+// QueryLogsByCursor retrieves audit logs matching the query after the given cursor, newest first.
+// It returns the cursor for the next page, or nil when there are no more rows.
+func (a *AuditLogger) QueryLogsByCursor(q LogQuery, cursor *utils.Cursor, limit int) ([]models.AuditLog, *string, error) {
+	var logs []models.AuditLog
+
+	query, err := a.applyLogQuery(a.db.Model(&models.AuditLog{}), q)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Fetch one extra row to know whether another page exists
+	if err := utils.ApplyCursor(query, cursor).Limit(limit + 1).Find(&logs).Error; err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *string
+	if len(logs) > limit {
+		logs = logs[:limit]
+		last := logs[len(logs)-1]
+		next := utils.EncodeCursor(last.CreatedAt, last.ID.String())
+		nextCursor = &next
+	}
+
+	return logs, nextCursor, nil
+}
+
+// applyLogQuery validates the query and adds its conditions
+func (a *AuditLogger) applyLogQuery(query *gorm.DB, q LogQuery) (*gorm.DB, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Apply filters
+	if q.UserID != nil {
+		if uuid, err := uuid.Parse(*q.UserID); err == nil {
+			query = query.Where("actor_user_id = ?", uuid)
+		}
+	}
+	if q.EntityTable != nil {
+		query = query.Where("entity_table = ?", *q.EntityTable)
+	}
+	if q.EntityID != nil {
+		entityUUID, err := uuid.Parse(*q.EntityID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid entity ID: %w", err)
+		}
+		query = query.Where("entity_id = ?", entityUUID)
+	}
+	if q.Action != nil {
+		query = query.Where("action = ?", *q.Action)
+	}
+	if q.From != nil {
+		query = query.Where("created_at >= ?", *q.From)
+	}
+	if q.To != nil {
+		query = query.Where("created_at <= ?", *q.To)
+	}
+
+	postgres := a.db.Dialector.Name() == "postgres"
+
+	var err error
+	if query, err = applyContains(query, "before_data", q.BeforeContains, postgres); err != nil {
+		return nil, err
+	}
+	if query, err = applyContains(query, "after_data", q.AfterContains, postgres); err != nil {
+		return nil, err
+	}
+
+	for _, key := range q.Changed {
+		if postgres {
+			query = query.Where("(before_data ->> ?) IS DISTINCT FROM (after_data ->> ?)", key, key)
+		} else {
+			path := "$." + key
+			query = query.Where("json_extract(before_data, ?) IS NOT json_extract(after_data, ?)", path, path)
+		}
+	}
+
+	return query, nil
+}
+
+// applyContains adds a JSON containment condition on column.
+// Postgres uses the @> operator; other dialects compare each top-level key.
+func applyContains(query *gorm.DB, column string, contains map[string]interface{}, postgres bool) (*gorm.DB, error) {
+	if len(contains) == 0 {
+		return query, nil
+	}
+
+	if postgres {
+		data, err := json.Marshal(contains)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON filter: %w", err)
+		}
+		return query.Where(column+" @> ?::jsonb", string(data)), nil
+	}
+
+	for key, value := range contains {
+		data, err := json.Marshal(map[string]interface{}{key: value})
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON filter: %w", err)
+		}
+		path := "$." + key
+		query = query.Where("json_extract("+column+", ?) = json_extract(?, ?)", path, string(data), path)
+	}
+
+	return query, nil
+}
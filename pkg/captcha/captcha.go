@@ -0,0 +1,106 @@
+package captcha
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"TinderTrip-Backend/pkg/config"
+)
+
+// ErrInvalidToken is returned when the provider rejects the CAPTCHA token
+var ErrInvalidToken = errors.New("captcha token is invalid")
+
+// Verifier checks CAPTCHA tokens solved by clients
+type Verifier interface {
+	Verify(token, remoteIP string) error
+}
+
+// verifyURLs are the server-side verification endpoints of the supported providers
+var verifyURLs = map[string]string{
+	"hcaptcha":  "https://api.hcaptcha.com/siteverify",
+	"recaptcha": "https://www.google.com/recaptcha/api/siteverify",
+}
+
+// NewVerifier creates the verifier configured by CAPTCHA_PROVIDER.
+// It returns nil when CAPTCHA is disabled.
+func NewVerifier() Verifier {
+	if config.AppConfig == nil {
+		return nil
+	}
+
+	cfg := config.AppConfig.Captcha
+	verifyURL, ok := verifyURLs[cfg.Provider]
+	if !ok {
+		return nil
+	}
+	return NewSiteVerifier(verifyURL, cfg.Secret)
+}
+
+// SiteVerifier verifies tokens against a siteverify endpoint. hCaptcha and reCAPTCHA share the
+// same request and response shape.
+type SiteVerifier struct {
+	verifyURL string
+	secret    string
+	client    *http.Client
+}
+
+// NewSiteVerifier creates a verifier for the given siteverify endpoint
+func NewSiteVerifier(verifyURL, secret string) *SiteVerifier {
+	return &SiteVerifier{
+		verifyURL: verifyURL,
+		secret:    secret,
+		client:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// siteVerifyResponse is the body returned by a siteverify endpoint
+type siteVerifyResponse struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+// Verify checks the token with the provider
+func (v *SiteVerifier) Verify(token, remoteIP string) error {
+	if v.secret == "" {
+		return fmt.Errorf("captcha is not configured")
+	}
+
+	form := url.Values{}
+	form.Set("secret", v.secret)
+	form.Set("response", token)
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, v.verifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to verify captcha: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 2000))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("captcha provider responded with status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var result siteVerifyResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return fmt.Errorf("failed to decode captcha response: %w", err)
+	}
+	if !result.Success {
+		return ErrInvalidToken
+	}
+	return nil
+}
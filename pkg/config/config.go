@@ -10,18 +10,31 @@ import (
 )
 
 type Config struct {
-	Server     ServerConfig
-	Database   DatabaseConfig
-	Redis      RedisConfig
-	JWT        JWTConfig
-	Email      EmailConfig
-	AWS        AWSConfig
-	Firebase   FirebaseConfig
-	Google     GoogleConfig
-	RateLimit  RateLimitConfig
-	CORS       CORSConfig
-	Nextcloud  NextcloudConfig
-	Monitoring MonitoringConfig
+	Server       ServerConfig
+	Database     DatabaseConfig
+	Redis        RedisConfig
+	JWT          JWTConfig
+	Email        EmailConfig
+	AWS          AWSConfig
+	Firebase     FirebaseConfig
+	Google       GoogleConfig
+	RateLimit    RateLimitConfig
+	CORS         CORSConfig
+	Nextcloud    NextcloudConfig
+	Monitoring   MonitoringConfig
+	Admin        AdminConfig
+	Webhook      WebhookConfig
+	Storage      StorageConfig
+	SMS          SMSConfig
+	OTP          OTPConfig
+	Captcha      CaptchaConfig
+	Event        EventConfig
+	Checkin      CheckinConfig
+	Broadcast    BroadcastConfig
+	Pagination   PaginationConfig
+	RequestID    RequestIDConfig
+	User         UserConfig
+	Notification NotificationConfig
 }
 
 type ServerConfig struct {
@@ -29,6 +42,17 @@ type ServerConfig struct {
 	Host        string
 	Mode        string
 	FrontendURL string
+
+	ListenHost               string // interface the API binds to
+	ReadTimeoutSeconds       int    // whole request, body included
+	ReadHeaderTimeoutSeconds int    // request headers; bounds slowloris clients
+	WriteTimeoutSeconds      int    // from the end of the request headers to the end of the response
+	IdleTimeoutSeconds       int    // keep-alive connections between requests
+	TLSCertFile              string // serve HTTPS with this certificate and key when both are set
+	TLSKeyFile               string
+	AutocertDomains          []string // serve HTTPS with Let's Encrypt certificates for these hosts
+	AutocertCacheDir         string
+	HTTP2                    bool // offer HTTP/2 to HTTPS clients
 }
 
 type DatabaseConfig struct {
@@ -38,6 +62,8 @@ type DatabaseConfig struct {
 	Password string
 	Name     string
 	SSLMode  string
+
+	SlowQueryThresholdMs int // queries slower than this are logged as slow
 }
 
 type RedisConfig struct {
@@ -48,8 +74,10 @@ type RedisConfig struct {
 }
 
 type JWTConfig struct {
-	Secret      string
-	ExpireHours int
+	Secret            string
+	ExpireHours       int
+	GuestExpireDays   int // lifetime of guest browsing tokens
+	RefreshExpireDays int // lifetime of refresh tokens; each refresh issues a new one
 }
 
 type EmailConfig struct {
@@ -58,6 +86,9 @@ type EmailConfig struct {
 	SMTPUsername string
 	SMTPPassword string
 	SMTPFromName string
+	Timezone     string // IANA zone that event dates in emails are shown in
+
+	SuppressOnboarding bool // skip welcome emails for every new user, e.g. while importing accounts
 }
 
 type AWSConfig struct {
@@ -76,6 +107,16 @@ type FirebaseConfig struct {
 type RateLimitConfig struct {
 	Requests int
 	Window   string
+	Enforce  bool // when false, only RateLimit-* headers are sent
+
+	GuestRequests      int // per guest token on public endpoints
+	AnonymousRequests  int // per IP on public endpoints without a token
+	GuestIssueRequests int // guest tokens issued per IP
+}
+
+type RequestIDConfig struct {
+	Header       string // header that carries the request ID in and out
+	TrustInbound bool   // when false, client-supplied IDs are ignored
 }
 
 type CORSConfig struct {
@@ -102,6 +143,97 @@ type MonitoringConfig struct {
 	HealthPort  string
 }
 
+type AdminConfig struct {
+	UserIDs []string
+	Emails  []string
+}
+
+type WebhookConfig struct {
+	MaxAttempts    int
+	TimeoutSeconds int
+}
+
+type SMSConfig struct {
+	Provider         string // twilio, log, or empty to disable
+	TwilioAccountSID string
+	TwilioAuthToken  string
+	FromNumber       string
+}
+
+type CaptchaConfig struct {
+	Provider string // hcaptcha, recaptcha, or empty to disable
+	Secret   string // provider secret used to verify tokens server-side
+}
+
+type OTPConfig struct {
+	DefaultChannel string // email or sms
+	Pepper         string // secret key for the HMAC that OTPs are stored as
+}
+
+type EventConfig struct {
+	RequireCategory           bool // reject new events without at least one category
+	PendingMemberTTLHours     int  // hours a member may stay pending before being declined
+	MinAttendeesDeadlineHours int  // hours before start an event below min_attendees is cancelled
+	TitleMaxLength            int  // max characters in an event title
+	DescriptionMaxLength      int  // max characters in an event description
+}
+
+// Display name modes
+const (
+	DisplayNameUnique     = "unique"             // reject a name another user has
+	DisplayNameAutoSuffix = "unique-suffix-auto" // store a taken name with a _2, _3, ... suffix
+	DisplayNameNonUnique  = "non-unique"         // allow duplicates and tell users apart by handle
+)
+
+type UserConfig struct {
+	DisplayNameMode          string // unique, unique-suffix-auto or non-unique
+	HandleChangeCooldownDays int    // days a user must wait between handle changes
+}
+
+type NotificationConfig struct {
+	RetentionDays int // days read notifications are kept
+	MaxPerUser    int // notifications kept per user; older ones are deleted first
+}
+
+type CheckinConfig struct {
+	RadiusMeters int // max distance from the event location for a location check-in
+}
+
+type BroadcastConfig struct {
+	DailyLimit int // max broadcasts per event in a rolling 24 hours
+}
+
+type PaginationConfig struct {
+	Limits map[string]PageLimit // page sizes keyed by endpoint group
+}
+
+// PageLimit is the default and maximum page size of an endpoint group
+type PageLimit struct {
+	Default int
+	Max     int
+}
+
+// DefaultPageLimits are the built-in page sizes per endpoint group.
+// Each can be overridden with PAGINATION_<GROUP>_DEFAULT_LIMIT and PAGINATION_<GROUP>_MAX_LIMIT.
+var DefaultPageLimits = map[string]PageLimit{
+	"events":        {Default: 10, Max: 100},
+	"suggestions":   {Default: 20, Max: 100},
+	"home":          {Default: 5, Max: 20},
+	"tags":          {Default: 50, Max: 100},
+	"chat":          {Default: 50, Max: 100},
+	"history":       {Default: 10, Max: 100},
+	"webhooks":      {Default: 10, Max: 100},
+	"audit":         {Default: 10, Max: 100},
+	"onboarding":    {Default: 8, Max: 20},
+	"notifications": {Default: 20, Max: 100},
+}
+
+type StorageConfig struct {
+	ReconcileDelete     bool // when false, orphan reconciliation only reports
+	ReconcileGraceHours int
+	MultipartMemoryMB   int // upload parts above this spill to temp files
+}
+
 var AppConfig *Config
 
 func LoadConfig() {
@@ -119,6 +251,17 @@ func LoadConfig() {
 			Host:        getEnv("SERVER_HOST", ""),
 			Mode:        getEnv("GIN_MODE", ""),
 			FrontendURL: "mobileapp://auth",
+
+			ListenHost:               getEnv("SERVER_LISTEN_HOST", "0.0.0.0"),
+			ReadTimeoutSeconds:       getEnvAsInt("SERVER_READ_TIMEOUT_SECONDS", 15),
+			ReadHeaderTimeoutSeconds: getEnvAsInt("SERVER_READ_HEADER_TIMEOUT_SECONDS", 5),
+			WriteTimeoutSeconds:      getEnvAsInt("SERVER_WRITE_TIMEOUT_SECONDS", 15),
+			IdleTimeoutSeconds:       getEnvAsInt("SERVER_IDLE_TIMEOUT_SECONDS", 60),
+			TLSCertFile:              getEnv("SERVER_TLS_CERT_FILE", ""),
+			TLSKeyFile:               getEnv("SERVER_TLS_KEY_FILE", ""),
+			AutocertDomains:          getEnvAsSlice("SERVER_AUTOCERT_DOMAINS", nil),
+			AutocertCacheDir:         getEnv("SERVER_AUTOCERT_CACHE_DIR", "autocert-cache"),
+			HTTP2:                    getEnvAsBool("SERVER_HTTP2", true),
 		},
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", ""),
@@ -127,6 +270,8 @@ func LoadConfig() {
 			Password: getEnv("DB_PASSWORD", ""),
 			Name:     getEnv("DB_NAME", ""),
 			SSLMode:  getEnv("DB_SSLMODE", ""),
+
+			SlowQueryThresholdMs: getEnvAsInt("DB_SLOW_QUERY_THRESHOLD_MS", 200),
 		},
 		Redis: RedisConfig{
 			Host:     getEnv("REDIS_HOST", ""),
@@ -137,6 +282,9 @@ func LoadConfig() {
 		JWT: JWTConfig{
 			Secret:      getEnv("JWT_SECRET", ""),
 			ExpireHours: getEnvAsInt("JWT_EXPIRE_HOURS", -1),
+
+			GuestExpireDays:   getEnvAsInt("GUEST_TOKEN_EXPIRE_DAYS", 30),
+			RefreshExpireDays: getEnvAsInt("JWT_REFRESH_EXPIRE_DAYS", 30),
 		},
 		Email: EmailConfig{
 			SMTPHost:     getEnv("SMTP_HOST", ""),
@@ -144,6 +292,9 @@ func LoadConfig() {
 			SMTPUsername: getEnv("SMTP_USERNAME", ""),
 			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
 			SMTPFromName: getEnv("SMTP_FROM_NAME", ""),
+			Timezone:     getEnv("EMAIL_TIMEZONE", "Asia/Bangkok"),
+
+			SuppressOnboarding: getEnvAsBool("SUPPRESS_ONBOARDING_EMAILS", false),
 		},
 		AWS: AWSConfig{
 			AccessKeyID:     getEnv("AWS_ACCESS_KEY_ID", ""),
@@ -164,6 +315,15 @@ func LoadConfig() {
 		RateLimit: RateLimitConfig{
 			Requests: getEnvAsInt("RATE_LIMIT_REQUESTS", -1),
 			Window:   getEnv("RATE_LIMIT_WINDOW", ""),
+			Enforce:  getEnvAsBool("RATE_LIMIT_ENFORCE", false),
+
+			GuestRequests:      getEnvAsInt("GUEST_RATE_LIMIT_REQUESTS", 100),
+			AnonymousRequests:  getEnvAsInt("ANONYMOUS_RATE_LIMIT_REQUESTS", 30),
+			GuestIssueRequests: getEnvAsInt("GUEST_ISSUE_RATE_LIMIT_REQUESTS", 5),
+		},
+		RequestID: RequestIDConfig{
+			Header:       getEnv("REQUEST_ID_HEADER", "X-Request-ID"),
+			TrustInbound: getEnvAsBool("REQUEST_ID_TRUST_INBOUND", true),
 		},
 		CORS: CORSConfig{
 			AllowedOrigins: getEnvAsSlice("CORS_ALLOWED_ORIGINS", []string{}),
@@ -180,6 +340,57 @@ func LoadConfig() {
 			MetricsPort: getEnv("METRICS_PORT", "9090"),
 			HealthPort:  getEnv("HEALTH_PORT", "8080"),
 		},
+		Admin: AdminConfig{
+			UserIDs: getEnvAsSlice("ADMIN_USER_IDS", []string{}),
+			Emails:  getEnvAsSlice("ADMIN_EMAILS", []string{}),
+		},
+		Webhook: WebhookConfig{
+			MaxAttempts:    getEnvAsInt("WEBHOOK_MAX_ATTEMPTS", 5),
+			TimeoutSeconds: getEnvAsInt("WEBHOOK_TIMEOUT_SECONDS", 10),
+		},
+		Storage: StorageConfig{
+			ReconcileDelete:     getEnvAsBool("STORAGE_RECONCILE_DELETE", false),
+			ReconcileGraceHours: getEnvAsInt("STORAGE_RECONCILE_GRACE_HOURS", 24),
+			MultipartMemoryMB:   getEnvAsInt("STORAGE_MULTIPART_MEMORY_MB", 0),
+		},
+		SMS: SMSConfig{
+			Provider:         getEnv("SMS_PROVIDER", ""),
+			TwilioAccountSID: getEnv("TWILIO_ACCOUNT_SID", ""),
+			TwilioAuthToken:  getEnv("TWILIO_AUTH_TOKEN", ""),
+			FromNumber:       getEnv("SMS_FROM_NUMBER", ""),
+		},
+		OTP: OTPConfig{
+			DefaultChannel: getEnv("OTP_DEFAULT_CHANNEL", "email"),
+			Pepper:         getEnv("OTP_PEPPER", ""),
+		},
+		Captcha: CaptchaConfig{
+			Provider: getEnv("CAPTCHA_PROVIDER", ""),
+			Secret:   getEnv("CAPTCHA_SECRET", ""),
+		},
+		Event: EventConfig{
+			RequireCategory:           getEnvAsBool("EVENTS_REQUIRE_CATEGORY", false),
+			PendingMemberTTLHours:     getEnvAsInt("EVENTS_PENDING_MEMBER_TTL_HOURS", 72),
+			MinAttendeesDeadlineHours: getEnvAsInt("EVENTS_MIN_ATTENDEES_DEADLINE_HOURS", 24),
+			TitleMaxLength:            getEnvAsInt("EVENTS_TITLE_MAX_LENGTH", 150),
+			DescriptionMaxLength:      getEnvAsInt("EVENTS_DESCRIPTION_MAX_LENGTH", 5000),
+		},
+		Checkin: CheckinConfig{
+			RadiusMeters: getEnvAsInt("CHECKIN_RADIUS_METERS", 200),
+		},
+		Broadcast: BroadcastConfig{
+			DailyLimit: getEnvAsInt("BROADCAST_DAILY_LIMIT", 3),
+		},
+		Pagination: PaginationConfig{
+			Limits: loadPageLimits(),
+		},
+		User: UserConfig{
+			DisplayNameMode:          getEnv("DISPLAY_NAME_MODE", DisplayNameUnique),
+			HandleChangeCooldownDays: getEnvAsInt("HANDLE_CHANGE_COOLDOWN_DAYS", 30),
+		},
+		Notification: NotificationConfig{
+			RetentionDays: getEnvAsInt("NOTIFICATION_RETENTION_DAYS", 90),
+			MaxPerUser:    getEnvAsInt("NOTIFICATION_MAX_PER_USER", 500),
+		},
 	}
 
 	// Validate required configuration
@@ -202,6 +413,19 @@ func getEnvAsInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+// loadPageLimits reads the page size overrides of every endpoint group
+func loadPageLimits() map[string]PageLimit {
+	limits := make(map[string]PageLimit, len(DefaultPageLimits))
+	for group, builtin := range DefaultPageLimits {
+		prefix := "PAGINATION_" + strings.ToUpper(group)
+		limits[group] = PageLimit{
+			Default: getEnvAsInt(prefix+"_DEFAULT_LIMIT", builtin.Default),
+			Max:     getEnvAsInt(prefix+"_MAX_LIMIT", builtin.Max),
+		}
+	}
+	return limits
+}
+
 func getEnvAsSlice(key string, defaultValue []string) []string {
 	if value := os.Getenv(key); value != "" {
 		return strings.Split(value, ",")
@@ -271,6 +495,26 @@ func validateConfig() {
 		AppConfig.RateLimit.Window = "1h"
 		log.Println("Using default RATE_LIMIT_WINDOW: 1h")
 	}
+	if AppConfig.RateLimit.GuestRequests <= 0 {
+		AppConfig.RateLimit.GuestRequests = 100
+		log.Println("Using default GUEST_RATE_LIMIT_REQUESTS: 100")
+	}
+	if AppConfig.RateLimit.AnonymousRequests <= 0 {
+		AppConfig.RateLimit.AnonymousRequests = 30
+		log.Println("Using default ANONYMOUS_RATE_LIMIT_REQUESTS: 30")
+	}
+	if AppConfig.RateLimit.GuestIssueRequests <= 0 {
+		AppConfig.RateLimit.GuestIssueRequests = 5
+		log.Println("Using default GUEST_ISSUE_RATE_LIMIT_REQUESTS: 5")
+	}
+	if AppConfig.JWT.GuestExpireDays <= 0 {
+		AppConfig.JWT.GuestExpireDays = 30
+		log.Println("Using default GUEST_TOKEN_EXPIRE_DAYS: 30")
+	}
+	if AppConfig.JWT.RefreshExpireDays <= 0 {
+		AppConfig.JWT.RefreshExpireDays = 30
+		log.Println("Using default JWT_REFRESH_EXPIRE_DAYS: 30")
+	}
 
 	// Set default CORS values if not provided
 	if len(AppConfig.CORS.AllowedOrigins) == 0 {
@@ -286,5 +530,160 @@ func validateConfig() {
 		log.Println("Using default CORS_ALLOWED_HEADERS")
 	}
 
+	// Set default webhook values if not provided
+	if AppConfig.Webhook.MaxAttempts <= 0 {
+		AppConfig.Webhook.MaxAttempts = 5
+		log.Println("Using default WEBHOOK_MAX_ATTEMPTS: 5")
+	}
+	if AppConfig.Webhook.TimeoutSeconds <= 0 {
+		AppConfig.Webhook.TimeoutSeconds = 10
+		log.Println("Using default WEBHOOK_TIMEOUT_SECONDS: 10")
+	}
+
+	// Set default OTP channel if not provided
+	if AppConfig.OTP.DefaultChannel != "email" && AppConfig.OTP.DefaultChannel != "sms" {
+		AppConfig.OTP.DefaultChannel = "email"
+		log.Println("Using default OTP_DEFAULT_CHANNEL: email")
+	}
+	if AppConfig.OTP.Pepper == "" {
+		AppConfig.OTP.Pepper = AppConfig.JWT.Secret
+		log.Println("OTP_PEPPER not set, using JWT_SECRET to hash OTPs")
+	}
+
+	// CAPTCHA stays off unless a known provider is chosen
+	switch AppConfig.Captcha.Provider {
+	case "", "hcaptcha", "recaptcha":
+	default:
+		log.Printf("Unknown CAPTCHA_PROVIDER %q, CAPTCHA disabled", AppConfig.Captcha.Provider)
+		AppConfig.Captcha.Provider = ""
+	}
+	if AppConfig.Captcha.Provider != "" && AppConfig.Captcha.Secret == "" {
+		log.Println("CAPTCHA_PROVIDER set without CAPTCHA_SECRET, protected requests will be rejected")
+	}
+
+	// Set default check-in radius if not provided
+	if AppConfig.Checkin.RadiusMeters <= 0 {
+		AppConfig.Checkin.RadiusMeters = 200
+		log.Println("Using default CHECKIN_RADIUS_METERS: 200")
+	}
+
+	// Set default request ID header if not provided
+	if AppConfig.RequestID.Header == "" {
+		AppConfig.RequestID.Header = "X-Request-ID"
+		log.Println("Using default REQUEST_ID_HEADER: X-Request-ID")
+	}
+
+	// Fall back to built-in page sizes for invalid overrides
+	for group, limit := range AppConfig.Pagination.Limits {
+		builtin := DefaultPageLimits[group]
+		if limit.Max <= 0 {
+			limit.Max = builtin.Max
+			log.Printf("Using default PAGINATION_%s_MAX_LIMIT: %d", strings.ToUpper(group), builtin.Max)
+		}
+		if limit.Default <= 0 || limit.Default > limit.Max {
+			limit.Default = builtin.Default
+			if limit.Default > limit.Max {
+				limit.Default = limit.Max
+			}
+			log.Printf("Using default PAGINATION_%s_DEFAULT_LIMIT: %d", strings.ToUpper(group), limit.Default)
+		}
+		AppConfig.Pagination.Limits[group] = limit
+	}
+
+	// TLS needs both halves of the key pair, and only one certificate source
+	if (AppConfig.Server.TLSCertFile == "") != (AppConfig.Server.TLSKeyFile == "") {
+		log.Fatal("SERVER_TLS_CERT_FILE and SERVER_TLS_KEY_FILE must be set together")
+	}
+	if AppConfig.Server.TLSCertFile != "" && len(AppConfig.Server.AutocertDomains) > 0 {
+		log.Fatal("Set either SERVER_TLS_CERT_FILE/SERVER_TLS_KEY_FILE or SERVER_AUTOCERT_DOMAINS, not both")
+	}
+
+	// Set default server timeouts if not provided; unbounded timeouts let slow clients hold connections open
+	if AppConfig.Server.ListenHost == "" {
+		AppConfig.Server.ListenHost = "0.0.0.0"
+	}
+	if AppConfig.Server.ReadTimeoutSeconds <= 0 {
+		AppConfig.Server.ReadTimeoutSeconds = 15
+		log.Println("Using default SERVER_READ_TIMEOUT_SECONDS: 15")
+	}
+	if AppConfig.Server.ReadHeaderTimeoutSeconds <= 0 {
+		AppConfig.Server.ReadHeaderTimeoutSeconds = 5
+		log.Println("Using default SERVER_READ_HEADER_TIMEOUT_SECONDS: 5")
+	}
+	if AppConfig.Server.WriteTimeoutSeconds <= 0 {
+		AppConfig.Server.WriteTimeoutSeconds = 15
+		log.Println("Using default SERVER_WRITE_TIMEOUT_SECONDS: 15")
+	}
+	if AppConfig.Server.IdleTimeoutSeconds <= 0 {
+		AppConfig.Server.IdleTimeoutSeconds = 60
+		log.Println("Using default SERVER_IDLE_TIMEOUT_SECONDS: 60")
+	}
+
+	// Set default pending member TTL if not provided
+	if AppConfig.Event.PendingMemberTTLHours <= 0 {
+		AppConfig.Event.PendingMemberTTLHours = 72
+		log.Println("Using default EVENTS_PENDING_MEMBER_TTL_HOURS: 72")
+	}
+
+	// Set default display name mode if not provided or unknown
+	switch AppConfig.User.DisplayNameMode {
+	case DisplayNameUnique, DisplayNameAutoSuffix, DisplayNameNonUnique:
+	default:
+		AppConfig.User.DisplayNameMode = DisplayNameUnique
+		log.Println("Using default DISPLAY_NAME_MODE: unique")
+	}
+	if AppConfig.User.HandleChangeCooldownDays <= 0 {
+		AppConfig.User.HandleChangeCooldownDays = 30
+		log.Println("Using default HANDLE_CHANGE_COOLDOWN_DAYS: 30")
+	}
+
+	// Set default min attendees deadline if not provided
+	if AppConfig.Event.MinAttendeesDeadlineHours <= 0 {
+		AppConfig.Event.MinAttendeesDeadlineHours = 24
+		log.Println("Using default EVENTS_MIN_ATTENDEES_DEADLINE_HOURS: 24")
+	}
+
+	// Set default event text limits if not provided
+	if AppConfig.Event.TitleMaxLength <= 0 {
+		AppConfig.Event.TitleMaxLength = 150
+		log.Println("Using default EVENTS_TITLE_MAX_LENGTH: 150")
+	}
+	if AppConfig.Event.DescriptionMaxLength <= 0 {
+		AppConfig.Event.DescriptionMaxLength = 5000
+		log.Println("Using default EVENTS_DESCRIPTION_MAX_LENGTH: 5000")
+	}
+
+	// Set default slow query threshold if not provided
+	if AppConfig.Database.SlowQueryThresholdMs <= 0 {
+		AppConfig.Database.SlowQueryThresholdMs = 200
+		log.Println("Using default DB_SLOW_QUERY_THRESHOLD_MS: 200")
+	}
+
+	// Set default notification retention if not provided
+	if AppConfig.Notification.RetentionDays <= 0 {
+		AppConfig.Notification.RetentionDays = 90
+		log.Println("Using default NOTIFICATION_RETENTION_DAYS: 90")
+	}
+	if AppConfig.Notification.MaxPerUser <= 0 {
+		AppConfig.Notification.MaxPerUser = 500
+		log.Println("Using default NOTIFICATION_MAX_PER_USER: 500")
+	}
+
+	// Set default broadcast limit if not provided
+	if AppConfig.Broadcast.DailyLimit <= 0 {
+		AppConfig.Broadcast.DailyLimit = 3
+		log.Println("Using default BROADCAST_DAILY_LIMIT: 3")
+	}
+
+	// Set default storage reconciliation values if not provided
+	if AppConfig.Storage.ReconcileGraceHours <= 0 {
+		AppConfig.Storage.ReconcileGraceHours = 24
+		log.Println("Using default STORAGE_RECONCILE_GRACE_HOURS: 24")
+	}
+	if AppConfig.Storage.MultipartMemoryMB <= 0 {
+		AppConfig.Storage.MultipartMemoryMB = 8
+		log.Println("Using default STORAGE_MULTIPART_MEMORY_MB: 8")
+	}
+
 	log.Println("Configuration validation passed")
 }
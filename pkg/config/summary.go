@@ -0,0 +1,103 @@
+package config
+
+// redacted replaces a configured secret in logs. Unset secrets stay empty so a missing one shows.
+func redacted(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return "[redacted]"
+}
+
+// Summary returns the settings worth logging at startup, with every secret redacted
+func (c *Config) Summary() map[string]interface{} {
+	return map[string]interface{}{
+		"server.host":            c.Server.Host,
+		"server.port":            c.Server.Port,
+		"server.mode":            c.Server.Mode,
+		"server.listen_host":     c.Server.ListenHost,
+		"server.tls":             tlsMode(c.Server),
+		"server.http2":           c.Server.HTTP2,
+		"database.host":          c.Database.Host,
+		"database.port":          c.Database.Port,
+		"database.name":          c.Database.Name,
+		"database.user":          c.Database.User,
+		"database.password":      redacted(c.Database.Password),
+		"database.sslmode":       c.Database.SSLMode,
+		"database.slow_query_ms": c.Database.SlowQueryThresholdMs,
+		"redis.host":             c.Redis.Host,
+		"redis.port":             c.Redis.Port,
+		"redis.db":               c.Redis.DB,
+		"redis.password":         redacted(c.Redis.Password),
+		"jwt.secret":             redacted(c.JWT.Secret),
+		"jwt.expire_hours":       c.JWT.ExpireHours,
+		"jwt.refresh_days":       c.JWT.RefreshExpireDays,
+		"email.smtp_host":        c.Email.SMTPHost,
+		"email.smtp_port":        c.Email.SMTPPort,
+		"email.smtp_username":    c.Email.SMTPUsername,
+		"email.smtp_password":    redacted(c.Email.SMTPPassword),
+		"email.timezone":         c.Email.Timezone,
+		"email.suppress_welcome": c.Email.SuppressOnboarding,
+		"aws.region":             c.AWS.Region,
+		"aws.s3_bucket":          c.AWS.S3Bucket,
+		"aws.secret_access_key":  redacted(c.AWS.SecretAccessKey),
+		"google.client_id":       c.Google.ClientID,
+		"google.client_secret":   redacted(c.Google.ClientSecret),
+		"firebase.project_id":    c.Firebase.ProjectID,
+		"firebase.private_key":   redacted(c.Firebase.PrivateKey),
+		"nextcloud.url":          c.Nextcloud.URL,
+		"nextcloud.password":     redacted(c.Nextcloud.Password),
+		"sms.provider":           c.SMS.Provider,
+		"sms.twilio_auth_token":  redacted(c.SMS.TwilioAuthToken),
+		"otp.default_channel":    c.OTP.DefaultChannel,
+		"otp.pepper":             redacted(c.OTP.Pepper),
+		"captcha.provider":       c.Captcha.Provider,
+		"captcha.secret":         redacted(c.Captcha.Secret),
+		"rate_limit.enforce":     c.RateLimit.Enforce,
+		"rate_limit.guest":       c.RateLimit.GuestRequests,
+		"rate_limit.anonymous":   c.RateLimit.AnonymousRequests,
+		"rate_limit.guest_issue": c.RateLimit.GuestIssueRequests,
+		"monitoring.enabled":     c.Monitoring.Enabled,
+		"cors.allowed_origins":   c.CORS.AllowedOrigins,
+		"storage.reconcile_mode": reconcileMode(c.Storage.ReconcileDelete),
+		"user.display_name_mode": c.User.DisplayNameMode,
+	}
+}
+
+// Redacted returns a copy of the effective config with every secret redacted, for admins to check
+// what a running instance loaded
+func (c *Config) Redacted() Config {
+	redactedConfig := *c
+	redactedConfig.Database.Password = redacted(c.Database.Password)
+	redactedConfig.Redis.Password = redacted(c.Redis.Password)
+	redactedConfig.JWT.Secret = redacted(c.JWT.Secret)
+	redactedConfig.Email.SMTPPassword = redacted(c.Email.SMTPPassword)
+	redactedConfig.AWS.AccessKeyID = redacted(c.AWS.AccessKeyID)
+	redactedConfig.AWS.SecretAccessKey = redacted(c.AWS.SecretAccessKey)
+	redactedConfig.Firebase.PrivateKey = redacted(c.Firebase.PrivateKey)
+	redactedConfig.Nextcloud.Password = redacted(c.Nextcloud.Password)
+	redactedConfig.Google.ClientSecret = redacted(c.Google.ClientSecret)
+	redactedConfig.SMS.TwilioAuthToken = redacted(c.SMS.TwilioAuthToken)
+	redactedConfig.Captcha.Secret = redacted(c.Captcha.Secret)
+	redactedConfig.OTP.Pepper = redacted(c.OTP.Pepper)
+	return redactedConfig
+}
+
+// reconcileMode names what orphan reconciliation does with what it finds
+func reconcileMode(deleteOrphans bool) string {
+	if deleteOrphans {
+		return "delete"
+	}
+	return "report"
+}
+
+// tlsMode names where the server's HTTPS certificate comes from, if anywhere
+func tlsMode(server ServerConfig) string {
+	switch {
+	case len(server.AutocertDomains) > 0:
+		return "autocert"
+	case server.TLSCertFile != "":
+		return "cert_file"
+	default:
+		return "off"
+	}
+}
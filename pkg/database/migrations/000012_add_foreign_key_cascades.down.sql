@@ -0,0 +1,49 @@
+-- Restore the constraints defined by the initial schema
+
+ALTER TABLE user_event_history DROP CONSTRAINT IF EXISTS fk_user_event_history_event;
+ALTER TABLE user_event_history ADD CONSTRAINT user_event_history_event_id_fkey
+  FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE;
+
+ALTER TABLE chat_messages DROP CONSTRAINT IF EXISTS fk_chat_messages_room;
+ALTER TABLE chat_messages ADD CONSTRAINT chat_messages_room_id_fkey
+  FOREIGN KEY (room_id) REFERENCES chat_rooms(id) ON DELETE CASCADE;
+
+ALTER TABLE chat_rooms DROP CONSTRAINT IF EXISTS fk_chat_rooms_event;
+ALTER TABLE chat_rooms ADD CONSTRAINT chat_rooms_event_id_fkey
+  FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE;
+
+ALTER TABLE event_categories DROP CONSTRAINT IF EXISTS fk_event_categories_event;
+ALTER TABLE event_categories ADD CONSTRAINT event_categories_event_id_fkey
+  FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE;
+
+ALTER TABLE event_tags DROP CONSTRAINT IF EXISTS fk_event_tags_event;
+ALTER TABLE event_tags ADD CONSTRAINT event_tags_event_id_fkey
+  FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE;
+
+ALTER TABLE event_photos DROP CONSTRAINT IF EXISTS fk_event_photos_event;
+ALTER TABLE event_photos ADD CONSTRAINT event_photos_event_id_fkey
+  FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE;
+
+ALTER TABLE event_swipes DROP CONSTRAINT IF EXISTS fk_event_swipes_user;
+ALTER TABLE event_swipes ADD CONSTRAINT event_swipes_user_id_fkey
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+ALTER TABLE event_swipes DROP CONSTRAINT IF EXISTS fk_event_swipes_event;
+ALTER TABLE event_swipes ADD CONSTRAINT event_swipes_event_id_fkey
+  FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE;
+
+ALTER TABLE event_members DROP CONSTRAINT IF EXISTS fk_event_members_confirmation_message;
+ALTER TABLE event_members ADD CONSTRAINT event_members_confirmation_message_id_fkey
+  FOREIGN KEY (confirmation_message_id) REFERENCES chat_messages(id);
+
+ALTER TABLE event_members DROP CONSTRAINT IF EXISTS fk_event_members_user;
+ALTER TABLE event_members ADD CONSTRAINT event_members_user_id_fkey
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+ALTER TABLE event_members DROP CONSTRAINT IF EXISTS fk_event_members_event;
+ALTER TABLE event_members ADD CONSTRAINT event_members_event_id_fkey
+  FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE;
+
+ALTER TABLE events DROP CONSTRAINT IF EXISTS fk_events_creator;
+ALTER TABLE events ADD CONSTRAINT events_creator_id_fkey
+  FOREIGN KEY (creator_id) REFERENCES users(id) ON DELETE CASCADE;
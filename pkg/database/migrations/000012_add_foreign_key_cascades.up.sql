@@ -0,0 +1,85 @@
+-- Enforce referential integrity between events, members, swipes, photos, chat and users.
+-- Existing constraints are dropped by their default names and recreated with explicit
+-- names and ON DELETE rules. Orphaned rows are removed first so the constraints apply.
+
+-- Remove orphaned rows
+DELETE FROM event_members em WHERE NOT EXISTS (SELECT 1 FROM events e WHERE e.id = em.event_id);
+DELETE FROM event_members em WHERE NOT EXISTS (SELECT 1 FROM users u WHERE u.id = em.user_id);
+DELETE FROM event_swipes es WHERE NOT EXISTS (SELECT 1 FROM events e WHERE e.id = es.event_id);
+DELETE FROM event_swipes es WHERE NOT EXISTS (SELECT 1 FROM users u WHERE u.id = es.user_id);
+DELETE FROM event_photos ep WHERE NOT EXISTS (SELECT 1 FROM events e WHERE e.id = ep.event_id);
+DELETE FROM event_tags et WHERE NOT EXISTS (SELECT 1 FROM events e WHERE e.id = et.event_id);
+DELETE FROM event_categories ec WHERE NOT EXISTS (SELECT 1 FROM events e WHERE e.id = ec.event_id);
+DELETE FROM chat_messages cm WHERE NOT EXISTS (SELECT 1 FROM chat_rooms cr WHERE cr.id = cm.room_id);
+DELETE FROM chat_rooms cr WHERE NOT EXISTS (SELECT 1 FROM events e WHERE e.id = cr.event_id);
+DELETE FROM user_event_history h WHERE NOT EXISTS (SELECT 1 FROM events e WHERE e.id = h.event_id);
+UPDATE event_members em SET confirmation_message_id = NULL
+  WHERE confirmation_message_id IS NOT NULL
+    AND NOT EXISTS (SELECT 1 FROM chat_messages cm WHERE cm.id = em.confirmation_message_id);
+
+-- events: creator must be handed over or removed explicitly
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_creator_id_fkey;
+ALTER TABLE events DROP CONSTRAINT IF EXISTS fk_events_creator;
+ALTER TABLE events ADD CONSTRAINT fk_events_creator
+  FOREIGN KEY (creator_id) REFERENCES users(id) ON DELETE RESTRICT;
+
+-- event_members
+ALTER TABLE event_members DROP CONSTRAINT IF EXISTS event_members_event_id_fkey;
+ALTER TABLE event_members DROP CONSTRAINT IF EXISTS fk_event_members_event;
+ALTER TABLE event_members ADD CONSTRAINT fk_event_members_event
+  FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE;
+
+ALTER TABLE event_members DROP CONSTRAINT IF EXISTS event_members_user_id_fkey;
+ALTER TABLE event_members DROP CONSTRAINT IF EXISTS fk_event_members_user;
+ALTER TABLE event_members ADD CONSTRAINT fk_event_members_user
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+ALTER TABLE event_members DROP CONSTRAINT IF EXISTS event_members_confirmation_message_id_fkey;
+ALTER TABLE event_members DROP CONSTRAINT IF EXISTS fk_event_members_confirmation_message;
+ALTER TABLE event_members ADD CONSTRAINT fk_event_members_confirmation_message
+  FOREIGN KEY (confirmation_message_id) REFERENCES chat_messages(id) ON DELETE SET NULL;
+
+-- event_swipes
+ALTER TABLE event_swipes DROP CONSTRAINT IF EXISTS event_swipes_event_id_fkey;
+ALTER TABLE event_swipes DROP CONSTRAINT IF EXISTS fk_event_swipes_event;
+ALTER TABLE event_swipes ADD CONSTRAINT fk_event_swipes_event
+  FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE;
+
+ALTER TABLE event_swipes DROP CONSTRAINT IF EXISTS event_swipes_user_id_fkey;
+ALTER TABLE event_swipes DROP CONSTRAINT IF EXISTS fk_event_swipes_user;
+ALTER TABLE event_swipes ADD CONSTRAINT fk_event_swipes_user
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+-- event_photos
+ALTER TABLE event_photos DROP CONSTRAINT IF EXISTS event_photos_event_id_fkey;
+ALTER TABLE event_photos DROP CONSTRAINT IF EXISTS fk_event_photos_event;
+ALTER TABLE event_photos ADD CONSTRAINT fk_event_photos_event
+  FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE;
+
+-- event_tags / event_categories
+ALTER TABLE event_tags DROP CONSTRAINT IF EXISTS event_tags_event_id_fkey;
+ALTER TABLE event_tags DROP CONSTRAINT IF EXISTS fk_event_tags_event;
+ALTER TABLE event_tags ADD CONSTRAINT fk_event_tags_event
+  FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE;
+
+ALTER TABLE event_categories DROP CONSTRAINT IF EXISTS event_categories_event_id_fkey;
+ALTER TABLE event_categories DROP CONSTRAINT IF EXISTS fk_event_categories_event;
+ALTER TABLE event_categories ADD CONSTRAINT fk_event_categories_event
+  FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE;
+
+-- chat_rooms / chat_messages
+ALTER TABLE chat_rooms DROP CONSTRAINT IF EXISTS chat_rooms_event_id_fkey;
+ALTER TABLE chat_rooms DROP CONSTRAINT IF EXISTS fk_chat_rooms_event;
+ALTER TABLE chat_rooms ADD CONSTRAINT fk_chat_rooms_event
+  FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE;
+
+ALTER TABLE chat_messages DROP CONSTRAINT IF EXISTS chat_messages_room_id_fkey;
+ALTER TABLE chat_messages DROP CONSTRAINT IF EXISTS fk_chat_messages_room;
+ALTER TABLE chat_messages ADD CONSTRAINT fk_chat_messages_room
+  FOREIGN KEY (room_id) REFERENCES chat_rooms(id) ON DELETE CASCADE;
+
+-- user_event_history
+ALTER TABLE user_event_history DROP CONSTRAINT IF EXISTS user_event_history_event_id_fkey;
+ALTER TABLE user_event_history DROP CONSTRAINT IF EXISTS fk_user_event_history_event;
+ALTER TABLE user_event_history ADD CONSTRAINT fk_user_event_history_event
+  FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_audit_logs_entity_table_created_at;
+DROP INDEX IF EXISTS idx_audit_logs_after_data;
+DROP INDEX IF EXISTS idx_audit_logs_before_data;
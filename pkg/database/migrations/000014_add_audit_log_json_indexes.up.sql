@@ -0,0 +1,4 @@
+-- Support JSON content filters on audit logs without full table scans
+CREATE INDEX IF NOT EXISTS idx_audit_logs_before_data ON audit_logs USING GIN (before_data jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_after_data ON audit_logs USING GIN (after_data jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_entity_table_created_at ON audit_logs(entity_table, created_at DESC);
@@ -0,0 +1,2 @@
+-- Drop storage_deletions table
+DROP TABLE IF EXISTS storage_deletions;
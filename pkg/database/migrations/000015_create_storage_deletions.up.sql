@@ -0,0 +1,13 @@
+-- Create storage_deletions table (retry queue for storage objects that failed to delete)
+CREATE TABLE storage_deletions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    object_url TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_storage_deletions_pending ON storage_deletions(next_attempt_at) WHERE status = 'pending';
@@ -0,0 +1,3 @@
+ALTER TABLE users DROP COLUMN IF EXISTS otp_channel;
+ALTER TABLE users DROP COLUMN IF EXISTS phone_verified;
+ALTER TABLE users DROP COLUMN IF EXISTS phone;
@@ -0,0 +1,4 @@
+-- Add phone number and OTP delivery preference to users
+ALTER TABLE users ADD COLUMN phone TEXT;
+ALTER TABLE users ADD COLUMN phone_verified BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE users ADD COLUMN otp_channel TEXT CHECK (otp_channel IN ('email', 'sms'));
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS ux_users_phone;
+DROP TABLE IF EXISTS phone_verifications;
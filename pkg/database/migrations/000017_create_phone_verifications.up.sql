@@ -0,0 +1,15 @@
+-- Create phone_verifications table (pending phone number changes awaiting OTP confirmation)
+CREATE TABLE phone_verifications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    phone TEXT NOT NULL,
+    otp TEXT NOT NULL, -- hash of the code
+    expires_at TIMESTAMPTZ NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_phone_verifications_user_id ON phone_verifications(user_id);
+
+-- A phone number can belong to only one active user
+CREATE UNIQUE INDEX ux_users_phone ON users(phone) WHERE phone IS NOT NULL AND deleted_at IS NULL;
@@ -0,0 +1,11 @@
+-- Create event_no_shows table (attendees reported as absent from completed events)
+CREATE TABLE event_no_shows (
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    reported_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reporter_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (event_id, reported_user_id, reporter_id),
+    CHECK (reported_user_id <> reporter_id)
+);
+
+CREATE INDEX idx_event_no_shows_reported_user_id ON event_no_shows(reported_user_id);
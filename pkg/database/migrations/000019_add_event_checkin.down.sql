@@ -0,0 +1,2 @@
+ALTER TABLE event_members DROP COLUMN IF EXISTS checked_in_at;
+ALTER TABLE events DROP COLUMN IF EXISTS checkin_code;
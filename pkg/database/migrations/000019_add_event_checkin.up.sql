@@ -0,0 +1,3 @@
+-- Add attendance check-in support
+ALTER TABLE events ADD COLUMN checkin_code TEXT;
+ALTER TABLE event_members ADD COLUMN checked_in_at TIMESTAMPTZ;
@@ -0,0 +1,3 @@
+ALTER TABLE events DROP COLUMN IF EXISTS members_visible;
+ALTER TABLE events DROP COLUMN IF EXISTS pending_chat_access;
+ALTER TABLE events DROP COLUMN IF EXISTS allow_member_invites;
@@ -0,0 +1,4 @@
+-- Add creator-controlled event settings
+ALTER TABLE events ADD COLUMN allow_member_invites BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE events ADD COLUMN pending_chat_access BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE events ADD COLUMN members_visible BOOLEAN NOT NULL DEFAULT TRUE;
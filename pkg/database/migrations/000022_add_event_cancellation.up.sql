@@ -0,0 +1,3 @@
+-- Record why and when an event was cancelled
+ALTER TABLE events ADD COLUMN cancellation_reason TEXT;
+ALTER TABLE events ADD COLUMN cancelled_at TIMESTAMPTZ;
@@ -0,0 +1,11 @@
+-- Create user_top_picks table (each user's best matching events, recomputed nightly)
+CREATE TABLE user_top_picks (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    rank INTEGER NOT NULL,
+    match_score DOUBLE PRECISION NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, event_id)
+);
+
+CREATE INDEX idx_user_top_picks_user_id_rank ON user_top_picks(user_id, rank);
@@ -0,0 +1,12 @@
+-- Create event_items table (things members bring to an event, each claimable by one member)
+CREATE TABLE event_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    added_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    claimed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    claimed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_event_items_event_id ON event_items(event_id);
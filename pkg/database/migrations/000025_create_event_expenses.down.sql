@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS event_expense_shares;
+DROP TABLE IF EXISTS event_expenses;
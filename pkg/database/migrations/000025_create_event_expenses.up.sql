@@ -0,0 +1,20 @@
+-- Create event_expenses table (costs a member paid on behalf of the group, in minor currency units)
+CREATE TABLE event_expenses (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    payer_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    description TEXT NOT NULL,
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_event_expenses_event_id ON event_expenses(event_id);
+
+-- Create event_expense_shares table (each member's portion of an expense)
+CREATE TABLE event_expense_shares (
+    expense_id UUID NOT NULL REFERENCES event_expenses(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    amount BIGINT NOT NULL,
+    PRIMARY KEY (expense_id, user_id)
+);
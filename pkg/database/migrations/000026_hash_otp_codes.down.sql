@@ -0,0 +1,3 @@
+DELETE FROM email_verifications;
+DELETE FROM password_resets;
+ALTER TABLE email_verifications ALTER COLUMN otp TYPE VARCHAR(6);
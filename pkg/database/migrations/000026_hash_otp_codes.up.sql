@@ -0,0 +1,6 @@
+-- OTPs are now stored as an HMAC of the code, which doesn't fit the old 6 character column.
+-- Outstanding plaintext codes can't be verified against hashes, so they are dropped;
+-- they expire within minutes anyway.
+DELETE FROM email_verifications;
+DELETE FROM password_resets;
+ALTER TABLE email_verifications ALTER COLUMN otp TYPE TEXT;
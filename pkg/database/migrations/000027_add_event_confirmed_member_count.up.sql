@@ -0,0 +1,7 @@
+-- Keep a running count of confirmed members so capacity checks and list pages don't COUNT(*) every time
+ALTER TABLE events ADD COLUMN confirmed_member_count INT NOT NULL DEFAULT 0;
+
+UPDATE events SET confirmed_member_count = (
+    SELECT COUNT(*) FROM event_members
+    WHERE event_members.event_id = events.id AND event_members.status = 'confirmed'
+);
@@ -0,0 +1 @@
+ALTER TABLE event_members DROP COLUMN IF EXISTS confirmation_email_at;
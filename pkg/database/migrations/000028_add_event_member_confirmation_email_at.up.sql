@@ -0,0 +1,2 @@
+-- Remember when a member's confirmation email was last sent, to rate limit resends
+ALTER TABLE event_members ADD COLUMN confirmation_email_at TIMESTAMPTZ;
@@ -0,0 +1,2 @@
+-- Events below their minimum group size are cancelled shortly before they start
+ALTER TABLE events ADD COLUMN min_attendees INT CHECK (min_attendees IS NULL OR min_attendees >= 1);
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS ux_users_handle;
+ALTER TABLE users DROP COLUMN IF EXISTS handle;
+
+-- Restoring the unique index fails while duplicate shared display names exist
+DROP INDEX IF EXISTS ix_users_display_name;
+DROP INDEX IF EXISTS ux_users_display_name;
+CREATE UNIQUE INDEX ux_users_display_name
+ON users(display_name)
+WHERE display_name IS NOT NULL AND deleted_at IS NULL;
+ALTER TABLE users DROP COLUMN IF EXISTS display_name_shared;
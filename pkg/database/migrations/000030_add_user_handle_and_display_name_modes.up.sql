@@ -0,0 +1,19 @@
+-- Names stored in non-unique mode (DISPLAY_NAME_MODE) are marked shared and left out of the
+-- unique index, so the other modes keep database-enforced uniqueness without a migration per mode.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS display_name_shared BOOLEAN NOT NULL DEFAULT FALSE;
+
+DROP INDEX IF EXISTS ux_users_display_name;
+CREATE UNIQUE INDEX ux_users_display_name
+ON users(display_name)
+WHERE display_name IS NOT NULL AND deleted_at IS NULL AND NOT display_name_shared;
+
+-- Lookups by display name cover shared names too
+CREATE INDEX IF NOT EXISTS ix_users_display_name
+ON users(display_name)
+WHERE deleted_at IS NULL;
+
+-- Generated handle that tells users with the same display name apart
+ALTER TABLE users ADD COLUMN IF NOT EXISTS handle TEXT;
+CREATE UNIQUE INDEX IF NOT EXISTS ux_users_handle
+ON users(handle)
+WHERE handle IS NOT NULL AND deleted_at IS NULL;
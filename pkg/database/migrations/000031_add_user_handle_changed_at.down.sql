@@ -0,0 +1,2 @@
+-- Backfilled handles are kept; they are valid handles either way
+ALTER TABLE users DROP COLUMN IF EXISTS handle_changed_at;
@@ -0,0 +1,13 @@
+-- When the user last chose their handle, for the change cooldown. NULL while they still have
+-- the handle generated for them.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS handle_changed_at TIMESTAMPTZ;
+
+-- Give every existing user a handle: their display name as lowercase letters and digits
+-- (or "user"), then the start of their ID so handles can't collide
+UPDATE users
+SET handle = COALESCE(
+        NULLIF(trim(both '_' from left(trim(both '_' from
+            regexp_replace(lower(COALESCE(display_name, '')), '[^a-z0-9]+', '_', 'g')), 20)), ''),
+        'user'
+    ) || '_' || left(replace(id::text, '-', ''), 8)
+WHERE handle IS NULL;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS chat_message_mentions;
@@ -0,0 +1,11 @@
+-- Create chat_message_mentions table (event members @mentioned in chat messages)
+CREATE TABLE chat_message_mentions (
+    message_id UUID NOT NULL REFERENCES chat_messages(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    start_offset INT NOT NULL CHECK (start_offset >= 0),
+    length INT NOT NULL CHECK (length > 1),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (message_id, user_id)
+);
+
+CREATE INDEX idx_chat_message_mentions_user_id ON chat_message_mentions(user_id);
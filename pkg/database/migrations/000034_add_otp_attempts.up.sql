@@ -0,0 +1,3 @@
+-- Count codes tried against each issued OTP so it can be locked after too many wrong guesses
+ALTER TABLE email_verifications ADD COLUMN IF NOT EXISTS attempts INT NOT NULL DEFAULT 0;
+ALTER TABLE password_resets ADD COLUMN IF NOT EXISTS attempts INT NOT NULL DEFAULT 0;
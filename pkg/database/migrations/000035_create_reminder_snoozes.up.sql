@@ -0,0 +1,13 @@
+-- Create reminder_snoozes table (queue of snoozed event reminders, sent again when due)
+CREATE TABLE reminder_snoozes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    notification_id UUID NOT NULL,
+    due_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- A reminder can only be snoozed once; its follow-up can be snoozed again
+CREATE UNIQUE INDEX ux_reminder_snoozes_notification_id ON reminder_snoozes(notification_id);
+CREATE INDEX idx_reminder_snoozes_due_at ON reminder_snoozes(due_at);
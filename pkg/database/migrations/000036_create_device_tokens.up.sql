@@ -0,0 +1,13 @@
+-- Create device_tokens table (FCM registration tokens for push notifications)
+CREATE TABLE device_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token TEXT NOT NULL,
+    platform TEXT NOT NULL CHECK (platform IN ('ios', 'android', 'web')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- A token identifies one app install, which belongs to one user at a time
+CREATE UNIQUE INDEX ux_device_tokens_token ON device_tokens(token);
+CREATE INDEX idx_device_tokens_user_id ON device_tokens(user_id);
@@ -0,0 +1,15 @@
+-- Create user_event_interests table (events a user liked, separate from joining them)
+CREATE TABLE user_event_interests (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, event_id)
+);
+
+CREATE INDEX idx_user_event_interests_event_id ON user_event_interests(event_id);
+
+-- Existing likes become interests. Pending members created by earlier likes are kept, since
+-- they can't be told apart from users who joined.
+INSERT INTO user_event_interests (user_id, event_id, created_at)
+SELECT user_id, event_id, created_at FROM event_swipes WHERE direction = 'like'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,9 @@
+-- Create event_impressions table (events served to users in their suggestion feed)
+CREATE TABLE event_impressions (
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    first_served_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_served_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    served_count INT NOT NULL DEFAULT 1,
+    PRIMARY KEY (event_id, user_id)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS guest_event_views;
+DROP TABLE IF EXISTS guest_sessions;
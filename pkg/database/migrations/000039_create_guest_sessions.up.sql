@@ -0,0 +1,19 @@
+-- Create guest_sessions table (clients browsing public endpoints without an account)
+CREATE TABLE guest_sessions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    converted_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    converted_at TIMESTAMPTZ
+);
+
+-- Create guest_event_views table (public events a guest has seen)
+CREATE TABLE guest_event_views (
+    guest_id UUID NOT NULL REFERENCES guest_sessions(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    first_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    seen_count INT NOT NULL DEFAULT 1,
+    PRIMARY KEY (guest_id, event_id)
+);
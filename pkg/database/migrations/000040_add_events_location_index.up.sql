@@ -0,0 +1,3 @@
+-- Index located events for the nearby events bounding box prefilter
+CREATE INDEX IF NOT EXISTS idx_events_location ON events(lat, lng)
+    WHERE deleted_at IS NULL AND lat IS NOT NULL AND lng IS NOT NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE email_verifications ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS idx_email_verifications_deleted_at ON email_verifications(deleted_at);
+
+DROP INDEX IF EXISTS ux_email_verifications_email;
+CREATE INDEX IF NOT EXISTS idx_email_verifications_email ON email_verifications(email);
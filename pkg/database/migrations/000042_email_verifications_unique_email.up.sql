@@ -0,0 +1,13 @@
+-- Keep only the newest verification per email so the email can be unique
+DELETE FROM email_verifications a
+USING email_verifications b
+WHERE a.email = b.email
+  AND (a.created_at < b.created_at OR (a.created_at = b.created_at AND a.id < b.id));
+
+-- One pending verification per email; sending a new OTP replaces it in place
+DROP INDEX IF EXISTS idx_email_verifications_email;
+CREATE UNIQUE INDEX ux_email_verifications_email ON email_verifications(email);
+
+-- Verifications are deleted outright once used or expired, so deleted_at was never set
+DROP INDEX IF EXISTS idx_email_verifications_deleted_at;
+ALTER TABLE email_verifications DROP COLUMN IF EXISTS deleted_at;
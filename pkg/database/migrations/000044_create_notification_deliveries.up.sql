@@ -0,0 +1,12 @@
+-- Create notification_deliveries table (how each notification was delivered, or why it wasn't, per
+-- channel; push has one row per device)
+CREATE TABLE notification_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    notification_id UUID NOT NULL REFERENCES notifications(id) ON DELETE CASCADE,
+    channel TEXT NOT NULL CHECK (channel IN ('push', 'email')),
+    status TEXT NOT NULL CHECK (status IN ('sent', 'failed', 'skipped')),
+    detail TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_notification_deliveries_notification_id ON notification_deliveries(notification_id);
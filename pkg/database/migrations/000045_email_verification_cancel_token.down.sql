@@ -0,0 +1 @@
+ALTER TABLE email_verifications DROP COLUMN IF EXISTS cancel_token_hash;
@@ -0,0 +1,2 @@
+-- Only whoever registered can cancel the registration, with the token /auth/register returned
+ALTER TABLE email_verifications ADD COLUMN IF NOT EXISTS cancel_token_hash TEXT;
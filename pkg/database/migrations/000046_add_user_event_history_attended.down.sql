@@ -0,0 +1,3 @@
+UPDATE user_event_history SET completed = attended, completed_at = CASE WHEN attended THEN completed_at END;
+
+ALTER TABLE user_event_history DROP COLUMN IF EXISTS attended;
@@ -0,0 +1,9 @@
+-- Attendance is recorded separately from completion; completed again covers every confirmed member
+ALTER TABLE user_event_history ADD COLUMN IF NOT EXISTS attended BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE user_event_history SET attended = completed;
+
+UPDATE user_event_history h
+SET completed = TRUE, completed_at = COALESCE(h.completed_at, h.created_at)
+FROM events e
+WHERE e.id = h.event_id AND e.status = 'completed' AND h.completed = FALSE;
@@ -9,7 +9,6 @@ import (
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
 )
 
 var DB *gorm.DB
@@ -22,7 +21,7 @@ func ConnectPostgres() error {
 
 	var err error
 	DB, err = gorm.Open(postgres.Open(dsn), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+		Logger: NewQueryLogger(slowQueryThreshold()),
 		NowFunc: func() time.Time {
 			return time.Now().UTC()
 		},
@@ -87,6 +86,20 @@ func ClosePostgres() error {
 	return nil
 }
 
+// MigrationVersion returns the schema version recorded by golang-migrate, and whether the
+// migration to it failed partway (dirty)
+func MigrationVersion() (uint, bool, error) {
+	var row struct {
+		Version uint
+		Dirty   bool
+	}
+	err := DB.Raw("SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&row).Error
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read migration version: %w", err)
+	}
+	return row.Version, row.Dirty, nil
+}
+
 func GetDB() *gorm.DB {
 	return DB
 }
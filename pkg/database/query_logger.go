@@ -0,0 +1,111 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// defaultSlowQueryThreshold is used when DB_SLOW_QUERY_THRESHOLD_MS is not configured
+const defaultSlowQueryThreshold = 200 * time.Millisecond
+
+var slowQueriesTotal = promauto.NewCounter(
+	prometheus.CounterOpts{
+		Name: "db_slow_queries_total",
+		Help: "Total number of database queries slower than the slow query threshold",
+	},
+)
+
+// QueryLogger is a GORM logger that writes structured entries through utils.Logger().
+// Queries slower than the threshold are logged as warnings with their SQL and duration
+// and counted in db_slow_queries_total; other queries are only logged at debug level.
+type QueryLogger struct {
+	slowThreshold time.Duration
+	level         logger.LogLevel
+}
+
+// NewQueryLogger creates a query logger that reports queries slower than slowThreshold
+func NewQueryLogger(slowThreshold time.Duration) *QueryLogger {
+	return &QueryLogger{slowThreshold: slowThreshold, level: logger.Info}
+}
+
+// slowQueryThreshold returns the configured slow query threshold
+func slowQueryThreshold() time.Duration {
+	if config.AppConfig != nil && config.AppConfig.Database.SlowQueryThresholdMs > 0 {
+		return time.Duration(config.AppConfig.Database.SlowQueryThresholdMs) * time.Millisecond
+	}
+	return defaultSlowQueryThreshold
+}
+
+// LogMode returns a copy of the logger at the given level
+func (l *QueryLogger) LogMode(level logger.LogLevel) logger.Interface {
+	copied := *l
+	copied.level = level
+	return &copied
+}
+
+// Info logs a GORM info message
+func (l *QueryLogger) Info(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= logger.Info {
+		utils.Logger().Infof(msg, args...)
+	}
+}
+
+// Warn logs a GORM warning
+func (l *QueryLogger) Warn(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= logger.Warn {
+		utils.Logger().Warnf(msg, args...)
+	}
+}
+
+// Error logs a GORM error
+func (l *QueryLogger) Error(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= logger.Error {
+		utils.Logger().Errorf(msg, args...)
+	}
+}
+
+// Trace logs a finished query. Failed queries are logged as errors, except for a missing
+// record which callers handle themselves.
+func (l *QueryLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	if l.level <= logger.Silent {
+		return
+	}
+
+	elapsed := time.Since(begin)
+	slow := l.slowThreshold > 0 && elapsed > l.slowThreshold
+	if slow {
+		slowQueriesTotal.Inc()
+	}
+
+	switch {
+	case err != nil && !errors.Is(err, gorm.ErrRecordNotFound) && l.level >= logger.Error:
+		sql, rows := fc()
+		utils.Logger().WithFields(queryFields(sql, rows, elapsed)).WithField("error", err.Error()).Error("Query failed")
+	case slow && l.level >= logger.Warn:
+		sql, rows := fc()
+		fields := queryFields(sql, rows, elapsed)
+		fields["threshold_ms"] = l.slowThreshold.Milliseconds()
+		utils.Logger().WithFields(fields).Warn("Slow query")
+	case l.level >= logger.Info:
+		sql, rows := fc()
+		utils.Logger().WithFields(queryFields(sql, rows, elapsed)).Debug("Query")
+	}
+}
+
+// queryFields are the log fields describing a query
+func queryFields(sql string, rows int64, elapsed time.Duration) map[string]interface{} {
+	return map[string]interface{}{
+		"sql":         sql,
+		"rows":        rows,
+		"duration_ms": float64(elapsed.Microseconds()) / 1000,
+	}
+}
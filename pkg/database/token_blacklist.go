@@ -0,0 +1,44 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrRedisNotConnected is returned by Redis-backed helpers when Redis was never connected
+var ErrRedisNotConnected = errors.New("redis not connected")
+
+// tokenBlacklistKey returns the Redis key that marks the token with the given ID as revoked
+func tokenBlacklistKey(tokenID string) string {
+	return "token_blacklist:" + tokenID
+}
+
+// BlacklistToken marks the token with the given ID (its jti) as revoked for ttl, which should be
+// the token's remaining lifetime; once it would have expired anyway the key is dropped
+func BlacklistToken(ctx context.Context, tokenID string, ttl time.Duration) error {
+	if RedisClient == nil {
+		return ErrRedisNotConnected
+	}
+	if ttl <= 0 {
+		return nil
+	}
+	return SetCache(ctx, tokenBlacklistKey(tokenID), "1", ttl)
+}
+
+// IsTokenBlacklisted reports whether the token with the given ID has been revoked
+func IsTokenBlacklisted(ctx context.Context, tokenID string) (bool, error) {
+	if RedisClient == nil {
+		return false, ErrRedisNotConnected
+	}
+	_, err := GetCache(ctx, tokenBlacklistKey(tokenID))
+	if err == redis.Nil {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
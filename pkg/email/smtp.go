@@ -4,14 +4,17 @@ import (
 	"TinderTrip-Backend/pkg/config"
 	"crypto/tls"
 	"fmt"
+	"html"
 	"net"
 	"net/smtp"
+	"strconv"
 	"strings"
 )
 
 // SMTPClient represents an SMTP email client
 type SMTPClient struct {
-	config *config.EmailConfig
+	config    *config.EmailConfig
+	transport Transport
 }
 
 // EmailMessage represents an email message
@@ -22,15 +25,29 @@ type EmailMessage struct {
 	HTML    string
 }
 
+// Transport delivers an email in place of the SMTP server
+type Transport func(message *EmailMessage) error
+
 // NewSMTPClient creates a new SMTP client
 func NewSMTPClient() *SMTPClient {
+	return NewSMTPClientWithTransport(nil)
+}
+
+// NewSMTPClientWithTransport creates a client that delivers email through transport instead of
+// SMTP, so flows that send mail can run without an SMTP server. A nil transport sends over SMTP.
+func NewSMTPClientWithTransport(transport Transport) *SMTPClient {
 	return &SMTPClient{
-		config: &config.AppConfig.Email,
+		config:    &config.AppConfig.Email,
+		transport: transport,
 	}
 }
 
 // SendEmail sends an email using SMTP
 func (c *SMTPClient) SendEmail(message *EmailMessage) error {
+	if c.transport != nil {
+		return c.transport(message)
+	}
+
 	// Create authentication
 	auth := smtp.PlainAuth("", c.config.SMTPUsername, c.config.SMTPPassword, c.config.SMTPHost)
 
@@ -38,7 +55,7 @@ func (c *SMTPClient) SendEmail(message *EmailMessage) error {
 	msg := c.createMessage(message)
 
 	// Connect to the server
-	addr := fmt.Sprintf("%s:%d", c.config.SMTPHost, c.config.SMTPPort)
+	addr := net.JoinHostPort(c.config.SMTPHost, strconv.Itoa(c.config.SMTPPort))
 
 	// Connect to SMTP server (plain connection first)
 	conn, err := net.Dial("tcp", addr)
@@ -543,6 +560,137 @@ func (c *SMTPClient) SendWelcomeEmail(to, name string) error {
 	return c.SendEmail(message)
 }
 
+// SendAccountDeletedEmail confirms to a user that their account was deleted and tells them how
+// to get it back
+func (c *SMTPClient) SendAccountDeletedEmail(to, name string) error {
+	subject := "Your TinderTrip account has been deleted"
+
+	htmlBody := fmt.Sprintf(`
+		<!DOCTYPE html>
+		<html lang="en">
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>Account Deleted</title>
+			<style>
+				* { margin: 0; padding: 0; box-sizing: border-box; }
+				body { 
+					font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif;
+					line-height: 1.6; 
+					color: #333333;
+					background-color: #f5f7fa;
+					padding: 20px;
+				}
+				.email-wrapper {
+					max-width: 600px;
+					margin: 0 auto;
+					background-color: #ffffff;
+					border-radius: 12px;
+					overflow: hidden;
+					box-shadow: 0 4px 6px rgba(0, 0, 0, 0.1);
+				}
+				.header {
+					background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%);
+					color: white;
+					padding: 40px 30px;
+					text-align: center;
+				}
+				.header h1 {
+					font-size: 32px;
+					font-weight: 700;
+					margin-bottom: 10px;
+					letter-spacing: -0.5px;
+				}
+				.content {
+					padding: 40px 30px;
+					background-color: #ffffff;
+				}
+				.content h2 {
+					font-size: 24px;
+					font-weight: 600;
+					color: #1a202c;
+					margin-bottom: 20px;
+					text-align: center;
+				}
+				.content p {
+					font-size: 16px;
+					color: #4a5568;
+					margin-bottom: 15px;
+					line-height: 1.7;
+				}
+				.restore {
+					background: linear-gradient(135deg, #fff5e6 0%%, #ffe0b2 100%%);
+					border-left: 4px solid #ff9800;
+					color: #e65100;
+					padding: 20px;
+					border-radius: 8px;
+					margin: 25px 0;
+				}
+				.restore strong {
+					display: block;
+					margin-bottom: 8px;
+					font-size: 16px;
+				}
+				.restore p {
+					margin: 0;
+					font-size: 14px;
+				}
+				.footer {
+					text-align: center;
+					padding: 30px;
+					background-color: #f7fafc;
+					border-top: 1px solid #e2e8f0;
+				}
+				.footer p {
+					font-size: 13px;
+					color: #718096;
+					margin: 5px 0;
+				}
+				@media only screen and (max-width: 600px) {
+					.header { padding: 30px 20px; }
+					.header h1 { font-size: 26px; }
+					.content { padding: 30px 20px; }
+				}
+			</style>
+		</head>
+		<body>
+			<div class="email-wrapper">
+				<div class="header">
+					<h1>TinderTrip</h1>
+					<p style="margin: 0; opacity: 0.9;">Account Deleted</p>
+				</div>
+				<div class="content">
+					<h2>Your account has been deleted</h2>
+					<p>Hello %s,</p>
+					<p>We're confirming that your TinderTrip account has been deleted. You have been signed out and your profile no longer appears to other travelers.</p>
+
+					<div class="restore">
+						<strong>Changed your mind?</strong>
+						<p>Your data is kept for now, so the account can still be restored. Reply to this email from this address and we'll restore it for you.</p>
+					</div>
+
+					<p style="margin-top: 25px; color: #718096; font-size: 14px;">
+						<strong>Didn't do this?</strong> If you didn't delete your account, reply to this email right away so we can restore it and secure it.
+					</p>
+				</div>
+				<div class="footer">
+					<p><strong>TinderTrip</strong></p>
+					<p>&copy; 2024 TinderTrip. All rights reserved.</p>
+				</div>
+			</div>
+		</body>
+		</html>
+	`, html.EscapeString(name))
+
+	message := &EmailMessage{
+		To:      []string{to},
+		Subject: subject,
+		HTML:    htmlBody,
+	}
+
+	return c.SendEmail(message)
+}
+
 // SendEventConfirmationEmail sends an event confirmation email
 func (c *SMTPClient) SendEventConfirmationEmail(to, name, eventTitle, eventDate string) error {
 	subject := fmt.Sprintf("Event Confirmation: %s - TinderTrip", eventTitle)
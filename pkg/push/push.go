@@ -0,0 +1,174 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"TinderTrip-Backend/pkg/config"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"golang.org/x/oauth2/jwt"
+)
+
+// fcmScope is the OAuth scope needed to send messages through the FCM HTTP v1 API
+const fcmScope = "https://www.googleapis.com/auth/firebase.messaging"
+
+// ErrInvalidToken is returned when the device token is no longer registered with FCM
+// and should be removed
+var ErrInvalidToken = errors.New("device token is invalid or expired")
+
+// Message is a push notification for a single device
+type Message struct {
+	Title string
+	Body  string
+	Data  map[string]string
+}
+
+// PushSender sends push notifications to device tokens
+type PushSender interface {
+	SendPush(token string, message *Message) error
+}
+
+// The sender built from config is shared, so every service reuses its cached access token
+var (
+	defaultMu     sync.Mutex
+	defaultSender *FCMSender
+	defaultConfig config.FirebaseConfig
+)
+
+// NewPushSender returns the FCM sender for the Firebase settings.
+// It returns nil when Firebase is not configured.
+func NewPushSender() PushSender {
+	if config.AppConfig == nil {
+		return nil
+	}
+
+	cfg := config.AppConfig.Firebase
+	if cfg.ProjectID == "" || cfg.ClientEmail == "" || cfg.PrivateKey == "" {
+		return nil
+	}
+
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	if defaultSender == nil || defaultConfig != cfg {
+		defaultSender = NewFCMSender(cfg)
+		defaultConfig = cfg
+	}
+	return defaultSender
+}
+
+// FCMSender sends push notifications through the Firebase Cloud Messaging HTTP v1 API
+type FCMSender struct {
+	projectID string
+	baseURL   string
+	client    *http.Client
+}
+
+// NewFCMSender creates an FCM sender that authenticates as the configured service account
+func NewFCMSender(cfg config.FirebaseConfig) *FCMSender {
+	jwtConfig := &jwt.Config{
+		Email: cfg.ClientEmail,
+		// Keys pasted into an env file usually have their newlines escaped
+		PrivateKey: []byte(strings.ReplaceAll(cfg.PrivateKey, `\n`, "\n")),
+		Scopes:     []string{fcmScope},
+		TokenURL:   google.JWTTokenURL,
+	}
+
+	client := oauth2.NewClient(context.Background(), jwtConfig.TokenSource(context.Background()))
+	client.Timeout = 10 * time.Second
+
+	return &FCMSender{
+		projectID: cfg.ProjectID,
+		baseURL:   "https://fcm.googleapis.com",
+		client:    client,
+	}
+}
+
+// fcmRequest is the body of an FCM v1 messages:send request
+type fcmRequest struct {
+	Message fcmMessage `json:"message"`
+}
+
+type fcmMessage struct {
+	Token        string            `json:"token"`
+	Notification fcmNotification   `json:"notification"`
+	Data         map[string]string `json:"data,omitempty"`
+}
+
+type fcmNotification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+// fcmErrorResponse is the error body returned by the FCM v1 API
+type fcmErrorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+		Details []struct {
+			ErrorCode string `json:"errorCode"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+// SendPush sends the message to one device
+func (s *FCMSender) SendPush(token string, message *Message) error {
+	payload, err := json.Marshal(fcmRequest{
+		Message: fcmMessage{
+			Token:        token,
+			Notification: fcmNotification{Title: message.Title, Body: message.Body},
+			Data:         message.Data,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode push message: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s/v1/projects/%s/messages:send", s.baseURL, url.PathEscape(s.projectID))
+	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send push notification: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 2000))
+	var fcmErr fcmErrorResponse
+	if json.Unmarshal(respBody, &fcmErr) == nil && isInvalidTokenError(fcmErr) {
+		return ErrInvalidToken
+	}
+	return fmt.Errorf("fcm responded with status %d: %s", resp.StatusCode, string(respBody))
+}
+
+// isInvalidTokenError reports whether FCM rejected the message because of its token rather
+// than the payload or the server's credentials
+func isInvalidTokenError(resp fcmErrorResponse) bool {
+	for _, detail := range resp.Error.Details {
+		switch detail.ErrorCode {
+		case "UNREGISTERED", "SENDER_ID_MISMATCH":
+			return true
+		case "INVALID_ARGUMENT":
+			// Also used for bad payloads, so only when it names the token
+			return strings.Contains(strings.ToLower(resp.Error.Message), "registration token")
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package sms
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"TinderTrip-Backend/pkg/config"
+)
+
+// SMSSender sends text messages to phone numbers in E.164 format
+type SMSSender interface {
+	SendSMS(to, body string) error
+}
+
+// NewSMSSender creates the sender configured by SMS_PROVIDER.
+// It returns nil when SMS delivery is disabled.
+func NewSMSSender() SMSSender {
+	if config.AppConfig == nil {
+		return nil
+	}
+
+	cfg := config.AppConfig.SMS
+	switch cfg.Provider {
+	case "twilio":
+		return NewTwilioSender(cfg)
+	case "log":
+		return &LogSender{}
+	default:
+		return nil
+	}
+}
+
+// TwilioSender sends SMS through the Twilio Messages API
+type TwilioSender struct {
+	accountSID string
+	authToken  string
+	fromNumber string
+	baseURL    string
+	client     *http.Client
+}
+
+// NewTwilioSender creates a Twilio sender
+func NewTwilioSender(cfg config.SMSConfig) *TwilioSender {
+	return &TwilioSender{
+		accountSID: cfg.TwilioAccountSID,
+		authToken:  cfg.TwilioAuthToken,
+		fromNumber: cfg.FromNumber,
+		baseURL:    "https://api.twilio.com",
+		client:     &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// SendSMS sends a message via Twilio
+func (s *TwilioSender) SendSMS(to, body string) error {
+	if s.accountSID == "" || s.authToken == "" || s.fromNumber == "" {
+		return fmt.Errorf("twilio is not configured")
+	}
+
+	endpoint := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json", s.baseURL, url.PathEscape(s.accountSID))
+	form := url.Values{}
+	form.Set("To", to)
+	form.Set("From", s.fromNumber)
+	form.Set("Body", body)
+
+	req, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.SetBasicAuth(s.accountSID, s.authToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send SMS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 500))
+		return fmt.Errorf("twilio responded with status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
+
+// LogSender writes messages to the log instead of sending them (development only)
+type LogSender struct{}
+
+// SendSMS logs the message
+func (s *LogSender) SendSMS(to, body string) error {
+	log.Printf("SMS to %s: %s", to, body)
+	return nil
+}
@@ -14,8 +14,8 @@ import (
 	"TinderTrip-Backend/internal/api/middleware"
 	"TinderTrip-Backend/internal/dto"
 	"TinderTrip-Backend/internal/models"
-	"TinderTrip-Backend/pkg/config"
-	"TinderTrip-Backend/pkg/database"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/tests/testdb"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -23,20 +23,9 @@ import (
 	"gorm.io/gorm"
 )
 
-// setupTestDB connects to the real PostgreSQL database for testing
-func setupTestDB(t *testing.T) *gorm.DB {
-	// Connect to existing database
-	err := database.ConnectPostgres()
-	if err != nil {
-		t.Skipf("Skipping integration test: Failed to connect to database: %v", err)
-	}
-
-	db := database.GetDB()
-	if db == nil {
-		t.Skip("Skipping integration test: Database connection is nil")
-	}
-
-	return db
+// TestMain starts a disposable PostgreSQL database for the package
+func TestMain(m *testing.M) {
+	os.Exit(testdb.Main(m))
 }
 
 // setupTestRouter creates a test router with all routes
@@ -46,7 +35,7 @@ func setupTestRouter(db *gorm.DB) *gin.Engine {
 	router.Use(middleware.RequestID())
 
 	// Initialize handlers
-	authHandler := handlers.NewAuthHandler()
+	authHandler := handlers.NewAuthHandlerWithEmailClient(testdb.MailClient())
 
 	// Auth routes
 	authGroup := router.Group("/api/v1/auth")
@@ -73,49 +62,29 @@ func setupTestRouter(db *gorm.DB) *gin.Engine {
 	return router
 }
 
-// loadTestConfig loads test configuration
-func loadTestConfig(t *testing.T) {
-	// Check if required environment variables are set
-	// If not available (e.g., in CI without .env), skip the test
-	requiredEnvVars := []string{
-		"DB_HOST", "DB_PORT", "DB_USER", "DB_PASSWORD", "DB_NAME",
-		"JWT_SECRET", "SERVER_PORT", "SERVER_HOST",
-	}
-
-	missingVars := []string{}
-	for _, envVar := range requiredEnvVars {
-		if os.Getenv(envVar) == "" {
-			missingVars = append(missingVars, envVar)
-		}
-	}
-
-	if len(missingVars) > 0 {
-		t.Skipf("Skipping integration test: Missing required environment variables: %v", missingVars)
-	}
-
-	// Load configuration
-	config.LoadConfig()
+// captureOTPs records the OTPs issued during the test, keyed by email.
+// OTPs are only stored hashed, so they can't be read back from the database.
+func captureOTPs(t *testing.T) map[string]string {
+	issued := make(map[string]string)
+	service.SetOTPObserver(func(recipient, otp string) {
+		issued[recipient] = otp
+	})
+	t.Cleanup(func() { service.SetOTPObserver(nil) })
+	return issued
 }
 
 // TestCompleteAuthFlow tests the complete authentication flow
 func TestCompleteAuthFlow(t *testing.T) {
 	// Setup
-	loadTestConfig(t)
-	db := setupTestDB(t)
+	db := testdb.Setup(t)
 	router := setupTestRouter(db)
+	issued := captureOTPs(t)
 
 	testEmail := fmt.Sprintf("test-%d@example.com", time.Now().UnixNano())
 	testPassword := "SecurePass123!"
 	testDisplayName := "Test User"
 	var capturedOTP string
 
-	// Cleanup test data after test completes
-	defer func() {
-		// Delete test user and related data
-		db.Where("email = ?", testEmail).Delete(&models.User{})
-		db.Where("email = ?", testEmail).Delete(&models.EmailVerification{})
-	}()
-
 	t.Run("Step 1: Register with email", func(t *testing.T) {
 		// Prepare request
 		registerReq := dto.RegisterRequest{
@@ -141,12 +110,23 @@ func TestCompleteAuthFlow(t *testing.T) {
 		assert.True(t, response["success"].(bool))
 		assert.Contains(t, response["message"].(string), "Verification OTP sent")
 
-		// Capture OTP from database for testing
+		// Capture the issued OTP; the database only holds its hash
 		var verification models.EmailVerification
 		err = db.Where("email = ?", testEmail).First(&verification).Error
 		require.NoError(t, err, "Should find verification record")
-		capturedOTP = verification.OTP
+		capturedOTP = issued[testEmail]
+		require.Len(t, capturedOTP, 6)
+		assert.NotEqual(t, capturedOTP, verification.OTP, "OTP should not be stored in plaintext")
 		t.Logf("Captured OTP: %s", capturedOTP)
+
+		// The verification email goes through the harness instead of SMTP
+		var sent bool
+		for _, message := range testdb.SentMail() {
+			if len(message.To) > 0 && message.To[0] == testEmail {
+				sent = true
+			}
+		}
+		assert.True(t, sent, "Verification email should be sent")
 	})
 
 	t.Run("Step 2: Verify OTP with invalid code (should fail)", func(t *testing.T) {
@@ -361,20 +341,13 @@ func TestCompleteAuthFlow(t *testing.T) {
 // TestResendVerificationFlow tests the resend verification OTP flow
 func TestResendVerificationFlow(t *testing.T) {
 	// Setup
-	loadTestConfig(t)
-	db := setupTestDB(t)
+	db := testdb.Setup(t)
 	router := setupTestRouter(db)
+	issued := captureOTPs(t)
 
 	testEmail := fmt.Sprintf("resend-%d@example.com", time.Now().UnixNano())
 	var firstOTP, secondOTP string
 
-	// Cleanup test data after test completes
-	defer func() {
-		// Delete test user and related data
-		db.Where("email = ?", testEmail).Delete(&models.User{})
-		db.Where("email = ?", testEmail).Delete(&models.EmailVerification{})
-	}()
-
 	t.Run("Step 1: Initial registration", func(t *testing.T) {
 		registerReq := dto.RegisterRequest{
 			Email:       testEmail,
@@ -394,7 +367,7 @@ func TestResendVerificationFlow(t *testing.T) {
 		var verification models.EmailVerification
 		err := db.Where("email = ?", testEmail).First(&verification).Error
 		require.NoError(t, err)
-		firstOTP = verification.OTP
+		firstOTP = issued[testEmail]
 		t.Logf("First OTP: %s", firstOTP)
 	})
 
@@ -423,7 +396,7 @@ func TestResendVerificationFlow(t *testing.T) {
 		var verification models.EmailVerification
 		err = db.Where("email = ?", testEmail).First(&verification).Error
 		require.NoError(t, err)
-		secondOTP = verification.OTP
+		secondOTP = issued[testEmail]
 		t.Logf("Second OTP: %s", secondOTP)
 
 		// OTPs might be the same due to random generation, but the record should be updated
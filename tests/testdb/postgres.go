@@ -0,0 +1,293 @@
+// Package testdb gives integration tests a disposable PostgreSQL database.
+//
+// Main starts one PostgreSQL container per test package (or uses the server in
+// TEST_DATABASE_URL, e.g. a CI service container) and applies the migrations in
+// pkg/database/migrations to a template database. Setup then clones a fresh
+// database from the template for each test, so tests never see each other's
+// data and nothing is left behind.
+package testdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/database"
+	"TinderTrip-Backend/pkg/email"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/testcontainers/testcontainers-go"
+	tcpostgres "github.com/testcontainers/testcontainers-go/modules/postgres"
+	"gorm.io/gorm"
+)
+
+const (
+	postgresImage    = "postgres:16-alpine"
+	templateDatabase = "tindertrip_template"
+	startupTimeout   = 2 * time.Minute
+)
+
+// duplicateObjectCodes are the PostgreSQL errors raised when a migration creates
+// something an earlier migration already created
+var duplicateObjectCodes = map[string]bool{
+	"42P07": true, // duplicate_table
+	"42710": true, // duplicate_object
+	"42701": true, // duplicate_column
+	"42P06": true, // duplicate_schema
+	"42723": true, // duplicate_function
+	"23505": true, // unique_violation: seed rows already inserted
+}
+
+var (
+	serverURL  *url.URL // connection URL of the server's maintenance database
+	admin      *sql.DB  // connection used to create and drop test databases
+	skipReason string   // set when no database could be started
+
+	mailMu sync.Mutex
+	mail   []email.EmailMessage
+)
+
+// Main starts the database for a test package, runs its tests and tears the database down.
+// Call it from the package's TestMain:
+//
+//	func TestMain(m *testing.M) { os.Exit(testdb.Main(m)) }
+//
+// When neither Docker nor TEST_DATABASE_URL is available, tests that call Setup are skipped.
+func Main(m *testing.M) int {
+	ctx, cancel := context.WithTimeout(context.Background(), startupTimeout)
+	defer cancel()
+
+	stop, err := startServer(ctx)
+	if err != nil {
+		skipReason = err.Error()
+		log.Printf("testdb: database tests will be skipped: %v", err)
+		return m.Run()
+	}
+	defer stop()
+
+	if err := prepareTemplate(ctx); err != nil {
+		log.Printf("testdb: failed to prepare the test database: %v", err)
+		return 1
+	}
+	defer admin.Close()
+
+	return m.Run()
+}
+
+// Setup gives the test its own freshly migrated database and points database.DB
+// and config.AppConfig at it. The database is dropped when the test ends.
+func Setup(t *testing.T) *gorm.DB {
+	t.Helper()
+	if skipReason != "" {
+		t.Skipf("Skipping integration test: %s", skipReason)
+	}
+	if admin == nil {
+		t.Fatal("testdb: Main must be called from TestMain before Setup")
+	}
+
+	name := "test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	if _, err := admin.Exec(fmt.Sprintf(`CREATE DATABASE %q TEMPLATE %q`, name, templateDatabase)); err != nil {
+		t.Fatalf("testdb: failed to create database: %v", err)
+	}
+
+	configure(name)
+	if err := database.ConnectPostgres(); err != nil {
+		t.Fatalf("testdb: failed to connect: %v", err)
+	}
+	db := database.GetDB()
+
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+		if _, err := admin.Exec(fmt.Sprintf(`DROP DATABASE IF EXISTS %q WITH (FORCE)`, name)); err != nil {
+			t.Logf("testdb: failed to drop database %s: %v", name, err)
+		}
+	})
+
+	return db
+}
+
+// MailClient returns an email client that records outgoing email for SentMail instead of
+// talking to an SMTP server
+func MailClient() *email.SMTPClient {
+	return email.NewSMTPClientWithTransport(func(message *email.EmailMessage) error {
+		mailMu.Lock()
+		defer mailMu.Unlock()
+		mail = append(mail, *message)
+		return nil
+	})
+}
+
+// SentMail returns the emails sent so far through MailClient in this test package
+func SentMail() []email.EmailMessage {
+	mailMu.Lock()
+	defer mailMu.Unlock()
+	return append([]email.EmailMessage(nil), mail...)
+}
+
+// startServer starts a PostgreSQL container, or uses TEST_DATABASE_URL when it is set.
+// The returned function stops the container.
+func startServer(ctx context.Context) (stop func(), err error) {
+	if raw := os.Getenv("TEST_DATABASE_URL"); raw != "" {
+		serverURL, err = url.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid TEST_DATABASE_URL: %w", err)
+		}
+		return func() {}, nil
+	}
+
+	// testcontainers panics on some hosts without a Docker socket
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("docker is not available: %v", r)
+		}
+	}()
+
+	container, err := tcpostgres.Run(ctx, postgresImage,
+		tcpostgres.WithDatabase("postgres"),
+		tcpostgres.WithUsername("tindertrip"),
+		tcpostgres.WithPassword("tindertrip"),
+		tcpostgres.BasicWaitStrategies(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start postgres container: %w", err)
+	}
+	stop = func() {
+		if err := testcontainers.TerminateContainer(container); err != nil {
+			log.Printf("testdb: failed to stop postgres container: %v", err)
+		}
+	}
+
+	raw, err := container.ConnectionString(ctx, "sslmode=disable")
+	if err != nil {
+		stop()
+		return nil, fmt.Errorf("failed to get connection string: %w", err)
+	}
+	if serverURL, err = url.Parse(raw); err != nil {
+		stop()
+		return nil, fmt.Errorf("invalid connection string: %w", err)
+	}
+	return stop, nil
+}
+
+// prepareTemplate creates the template database and applies every migration to it
+func prepareTemplate(ctx context.Context) error {
+	var err error
+	admin, err = sql.Open("pgx", serverURL.String())
+	if err != nil {
+		return fmt.Errorf("failed to open admin connection: %w", err)
+	}
+	if err := admin.PingContext(ctx); err != nil {
+		return fmt.Errorf("failed to reach database server: %w", err)
+	}
+
+	if _, err := admin.ExecContext(ctx, fmt.Sprintf(`DROP DATABASE IF EXISTS %q WITH (FORCE)`, templateDatabase)); err != nil {
+		return fmt.Errorf("failed to drop old template: %w", err)
+	}
+	if _, err := admin.ExecContext(ctx, fmt.Sprintf(`CREATE DATABASE %q`, templateDatabase)); err != nil {
+		return fmt.Errorf("failed to create template: %w", err)
+	}
+
+	template, err := sql.Open("pgx", databaseURL(templateDatabase).String())
+	if err != nil {
+		return fmt.Errorf("failed to open template: %w", err)
+	}
+	// The template must have no open connections when it is cloned
+	defer template.Close()
+
+	return applyMigrations(ctx, template, migrationsDir())
+}
+
+// applyMigrations runs every *.up.sql file in name order, each in its own transaction.
+// Some version numbers are shared by an early per-table migration and the consolidated
+// schema; a file that only recreates existing objects is rolled back and skipped.
+func applyMigrations(ctx context.Context, db *sql.DB, dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.up.sql"))
+	if err != nil {
+		return fmt.Errorf("failed to list migrations: %w", err)
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no migrations found in %s", dir)
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		script, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", filepath.Base(file), err)
+		}
+
+		err = runInTransaction(ctx, db, string(script))
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && duplicateObjectCodes[pgErr.Code] {
+			log.Printf("testdb: skipping %s: %s", filepath.Base(file), pgErr.Message)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("migration %s failed: %w", filepath.Base(file), err)
+		}
+	}
+	return nil
+}
+
+// runInTransaction executes a multi-statement script atomically
+func runInTransaction(ctx context.Context, db *sql.DB, script string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// configure points the app config at the named database.
+// The config is built here rather than with config.LoadConfig, which exits when
+// the usual environment variables are missing.
+func configure(name string) {
+	password, _ := serverURL.User.Password()
+	config.AppConfig = &config.Config{
+		Database: config.DatabaseConfig{
+			Host:     serverURL.Hostname(),
+			Port:     serverURL.Port(),
+			User:     serverURL.User.Username(),
+			Password: password,
+			Name:     name,
+			SSLMode:  "disable",
+		},
+		JWT: config.JWTConfig{
+			Secret:      "integration-test-secret",
+			ExpireHours: 24,
+		},
+	}
+}
+
+// databaseURL returns the server URL pointing at the named database
+func databaseURL(name string) *url.URL {
+	u := *serverURL
+	u.Path = "/" + name
+	return &u
+}
+
+// migrationsDir locates pkg/database/migrations relative to this file
+func migrationsDir() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "pkg", "database", "migrations")
+}
@@ -0,0 +1,138 @@
+package testdb
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/database"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/migrator"
+	"gorm.io/gorm/schema"
+)
+
+// sqliteUUID generates a random version 4 UUID in SQLite, standing in for gen_random_uuid()
+const sqliteUUID = "lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || " +
+	"substr('89ab', 1 + (abs(random()) % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))"
+
+// sqliteTypes maps the Postgres column types used in model tags to SQLite types.
+// Types not listed here and not understood by SQLite (enums, ranges) are stored as text.
+var sqliteTypes = map[string]string{
+	"uuid":             "text",
+	"citext":           "text COLLATE NOCASE",
+	"jsonb":            "text",
+	"json":             "text",
+	"inet":             "text",
+	"timestamptz":      "datetime",
+	"timestamp":        "datetime",
+	"date":             "date",
+	"text":             "text",
+	"boolean":          "boolean",
+	"int":              "integer",
+	"integer":          "integer",
+	"bigint":           "integer",
+	"smallint":         "integer",
+	"double precision": "real",
+	"real":             "real",
+	"numeric":          "numeric",
+}
+
+// sqliteDefaults maps Postgres column defaults used in model tags to SQLite expressions
+var sqliteDefaults = map[string]string{
+	"now()":             "CURRENT_TIMESTAMP",
+	"gen_random_uuid()": sqliteUUID,
+}
+
+// SQLiteDSN returns the DSN of a fresh SQLite database file owned by the test.
+//
+// A file database lets the connection pool hold several connections, so concurrency tests
+// really run concurrently. Writers wait for each other instead of failing: transactions take
+// the write lock when they begin and a busy connection retries for up to five seconds.
+func SQLiteDSN(t *testing.T) string {
+	t.Helper()
+	return "file:" + filepath.Join(t.TempDir(), "test.db") + "?_busy_timeout=5000&_journal_mode=WAL&_txlock=immediate"
+}
+
+// SQLite opens an isolated SQLite database, creates the tables for models
+// with AutoMigrate, and points database.DB at it.
+//
+// The schema comes from the model definitions themselves, so a model change shows up
+// in unit tests instead of drifting away from hand-written CREATE TABLE statements.
+// Postgres-only column types and defaults in the gorm tags are translated to SQLite
+// equivalents by a thin wrapper around the SQLite dialect.
+func SQLite(t *testing.T, models ...interface{}) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqliteDialector{sqlite.Open(SQLiteDSN(t)).(*sqlite.Dialector)}, &gorm.Config{
+		DisableForeignKeyConstraintWhenMigrating: true,
+	})
+	if err != nil {
+		t.Fatalf("testdb: failed to open sqlite: %v", err)
+	}
+	if err := db.AutoMigrate(models...); err != nil {
+		t.Fatalf("testdb: failed to migrate models: %v", err)
+	}
+
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+	// Runs first, so notifications the test sent in the background finish before the database
+	// is closed and the next test replaces it
+	t.Cleanup(service.WaitForBackgroundSends)
+
+	database.DB = db
+	if config.AppConfig == nil {
+		config.AppConfig = &config.Config{}
+	}
+	return db
+}
+
+// sqliteDialector is the SQLite dialect with Postgres column types and defaults translated
+type sqliteDialector struct {
+	*sqlite.Dialector
+}
+
+// DataTypeOf returns the SQLite column type for a field
+func (d sqliteDialector) DataTypeOf(field *schema.Field) string {
+	dataType := strings.ToLower(d.Dialector.DataTypeOf(field))
+	if mapped, ok := sqliteTypes[dataType]; ok {
+		return mapped
+	}
+	if strings.HasPrefix(dataType, "varchar") || strings.HasPrefix(dataType, "integer") ||
+		dataType == "datetime" || dataType == "blob" {
+		return dataType
+	}
+	return "text"
+}
+
+// Migrator returns a SQLite migrator that builds columns with this dialect
+func (d sqliteDialector) Migrator(db *gorm.DB) gorm.Migrator {
+	return sqliteMigrator{sqlite.Migrator{Migrator: migrator.Migrator{Config: migrator.Config{
+		DB:                          db,
+		Dialector:                   d,
+		CreateIndexAfterCreateTable: true,
+	}}}}
+}
+
+// sqliteMigrator translates Postgres column defaults when building column definitions
+type sqliteMigrator struct {
+	sqlite.Migrator
+}
+
+// FullDataTypeOf returns the full column definition for a field
+func (m sqliteMigrator) FullDataTypeOf(field *schema.Field) clause.Expr {
+	if expr, ok := sqliteDefaults[strings.ToLower(field.DefaultValue)]; ok {
+		translated := *field
+		// SQLite only accepts expression defaults inside parentheses
+		translated.DefaultValue = "(" + expr + ")"
+		field = &translated
+	}
+	return m.Migrator.FullDataTypeOf(field)
+}
@@ -0,0 +1,75 @@
+package api_test
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api"
+	"TinderTrip-Backend/pkg/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHTTPServer_AppliesListenConfig(t *testing.T) {
+	server := api.NewHTTPServer(config.ServerConfig{
+		ListenHost:               "127.0.0.1",
+		Port:                     "9952",
+		ReadTimeoutSeconds:       20,
+		ReadHeaderTimeoutSeconds: 3,
+		WriteTimeoutSeconds:      25,
+		IdleTimeoutSeconds:       90,
+		HTTP2:                    true,
+	}, http.NotFoundHandler())
+
+	assert.Equal(t, "127.0.0.1:9952", server.Addr)
+	assert.Equal(t, 20*time.Second, server.ReadTimeout)
+	assert.Equal(t, 3*time.Second, server.ReadHeaderTimeout)
+	assert.Equal(t, 25*time.Second, server.WriteTimeout)
+	assert.Equal(t, 90*time.Second, server.IdleTimeout)
+	require.NotNil(t, server.Protocols)
+	assert.True(t, server.Protocols.HTTP1())
+	assert.True(t, server.Protocols.HTTP2())
+}
+
+func TestNewHTTPServer_HTTP2Disabled(t *testing.T) {
+	server := api.NewHTTPServer(config.ServerConfig{ListenHost: "0.0.0.0", Port: "8080"}, http.NotFoundHandler())
+
+	assert.Equal(t, "0.0.0.0:8080", server.Addr)
+	assert.True(t, server.Protocols.HTTP1())
+	assert.False(t, server.Protocols.HTTP2())
+}
+
+func TestNewHTTPServer_HeaderTimeoutDropsSlowClients(t *testing.T) {
+	server := api.NewHTTPServer(config.ServerConfig{
+		ListenHost:               "127.0.0.1",
+		Port:                     "0",
+		ReadTimeoutSeconds:       10,
+		ReadHeaderTimeoutSeconds: 1,
+		WriteTimeoutSeconds:      10,
+		IdleTimeoutSeconds:       10,
+	}, http.NotFoundHandler())
+
+	listener, err := net.Listen("tcp", server.Addr)
+	require.NoError(t, err)
+	go server.Serve(listener)
+	t.Cleanup(func() { server.Close() })
+
+	// A slowloris client sends part of the headers and then stalls
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("GET / HTTP/1.1\r\nHost: example.com\r\n"))
+	require.NoError(t, err)
+
+	started := time.Now()
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	_, err = io.ReadAll(conn)
+
+	// The server hangs up after the header timeout instead of waiting on the client
+	require.NoError(t, err, "connection should be closed by the server, not by the read deadline")
+	assert.Less(t, time.Since(started), 4*time.Second)
+}
@@ -0,0 +1,115 @@
+package database_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// slowQuery counts to two million with a recursive CTE, which takes well over a millisecond
+const slowQuery = `WITH RECURSIVE c(x) AS (SELECT 1 UNION ALL SELECT x + 1 FROM c WHERE x < 2000000) SELECT count(*) FROM c`
+
+// captureLogs collects the JSON log entries written through utils.Logger() during the test
+func captureLogs(t *testing.T) func() []map[string]interface{} {
+	t.Helper()
+	var buf bytes.Buffer
+	utils.Logger().SetOutput(&buf)
+	t.Cleanup(func() { utils.Logger().SetOutput(os.Stdout) })
+
+	return func() []map[string]interface{} {
+		var entries []map[string]interface{}
+		for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+			if line == "" {
+				continue
+			}
+			var entry map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(line), &entry))
+			entries = append(entries, entry)
+		}
+		return entries
+	}
+}
+
+// slowQueryCount reads db_slow_queries_total from the default registry
+func slowQueryCount(t *testing.T) float64 {
+	t.Helper()
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() == "db_slow_queries_total" {
+			return family.GetMetric()[0].GetCounter().GetValue()
+		}
+	}
+	t.Fatal("db_slow_queries_total is not registered")
+	return 0
+}
+
+func openLoggedDB(t *testing.T, threshold time.Duration) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: database.NewQueryLogger(threshold)})
+	require.NoError(t, err)
+	return db
+}
+
+func TestQueryLogger_LogsSlowQueries(t *testing.T) {
+	logs := captureLogs(t)
+	db := openLoggedDB(t, time.Millisecond)
+	before := slowQueryCount(t)
+
+	var count int64
+	require.NoError(t, db.Raw(slowQuery).Scan(&count).Error)
+	assert.Equal(t, int64(2000000), count)
+
+	var slow []map[string]interface{}
+	for _, entry := range logs() {
+		if entry["msg"] == "Slow query" {
+			slow = append(slow, entry)
+		}
+	}
+	require.Len(t, slow, 1)
+	assert.Equal(t, "warning", slow[0]["level"])
+	assert.Equal(t, slowQuery, slow[0]["sql"])
+	assert.Equal(t, float64(1), slow[0]["threshold_ms"])
+	assert.Greater(t, slow[0]["duration_ms"].(float64), float64(1))
+	assert.Equal(t, before+1, slowQueryCount(t))
+}
+
+func TestQueryLogger_FastQueriesAreNotReported(t *testing.T) {
+	logs := captureLogs(t)
+	db := openLoggedDB(t, time.Minute)
+	before := slowQueryCount(t)
+
+	var one int
+	require.NoError(t, db.Raw("SELECT 1").Scan(&one).Error)
+
+	// Only logged at debug level, below the default info level
+	assert.Empty(t, logs())
+	assert.Equal(t, before, slowQueryCount(t))
+}
+
+func TestQueryLogger_LogsFailedQueries(t *testing.T) {
+	logs := captureLogs(t)
+	db := openLoggedDB(t, time.Minute)
+
+	err := db.Exec("SELECT * FROM missing_table").Error
+	require.Error(t, err)
+
+	entries := logs()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "Query failed", entries[0]["msg"])
+	assert.Equal(t, "error", entries[0]["level"])
+	assert.Equal(t, "SELECT * FROM missing_table", entries[0]["sql"])
+	assert.Contains(t, entries[0]["error"], "missing_table")
+}
@@ -0,0 +1,133 @@
+package dto_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"TinderTrip-Backend/internal/dto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleEventResponse() dto.EventResponse {
+	cover := "https://example.com/cover.jpg"
+	return dto.EventResponse{
+		ID:            "event-1",
+		CreatorID:     "user-1",
+		Title:         "Dinner",
+		EventType:     "meal",
+		Status:        "published",
+		CoverImageURL: &cover,
+		Creator:       &dto.UserResponse{ID: "user-1", DisplayName: "creator"},
+		Photos:        []dto.EventPhotoResponse{{ID: "photo-1", URL: cover}},
+		Members:       []dto.EventMemberResponse{{UserID: "user-1", Role: "creator", Status: "confirmed"}},
+		MemberCount:   1,
+		IsJoined:      true,
+	}
+}
+
+func TestParseEventFields(t *testing.T) {
+	t.Run("Empty value selects everything", func(t *testing.T) {
+		fields, err := dto.ParseEventFields("")
+		assert.NoError(t, err)
+		assert.Nil(t, fields)
+	})
+
+	t.Run("Groups expand to their keys", func(t *testing.T) {
+		fields, err := dto.ParseEventFields("members, budget")
+		require.NoError(t, err)
+		assert.True(t, fields["id"])
+		assert.True(t, fields["members"])
+		assert.True(t, fields["member_count"])
+		assert.True(t, fields["currency"])
+		assert.False(t, fields["title"])
+	})
+
+	t.Run("Settings and spots left are details", func(t *testing.T) {
+		fields, err := dto.ParseEventFields("details")
+		require.NoError(t, err)
+		assert.True(t, fields["settings"])
+		assert.True(t, fields["spots_left"])
+
+		fields, err = dto.ParseEventFields("settings")
+		require.NoError(t, err)
+		assert.True(t, fields["settings"])
+	})
+
+	t.Run("Every response key can be selected", func(t *testing.T) {
+		responseType := reflect.TypeOf(dto.EventResponse{})
+		for i := 0; i < responseType.NumField(); i++ {
+			key := strings.Split(responseType.Field(i).Tag.Get("json"), ",")[0]
+			_, err := dto.ParseEventFields(key)
+			assert.NoError(t, err, "field %s", key)
+		}
+	})
+
+	t.Run("Every group key is a response key", func(t *testing.T) {
+		for group, keys := range dto.EventFieldGroups {
+			for _, key := range keys {
+				_, err := dto.ParseEventFields(key)
+				assert.NoError(t, err, "group %s field %s", group, key)
+			}
+		}
+	})
+
+	t.Run("Unknown field is rejected", func(t *testing.T) {
+		_, err := dto.ParseEventFields("id,secret")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown field: secret")
+	})
+}
+
+func TestSelectEventFields(t *testing.T) {
+	t.Run("Individual keys", func(t *testing.T) {
+		fields, err := dto.ParseEventFields("id,title,cover_image_url")
+		require.NoError(t, err)
+
+		selected, ok := dto.SelectEventFields(sampleEventResponse(), fields).(map[string]interface{})
+		require.True(t, ok)
+		assert.Len(t, selected, 3)
+		assert.Equal(t, "Dinner", selected["title"])
+		assert.Equal(t, "https://example.com/cover.jpg", selected["cover_image_url"])
+		assert.NotContains(t, selected, "members")
+		assert.NotContains(t, selected, "creator")
+	})
+
+	t.Run("Viewer group", func(t *testing.T) {
+		fields, err := dto.ParseEventFields("viewer")
+		require.NoError(t, err)
+
+		selected, ok := dto.SelectEventFields(sampleEventResponse(), fields).(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, true, selected["is_joined"])
+		assert.Equal(t, "event-1", selected["id"])
+		assert.NotContains(t, selected, "title")
+	})
+
+	t.Run("Items group", func(t *testing.T) {
+		event := sampleEventResponse()
+		event.Items = []dto.EventItemResponse{{Name: "Tent"}}
+
+		fields, err := dto.ParseEventFields("items")
+		require.NoError(t, err)
+		selected, ok := dto.SelectEventFields(event, fields).(map[string]interface{})
+		require.True(t, ok)
+		assert.Len(t, selected["items"], 1)
+
+		fields, err = dto.ParseEventFields("title")
+		require.NoError(t, err)
+		selected, ok = dto.SelectEventFields(event, fields).(map[string]interface{})
+		require.True(t, ok)
+		assert.NotContains(t, selected, "items")
+	})
+
+	t.Run("No selection returns full response", func(t *testing.T) {
+		event := sampleEventResponse()
+		assert.Equal(t, event, dto.SelectEventFields(event, nil))
+
+		list := dto.SelectEventListFields([]dto.EventResponse{event}, nil)
+		assert.Equal(t, []dto.EventResponse{event}, list)
+	})
+}
@@ -0,0 +1,88 @@
+package dto_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// assertComplete checks that every field of a struct, including omitempty ones,
+// appears in its encoded form, descending into nested structs but not slices
+func assertComplete(t *testing.T, path string, typ reflect.Type, encoded map[string]interface{}) {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		value, ok := encoded[name]
+		if !assert.True(t, ok, "%s.%s is missing", path, name) {
+			continue
+		}
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if nested, isObject := value.(map[string]interface{}); isObject && fieldType.Kind() == reflect.Struct {
+			assertComplete(t, path+"."+name, fieldType, nested)
+		}
+	}
+}
+
+func TestExamples(t *testing.T) {
+	examples := dto.Examples()
+	require.NotEmpty(t, examples)
+	for _, name := range []string{"EventResponse", "AuthResponseWrapper", "UserResponse", "ErrorAPIResponse"} {
+		assert.Contains(t, examples, name)
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			typ := reflect.TypeOf(example)
+			assert.Equal(t, name, typ.Name(), "examples are keyed by their type name")
+
+			encoded, err := json.Marshal(example)
+			require.NoError(t, err)
+
+			// Decoding strictly into the DTO proves the example has exactly its shape
+			decoded := reflect.New(typ).Interface()
+			decoder := json.NewDecoder(bytes.NewReader(encoded))
+			decoder.DisallowUnknownFields()
+			require.NoError(t, decoder.Decode(decoded))
+			reencoded, err := json.Marshal(decoded)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(encoded), string(reencoded))
+
+			var object map[string]interface{}
+			require.NoError(t, json.Unmarshal(encoded, &object))
+			assertComplete(t, name, typ, object)
+		})
+	}
+}
+
+func TestExamples_UseValidEnumValues(t *testing.T) {
+	event := dto.Examples()["EventResponse"].(dto.EventResponse)
+
+	assert.True(t, models.IsValidEventType(event.EventType))
+	assert.Contains(t, []models.EventStatus{
+		models.EventStatusPublished, models.EventStatusCancelled, models.EventStatusCompleted,
+	}, models.EventStatus(event.Status))
+	for _, tag := range append(event.Categories, event.Tags...) {
+		assert.Contains(t, models.TagKinds, models.TagKind(tag.Kind))
+	}
+	for _, member := range event.Members {
+		assert.Contains(t, []models.MemberRole{models.MemberRoleCreator, models.MemberRoleParticipant}, models.MemberRole(member.Role))
+	}
+}
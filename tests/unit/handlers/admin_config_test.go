@@ -0,0 +1,85 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/api/middleware"
+	"TinderTrip-Backend/pkg/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveAdminConfig requests the config as the given user
+func serveAdminConfig(userID string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/admin/config", func(c *gin.Context) {
+		c.Set("user_id", userID)
+		c.Next()
+	}, middleware.AdminMiddleware(), handlers.NewAdminHandler().GetConfig)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/config", nil))
+	return w
+}
+
+func TestAdminGetConfig_RedactsSecrets(t *testing.T) {
+	secrets := []string{"db-password", "redis-password", "jwt-secret", "smtp-password", "aws-key-id",
+		"aws-secret", "firebase-key", "nextcloud-password", "google-secret", "twilio-token", "captcha-secret", "otp-pepper"}
+	config.AppConfig = &config.Config{
+		Server:    config.ServerConfig{Port: "8080", Mode: "release"},
+		Database:  config.DatabaseConfig{Host: "db.internal", Password: "db-password"},
+		Redis:     config.RedisConfig{Password: "redis-password"},
+		JWT:       config.JWTConfig{Secret: "jwt-secret", ExpireHours: 24},
+		Email:     config.EmailConfig{SMTPHost: "smtp.example.com", SMTPPassword: "smtp-password"},
+		AWS:       config.AWSConfig{AccessKeyID: "aws-key-id", SecretAccessKey: "aws-secret"},
+		Firebase:  config.FirebaseConfig{PrivateKey: "firebase-key"},
+		Nextcloud: config.NextcloudConfig{Password: "nextcloud-password"},
+		Google:    config.GoogleConfig{ClientID: "google-client", ClientSecret: "google-secret"},
+		SMS:       config.SMSConfig{TwilioAuthToken: "twilio-token"},
+		Captcha:   config.CaptchaConfig{Provider: "hcaptcha", Secret: "captcha-secret"},
+		OTP:       config.OTPConfig{Pepper: "otp-pepper"},
+		Admin:     config.AdminConfig{UserIDs: []string{"admin-1"}},
+	}
+
+	w := serveAdminConfig("admin-1")
+	require.Equal(t, http.StatusOK, w.Code)
+	for _, secret := range secrets {
+		assert.NotContains(t, w.Body.String(), secret)
+	}
+
+	var body struct {
+		Data config.Config `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "[redacted]", body.Data.JWT.Secret)
+	assert.Equal(t, "[redacted]", body.Data.Database.Password)
+	assert.Equal(t, "[redacted]", body.Data.Email.SMTPPassword)
+	assert.Equal(t, "[redacted]", body.Data.Google.ClientSecret)
+
+	// Everything else is shown as loaded
+	assert.Equal(t, "db.internal", body.Data.Database.Host)
+	assert.Equal(t, "google-client", body.Data.Google.ClientID)
+	assert.Equal(t, 24, body.Data.JWT.ExpireHours)
+	assert.Equal(t, "hcaptcha", body.Data.Captcha.Provider)
+
+	// The loaded config itself is untouched
+	assert.Equal(t, "jwt-secret", config.AppConfig.JWT.Secret)
+}
+
+func TestAdminGetConfig_AdminOnly(t *testing.T) {
+	config.AppConfig = &config.Config{
+		JWT:   config.JWTConfig{Secret: "jwt-secret"},
+		Admin: config.AdminConfig{UserIDs: []string{"admin-1"}},
+	}
+
+	w := serveAdminConfig("someone-else")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.NotContains(t, w.Body.String(), "jwt-secret")
+}
@@ -0,0 +1,58 @@
+package handlers_test
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"TinderTrip-Backend/internal/api/handlers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupCreateEventRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	eventHandler := handlers.NewEventHandler()
+	router.POST("/events", func(c *gin.Context) {
+		c.Set("user_id", uuid.New().String())
+		c.Next()
+	}, eventHandler.CreateEvent)
+	return router
+}
+
+func TestCreateEvent_UnknownEventTypeJSON(t *testing.T) {
+	router := setupCreateEventRouter()
+
+	req := httptest.NewRequest("POST", "/events", strings.NewReader(`{"title":"Trip","event_type":"daytrip"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), "invalid event_type")
+}
+
+func TestCreateEvent_UnknownEventTypeMultipart(t *testing.T) {
+	router := setupCreateEventRouter()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	require.NoError(t, writer.WriteField("title", "Trip"))
+	require.NoError(t, writer.WriteField("event_type", "road_trip"))
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest("POST", "/events", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), "invalid event_type")
+}
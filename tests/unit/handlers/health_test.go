@@ -0,0 +1,40 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"TinderTrip-Backend/internal/api/handlers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func serveReady(router *gin.Engine) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ready", nil))
+	return w
+}
+
+func TestReady_GatedUntilStartupCompletes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	health := handlers.NewHealthHandler()
+	router.GET("/ready", health.Ready)
+
+	// Still starting up
+	w := serveReady(router)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Contains(t, w.Body.String(), "SERVICE_UNAVAILABLE")
+
+	health.MarkReady()
+	w = serveReady(router)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"status":"ready"`)
+
+	// Shutting down
+	health.MarkNotReady()
+	w = serveReady(router)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
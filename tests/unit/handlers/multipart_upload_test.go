@@ -0,0 +1,168 @@
+package handlers_test
+
+import (
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"runtime"
+	"testing"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/pkg/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setMultipartMemory configures the in-memory upload threshold for one test
+func setMultipartMemory(t *testing.T, memoryMB int) {
+	t.Helper()
+	previous := config.AppConfig
+	config.AppConfig = &config.Config{Storage: config.StorageConfig{MultipartMemoryMB: memoryMB}}
+	t.Cleanup(func() { config.AppConfig = previous })
+}
+
+// streamUpload streams a multipart body with the given fields and a file of size bytes, so the
+// test itself never holds the whole file in memory
+func streamUpload(t *testing.T, fields map[string]string, fileField string, size int) (io.Reader, string) {
+	t.Helper()
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+	go func() {
+		for name, value := range fields {
+			if err := writer.WriteField(name, value); err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+		part, err := writer.CreateFormFile(fileField, "photo.jpg")
+		if err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		chunk := make([]byte, 32<<10)
+		for written := 0; written < size; written += len(chunk) {
+			if size-written < len(chunk) {
+				chunk = chunk[:size-written]
+			}
+			if _, err := part.Write(chunk); err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+		pw.CloseWithError(writer.Close())
+	}()
+	return pr, writer.FormDataContentType()
+}
+
+// uploadRouter routes method and path to the handler and hands back the parsed form once the
+// handler has returned
+func uploadRouter(method, path string, handler gin.HandlerFunc, form **multipart.Form) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Handle(method, path, func(c *gin.Context) {
+		c.Set("user_id", uuid.New().String())
+		c.Next()
+		*form = c.Request.MultipartForm
+	}, handler)
+	return router
+}
+
+func TestMultipartUpload_LargeFilesSpillToTempFiles(t *testing.T) {
+	setMultipartMemory(t, 1)
+	tmpDir := t.TempDir()
+	t.Setenv("TMPDIR", tmpDir)
+	eventHandler := handlers.NewEventHandler()
+
+	for _, tc := range []struct {
+		name      string
+		method    string
+		route     string
+		path      string
+		handler   gin.HandlerFunc
+		fields    map[string]string
+		fileField string
+		status    int
+	}{
+		{
+			// Parsed before the ownership check, which rejects the malformed event ID
+			name:      "AddPhotos",
+			method:    http.MethodPost,
+			route:     "/events/:id/photos",
+			path:      "/events/not-a-uuid/photos",
+			handler:   eventHandler.AddPhotos,
+			fileField: "files[]",
+			status:    http.StatusBadRequest,
+		},
+		{
+			// Parsed before the event type is validated
+			name:      "CreateEvent",
+			method:    http.MethodPost,
+			route:     "/events",
+			path:      "/events",
+			handler:   eventHandler.CreateEvent,
+			fields:    map[string]string{"title": "Trip", "event_type": "road_trip"},
+			fileField: "file",
+			status:    http.StatusUnprocessableEntity,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var form *multipart.Form
+			router := uploadRouter(tc.method, tc.route, tc.handler, &form)
+
+			const size = 32 << 20
+			body, contentType := streamUpload(t, tc.fields, tc.fileField, size)
+			req := httptest.NewRequest(tc.method, tc.path, body)
+			req.Header.Set("Content-Type", contentType)
+			w := httptest.NewRecorder()
+
+			var before, after runtime.MemStats
+			runtime.GC()
+			runtime.ReadMemStats(&before)
+			router.ServeHTTP(w, req)
+			runtime.ReadMemStats(&after)
+			assert.Equal(t, tc.status, w.Code)
+
+			// Far less than the file was allocated while parsing it
+			assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(size/4))
+
+			// The file was written to disk and removed once the handler returned
+			require.NotNil(t, form)
+			require.Len(t, form.File[tc.fileField], 1)
+			assert.EqualValues(t, size, form.File[tc.fileField][0].Size)
+			_, err := form.File[tc.fileField][0].Open()
+			assert.ErrorIs(t, err, os.ErrNotExist)
+			entries, err := os.ReadDir(tmpDir)
+			require.NoError(t, err)
+			assert.Empty(t, entries)
+		})
+	}
+}
+
+func TestMultipartUpload_SmallFilesStayInMemory(t *testing.T) {
+	setMultipartMemory(t, 1)
+	tmpDir := t.TempDir()
+	t.Setenv("TMPDIR", tmpDir)
+
+	var form *multipart.Form
+	router := uploadRouter(http.MethodPost, "/events/:id/photos", handlers.NewEventHandler().AddPhotos, &form)
+	body, contentType := streamUpload(t, nil, "files[]", 64<<10)
+	req := httptest.NewRequest(http.MethodPost, "/events/not-a-uuid/photos", body)
+	req.Header.Set("Content-Type", contentType)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	// Below the threshold nothing touches the disk
+	require.NotNil(t, form)
+	file, err := form.File["files[]"][0].Open()
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	entries, err := os.ReadDir(tmpDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
@@ -477,6 +477,9 @@ func TestRequireGoogleAuth(t *testing.T) {
 }
 
 func TestAdminMiddleware(t *testing.T) {
+	setupMiddlewareTests()
+	config.AppConfig.Admin.UserIDs = []string{"user-123"}
+
 	tests := []struct {
 		name           string
 		setupCtx       func() *gin.Context
@@ -484,7 +487,7 @@ func TestAdminMiddleware(t *testing.T) {
 		expectAbort    bool
 	}{
 		{
-			name: "Authenticated user",
+			name: "Admin user",
 			setupCtx: func() *gin.Context {
 				c, _ := gin.CreateTestContext(httptest.NewRecorder())
 				c.Set("user_id", "user-123")
@@ -493,6 +496,16 @@ func TestAdminMiddleware(t *testing.T) {
 			expectedStatus: http.StatusOK,
 			expectAbort:    false,
 		},
+		{
+			name: "Non-admin user",
+			setupCtx: func() *gin.Context {
+				c, _ := gin.CreateTestContext(httptest.NewRecorder())
+				c.Set("user_id", "user-456")
+				return c
+			},
+			expectedStatus: http.StatusForbidden,
+			expectAbort:    true,
+		},
 		{
 			name: "Not authenticated",
 			setupCtx: func() *gin.Context {
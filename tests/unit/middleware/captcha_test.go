@@ -0,0 +1,106 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"TinderTrip-Backend/internal/api/middleware"
+	"TinderTrip-Backend/pkg/captcha"
+	"TinderTrip-Backend/pkg/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockVerifier accepts one token and records the tokens it was asked about
+type mockVerifier struct {
+	validToken string
+	err        error
+	checked    []string
+}
+
+func (v *mockVerifier) Verify(token, remoteIP string) error {
+	v.checked = append(v.checked, token)
+	if v.err != nil {
+		return v.err
+	}
+	if token != v.validToken {
+		return captcha.ErrInvalidToken
+	}
+	return nil
+}
+
+func setupCaptchaRouter(verifier captcha.Verifier) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/auth/register", middleware.RequireCaptchaWithVerifier(verifier), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return router
+}
+
+// captchaRequest posts to the protected route with the given CAPTCHA token, if any
+func captchaRequest(router *gin.Engine, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", "/auth/register", nil)
+	if token != "" {
+		req.Header.Set(middleware.CaptchaTokenHeader, token)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestRequireCaptcha_DisabledByDefault(t *testing.T) {
+	config.AppConfig = &config.Config{}
+	assert.Nil(t, captcha.NewVerifier())
+
+	router := setupCaptchaRouter(nil)
+	assert.Equal(t, http.StatusOK, captchaRequest(router, "").Code)
+}
+
+func TestRequireCaptcha_RejectsMissingAndInvalidTokens(t *testing.T) {
+	verifier := &mockVerifier{validToken: "solved"}
+	router := setupCaptchaRouter(verifier)
+
+	w := captchaRequest(router, "")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "CAPTCHA token is required")
+	assert.Empty(t, verifier.checked)
+
+	w = captchaRequest(router, "forged")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Invalid CAPTCHA token")
+
+	assert.Equal(t, http.StatusOK, captchaRequest(router, "solved").Code)
+	assert.Equal(t, []string{"forged", "solved"}, verifier.checked)
+}
+
+func TestRequireCaptcha_ProviderUnavailable(t *testing.T) {
+	router := setupCaptchaRouter(&mockVerifier{err: errors.New("connection refused")})
+
+	// Fails closed rather than letting unverified requests through
+	assert.Equal(t, http.StatusServiceUnavailable, captchaRequest(router, "solved").Code)
+}
+
+func TestSiteVerifier(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "provider-secret", r.PostForm.Get("secret"))
+		if r.PostForm.Get("response") == "solved" {
+			w.Write([]byte(`{"success": true}`))
+			return
+		}
+		w.Write([]byte(`{"success": false, "error-codes": ["invalid-input-response"]}`))
+	}))
+	defer server.Close()
+
+	verifier := captcha.NewSiteVerifier(server.URL, "provider-secret")
+	assert.NoError(t, verifier.Verify("solved", "203.0.113.7"))
+	assert.ErrorIs(t, verifier.Verify("forged", "203.0.113.7"), captcha.ErrInvalidToken)
+
+	// Enabled without a secret, every token is refused
+	assert.Error(t, captcha.NewSiteVerifier(server.URL, "").Verify("solved", ""))
+}
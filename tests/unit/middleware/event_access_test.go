@@ -0,0 +1,184 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api/middleware"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/tests/testdb"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// eventAccessFixture is an event with one caller for each relationship to it
+type eventAccessFixture struct {
+	event     *models.Event
+	creator   uuid.UUID
+	confirmed uuid.UUID
+	pending   uuid.UUID
+	left      uuid.UUID
+	outsider  uuid.UUID
+}
+
+func setupEventAccessFixture(t *testing.T) (*gorm.DB, eventAccessFixture) {
+	db := testdb.SQLite(t, &models.User{}, &models.Event{}, &models.EventMember{})
+
+	newUser := func() uuid.UUID {
+		user := &models.User{Provider: models.AuthProviderPassword}
+		require.NoError(t, db.Create(user).Error)
+		return user.ID
+	}
+	addMember := func(event *models.Event, userID uuid.UUID, role models.MemberRole, status models.MemberStatus) {
+		require.NoError(t, db.Create(&models.EventMember{
+			EventID:  event.ID,
+			UserID:   userID,
+			Role:     role,
+			Status:   status,
+			JoinedAt: time.Now(),
+		}).Error)
+	}
+
+	f := eventAccessFixture{
+		creator:   newUser(),
+		confirmed: newUser(),
+		pending:   newUser(),
+		left:      newUser(),
+		outsider:  newUser(),
+	}
+	f.event = &models.Event{
+		CreatorID: f.creator,
+		Title:     "Weekend Hike",
+		EventType: models.EventTypeMeal,
+		Status:    models.EventStatusPublished,
+	}
+	require.NoError(t, db.Create(f.event).Error)
+	addMember(f.event, f.creator, models.MemberRoleCreator, models.MemberStatusConfirmed)
+	addMember(f.event, f.confirmed, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	addMember(f.event, f.pending, models.MemberRoleParticipant, models.MemberStatusPending)
+	addMember(f.event, f.left, models.MemberRoleParticipant, models.MemberStatusLeft)
+
+	return db, f
+}
+
+// setupEventAccessRouter routes a handler behind RequireEventRole that echoes the loaded event
+func setupEventAccessRouter(userID uuid.UUID, role middleware.EventRole) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", userID.String())
+		c.Next()
+	})
+	router.POST("/events/:id", middleware.RequireEventRole(role), func(c *gin.Context) {
+		access, ok := middleware.GetEventAccess(c)
+		if !ok {
+			c.Status(http.StatusInternalServerError)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"title": access.Event.Title, "creator": access.IsCreator()})
+	})
+	return router
+}
+
+func serveEventAccess(router *gin.Engine, eventID string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/events/"+eventID, nil))
+	return w
+}
+
+func TestRequireEventRole_PermissionLevels(t *testing.T) {
+	_, f := setupEventAccessFixture(t)
+
+	callers := []struct {
+		name string
+		id   uuid.UUID
+	}{
+		{"creator", f.creator},
+		{"confirmed member", f.confirmed},
+		{"pending member", f.pending},
+		{"left member", f.left},
+		{"outsider", f.outsider},
+	}
+
+	tests := []struct {
+		role middleware.EventRole
+		want []int // expected status per caller, in the order above
+	}{
+		{middleware.EventRoleMember, []int{200, 200, 200, 404, 404}},
+		{middleware.EventRoleConfirmedMember, []int{200, 200, 403, 404, 404}},
+		{middleware.EventRoleCreator, []int{200, 403, 403, 404, 404}},
+	}
+
+	for _, tt := range tests {
+		for i, caller := range callers {
+			w := serveEventAccess(setupEventAccessRouter(caller.id, tt.role), f.event.ID.String())
+			assert.Equal(t, tt.want[i], w.Code, "role %d, caller %s", tt.role, caller.name)
+		}
+	}
+}
+
+func TestRequireEventRole_OutsiderSeesMissingEvent(t *testing.T) {
+	_, f := setupEventAccessFixture(t)
+	router := setupEventAccessRouter(f.outsider, middleware.EventRoleCreator)
+
+	foreign := serveEventAccess(router, f.event.ID.String())
+	missing := serveEventAccess(router, uuid.New().String())
+
+	assert.Equal(t, http.StatusNotFound, missing.Code)
+	assert.Equal(t, missing.Code, foreign.Code)
+	assert.Equal(t, responseMessage(t, missing), responseMessage(t, foreign))
+}
+
+func TestRequireEventRole_DeletedEvent(t *testing.T) {
+	db, f := setupEventAccessFixture(t)
+	require.NoError(t, db.Model(f.event).Update("deleted_at", time.Now()).Error)
+
+	w := serveEventAccess(setupEventAccessRouter(f.creator, middleware.EventRoleCreator), f.event.ID.String())
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestRequireEventRole_InvalidEventID(t *testing.T) {
+	_, f := setupEventAccessFixture(t)
+
+	w := serveEventAccess(setupEventAccessRouter(f.creator, middleware.EventRoleCreator), "not-a-uuid")
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestRequireEventRole_AttachesEventToContext(t *testing.T) {
+	_, f := setupEventAccessFixture(t)
+
+	w := serveEventAccess(setupEventAccessRouter(f.creator, middleware.EventRoleCreator), f.event.ID.String())
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "Weekend Hike", body["title"])
+	assert.Equal(t, true, body["creator"])
+}
+
+func TestGetEventAccess_MissingWithoutMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+	access, ok := middleware.GetEventAccess(c)
+
+	assert.False(t, ok)
+	assert.Nil(t, access)
+}
+
+// responseMessage returns the message of an error response
+func responseMessage(t *testing.T, w *httptest.ResponseRecorder) string {
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	message, _ := body["message"].(string)
+	return message
+}
@@ -0,0 +1,124 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api/middleware"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupPublicRateLimitRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	config.AppConfig = &config.Config{
+		JWT: config.JWTConfig{Secret: "test-secret", ExpireHours: 24},
+		RateLimit: config.RateLimitConfig{
+			Requests:          100,
+			Window:            "1m",
+			Enforce:           true,
+			GuestRequests:     3,
+			AnonymousRequests: 2,
+		},
+	}
+
+	router := gin.New()
+	router.Use(middleware.AuthContext(), middleware.GuestContext(), middleware.PublicRateLimit())
+	router.GET("/public", func(c *gin.Context) {
+		c.String(http.StatusOK, middleware.GetGuestID(c))
+	})
+	return router
+}
+
+// publicRequest sends a request to the public route with the given headers
+func publicRequest(router *gin.Engine, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("GET", "/public", nil)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestPublicRateLimit_AnonymousLowerThanGuest(t *testing.T) {
+	router := setupPublicRateLimitRouter()
+
+	for i := 0; i < 2; i++ {
+		w := publicRequest(router, nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "2", w.Header().Get("RateLimit-Limit"))
+	}
+	assert.Equal(t, http.StatusTooManyRequests, publicRequest(router, nil).Code)
+
+	// A guest from the same address has its own, higher limit
+	token, err := utils.GenerateGuestToken("guest-1", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	guest := map[string]string{middleware.GuestTokenHeader: token}
+	for i := 0; i < 3; i++ {
+		w := publicRequest(router, guest)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "guest-1", w.Body.String())
+		assert.Equal(t, "3", w.Header().Get("RateLimit-Limit"))
+	}
+	assert.Equal(t, http.StatusTooManyRequests, publicRequest(router, guest).Code)
+
+	// An invalid or expired guest token counts as anonymous
+	expired, err := utils.GenerateGuestToken("guest-2", time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, publicRequest(router, map[string]string{middleware.GuestTokenHeader: expired}).Code)
+}
+
+func TestPublicRateLimit_SignedInUsersSkip(t *testing.T) {
+	router := setupPublicRateLimitRouter()
+
+	token, err := utils.GenerateToken("550e8400-e29b-41d4-a716-446655440000", "user@example.com", "password")
+	require.NoError(t, err)
+	user := map[string]string{"Authorization": "Bearer " + token}
+	for i := 0; i < 5; i++ {
+		w := publicRequest(router, user)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("RateLimit-Limit"))
+	}
+
+	// A guest token can't pass as a user's
+	guestToken, err := utils.GenerateGuestToken("guest-3", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	w := publicRequest(router, map[string]string{"Authorization": "Bearer " + guestToken})
+	assert.Equal(t, "2", w.Header().Get("RateLimit-Limit"))
+}
+
+func TestGuestIssueRateLimit_PerIP(t *testing.T) {
+	setupPublicRateLimitRouter()
+	config.AppConfig.RateLimit.GuestIssueRequests = 2
+
+	router := gin.New()
+	router.POST("/auth/guest", middleware.GuestIssueRateLimit(), func(c *gin.Context) {
+		c.String(http.StatusOK, "token")
+	})
+	issue := func(ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/auth/guest", nil)
+		req.RemoteAddr = ip + ":1234"
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	for i := 0; i < 2; i++ {
+		w := issue("192.0.2.1")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "2", w.Header().Get("RateLimit-Limit"))
+	}
+	w := issue("192.0.2.1")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.NotEmpty(t, w.Header().Get("Retry-After"))
+
+	// Other addresses get their own tokens
+	assert.Equal(t, http.StatusOK, issue("192.0.2.2").Code)
+}
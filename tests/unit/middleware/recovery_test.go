@@ -0,0 +1,94 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"TinderTrip-Backend/internal/api/middleware"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scrapeMetric returns the exposition line of the metric with the given name and labels, if any
+func scrapeMetric(t *testing.T, prefix string) string {
+	w := httptest.NewRecorder()
+	promhttp.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	for _, line := range strings.Split(w.Body.String(), "\n") {
+		if strings.HasPrefix(line, prefix) {
+			return line
+		}
+	}
+	return ""
+}
+
+func TestRecovery_PanickingHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	config.AppConfig = &config.Config{RequestID: config.RequestIDConfig{Header: "X-Request-ID"}}
+
+	var logs bytes.Buffer
+	utils.Logger().SetOutput(&logs)
+	t.Cleanup(func() { utils.Logger().SetOutput(os.Stdout) })
+
+	middleware.SetMonitoringService(service.NewMonitoringService(nil))
+	t.Cleanup(func() { middleware.SetMonitoringService(nil) })
+
+	router := gin.New()
+	router.Use(middleware.RequestID())
+	router.Use(middleware.Recovery())
+	router.GET("/boom", func(c *gin.Context) {
+		var email *string
+		c.String(http.StatusOK, *email)
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/boom", nil))
+
+	// A clean 500 in the standard envelope, without the panic details
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	var body utils.APIResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.False(t, body.Success)
+	assert.Equal(t, utils.ErrCodeInternalServer, body.Code)
+	assert.NotContains(t, w.Body.String(), "nil pointer")
+	requestID := w.Header().Get("X-Request-ID")
+	require.NotEmpty(t, requestID)
+	assert.Equal(t, requestID, body.RequestID)
+
+	// The log entry carries the request ID and the stack
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+	assert.Equal(t, "Panic recovered", entry["msg"])
+	assert.Equal(t, requestID, entry[utils.RequestIDKey])
+	assert.Contains(t, entry["error"], "nil pointer dereference")
+	assert.Contains(t, entry["stack"], "recovery_test.go")
+
+	assert.Equal(t, `http_panics_total{endpoint="/boom",method="GET"} 1`,
+		scrapeMetric(t, `http_panics_total{endpoint="/boom"`))
+}
+
+func TestRecovery_PassesThroughWithoutPanic(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.Recovery())
+	router.GET("/fine", func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/fine", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "ok", w.Body.String())
+}
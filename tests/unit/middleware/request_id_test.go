@@ -0,0 +1,129 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"TinderTrip-Backend/internal/api/middleware"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRequestIDRouter(header string, trustInbound bool) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	config.AppConfig = &config.Config{
+		RequestID: config.RequestIDConfig{
+			Header:       header,
+			TrustInbound: trustInbound,
+		},
+	}
+
+	router := gin.New()
+	router.Use(middleware.RequestID())
+	router.GET("/test", func(c *gin.Context) {
+		utils.BadRequestResponse(c, "Invalid request")
+	})
+	return router
+}
+
+func envelopeRequestID(t *testing.T, w *httptest.ResponseRecorder) string {
+	var body utils.APIResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	return body.RequestID
+}
+
+func TestRequestID_ReusesValidInboundID(t *testing.T) {
+	router := setupRequestIDRouter("X-Request-ID", true)
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set("X-Request-ID", "client-trace.42:abc_DEF")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "client-trace.42:abc_DEF", w.Header().Get("X-Request-ID"))
+	assert.Equal(t, "client-trace.42:abc_DEF", envelopeRequestID(t, w))
+}
+
+func TestRequestID_GeneratesWhenMissing(t *testing.T) {
+	router := setupRequestIDRouter("X-Request-ID", true)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/test", nil))
+
+	id := w.Header().Get("X-Request-ID")
+	_, err := uuid.Parse(id)
+	assert.NoError(t, err, "generated request ID should be a UUID")
+	assert.Equal(t, id, envelopeRequestID(t, w))
+}
+
+func TestRequestID_ReplacesInvalidInboundID(t *testing.T) {
+	router := setupRequestIDRouter("X-Request-ID", true)
+
+	for _, inbound := range []string{"has spaces", "bad\"quote", "<script>", strings.Repeat("a", 129)} {
+		req := httptest.NewRequest("GET", "/test", nil)
+		req.Header.Set("X-Request-ID", inbound)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		id := w.Header().Get("X-Request-ID")
+		assert.NotEqual(t, inbound, id)
+		_, err := uuid.Parse(id)
+		assert.NoError(t, err, "invalid inbound %q should be replaced by a UUID", inbound)
+		assert.Equal(t, id, envelopeRequestID(t, w))
+	}
+}
+
+func TestRequestID_IgnoresInboundWhenUntrusted(t *testing.T) {
+	router := setupRequestIDRouter("X-Request-ID", false)
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set("X-Request-ID", "client-trace-1")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.NotEqual(t, "client-trace-1", w.Header().Get("X-Request-ID"))
+	assert.NotEmpty(t, w.Header().Get("X-Request-ID"))
+}
+
+func TestRequestID_CustomHeader(t *testing.T) {
+	router := setupRequestIDRouter("X-Correlation-ID", true)
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set("X-Correlation-ID", "corr-123")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, "corr-123", w.Header().Get("X-Correlation-ID"))
+	assert.Empty(t, w.Header().Get("X-Request-ID"))
+	assert.Equal(t, "corr-123", envelopeRequestID(t, w))
+}
+
+func TestRequestID_PanicEnvelopeCarriesID(t *testing.T) {
+	setupRequestIDRouter("X-Request-ID", true)
+
+	router := gin.New()
+	router.Use(middleware.RequestID(), middleware.Recovery())
+	router.GET("/panic", func(c *gin.Context) {
+		panic("boom")
+	})
+
+	req := httptest.NewRequest("GET", "/panic", nil)
+	req.Header.Set("X-Request-ID", "panic-trace")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, "panic-trace", w.Header().Get("X-Request-ID"))
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "panic-trace", body["request_id"])
+}
@@ -0,0 +1,268 @@
+package middleware_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/api/middleware"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRedis speaks just enough of the Redis protocol for GET, SET (with EX/PX) and DEL
+type fakeRedis struct {
+	mu      sync.Mutex
+	values  map[string]string
+	expires map[string]time.Time
+}
+
+// startFakeRedis serves a fakeRedis on a local port and points database.RedisClient at it
+func startFakeRedis(t *testing.T) *fakeRedis {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := &fakeRedis{values: map[string]string{}, expires: map[string]time.Time{}}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serve(conn)
+		}
+	}()
+
+	useRedisClient(t, redis.NewClient(&redis.Options{Addr: listener.Addr().String(), DisableIndentity: true}))
+	t.Cleanup(func() { listener.Close() })
+	return server
+}
+
+// useRedisClient swaps database.RedisClient for the test
+func useRedisClient(t *testing.T, client *redis.Client) {
+	previous := database.RedisClient
+	database.RedisClient = client
+	t.Cleanup(func() {
+		if client != nil {
+			client.Close()
+		}
+		database.RedisClient = previous
+	})
+}
+
+func (f *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+		if _, err := io.WriteString(conn, f.execute(args)); err != nil {
+			return
+		}
+	}
+}
+
+func (f *fakeRedis) execute(args []string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		return "+PONG\r\n"
+	case "SET":
+		f.values[args[1]] = args[2]
+		delete(f.expires, args[1])
+		if len(args) == 5 {
+			n, _ := strconv.Atoi(args[4])
+			unit := time.Second
+			if strings.EqualFold(args[3], "px") {
+				unit = time.Millisecond
+			}
+			f.expires[args[1]] = time.Now().Add(time.Duration(n) * unit)
+		}
+		return "+OK\r\n"
+	case "GET":
+		value, ok := f.values[args[1]]
+		if expires, set := f.expires[args[1]]; !ok || (set && time.Now().After(expires)) {
+			return "$-1\r\n"
+		}
+		return fmt.Sprintf("$%d\r\n%s\r\n", len(value), value)
+	case "DEL":
+		_, ok := f.values[args[1]]
+		delete(f.values, args[1])
+		if ok {
+			return ":1\r\n"
+		}
+		return ":0\r\n"
+	default:
+		// Includes HELLO, so the client falls back to RESP2
+		return "-ERR unknown command\r\n"
+	}
+}
+
+// expiry returns when the key expires
+func (f *fakeRedis) expiry(key string) (time.Time, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	expires, ok := f.expires[key]
+	return expires, ok
+}
+
+// readCommand reads one RESP array of bulk strings
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "*")))
+	if err != nil || count < 1 {
+		return nil, fmt.Errorf("unexpected command %q", line)
+	}
+	args := make([]string, count)
+	for i := range args {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(header, "$")))
+		if err != nil {
+			return nil, fmt.Errorf("unexpected argument %q", header)
+		}
+		data := make([]byte, size+2)
+		if _, err := io.ReadFull(reader, data); err != nil {
+			return nil, err
+		}
+		args[i] = string(data[:size])
+	}
+	return args, nil
+}
+
+// serveWithToken requests path on router with the token as a bearer token
+func serveWithToken(router *gin.Engine, method, path, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// blacklistRouter serves /me behind AuthMiddleware, /maybe behind OptionalAuthMiddleware,
+// /context behind AuthContext alone and /public behind AuthContext then OptionalAuthMiddleware as
+// the public routes are, each answering with the authenticated user ID
+func blacklistRouter() *gin.Engine {
+	router := gin.New()
+	whoami := func(c *gin.Context) { c.String(http.StatusOK, c.GetString("user_id")) }
+	router.GET("/me", middleware.AuthMiddleware(), whoami)
+	router.GET("/maybe", middleware.OptionalAuthMiddleware(), whoami)
+	router.GET("/context", middleware.AuthContext(), whoami)
+	router.GET("/public", middleware.AuthContext(), middleware.OptionalAuthMiddleware(), whoami)
+	return router
+}
+
+func TestAuthMiddleware_RejectsBlacklistedToken(t *testing.T) {
+	setupMiddlewareTests()
+	startFakeRedis(t)
+	router := blacklistRouter()
+
+	revoked, err := utils.GenerateToken("user-123", "test@example.com", "password")
+	require.NoError(t, err)
+	other, err := utils.GenerateToken("user-123", "test@example.com", "password")
+	require.NoError(t, err)
+
+	claims, err := utils.ValidateToken(revoked)
+	require.NoError(t, err)
+	require.NotEmpty(t, claims.ID)
+	otherClaims, err := utils.ValidateToken(other)
+	require.NoError(t, err)
+	assert.NotEqual(t, claims.ID, otherClaims.ID, "every token gets its own ID")
+
+	require.Equal(t, http.StatusOK, serveWithToken(router, http.MethodGet, "/me", revoked).Code)
+	require.NoError(t, database.BlacklistToken(context.Background(), claims.ID, time.Hour))
+
+	w := serveWithToken(router, http.MethodGet, "/me", revoked)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), "token has been revoked")
+
+	// Optional auth treats it as no token at all
+	w = serveWithToken(router, http.MethodGet, "/maybe", revoked)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Body.String())
+
+	// So does the global AuthContext, on its own and in front of optional auth
+	w = serveWithToken(router, http.MethodGet, "/context", revoked)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Body.String())
+	w = serveWithToken(router, http.MethodGet, "/public", revoked)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Body.String())
+
+	// The user's other sessions are unaffected
+	w = serveWithToken(router, http.MethodGet, "/me", other)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "user-123", w.Body.String())
+	w = serveWithToken(router, http.MethodGet, "/context", other)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "user-123", w.Body.String())
+	w = serveWithToken(router, http.MethodGet, "/public", other)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "user-123", w.Body.String())
+}
+
+func TestLogout_BlacklistsTokenForItsRemainingLifetime(t *testing.T) {
+	setupMiddlewareTests()
+	server := startFakeRedis(t)
+	router := blacklistRouter()
+	authHandler := handlers.NewAuthHandler()
+	t.Cleanup(authHandler.StopCleanup)
+	router.POST("/logout", middleware.AuthMiddleware(), authHandler.Logout)
+
+	token, err := utils.GenerateToken("user-123", "test@example.com", "password")
+	require.NoError(t, err)
+	claims, err := utils.ValidateToken(token)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, serveWithToken(router, http.MethodPost, "/logout", token).Code)
+	assert.Equal(t, http.StatusUnauthorized, serveWithToken(router, http.MethodGet, "/me", token).Code)
+	assert.Equal(t, http.StatusUnauthorized, serveWithToken(router, http.MethodPost, "/logout", token).Code)
+
+	// Kept only until the token would have expired (24 hours in the test config)
+	expires, ok := server.expiry("token_blacklist:" + claims.ID)
+	require.True(t, ok)
+	assert.WithinDuration(t, time.Unix(claims.ExpiresAt, 0), expires, 2*time.Second)
+}
+
+func TestAuthMiddleware_AllowsTokenWhenRedisUnavailable(t *testing.T) {
+	setupMiddlewareTests()
+	router := blacklistRouter()
+	token, err := utils.GenerateToken("user-123", "test@example.com", "password")
+	require.NoError(t, err)
+
+	// Nothing listens here, so every blacklist lookup fails
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	listener.Close()
+	useRedisClient(t, redis.NewClient(&redis.Options{Addr: addr, DisableIndentity: true, MaxRetries: -1}))
+
+	assert.Equal(t, http.StatusOK, serveWithToken(router, http.MethodGet, "/me", token).Code)
+
+	// Nor does it matter when Redis was never connected
+	useRedisClient(t, nil)
+	assert.Equal(t, http.StatusOK, serveWithToken(router, http.MethodGet, "/me", token).Code)
+}
@@ -295,10 +295,8 @@ func TestEvent_TableName(t *testing.T) {
 
 func TestEventType_Constants(t *testing.T) {
 	assert.Equal(t, models.EventType("meal"), models.EventTypeMeal)
-	assert.Equal(t, models.EventType("daytrip"), models.EventTypeDaytrip)
+	assert.Equal(t, models.EventType("one_day_trip"), models.EventTypeDaytrip)
 	assert.Equal(t, models.EventType("overnight"), models.EventTypeOvernight)
-	assert.Equal(t, models.EventType("activity"), models.EventTypeActivity)
-	assert.Equal(t, models.EventType("other"), models.EventTypeOther)
 }
 
 func TestEventStatus_Constants(t *testing.T) {
@@ -361,7 +359,7 @@ func TestEvent_CompleteScenario(t *testing.T) {
 
 		event := &models.Event{
 			Title:     "Past Event",
-			EventType: models.EventTypeActivity,
+			EventType: models.EventTypeOvernight,
 			StartAt:   &past,
 			EndAt:     &pastEnd,
 			Status:    models.EventStatusCompleted,
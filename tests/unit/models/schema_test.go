@@ -0,0 +1,60 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/tests/testdb"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// allModels lists every model backed by a table
+var allModels = []interface{}{
+	&models.User{}, &models.UserProfile{}, &models.PasswordReset{}, &models.EmailVerification{},
+	&models.PhoneVerification{}, &models.AuditLog{}, &models.APILog{}, &models.Notification{}, &models.NotificationDelivery{},
+	&models.Tag{}, &models.UserTag{}, &models.Interest{}, &models.UserInterest{},
+	&models.PrefBudget{}, &models.PrefAvailability{}, &models.TravelPreference{}, &models.TravelStyleMaster{},
+	&models.FoodPreference{}, &models.FoodCategoryMaster{},
+	&models.Event{}, &models.EventPhoto{}, &models.EventMember{}, &models.EventSwipe{},
+	&models.EventCategory{}, &models.EventTag{}, &models.EventInterest{}, &models.EventNoShow{},
+	&models.EventBroadcast{}, &models.EventItem{}, &models.EventExpense{}, &models.EventExpenseShare{},
+	&models.ChatRoom{}, &models.ChatMessage{}, &models.UserEventHistory{}, &models.UserTopPick{},
+	&models.StorageDeletion{}, &models.Webhook{}, &models.WebhookDelivery{},
+}
+
+func TestModels_MigrateOnSQLite(t *testing.T) {
+	db := testdb.SQLite(t, allModels...)
+
+	for _, model := range allModels {
+		assert.True(t, db.Migrator().HasTable(model), "table for %T should exist", model)
+	}
+}
+
+func TestModels_SQLiteDefaults(t *testing.T) {
+	db := testdb.SQLite(t, &models.User{}, &models.EmailVerification{})
+
+	email := "defaults@example.com"
+	user := models.User{Email: &email, Provider: models.AuthProviderPassword}
+	require.NoError(t, db.Create(&user).Error)
+
+	var stored models.User
+	require.NoError(t, db.First(&stored, "id = ?", user.ID).Error)
+	assert.False(t, stored.EmailVerified)
+	assert.WithinDuration(t, time.Now(), stored.CreatedAt, time.Minute)
+
+	// citext columns compare case-insensitively, as in Postgres
+	var count int64
+	db.Model(&models.User{}).Where("email = ?", "DEFAULTS@example.com").Count(&count)
+	assert.Equal(t, int64(1), count)
+
+	// Primary keys default to a generated UUID when the model doesn't set one
+	require.NoError(t, db.Exec(`INSERT INTO email_verifications (email, otp, expires_at) VALUES (?, ?, ?)`,
+		email, "123456", time.Now().Add(time.Hour)).Error)
+	var verification models.EmailVerification
+	require.NoError(t, db.First(&verification, "email = ?", email).Error)
+	assert.NotEqual(t, "00000000-0000-0000-0000-000000000000", verification.ID.String())
+	assert.Equal(t, 4, int(verification.ID.Version()))
+}
@@ -0,0 +1,115 @@
+package service_test
+
+import (
+	"testing"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventService_AdminCompleteEvent(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "creator")
+	admin := createTestUser(t, db, "admin")
+	member := createTestUser(t, db, "member")
+	event := createTestEvent(t, db, creator, "Stuck event")
+	addTestMember(t, db, event, member, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+
+	// Creator-only completion rejects the admin
+	err := eventService.CompleteEvent(event.ID.String(), admin.ID.String())
+	assert.Error(t, err)
+
+	reason := "creator vanished"
+	err = eventService.AdminCompleteEvent(event.ID.String(), admin.ID.String(), &reason)
+	require.NoError(t, err)
+
+	var updated models.Event
+	require.NoError(t, db.First(&updated, "id = ?", event.ID).Error)
+	assert.Equal(t, models.EventStatusCompleted, updated.Status)
+
+	// History is recorded for confirmed members
+	var historyCount int64
+	db.Model(&models.UserEventHistory{}).Where("event_id = ? AND completed = ?", event.ID, true).Count(&historyCount)
+	assert.Equal(t, int64(2), historyCount)
+
+	// Audit log records the admin as actor
+	var auditLog models.AuditLog
+	require.NoError(t, db.Where("entity_id = ? AND action = ?", event.ID, "ADMIN_COMPLETE").First(&auditLog).Error)
+	require.NotNil(t, auditLog.ActorUserID)
+	assert.Equal(t, admin.ID, *auditLog.ActorUserID)
+	require.NotNil(t, auditLog.AfterData)
+	assert.Contains(t, *auditLog.AfterData, reason)
+
+	// Completing twice is a conflict
+	err = eventService.AdminCompleteEvent(event.ID.String(), admin.ID.String(), nil)
+	assert.EqualError(t, err, "event already completed")
+}
+
+func TestEventService_AdminCompleteEvent_Cancelled(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "creator")
+	admin := createTestUser(t, db, "admin")
+	member := createTestUser(t, db, "member")
+	event := createTestEvent(t, db, creator, "Called off")
+	addTestMember(t, db, event, member, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	require.NoError(t, eventService.AdminCancelEvent(event.ID.String(), admin.ID.String(), nil))
+
+	err := eventService.AdminCompleteEvent(event.ID.String(), admin.ID.String(), nil)
+	assert.EqualError(t, err, "event already cancelled")
+
+	var updated models.Event
+	require.NoError(t, db.First(&updated, "id = ?", event.ID).Error)
+	assert.Equal(t, models.EventStatusCancelled, updated.Status)
+
+	// No history or audit entry for an event that never happened
+	var historyCount, auditCount int64
+	db.Model(&models.UserEventHistory{}).Where("event_id = ?", event.ID).Count(&historyCount)
+	assert.Zero(t, historyCount)
+	db.Model(&models.AuditLog{}).Where("entity_id = ? AND action = ?", event.ID, "ADMIN_COMPLETE").Count(&auditCount)
+	assert.Zero(t, auditCount)
+}
+
+func TestEventService_AdminCancelEvent(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "creator")
+	admin := createTestUser(t, db, "admin")
+	event := createTestEvent(t, db, creator, "Abusive event")
+
+	err := eventService.AdminCancelEvent(event.ID.String(), admin.ID.String(), nil)
+	require.NoError(t, err)
+
+	var updated models.Event
+	require.NoError(t, db.First(&updated, "id = ?", event.ID).Error)
+	assert.Equal(t, models.EventStatusCancelled, updated.Status)
+
+	var auditLog models.AuditLog
+	require.NoError(t, db.Where("entity_id = ? AND action = ?", event.ID, "ADMIN_CANCEL").First(&auditLog).Error)
+	require.NotNil(t, auditLog.ActorUserID)
+	assert.Equal(t, admin.ID, *auditLog.ActorUserID)
+	require.NotNil(t, auditLog.BeforeData)
+	assert.Contains(t, *auditLog.BeforeData, "published")
+
+	t.Run("Already cancelled", func(t *testing.T) {
+		err := eventService.AdminCancelEvent(event.ID.String(), admin.ID.String(), nil)
+		assert.EqualError(t, err, "event already cancelled")
+	})
+
+	t.Run("Unknown event", func(t *testing.T) {
+		err := eventService.AdminCancelEvent("00000000-0000-0000-0000-000000000000", admin.ID.String(), nil)
+		assert.EqualError(t, err, "event not found")
+	})
+
+	t.Run("Invalid event ID", func(t *testing.T) {
+		err := eventService.AdminCompleteEvent("not-a-uuid", admin.ID.String(), nil)
+		assert.EqualError(t, err, "invalid event ID")
+	})
+}
@@ -0,0 +1,78 @@
+package service_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventService_ExportAttendeesCSV(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "export-creator")
+	confirmed := createTestUser(t, db, "export-confirmed")
+	pending := createTestUser(t, db, "export-pending")
+	event := createTestEvent(t, db, creator, "Night market walk")
+	addTestMember(t, db, event, confirmed, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	addTestMember(t, db, event, pending, models.MemberRoleParticipant, models.MemberStatusPending)
+	eventID := event.ID.String()
+
+	var buf bytes.Buffer
+	require.NoError(t, eventService.ExportAttendeesCSV(eventID, creator.ID.String(), &buf))
+
+	output := buf.String()
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3) // header + creator + confirmed member
+	assert.Equal(t, []string{"display_name", "role", "joined_at", "confirmed_at", "checked_in_at"}, records[0])
+
+	names := []string{records[1][0], records[2][0]}
+	assert.ElementsMatch(t, []string{"export-creator", "export-confirmed"}, names)
+	assert.NotContains(t, output, "@example.com")
+
+	t.Run("formula-like names are escaped", func(t *testing.T) {
+		names := map[string]string{
+			`=HYPERLINK("http://evil.example","click")`: `'=HYPERLINK("http://evil.example","click")`,
+			"+1+2":        "'+1+2",
+			"-2+3":        "'-2+3",
+			"@SUM(A1)":    "'@SUM(A1)",
+			"\tTabbed":    "'\tTabbed",
+			"\rReturned":  "'\rReturned",
+			"Plain name":  "Plain name",
+			"Mid=formula": "Mid=formula",
+		}
+		formulaEvent := createTestEvent(t, db, creator, "Spreadsheet party")
+		for name := range names {
+			user := createTestUser(t, db, name)
+			addTestMember(t, db, formulaEvent, user, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+		}
+
+		var out bytes.Buffer
+		require.NoError(t, eventService.ExportAttendeesCSV(formulaEvent.ID.String(), creator.ID.String(), &out))
+		records, err := csv.NewReader(&out).ReadAll()
+		require.NoError(t, err)
+
+		exported := make(map[string]bool)
+		for _, record := range records[1:] {
+			exported[record[0]] = true
+		}
+		for _, escaped := range names {
+			assert.True(t, exported[escaped], "expected %q in the export", escaped)
+		}
+	})
+
+	t.Run("non-creator is rejected before any output", func(t *testing.T) {
+		var out bytes.Buffer
+		err := eventService.ExportAttendeesCSV(eventID, confirmed.ID.String(), &out)
+		require.Error(t, err)
+		assert.Equal(t, "permission denied", err.Error())
+		assert.Zero(t, out.Len())
+	})
+}
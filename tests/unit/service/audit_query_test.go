@@ -0,0 +1,136 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/pkg/audit"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// seedAuditPayloads writes audit rows with JSON before/after data
+func seedAuditPayloads(t *testing.T, logger *audit.AuditLogger) {
+	entries := []struct {
+		table  string
+		action string
+		before interface{}
+		after  interface{}
+	}{
+		{"events", "UPDATE", map[string]interface{}{"capacity": 10, "status": "published"}, map[string]interface{}{"capacity": 12, "status": "published"}},
+		{"events", "UPDATE", map[string]interface{}{"capacity": 10, "status": "published"}, map[string]interface{}{"capacity": 10, "status": "cancelled"}},
+		{"events", "CREATE", nil, map[string]interface{}{"capacity": 5, "status": "published"}},
+		{"users", "UPDATE", map[string]interface{}{"status": "active"}, map[string]interface{}{"status": "cancelled"}},
+	}
+	for _, entry := range entries {
+		entityID := uuid.New().String()
+		require.NoError(t, logger.LogAction(nil, entry.table, &entityID, entry.action, entry.before, entry.after))
+	}
+}
+
+func TestQueryLogs_JSONFilters(t *testing.T) {
+	setupEventDomainDB(t)
+	logger := audit.NewAuditLogger()
+	seedAuditPayloads(t, logger)
+
+	events := "events"
+
+	t.Run("Changed key", func(t *testing.T) {
+		logs, total, err := logger.QueryLogs(audit.LogQuery{EntityTable: &events, Changed: []string{"capacity"}}, 1, 10)
+		require.NoError(t, err)
+		// The create has no before data, so capacity counts as changed
+		assert.Equal(t, int64(2), total)
+		for _, log := range logs {
+			assert.NotContains(t, *log.AfterData, `"status":"cancelled"`)
+		}
+	})
+
+	t.Run("After contains", func(t *testing.T) {
+		logs, total, err := logger.QueryLogs(audit.LogQuery{
+			EntityTable:   &events,
+			AfterContains: map[string]interface{}{"status": "cancelled"},
+		}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, logs, 1)
+		assert.Equal(t, "events", logs[0].EntityTable)
+	})
+
+	t.Run("Before and after contains", func(t *testing.T) {
+		_, total, err := logger.QueryLogs(audit.LogQuery{
+			EntityTable:    &events,
+			BeforeContains: map[string]interface{}{"capacity": 10},
+			AfterContains:  map[string]interface{}{"capacity": 12},
+		}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+	})
+
+	t.Run("Unscoped query within time range", func(t *testing.T) {
+		from := time.Now().Add(-time.Hour)
+		to := time.Now().Add(time.Hour)
+		_, total, err := logger.QueryLogs(audit.LogQuery{
+			From:          &from,
+			To:            &to,
+			AfterContains: map[string]interface{}{"status": "cancelled"},
+		}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+	})
+}
+
+func TestLogQuery_Validate(t *testing.T) {
+	events := "events"
+	now := time.Now()
+	monthsAgo := now.AddDate(0, -3, 0)
+
+	tests := []struct {
+		name    string
+		query   audit.LogQuery
+		wantErr string
+	}{
+		{
+			name:  "No JSON filters",
+			query: audit.LogQuery{},
+		},
+		{
+			name:    "Unscoped JSON filter",
+			query:   audit.LogQuery{Changed: []string{"capacity"}},
+			wantErr: "JSON filters require entity_table or a from/to range",
+		},
+		{
+			name:    "Range too large",
+			query:   audit.LogQuery{From: &monthsAgo, To: &now, Changed: []string{"capacity"}},
+			wantErr: "time range too large for JSON filters",
+		},
+		{
+			name:    "Invalid key",
+			query:   audit.LogQuery{EntityTable: &events, Changed: []string{"capacity') OR 1=1 --"}},
+			wantErr: "invalid JSON filter key: capacity') OR 1=1 --",
+		},
+		{
+			name:    "Too many filters",
+			query:   audit.LogQuery{EntityTable: &events, Changed: []string{"a", "b", "c", "d", "e", "f"}},
+			wantErr: "too many JSON filters",
+		},
+		{
+			name:    "Inverted range",
+			query:   audit.LogQuery{From: &now, To: &monthsAgo},
+			wantErr: "invalid time range",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.query.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.wantErr, err.Error())
+		})
+	}
+}
@@ -9,94 +9,24 @@ import (
 	"TinderTrip-Backend/internal/utils"
 	"TinderTrip-Backend/pkg/config"
 	"TinderTrip-Backend/pkg/database"
+	"TinderTrip-Backend/tests/testdb"
 
 	"github.com/stretchr/testify/assert"
-	"gorm.io/driver/sqlite"
+	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
 )
 
 func setupAuthServiceTest(t *testing.T) (*gorm.DB, *service.AuthService) {
-	// Setup in-memory SQLite database for testing
-	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
-		DisableForeignKeyConstraintWhenMigrating: true,
-	})
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Create simplified tables for testing (SQLite compatible)
-	// We manually create tables instead of AutoMigrate to avoid PostgreSQL-specific types
-	sqlDB, _ := db.DB()
-
-	// Users table
-	_, err = sqlDB.Exec(`
-		CREATE TABLE IF NOT EXISTS users (
-			id TEXT PRIMARY KEY,
-			email TEXT UNIQUE,
-			provider TEXT NOT NULL,
-			password_hash TEXT,
-			email_verified INTEGER NOT NULL DEFAULT 0,
-			google_id TEXT,
-			display_name TEXT,
-			last_login_at DATETIME,
-			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
-			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
-			deleted_at DATETIME
-		)
-	`)
-	if err != nil {
-		t.Fatal("Failed to create users table:", err)
-	}
-
-	// Password resets table
-	_, err = sqlDB.Exec(`
-		CREATE TABLE IF NOT EXISTS password_resets (
-			id TEXT PRIMARY KEY,
-			user_id TEXT NOT NULL,
-			token TEXT NOT NULL,
-			expires_at DATETIME NOT NULL,
-			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
-		)
-	`)
-	if err != nil {
-		t.Fatal("Failed to create password_resets table:", err)
-	}
-
-	// Email verifications table
-	_, err = sqlDB.Exec(`
-		CREATE TABLE IF NOT EXISTS email_verifications (
-			id TEXT PRIMARY KEY,
-			email TEXT NOT NULL,
-			otp TEXT NOT NULL,
-			expires_at DATETIME NOT NULL,
-			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
-			updated_at DATETIME,
-			deleted_at DATETIME
-		)
-	`)
-	if err != nil {
-		t.Fatal("Failed to create email_verifications table:", err)
-	}
-
-	// Audit logs table
-	_, err = sqlDB.Exec(`
-		CREATE TABLE IF NOT EXISTS audit_logs (
-			id TEXT PRIMARY KEY,
-			actor_user_id TEXT,
-			entity_table TEXT NOT NULL,
-			entity_id TEXT NOT NULL,
-			action TEXT NOT NULL,
-			before_data TEXT,
-			after_data TEXT,
-			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
-		)
-	`)
-	if err != nil {
-		t.Fatal("Failed to create audit_logs table:", err)
-	}
-
-	// Set global DB for testing
-	database.DB = db
+	// Build the tables from the models so the test schema can't drift from them
+	db := testdb.SQLite(t,
+		&models.User{},
+		&models.UserProfile{},
+		&models.PasswordReset{},
+		&models.EmailVerification{},
+		&models.AuditLog{},
+		&models.EmailOutbox{},
+		&models.RefreshToken{},
+	)
 
 	// Setup config
 	config.AppConfig = &config.Config{
@@ -104,14 +34,38 @@ func setupAuthServiceTest(t *testing.T) (*gorm.DB, *service.AuthService) {
 			Secret:      "test-secret",
 			ExpireHours: 24,
 		},
+		OTP: config.OTPConfig{
+			Pepper: testOTPPepper,
+		},
 	}
 
-	authService := service.NewAuthService()
+	// OTP emails are captured instead of sent over SMTP
+	mailer, _ := captureEmails()
+	authService := service.NewAuthServiceWithEmailClient(mailer, utils.RealClock{})
 	return db, authService
 }
 
+// testOTPPepper is the OTP pepper configured by setupAuthServiceTest
+const testOTPPepper = "test-pepper"
+
+// hashedOTP returns the stored form of an OTP under the test pepper
+func hashedOTP(otp string) string {
+	return utils.HashOTP(otp, testOTPPepper)
+}
+
+// captureOTPs records the plaintext OTPs issued during the test, keyed by recipient
+func captureOTPs(t *testing.T) map[string]string {
+	issued := make(map[string]string)
+	service.SetOTPObserver(func(recipient, otp string) {
+		issued[recipient] = otp
+	})
+	t.Cleanup(func() { service.SetOTPObserver(nil) })
+	return issued
+}
+
 func TestAuthService_GenerateOTP(t *testing.T) {
 	_, authService := setupAuthServiceTest(t)
+	issued := captureOTPs(t)
 
 	// Since generateOTP is private, we test it indirectly through password reset
 	email := "test@example.com"
@@ -134,8 +88,11 @@ func TestAuthService_GenerateOTP(t *testing.T) {
 	var resetRecord models.PasswordReset
 	err := database.DB.Where("user_id = ?", user.ID).First(&resetRecord).Error
 	assert.NoError(t, err)
-	assert.NotEmpty(t, resetRecord.Token)
-	assert.Len(t, resetRecord.Token, 6) // OTP should be 6 digits
+	assert.Len(t, issued[email], 6) // OTP should be 6 digits
+
+	// Only the hash of the OTP is stored
+	assert.NotEqual(t, issued[email], resetRecord.Token)
+	assert.Equal(t, hashedOTP(issued[email]), resetRecord.Token)
 }
 
 func TestAuthService_VerifyOTP(t *testing.T) {
@@ -163,7 +120,7 @@ func TestAuthService_VerifyOTP(t *testing.T) {
 				// Create reset record
 				reset := &models.PasswordReset{
 					UserID:    user.ID,
-					Token:     "123456",
+					Token:     hashedOTP("123456"),
 					ExpiresAt: time.Now().Add(10 * time.Minute),
 				}
 				database.DB.Create(reset)
@@ -197,7 +154,7 @@ func TestAuthService_VerifyOTP(t *testing.T) {
 				// Create expired reset record
 				reset := &models.PasswordReset{
 					UserID:    user.ID,
-					Token:     "654321", // Use different OTP
+					Token:     hashedOTP("654321"), // Use different OTP
 					ExpiresAt: time.Now().Add(-10 * time.Minute),
 				}
 				database.DB.Create(reset)
@@ -364,7 +321,7 @@ func TestAuthService_ResetPassword(t *testing.T) {
 				otp := "555555"
 				reset := &models.PasswordReset{
 					UserID:    user.ID,
-					Token:     otp,
+					Token:     hashedOTP(otp),
 					ExpiresAt: time.Now().Add(10 * time.Minute),
 				}
 				database.DB.Create(reset)
@@ -413,7 +370,7 @@ func TestAuthService_ResetPassword(t *testing.T) {
 				otp := "123456"
 				reset := &models.PasswordReset{
 					UserID:    user.ID,
-					Token:     otp,
+					Token:     hashedOTP(otp),
 					ExpiresAt: time.Now().Add(10 * time.Minute),
 				}
 				database.DB.Create(reset)
@@ -422,7 +379,7 @@ func TestAuthService_ResetPassword(t *testing.T) {
 				return "different@example.com", otp, "NewPass123!"
 			},
 			wantErr: true,
-			errMsg:  "invalid email",
+			errMsg:  "invalid or expired OTP",
 		},
 	}
 
@@ -607,6 +564,47 @@ func TestAuthService_DeleteUser(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, deletedAt, "deleted_at should be set")
 	})
+
+	t.Run("Records an audit entry and emails a confirmation", func(t *testing.T) {
+		mailer, sentTo := captureEmails()
+		email, name := "leaving@example.com", "Malee"
+		user := &models.User{Email: &email, Provider: models.AuthProviderPassword, DisplayName: &name}
+		require.NoError(t, database.DB.Create(user).Error)
+
+		require.NoError(t, authService.DeleteUser(user.ID.String()))
+
+		var logs []models.AuditLog
+		require.NoError(t, database.DB.Where("entity_table = ? AND entity_id = ? AND action = ?", "users", user.ID, "DELETE").Find(&logs).Error)
+		require.Len(t, logs, 1)
+		require.NotNil(t, logs[0].ActorUserID)
+		assert.Equal(t, user.ID, *logs[0].ActorUserID)
+		require.NotNil(t, logs[0].BeforeData)
+		assert.Contains(t, *logs[0].BeforeData, email)
+		assert.NotContains(t, *logs[0].BeforeData, "password_hash")
+
+		// Sent by the worker through the outbox, with instructions to restore the account
+		_, err := service.NewEmailOutboxServiceWithEmailClient(mailer, utils.RealClock{}).ProcessPendingEmails(10)
+		require.NoError(t, err)
+		messages := sentTo(email)
+		require.Len(t, messages, 1)
+		assert.Contains(t, messages[0].Subject, "deleted")
+		assert.Contains(t, messages[0].HTML, "Hello Malee")
+		assert.Contains(t, messages[0].HTML, "restored")
+	})
+
+	t.Run("Deleting twice fails without a second email", func(t *testing.T) {
+		email := "twice@example.com"
+		user := &models.User{Email: &email, Provider: models.AuthProviderPassword}
+		require.NoError(t, database.DB.Create(user).Error)
+		require.NoError(t, authService.DeleteUser(user.ID.String()))
+
+		err := authService.DeleteUser(user.ID.String())
+		require.Error(t, err)
+		assert.Equal(t, "user not found", err.Error())
+		var queued int64
+		require.NoError(t, database.DB.Model(&models.EmailOutbox{}).Where("recipient = ?", email).Count(&queued).Error)
+		assert.Equal(t, int64(1), queued)
+	})
 }
 
 func TestAuthService_VerifyEmailOTP(t *testing.T) {
@@ -627,7 +625,7 @@ func TestAuthService_VerifyEmailOTP(t *testing.T) {
 				// Create email verification record
 				verification := &models.EmailVerification{
 					Email:     email,
-					OTP:       otp,
+					OTP:       hashedOTP(otp),
 					ExpiresAt: time.Now().Add(10 * time.Minute),
 				}
 				database.DB.Create(verification)
@@ -657,7 +655,7 @@ func TestAuthService_VerifyEmailOTP(t *testing.T) {
 				otp := "123456"
 				verification := &models.EmailVerification{
 					Email:     email,
-					OTP:       otp,
+					OTP:       hashedOTP(otp),
 					ExpiresAt: time.Now().Add(10 * time.Minute),
 				}
 				database.DB.Create(verification)
@@ -0,0 +1,78 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventService_BroadcastToMembers(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "broadcast-creator")
+	member := createTestUser(t, db, "broadcast-member")
+	pending := createTestUser(t, db, "broadcast-pending")
+	event := createTestEvent(t, db, creator, "Rainy hike")
+	addTestMember(t, db, event, member, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	addTestMember(t, db, event, pending, models.MemberRoleParticipant, models.MemberStatusPending)
+	room := &models.ChatRoom{ID: uuid.New(), EventID: event.ID}
+	require.NoError(t, db.Create(room).Error)
+	eventID := event.ID.String()
+
+	req := dto.BroadcastRequest{Title: "Weather", Body: "Bring an umbrella", PostToChat: true}
+	result, err := eventService.BroadcastToMembers(eventID, creator.ID.String(), req)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Recipients)
+	assert.Equal(t, 2, result.RemainingToday)
+	require.NotNil(t, result.ChatMessageID)
+
+	// Only confirmed members other than the creator are notified
+	var notified []string
+	require.NoError(t, db.Model(&models.Notification{}).Pluck("user_id", &notified).Error)
+	assert.Equal(t, []string{member.ID.String()}, notified)
+
+	var message models.ChatMessage
+	require.NoError(t, db.First(&message, "id = ?", *result.ChatMessageID).Error)
+	assert.True(t, message.IsSystem())
+	assert.Equal(t, room.ID, message.RoomID)
+
+	t.Run("only the creator can broadcast", func(t *testing.T) {
+		_, err := eventService.BroadcastToMembers(eventID, member.ID.String(), req)
+		require.Error(t, err)
+		assert.Equal(t, "permission denied", err.Error())
+	})
+}
+
+func TestEventService_BroadcastRateLimit(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "limit-creator")
+	event := createTestEvent(t, db, creator, "Beach cleanup")
+	eventID := event.ID.String()
+	req := dto.BroadcastRequest{Title: "Reminder", Body: "See you tomorrow"}
+
+	for i := 0; i < 3; i++ {
+		_, err := eventService.BroadcastToMembers(eventID, creator.ID.String(), req)
+		require.NoError(t, err)
+	}
+
+	_, err := eventService.BroadcastToMembers(eventID, creator.ID.String(), req)
+	require.Error(t, err)
+	assert.Equal(t, "broadcast limit reached", err.Error())
+
+	// Broadcasts older than the rolling window no longer count
+	require.NoError(t, db.Model(&models.EventBroadcast{}).Where("event_id = ?", event.ID).
+		Update("created_at", time.Now().Add(-25*time.Hour)).Error)
+	result, err := eventService.BroadcastToMembers(eventID, creator.ID.String(), req)
+	require.NoError(t, err)
+	assert.Nil(t, result.ChatMessageID)
+}
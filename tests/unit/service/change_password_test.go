@@ -0,0 +1,128 @@
+package service_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// createPasswordUser inserts a verified password user
+func createPasswordUser(t *testing.T, db *gorm.DB, email, password string) *models.User {
+	t.Helper()
+	hashedPass, err := utils.HashPassword(password)
+	require.NoError(t, err)
+	user := &models.User{
+		Email:         &email,
+		Provider:      models.AuthProviderPassword,
+		PasswordHash:  &hashedPass,
+		EmailVerified: true,
+	}
+	require.NoError(t, db.Create(user).Error)
+	return user
+}
+
+func TestAuthService_ChangePassword(t *testing.T) {
+	db, authService := setupAuthServiceTest(t)
+	user := createPasswordUser(t, db, "change@example.com", "OldPass123!")
+	first, err := authService.IssueRefreshToken(user.ID)
+	require.NoError(t, err)
+	second, err := authService.IssueRefreshToken(user.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, authService.ChangePassword(user.ID.String(), "OldPass123!", "NewPass456!"))
+
+	_, err = authService.Login("change@example.com", "OldPass123!")
+	assert.Error(t, err)
+	_, err = authService.Login("change@example.com", "NewPass456!")
+	assert.NoError(t, err)
+
+	// Every session has to sign in again
+	for _, token := range []string{first, second} {
+		_, _, err = authService.RotateRefreshToken(token)
+		assert.Error(t, err)
+	}
+
+	var audit models.AuditLog
+	require.NoError(t, db.Where("action = ?", "PASSWORD_CHANGE").First(&audit).Error)
+	assert.Equal(t, user.ID, *audit.ActorUserID)
+}
+
+func TestAuthService_ChangePassword_WrongCurrentPassword(t *testing.T) {
+	db, authService := setupAuthServiceTest(t)
+	user := createPasswordUser(t, db, "wrong@example.com", "OldPass123!")
+	token, err := authService.IssueRefreshToken(user.ID)
+	require.NoError(t, err)
+
+	err = authService.ChangePassword(user.ID.String(), "NotMyPass1!", "NewPass456!")
+	assert.EqualError(t, err, "current password is incorrect")
+
+	// Nothing changed
+	_, err = authService.Login("wrong@example.com", "OldPass123!")
+	assert.NoError(t, err)
+	_, _, err = authService.RotateRefreshToken(token)
+	assert.NoError(t, err)
+
+	err = authService.ChangePassword(user.ID.String(), "OldPass123!", "OldPass123!")
+	assert.EqualError(t, err, "new password must be different from the current password")
+}
+
+func TestAuthService_ChangePassword_RejectsGoogleAccounts(t *testing.T) {
+	db, authService := setupAuthServiceTest(t)
+	email, googleID := "google-change@example.com", "google-123"
+	user := &models.User{Email: &email, Provider: models.AuthProviderGoogle, GoogleID: &googleID, EmailVerified: true}
+	require.NoError(t, db.Create(user).Error)
+
+	err := authService.ChangePassword(user.ID.String(), "anything", "NewPass456!")
+	assert.EqualError(t, err, "this account uses Google login and has no password")
+
+	var stored models.User
+	require.NoError(t, db.First(&stored, "id = ?", user.ID).Error)
+	assert.Nil(t, stored.PasswordHash)
+}
+
+func TestChangePasswordEndpoint(t *testing.T) {
+	db, _ := setupAuthServiceTest(t)
+	user := createPasswordUser(t, db, "endpoint-change@example.com", "OldPass123!")
+	email, googleID := "endpoint-google@example.com", "google-456"
+	googleUser := &models.User{Email: &email, Provider: models.AuthProviderGoogle, GoogleID: &googleID, EmailVerified: true}
+	require.NoError(t, db.Create(googleUser).Error)
+
+	authHandler := handlers.NewAuthHandler()
+	t.Cleanup(authHandler.StopCleanup)
+	changePassword := func(caller *models.User, body string) *httptest.ResponseRecorder {
+		gin.SetMode(gin.TestMode)
+		router := gin.New()
+		router.POST("/auth/change-password", func(c *gin.Context) {
+			c.Set("user_id", caller.ID.String())
+			c.Next()
+		}, authHandler.ChangePassword)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/auth/change-password", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := changePassword(user, `{"current_password":"Wrong123!","new_password":"NewPass456!"}`)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	w = changePassword(googleUser, `{"current_password":"anything","new_password":"NewPass456!"}`)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	w = changePassword(user, `{"current_password":"OldPass123!","new_password":"short"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	// This session gets a fresh pair of tokens
+	w = changePassword(user, `{"current_password":"OldPass123!","new_password":"NewPass456!"}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"token":"`)
+	assert.Contains(t, w.Body.String(), `"refresh_token":"`)
+}
@@ -0,0 +1,85 @@
+package service_test
+
+import (
+	"testing"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendMessage_Mentions(t *testing.T) {
+	db := setupEventDomainDB(t)
+	chatService := service.NewChatService()
+
+	creator := createTestUser(t, db, "mention-creator")
+	guest := createTestUser(t, db, "mention-guest")
+	declined := createTestUser(t, db, "mention-declined")
+	outsider := createTestUser(t, db, "mention-outsider")
+	setHandle(t, db, creator, "creator")
+	setHandle(t, db, guest, "somchai")
+	setHandle(t, db, declined, "malee")
+	setHandle(t, db, outsider, "stranger")
+
+	event := createTestEvent(t, db, creator, "Night market")
+	addTestMember(t, db, event, guest, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	addTestMember(t, db, event, declined, models.MemberRoleParticipant, models.MemberStatusDeclined)
+	room := &models.ChatRoom{ID: uuid.New(), EventID: event.ID}
+	require.NoError(t, db.Create(room).Error)
+
+	body := "@SomChai @malee @stranger see you at 7, @somchai! cc @creator and mail me at me@creator"
+	message, err := chatService.SendMessage(room.ID.String(), creator.ID.String(), dto.SendMessageRequest{Body: body, MessageType: "text"})
+	require.NoError(t, err)
+
+	// Only members who can see the chat are mentioned, once each at their first mention
+	require.Len(t, message.Mentions, 2)
+	assert.Equal(t, dto.ChatMentionResponse{
+		UserID: guest.ID.String(), Handle: "somchai", DisplayName: "mention-guest", Start: 0, Length: 8,
+	}, message.Mentions[0])
+	assert.Equal(t, creator.ID.String(), message.Mentions[1].UserID)
+	assert.Equal(t, "@creator", string([]rune(body)[message.Mentions[1].Start:message.Mentions[1].Start+message.Mentions[1].Length]))
+
+	var stored int64
+	require.NoError(t, db.Model(&models.ChatMessageMention{}).Where("message_id = ?", message.ID).Count(&stored).Error)
+	assert.Equal(t, int64(2), stored)
+
+	// The mentioned member is notified once; the sender, non-members and former members are not
+	service.WaitForBackgroundSends()
+	var notifications []models.Notification
+	require.NoError(t, db.Find(&notifications).Error)
+	require.Len(t, notifications, 1)
+	assert.Equal(t, guest.ID, notifications[0].UserID)
+	assert.Equal(t, "chat_mention", notifications[0].Data["type"])
+	assert.Equal(t, message.ID, notifications[0].Data["message_id"])
+	assert.Equal(t, room.ID.String(), notifications[0].Data["room_id"])
+	assert.Contains(t, notifications[0].Body, "mention-creator mentioned you in 'Night market'")
+
+	// Mentions come back with the message history
+	messages, _, err := chatService.GetMessages(room.ID.String(), guest.ID.String(), 1, 20)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.Equal(t, message.Mentions, messages[0].Mentions)
+}
+
+func TestSendMessage_NoMentions(t *testing.T) {
+	db := setupEventDomainDB(t)
+	chatService := service.NewChatService()
+
+	creator := createTestUser(t, db, "plain-creator")
+	event := createTestEvent(t, db, creator, "Temple walk")
+	room := &models.ChatRoom{ID: uuid.New(), EventID: event.ID}
+	require.NoError(t, db.Create(room).Error)
+
+	message, err := chatService.SendMessage(room.ID.String(), creator.ID.String(), dto.SendMessageRequest{Body: "Meet at 9", MessageType: "text"})
+	require.NoError(t, err)
+	assert.Empty(t, message.Mentions)
+
+	service.WaitForBackgroundSends()
+	var notifications int64
+	require.NoError(t, db.Model(&models.Notification{}).Count(&notifications).Error)
+	assert.Zero(t, notifications)
+}
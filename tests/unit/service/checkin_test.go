@@ -0,0 +1,205 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+const (
+	checkinEventLat = 13.7563
+	checkinEventLng = 100.5018
+)
+
+// ongoingEvent creates an event that started 10 minutes ago at a fixed location with one confirmed member
+func ongoingEvent(t *testing.T, db *gorm.DB, withLocation bool) (*models.Event, *models.User, *models.User) {
+	creator := createTestUser(t, db, "checkin-creator")
+	member := createTestUser(t, db, "checkin-member")
+	event := createTestEvent(t, db, creator, "Street food walk")
+	addTestMember(t, db, event, member, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+
+	startAt := time.Now().Add(-10 * time.Minute)
+	endAt := time.Now().Add(2 * time.Hour)
+	updates := map[string]interface{}{"start_at": startAt, "end_at": endAt}
+	if withLocation {
+		updates["lat"] = checkinEventLat
+		updates["lng"] = checkinEventLng
+	}
+	require.NoError(t, db.Model(&models.Event{}).Where("id = ?", event.ID).Updates(updates).Error)
+	return event, creator, member
+}
+
+func floatPtr(v float64) *float64 {
+	return &v
+}
+
+func TestCheckinService_Geofence(t *testing.T) {
+	db := setupEventDomainDB(t)
+	checkinService := service.NewCheckinService()
+	event, _, member := ongoingEvent(t, db, true)
+
+	// About 1.1 km away
+	_, err := checkinService.CheckIn(event.ID.String(), member.ID.String(), dto.CheckinRequest{
+		Lat: floatPtr(checkinEventLat + 0.01),
+		Lng: floatPtr(checkinEventLng),
+	})
+	require.Error(t, err)
+	assert.Equal(t, "too far from event location", err.Error())
+
+	// About 55 m away
+	checkin, err := checkinService.CheckIn(event.ID.String(), member.ID.String(), dto.CheckinRequest{
+		Lat: floatPtr(checkinEventLat + 0.0005),
+		Lng: floatPtr(checkinEventLng),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, service.CheckinMethodLocation, checkin.Method)
+
+	var stored models.EventMember
+	require.NoError(t, db.Where("event_id = ? AND user_id = ?", event.ID, member.ID).First(&stored).Error)
+	assert.NotNil(t, stored.CheckedInAt)
+
+	_, err = checkinService.CheckIn(event.ID.String(), member.ID.String(), dto.CheckinRequest{
+		Lat: floatPtr(checkinEventLat),
+		Lng: floatPtr(checkinEventLng),
+	})
+	require.Error(t, err)
+	assert.Equal(t, "already checked in", err.Error())
+}
+
+func TestCheckinService_ProofRequiredWhenEventHasLocation(t *testing.T) {
+	db := setupEventDomainDB(t)
+	checkinService := service.NewCheckinService()
+	event, _, member := ongoingEvent(t, db, true)
+
+	_, err := checkinService.CheckIn(event.ID.String(), member.ID.String(), dto.CheckinRequest{})
+	require.Error(t, err)
+	assert.Equal(t, "check-in code or location required", err.Error())
+
+	_, err = checkinService.CheckIn(event.ID.String(), member.ID.String(), dto.CheckinRequest{Lat: floatPtr(checkinEventLat)})
+	require.Error(t, err)
+	assert.Equal(t, "lat and lng must be provided together", err.Error())
+}
+
+func TestCheckinService_Code(t *testing.T) {
+	db := setupEventDomainDB(t)
+	checkinService := service.NewCheckinService()
+	event, creator, member := ongoingEvent(t, db, false)
+
+	// Only the creator can generate a code
+	_, err := checkinService.GenerateCheckinCode(event.ID.String(), member.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "not authorized", err.Error())
+
+	generated, err := checkinService.GenerateCheckinCode(event.ID.String(), creator.ID.String())
+	require.NoError(t, err)
+	assert.Len(t, generated.Code, 6)
+
+	wrong := "WRONG1"
+	_, err = checkinService.CheckIn(event.ID.String(), member.ID.String(), dto.CheckinRequest{Code: &wrong})
+	require.Error(t, err)
+	assert.Equal(t, "invalid check-in code", err.Error())
+
+	// A code is now required even though the event has no location
+	_, err = checkinService.CheckIn(event.ID.String(), member.ID.String(), dto.CheckinRequest{})
+	require.Error(t, err)
+	assert.Equal(t, "check-in code or location required", err.Error())
+
+	padded := " " + generated.Code + " "
+	checkin, err := checkinService.CheckIn(event.ID.String(), member.ID.String(), dto.CheckinRequest{Code: &padded})
+	require.NoError(t, err)
+	assert.Equal(t, service.CheckinMethodCode, checkin.Method)
+}
+
+func TestCheckinService_Eligibility(t *testing.T) {
+	db := setupEventDomainDB(t)
+	checkinService := service.NewCheckinService()
+	event, _, _ := ongoingEvent(t, db, false)
+
+	outsider := createTestUser(t, db, "checkin-outsider")
+	_, err := checkinService.CheckIn(event.ID.String(), outsider.ID.String(), dto.CheckinRequest{})
+	require.Error(t, err)
+	assert.Equal(t, "not a member", err.Error())
+
+	pending := createTestUser(t, db, "checkin-pending")
+	addTestMember(t, db, event, pending, models.MemberRoleParticipant, models.MemberStatusPending)
+	_, err = checkinService.CheckIn(event.ID.String(), pending.ID.String(), dto.CheckinRequest{})
+	require.Error(t, err)
+	assert.Equal(t, "not a confirmed member", err.Error())
+}
+
+func TestCheckinService_Window(t *testing.T) {
+	db := setupEventDomainDB(t)
+	checkinService := service.NewCheckinService()
+	event, _, member := ongoingEvent(t, db, false)
+
+	tests := []struct {
+		name    string
+		startAt time.Time
+		endAt   time.Time
+		open    bool
+	}{
+		{name: "Too early", startAt: time.Now().Add(2 * time.Hour), endAt: time.Now().Add(4 * time.Hour)},
+		{name: "After end", startAt: time.Now().Add(-4 * time.Hour), endAt: time.Now().Add(-time.Hour)},
+		{name: "Shortly before start", startAt: time.Now().Add(15 * time.Minute), endAt: time.Now().Add(2 * time.Hour), open: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, db.Model(&models.Event{}).Where("id = ?", event.ID).
+				Updates(map[string]interface{}{"start_at": tt.startAt, "end_at": tt.endAt}).Error)
+
+			_, err := checkinService.CheckIn(event.ID.String(), member.ID.String(), dto.CheckinRequest{})
+			if tt.open {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, "check-in is not open", err.Error())
+		})
+	}
+}
+
+func TestCompleteEvent_UsesCheckinsForHistory(t *testing.T) {
+	db := setupEventDomainDB(t)
+	checkinService := service.NewCheckinService()
+	event, creator, member := ongoingEvent(t, db, false)
+	absent := createTestUser(t, db, "checkin-absent")
+	addTestMember(t, db, event, absent, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+
+	_, err := checkinService.CheckIn(event.ID.String(), member.ID.String(), dto.CheckinRequest{})
+	require.NoError(t, err)
+	_, err = checkinService.CheckIn(event.ID.String(), creator.ID.String(), dto.CheckinRequest{})
+	require.NoError(t, err)
+
+	require.NoError(t, service.NewEventService().CompleteEvent(event.ID.String(), creator.ID.String()))
+
+	var attended, missed models.UserEventHistory
+	require.NoError(t, db.Where("event_id = ? AND user_id = ?", event.ID, member.ID).First(&attended).Error)
+	require.NoError(t, db.Where("event_id = ? AND user_id = ?", event.ID, absent.ID).First(&missed).Error)
+	assert.True(t, attended.Completed)
+	assert.True(t, attended.Attended)
+	assert.True(t, missed.Completed)
+	assert.NotNil(t, missed.CompletedAt)
+	assert.False(t, missed.Attended)
+
+	// A missed check-in counts as a no-show, once even when the creator also reports it
+	reliabilityService := service.NewReliabilityService()
+	require.NoError(t, reliabilityService.ReportNoShow(event.ID.String(), creator.ID.String(), absent.ID.String()))
+	reliability, err := reliabilityService.GetUserReliability(absent.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), reliability.CompletedEvents)
+	assert.Equal(t, int64(1), reliability.NoShows)
+	require.NotNil(t, reliability.ReliabilityScore)
+	assert.Equal(t, 0, *reliability.ReliabilityScore)
+
+	reliability, err = reliabilityService.GetUserReliability(member.ID.String())
+	require.NoError(t, err)
+	assert.Zero(t, reliability.NoShows)
+}
@@ -0,0 +1,123 @@
+package service_test
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/email"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// captureEmails returns an email client that records outgoing email in memory, and a function
+// returning the messages sent through it to the given address
+func captureEmails() (*email.SMTPClient, func(to string) []*email.EmailMessage) {
+	var mu sync.Mutex
+	var sent []*email.EmailMessage
+	client := email.NewSMTPClientWithTransport(func(message *email.EmailMessage) error {
+		mu.Lock()
+		defer mu.Unlock()
+		sent = append(sent, message)
+		return nil
+	})
+
+	return client, func(to string) []*email.EmailMessage {
+		mu.Lock()
+		defer mu.Unlock()
+		var matched []*email.EmailMessage
+		for _, message := range sent {
+			for _, recipient := range message.To {
+				if recipient == to {
+					matched = append(matched, message)
+				}
+			}
+		}
+		return matched
+	}
+}
+
+func TestResendConfirmationEmail_RateLimited(t *testing.T) {
+	db := setupEventDomainDB(t)
+	mailer, sentTo := captureEmails()
+	previousTimezone := config.AppConfig.Email.Timezone
+	config.AppConfig.Email.Timezone = "Asia/Bangkok"
+	t.Cleanup(func() { config.AppConfig.Email.Timezone = previousTimezone })
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := utils.NewFakeClock(now)
+	eventService := service.NewEventServiceWithEmailClient(mailer, clock)
+
+	creator := createTestUser(t, db, "resend-creator")
+	member := createTestUser(t, db, "resend-member")
+	event := createTestEvent(t, db, creator, "Night market crawl")
+	require.NoError(t, db.Model(event).Update("start_at", time.Date(2025, 6, 7, 11, 0, 0, 0, time.UTC)).Error)
+	addTestMember(t, db, event, member, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	eventID := event.ID.String()
+
+	require.NoError(t, eventService.ResendConfirmationEmail(eventID, member.ID.String()))
+	messages := sentTo(*member.Email)
+	require.Len(t, messages, 1)
+	assert.Contains(t, messages[0].Subject, "Night market crawl")
+	// 11:00 UTC is 18:00 in Bangkok
+	assert.Contains(t, messages[0].HTML, "Saturday, 7 June 2025 at 18:00")
+
+	// A second request within the cooldown is refused and sends nothing
+	clock.Advance(5 * time.Minute)
+	err := eventService.ResendConfirmationEmail(eventID, member.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "confirmation email sent recently", err.Error())
+	assert.Len(t, sentTo(*member.Email), 1)
+
+	// Once the cooldown has passed it can be sent again
+	clock.Advance(5 * time.Minute)
+	require.NoError(t, eventService.ResendConfirmationEmail(eventID, member.ID.String()))
+	assert.Len(t, sentTo(*member.Email), 2)
+}
+
+func TestResendConfirmationEmail_RejectsUnconfirmed(t *testing.T) {
+	db := setupEventDomainDB(t)
+	mailer, sentTo := captureEmails()
+	eventService := service.NewEventServiceWithEmailClient(mailer, utils.RealClock{})
+
+	creator := createTestUser(t, db, "unconfirmed-creator")
+	pending := createTestUser(t, db, "unconfirmed-pending")
+	declined := createTestUser(t, db, "unconfirmed-declined")
+	outsider := createTestUser(t, db, "unconfirmed-outsider")
+	event := createTestEvent(t, db, creator, "Temple run")
+	addTestMember(t, db, event, pending, models.MemberRoleParticipant, models.MemberStatusPending)
+	addTestMember(t, db, event, declined, models.MemberRoleParticipant, models.MemberStatusDeclined)
+	eventID := event.ID.String()
+
+	for _, user := range []*models.User{pending, declined} {
+		err := eventService.ResendConfirmationEmail(eventID, user.ID.String())
+		require.Error(t, err)
+		assert.Equal(t, "not a confirmed member", err.Error())
+		assert.Empty(t, sentTo(*user.Email))
+	}
+
+	err := eventService.ResendConfirmationEmail(eventID, outsider.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "not a member", err.Error())
+
+	// The handler explains why instead of hiding the event from a pending member
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", pending.ID.String())
+		c.Next()
+	})
+	router.POST("/events/:id/confirmation/resend", handlers.NewEventHandler().ResendConfirmationEmail)
+
+	w := serveEventRequest(router, "POST", "/events/"+eventID+"/confirmation/resend", "")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), "Only confirmed members")
+}
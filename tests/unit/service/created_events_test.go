@@ -0,0 +1,108 @@
+package service_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCreatedEvents_ExcludesJoinedEvents(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	user := createTestUser(t, db, "created-user")
+	other := createTestUser(t, db, "created-other")
+
+	older := createTestEvent(t, db, user, "First trip")
+	require.NoError(t, db.Model(older).Update("created_at", time.Now().Add(-48*time.Hour)).Error)
+	newer := createTestEvent(t, db, user, "Second trip")
+	cancelled := createTestEvent(t, db, user, "Called off")
+	require.NoError(t, db.Model(cancelled).Updates(map[string]interface{}{
+		"status":     models.EventStatusCancelled,
+		"created_at": time.Now().Add(-24 * time.Hour),
+	}).Error)
+	deleted := createTestEvent(t, db, user, "Deleted")
+	require.NoError(t, db.Model(deleted).Update("deleted_at", time.Now()).Error)
+
+	// Joined, pending and confirmed, but not created by the user
+	joined := createTestEvent(t, db, other, "Someone else's trip")
+	addTestMember(t, db, joined, user, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	pending := createTestEvent(t, db, other, "Maybe going")
+	addTestMember(t, db, pending, user, models.MemberRoleParticipant, models.MemberStatusPending)
+
+	events, total, err := eventService.GetCreatedEvents(user.ID.String(), 1, 10, "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	require.Len(t, events, 3)
+
+	// Newest first
+	assert.Equal(t, newer.ID.String(), events[0].ID)
+	assert.Equal(t, cancelled.ID.String(), events[1].ID)
+	assert.Equal(t, older.ID.String(), events[2].ID)
+
+	// The joined events are still listed as joined
+	joinedEvents, _, err := eventService.GetJoinedEvents(user.ID.String(), 1, 10, "")
+	require.NoError(t, err)
+	var joinedIDs []string
+	for _, event := range joinedEvents {
+		joinedIDs = append(joinedIDs, event.ID)
+	}
+	assert.Contains(t, joinedIDs, joined.ID.String())
+	assert.Contains(t, joinedIDs, pending.ID.String())
+}
+
+func TestGetCreatedEvents_StatusFilterAndPaging(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	user := createTestUser(t, db, "filter-user")
+	published := createTestEvent(t, db, user, "Going ahead")
+	require.NoError(t, db.Model(published).Update("created_at", time.Now().Add(-time.Hour)).Error)
+	cancelled := createTestEvent(t, db, user, "Called off")
+	require.NoError(t, db.Model(cancelled).Update("status", models.EventStatusCancelled).Error)
+
+	events, total, err := eventService.GetCreatedEvents(user.ID.String(), 1, 10, string(models.EventStatusCancelled))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, events, 1)
+	assert.Equal(t, cancelled.ID.String(), events[0].ID)
+
+	events, total, err = eventService.GetCreatedEvents(user.ID.String(), 2, 1, "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, events, 1)
+	assert.Equal(t, published.ID.String(), events[0].ID)
+
+	_, _, err = eventService.GetCreatedEvents(user.ID.String(), 1, 10, "draft")
+	require.Error(t, err)
+	assert.Equal(t, "invalid status", err.Error())
+}
+
+func TestGetCreatedEventsHandler(t *testing.T) {
+	db := setupEventDomainDB(t)
+	user := createTestUser(t, db, "created-handler")
+	createTestEvent(t, db, user, "Mine")
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", user.ID.String())
+		c.Next()
+	})
+	router.GET("/events/created", handlers.NewEventHandler().GetCreatedEvents)
+
+	w := serveEventRequest(router, "GET", "/events/created?status=published", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Mine")
+
+	w = serveEventRequest(router, "GET", "/events/created?status=unknown", "")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
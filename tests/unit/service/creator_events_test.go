@@ -0,0 +1,101 @@
+package service_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEventsByCreator_OnlyPublishedUpcoming(t *testing.T) {
+	db := setupEventDomainDB(t)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	eventService := service.NewEventServiceWithClock(utils.NewFakeClock(now))
+
+	creator := createTestUser(t, db, "profile-creator")
+	other := createTestUser(t, db, "profile-other")
+	viewer := createTestUser(t, db, "profile-viewer")
+
+	startAt := func(event *models.Event, at time.Time) {
+		require.NoError(t, db.Model(event).Update("start_at", at).Error)
+	}
+
+	later := createTestEvent(t, db, creator, "Next month")
+	startAt(later, now.Add(30*24*time.Hour))
+	soon := createTestEvent(t, db, creator, "Tomorrow")
+	startAt(soon, now.Add(24*time.Hour))
+	undated := createTestEvent(t, db, creator, "Some day")
+
+	past := createTestEvent(t, db, creator, "Last week")
+	startAt(past, now.Add(-7*24*time.Hour))
+	cancelled := createTestEvent(t, db, creator, "Cancelled")
+	startAt(cancelled, now.Add(24*time.Hour))
+	require.NoError(t, db.Model(cancelled).Update("status", models.EventStatusCancelled).Error)
+	completed := createTestEvent(t, db, creator, "Completed")
+	require.NoError(t, db.Model(completed).Update("status", models.EventStatusCompleted).Error)
+	deleted := createTestEvent(t, db, creator, "Deleted")
+	require.NoError(t, db.Model(deleted).Update("deleted_at", now).Error)
+	createTestEvent(t, db, other, "Someone else's")
+
+	events, total, err := eventService.GetEventsByCreator(creator.ID.String(), viewer.ID.String(), 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	require.Len(t, events, 3)
+
+	// Soonest first, undated last
+	assert.Equal(t, soon.ID.String(), events[0].ID)
+	assert.Equal(t, later.ID.String(), events[1].ID)
+	assert.Equal(t, undated.ID.String(), events[2].ID)
+	for _, event := range events {
+		assert.False(t, event.IsJoined)
+	}
+
+	// Paginated with the same total
+	page, total, err := eventService.GetEventsByCreator(creator.ID.String(), viewer.ID.String(), 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	require.Len(t, page, 1)
+	assert.Equal(t, undated.ID.String(), page[0].ID)
+}
+
+func TestGetEventsByCreator_UnknownUser(t *testing.T) {
+	setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	_, _, err := eventService.GetEventsByCreator(uuid.New().String(), "", 1, 10)
+	require.Error(t, err)
+	assert.Equal(t, "user not found", err.Error())
+
+	_, _, err = eventService.GetEventsByCreator("not-a-uuid", "", 1, 10)
+	require.Error(t, err)
+	assert.Equal(t, "invalid user ID", err.Error())
+}
+
+func TestGetUserEventsHandler(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "handler-creator")
+	createTestEvent(t, db, creator, "Listed")
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/users/:id/events", handlers.NewUserHandler().GetUserEvents)
+
+	w := serveEventRequest(router, "GET", "/users/"+creator.ID.String()+"/events", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Listed")
+
+	w = serveEventRequest(router, "GET", "/users/"+uuid.New().String()+"/events", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = serveEventRequest(router, "GET", "/users/not-a-uuid/events", "")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,96 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/audit"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryLogsByCursor_StableOrdering(t *testing.T) {
+	db := setupEventDomainDB(t)
+
+	// Several rows share a timestamp so the id tie-breaker is exercised
+	base := time.Now().UTC().Truncate(time.Second)
+	for i := 0; i < 7; i++ {
+		log := &models.AuditLog{
+			EntityTable: "events",
+			Action:      "UPDATE",
+			CreatedAt:   base.Add(-time.Duration(i/3) * time.Minute),
+		}
+		require.NoError(t, db.Create(log).Error)
+	}
+
+	logger := audit.NewAuditLogger()
+
+	var seen []string
+	var cursor *utils.Cursor
+	for page := 0; page < 5; page++ {
+		logs, next, err := logger.QueryLogsByCursor(audit.LogQuery{}, cursor, 3)
+		require.NoError(t, err)
+		for _, log := range logs {
+			seen = append(seen, log.ID.String())
+		}
+		if next == nil {
+			break
+		}
+		cursor, err = utils.DecodeCursor(*next)
+		require.NoError(t, err)
+	}
+
+	// Every row is returned exactly once, matching the full ordered listing
+	var all []models.AuditLog
+	require.NoError(t, db.Order("created_at DESC").Order("id DESC").Find(&all).Error)
+	require.Len(t, seen, len(all))
+	for i, log := range all {
+		assert.Equal(t, log.ID.String(), seen[i])
+	}
+}
+
+func TestGetNotificationsByCursor(t *testing.T) {
+	db := setupEventDomainDB(t)
+	user := createTestUser(t, db, "Reader")
+	other := createTestUser(t, db, "Other")
+
+	base := time.Now().UTC().Truncate(time.Second)
+	for i := 0; i < 5; i++ {
+		notification := &models.Notification{
+			ID:        uuid.New(),
+			UserID:    user.ID,
+			Title:     "Title",
+			Body:      "Body",
+			Type:      "push",
+			Data:      map[string]interface{}{"index": i},
+			CreatedAt: base.Add(-time.Duration(i) * time.Minute),
+		}
+		require.NoError(t, db.Create(notification).Error)
+	}
+	require.NoError(t, db.Create(&models.Notification{
+		ID: uuid.New(), UserID: other.ID, Title: "Other", Body: "Body", Type: "push", CreatedAt: base,
+	}).Error)
+
+	notificationService := service.NewNotificationService()
+
+	first, next, err := notificationService.GetNotificationsByCursor(user.ID.String(), nil, 3, nil)
+	require.NoError(t, err)
+	require.Len(t, first, 3)
+	require.NotNil(t, next)
+	assert.Equal(t, float64(0), first[0].Data["index"])
+
+	cursor, err := utils.DecodeCursor(*next)
+	require.NoError(t, err)
+
+	second, next, err := notificationService.GetNotificationsByCursor(user.ID.String(), cursor, 3, nil)
+	require.NoError(t, err)
+	require.Len(t, second, 2)
+	assert.Nil(t, next)
+	assert.Equal(t, float64(3), second[0].Data["index"])
+	assert.Equal(t, float64(4), second[1].Data["index"])
+}
@@ -0,0 +1,136 @@
+package service_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// storedDevices returns every registered device token
+func storedDevices(t *testing.T, db *gorm.DB) []models.DeviceToken {
+	t.Helper()
+	var devices []models.DeviceToken
+	require.NoError(t, db.Order("token").Find(&devices).Error)
+	return devices
+}
+
+func TestRegisterDevice_UpsertsByToken(t *testing.T) {
+	db := setupEventDomainDB(t)
+	registeredAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	clock := utils.NewFakeClock(registeredAt)
+	deviceService := service.NewDeviceServiceWithClock(clock)
+	user := createTestUser(t, db, "device-user")
+
+	device, err := deviceService.RegisterDevice(user.ID.String(), "fcm-token", models.DevicePlatformAndroid)
+	require.NoError(t, err)
+	assert.Equal(t, "fcm-token", device.Token)
+
+	// Registering again on app start only refreshes the token
+	clock.Advance(48 * time.Hour)
+	device, err = deviceService.RegisterDevice(user.ID.String(), "fcm-token", models.DevicePlatformAndroid)
+	require.NoError(t, err)
+	assert.WithinDuration(t, registeredAt, device.CreatedAt, time.Second)
+	assert.WithinDuration(t, clock.Now(), device.LastSeenAt, time.Second)
+
+	devices := storedDevices(t, db)
+	require.Len(t, devices, 1)
+	assert.Equal(t, user.ID, devices[0].UserID)
+	assert.WithinDuration(t, registeredAt, devices[0].CreatedAt, time.Second)
+	assert.WithinDuration(t, clock.Now(), devices[0].LastSeenAt, time.Second)
+
+	// A second device is a separate token
+	_, err = deviceService.RegisterDevice(user.ID.String(), "web-token", models.DevicePlatformWeb)
+	require.NoError(t, err)
+	assert.Len(t, storedDevices(t, db), 2)
+}
+
+func TestRegisterDevice_ReassignsTokenToNewUser(t *testing.T) {
+	db := setupEventDomainDB(t)
+	deviceService := service.NewDeviceService()
+	first := createTestUser(t, db, "first-owner")
+	second := createTestUser(t, db, "second-owner")
+
+	_, err := deviceService.RegisterDevice(first.ID.String(), "shared-phone", models.DevicePlatformIOS)
+	require.NoError(t, err)
+
+	// Someone else logs in on the same phone
+	_, err = deviceService.RegisterDevice(second.ID.String(), "shared-phone", models.DevicePlatformIOS)
+	require.NoError(t, err)
+
+	devices := storedDevices(t, db)
+	require.Len(t, devices, 1)
+	assert.Equal(t, second.ID, devices[0].UserID)
+
+	// The previous owner can no longer remove it
+	err = deviceService.UnregisterDevice(first.ID.String(), "shared-phone")
+	require.Error(t, err)
+	assert.Equal(t, "device not found", err.Error())
+	require.NoError(t, deviceService.UnregisterDevice(second.ID.String(), "shared-phone"))
+	assert.Empty(t, storedDevices(t, db))
+}
+
+func TestRegisterDevice_Validation(t *testing.T) {
+	db := setupEventDomainDB(t)
+	deviceService := service.NewDeviceService()
+	user := createTestUser(t, db, "invalid-device")
+
+	for _, tt := range []struct {
+		token, platform, wantErr string
+	}{
+		{token: "fcm-token", platform: "symbian", wantErr: "invalid platform"},
+		{token: "fcm-token", platform: "IOS", wantErr: "invalid platform"},
+		{token: "fcm-token", platform: "", wantErr: "invalid platform"},
+		{token: "  ", platform: models.DevicePlatformIOS, wantErr: "invalid device token"},
+	} {
+		_, err := deviceService.RegisterDevice(user.ID.String(), tt.token, tt.platform)
+		if assert.Error(t, err, tt.platform) {
+			assert.Equal(t, tt.wantErr, err.Error(), tt.platform)
+		}
+	}
+	assert.Empty(t, storedDevices(t, db))
+}
+
+func TestDeviceHandlers(t *testing.T) {
+	db := setupEventDomainDB(t)
+	user := createTestUser(t, db, "device-owner")
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", user.ID.String())
+		c.Next()
+	})
+	userHandler := handlers.NewUserHandler()
+	router.POST("/users/me/devices", userHandler.RegisterDevice)
+	router.DELETE("/users/me/devices/:token", userHandler.UnregisterDevice)
+
+	w := serveEventRequest(router, "POST", "/users/me/devices", `{"token": "abc", "platform": "symbian"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = serveEventRequest(router, "POST", "/users/me/devices", `{"token": "   ", "platform": "ios"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = serveEventRequest(router, "POST", "/users/me/devices", `{"token": " fcm-token ", "platform": "ios"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"token":"fcm-token"`)
+
+	devices := storedDevices(t, db)
+	require.Len(t, devices, 1)
+	assert.Equal(t, user.ID, devices[0].UserID)
+	assert.Equal(t, models.DevicePlatformIOS, devices[0].Platform)
+
+	w = serveEventRequest(router, "DELETE", "/users/me/devices/fcm-token", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, storedDevices(t, db))
+	w = serveEventRequest(router, "DELETE", "/users/me/devices/fcm-token", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
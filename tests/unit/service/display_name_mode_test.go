@@ -0,0 +1,147 @@
+package service_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// handlePattern is the shape of a generated handle
+var handlePattern = regexp.MustCompile(`^[a-z0-9_]+_[0-9]{4}$`)
+
+// setDisplayNameMode switches the display name mode for the rest of the test
+func setDisplayNameMode(t *testing.T, mode string) {
+	t.Helper()
+	config.AppConfig.User.DisplayNameMode = mode
+	t.Cleanup(func() { config.AppConfig.User.DisplayNameMode = "" })
+}
+
+// signUp verifies an email OTP for a new user with the given display name
+func signUp(t *testing.T, authService *service.AuthService, email, displayName string) (*models.User, error) {
+	t.Helper()
+	// Replaces any OTP already sent to the address, as a resend would
+	require.NoError(t, database.DB.Where("email = ?", email).Delete(&models.EmailVerification{}).Error)
+	require.NoError(t, database.DB.Create(&models.EmailVerification{
+		Email:     email,
+		OTP:       hashedOTP("123456"),
+		ExpiresAt: time.Now().Add(10 * time.Minute),
+	}).Error)
+	return authService.VerifyEmailOTP(email, "123456", "TestPass123!", displayName)
+}
+
+func TestDisplayNameMode_Unique(t *testing.T) {
+	_, authService := setupAuthServiceTest(t)
+	setDisplayNameMode(t, config.DisplayNameUnique)
+
+	first, err := signUp(t, authService, "first@example.com", "Somchai")
+	require.NoError(t, err)
+	require.NotNil(t, first.Handle)
+	assert.Regexp(t, handlePattern, *first.Handle)
+
+	// Turned away before the OTP is even sent, and again at verification
+	_, err = authService.SendEmailVerificationOTP("second@example.com", "Somchai")
+	require.Error(t, err)
+	assert.Equal(t, "display name already taken", err.Error())
+
+	_, err = signUp(t, authService, "second@example.com", "Somchai")
+	require.Error(t, err)
+	assert.Equal(t, "display name already taken", err.Error())
+}
+
+func TestDisplayNameMode_UniqueSuffixAuto(t *testing.T) {
+	_, authService := setupAuthServiceTest(t)
+	setDisplayNameMode(t, config.DisplayNameAutoSuffix)
+
+	registerEmail(t, authService, "first@example.com", "Somchai")
+	first, err := signUp(t, authService, "first@example.com", "Somchai")
+	require.NoError(t, err)
+	assert.Equal(t, "Somchai", *first.DisplayName)
+
+	// A taken name is accepted up front and stored with the next free suffix
+	registerEmail(t, authService, "second@example.com", "Somchai")
+	second, err := signUp(t, authService, "second@example.com", "Somchai")
+	require.NoError(t, err)
+	assert.Equal(t, "Somchai_2", *second.DisplayName)
+
+	third, err := signUp(t, authService, "third@example.com", "Somchai")
+	require.NoError(t, err)
+	assert.Equal(t, "Somchai_3", *third.DisplayName)
+}
+
+func TestDisplayNameMode_NonUnique(t *testing.T) {
+	_, authService := setupAuthServiceTest(t)
+	setDisplayNameMode(t, config.DisplayNameNonUnique)
+
+	registerEmail(t, authService, "first@example.com", "Somchai Jaidee")
+	first, err := signUp(t, authService, "first@example.com", "Somchai Jaidee")
+	require.NoError(t, err)
+	second, err := signUp(t, authService, "second@example.com", "Somchai Jaidee")
+	require.NoError(t, err)
+
+	// Both keep the name and are told apart by their handles
+	assert.Equal(t, "Somchai Jaidee", *first.DisplayName)
+	assert.Equal(t, "Somchai Jaidee", *second.DisplayName)
+	require.NotNil(t, first.Handle)
+	require.NotNil(t, second.Handle)
+	assert.Regexp(t, `^somchai_jaidee_[0-9]{4}$`, *first.Handle)
+	assert.NotEqual(t, *first.Handle, *second.Handle)
+
+	// A name without ASCII letters still gets a URL-safe handle
+	thai, err := signUp(t, authService, "thai@example.com", "สมชาย")
+	require.NoError(t, err)
+	require.NotNil(t, thai.Handle)
+	assert.Regexp(t, `^user_[0-9]{4}$`, *thai.Handle)
+}
+
+func TestDisplayNameMode_ProfileUpdate(t *testing.T) {
+	setupEventDomainDB(t)
+	setDisplayNameMode(t, config.DisplayNameUnique)
+	userService := service.NewUserService()
+
+	taken, existing := "Malee", "Old name"
+	require.NoError(t, database.DB.Create(&models.User{Email: strPtr("malee@example.com"), Provider: models.AuthProviderPassword, DisplayName: &taken}).Error)
+	user := &models.User{Email: strPtr("user@example.com"), Provider: models.AuthProviderPassword, DisplayName: &existing, Handle: strPtr("old_name_0001")}
+	require.NoError(t, database.DB.Create(user).Error)
+
+	_, err := userService.UpdateProfile(user.ID.String(), dto.UpdateProfileRequest{DisplayName: &taken})
+	require.Error(t, err)
+	assert.Equal(t, "display name already taken", err.Error())
+
+	setDisplayNameMode(t, config.DisplayNameAutoSuffix)
+	profile, err := userService.UpdateProfile(user.ID.String(), dto.UpdateProfileRequest{DisplayName: &taken})
+	require.NoError(t, err)
+	assert.Equal(t, "Malee_2", *profile.DisplayName)
+
+	// The handle is separate from the display name and stays put
+	setDisplayNameMode(t, config.DisplayNameNonUnique)
+	profile, err = userService.UpdateProfile(user.ID.String(), dto.UpdateProfileRequest{DisplayName: &taken})
+	require.NoError(t, err)
+	assert.Equal(t, "Malee", *profile.DisplayName)
+	require.NotNil(t, profile.Handle)
+	assert.Equal(t, "old_name_0001", *profile.Handle)
+}
+
+func TestDisplayNameMode_UniqueIndexSkipsSharedNames(t *testing.T) {
+	_, authService := setupAuthServiceTest(t)
+	setDisplayNameMode(t, config.DisplayNameNonUnique)
+
+	// Shared names may repeat, including one a uniquely named user already has
+	name := "Somchai"
+	require.NoError(t, database.DB.Create(&models.User{Email: strPtr("owner@example.com"), Provider: models.AuthProviderPassword, DisplayName: &name}).Error)
+	shared, err := signUp(t, authService, "shared@example.com", name)
+	require.NoError(t, err)
+	assert.True(t, shared.DisplayNameShared)
+
+	// Outside non-unique mode the database still rejects a duplicate that slips past the check
+	err = database.DB.Create(&models.User{Email: strPtr("racer@example.com"), Provider: models.AuthProviderPassword, DisplayName: &name}).Error
+	require.Error(t, err)
+}
@@ -0,0 +1,143 @@
+package service_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendEmailVerificationOTP_ConcurrentRegistrations(t *testing.T) {
+	db, authService := setupAuthServiceTest(t)
+
+	var mu sync.Mutex
+	var issued []string
+	service.SetOTPObserver(func(recipient, otp string) {
+		mu.Lock()
+		defer mu.Unlock()
+		issued = append(issued, otp)
+	})
+	t.Cleanup(func() { service.SetOTPObserver(nil) })
+
+	const registrations = 8
+	address := "race@example.com"
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	errs := make([]error, registrations)
+	for i := 0; i < registrations; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			_, errs[i] = authService.SendEmailVerificationOTP(address, "Racer")
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+
+	// Every send replaced the same row instead of racing on delete-then-insert
+	var verifications []models.EmailVerification
+	require.NoError(t, db.Where("email = ?", address).Find(&verifications).Error)
+	require.Len(t, verifications, 1)
+
+	// The surviving OTP is the last one stored and still verifies
+	var latest string
+	for _, otp := range issued {
+		if hashedOTP(otp) == verifications[0].OTP {
+			latest = otp
+		}
+	}
+	require.NotEmpty(t, latest)
+	user, err := authService.VerifyEmailOTP(address, latest, "TestPass123!", "Racer")
+	require.NoError(t, err)
+	assert.Equal(t, address, *user.Email)
+}
+
+func TestResendEmailVerificationOTP_ReplacesInPlace(t *testing.T) {
+	db, authService := setupAuthServiceTest(t)
+	issued := captureOTPs(t)
+
+	address := "resend@example.com"
+	registerEmail(t, authService, address, "Resender")
+	first := issued[address]
+
+	// Burn some attempts on the first code
+	_, err := authService.VerifyEmailOTP(address, "000000", "TestPass123!", "Resender")
+	require.Error(t, err)
+
+	require.NoError(t, authService.ResendEmailVerificationOTP(address))
+	var verifications []models.EmailVerification
+	require.NoError(t, db.Where("email = ?", address).Find(&verifications).Error)
+	require.Len(t, verifications, 1)
+	assert.Equal(t, 0, verifications[0].Attempts)
+	assert.WithinDuration(t, time.Now().Add(10*time.Minute), verifications[0].ExpiresAt, time.Minute)
+
+	// The earlier code no longer works once a new one is sent
+	if first != issued[address] {
+		_, err = authService.VerifyEmailOTP(address, first, "TestPass123!", "Resender")
+		require.Error(t, err)
+	}
+	_, err = authService.VerifyEmailOTP(address, issued[address], "TestPass123!", "Resender")
+	require.NoError(t, err)
+}
+
+// registerEmail starts a registration and returns its cancel token
+func registerEmail(t *testing.T, authService *service.AuthService, address, displayName string) string {
+	t.Helper()
+	cancelToken, err := authService.SendEmailVerificationOTP(address, displayName)
+	require.NoError(t, err)
+	require.NotEmpty(t, cancelToken)
+	return cancelToken
+}
+
+func TestCancelEmailVerification(t *testing.T) {
+	db, authService := setupAuthServiceTest(t)
+	issued := captureOTPs(t)
+
+	mistyped, other := "mistyped@exmaple.com", "other@example.com"
+	cancelToken := registerEmail(t, authService, mistyped, "Typo")
+	otherToken := registerEmail(t, authService, other, "Other")
+	pending := func(address string) int64 {
+		t.Helper()
+		var count int64
+		require.NoError(t, db.Model(&models.EmailVerification{}).Where("email = ?", address).Count(&count).Error)
+		return count
+	}
+
+	// Without the token from registering, nobody else can cancel it
+	assert.NoError(t, authService.CancelEmailVerification(mistyped, "guessed"))
+	assert.NoError(t, authService.CancelEmailVerification(mistyped, otherToken))
+	assert.Equal(t, int64(1), pending(mistyped))
+
+	// A resend keeps the registration's token
+	require.NoError(t, authService.ResendEmailVerificationOTP(mistyped))
+	require.NoError(t, authService.CancelEmailVerification(mistyped, cancelToken))
+	assert.Zero(t, pending(mistyped))
+
+	// The OTP sent to the mistyped address no longer works
+	_, err := authService.VerifyEmailOTP(mistyped, issued[mistyped], "TestPass123!", "Typo")
+	require.Error(t, err)
+	assert.Equal(t, "invalid or expired OTP", err.Error())
+
+	// Other pending registrations are untouched
+	assert.Equal(t, int64(1), pending(other))
+
+	// Cancelling an email with nothing pending looks the same
+	assert.NoError(t, authService.CancelEmailVerification("nobody@example.com", cancelToken))
+	assert.NoError(t, authService.CancelEmailVerification(mistyped, cancelToken))
+
+	// The address can register again, with a new token
+	newToken := registerEmail(t, authService, mistyped, "Typo")
+	assert.NotEqual(t, cancelToken, newToken)
+	_, err = authService.VerifyEmailOTP(mistyped, issued[mistyped], "TestPass123!", "Typo")
+	require.NoError(t, err)
+}
@@ -0,0 +1,93 @@
+package service_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupEventAccessRouter routes creator-only and member-only event endpoints for the given caller
+func setupEventAccessRouter(userID string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", userID)
+		c.Next()
+	})
+	eventHandler := handlers.NewEventHandler()
+	router.PUT("/events/:id", eventHandler.UpdateEvent)
+	router.DELETE("/events/:id", eventHandler.DeleteEvent)
+	router.POST("/events/:id/complete", eventHandler.CompleteEvent)
+	router.POST("/events/:id/checkin-code", eventHandler.GenerateCheckinCode)
+	router.POST("/events/:id/checkin", eventHandler.CheckIn)
+	router.GET("/events/:id/items", eventHandler.GetEventItems)
+	router.POST("/events/:id/items", eventHandler.AddEventItem)
+	return router
+}
+
+func TestEventAccess_ForeignEventLooksMissing(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Creator")
+	outsider := createTestUser(t, db, "Outsider")
+	event := createTestEvent(t, db, creator, "Private Trip")
+
+	router := setupEventAccessRouter(outsider.ID.String())
+
+	requests := []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{"PUT", "/events/%s", `{"title":"Hijacked"}`},
+		{"DELETE", "/events/%s", ""},
+		{"POST", "/events/%s/complete", ""},
+		{"POST", "/events/%s/checkin-code", ""},
+		{"POST", "/events/%s/checkin", `{"code":"123456"}`},
+		{"GET", "/events/%s/items", ""},
+		{"POST", "/events/%s/items", `{"name":"Snacks"}`},
+	}
+
+	for _, r := range requests {
+		foreign := serveEventRequest(router, r.method, strings.Replace(r.path, "%s", event.ID.String(), 1), r.body)
+		missing := serveEventRequest(router, r.method, strings.Replace(r.path, "%s", uuid.New().String(), 1), r.body)
+
+		assert.Equal(t, http.StatusNotFound, foreign.Code, "%s %s", r.method, r.path)
+		assert.Equal(t, missing.Code, foreign.Code, "%s %s", r.method, r.path)
+		assert.Equal(t, errorBody(t, missing), errorBody(t, foreign), "%s %s", r.method, r.path)
+	}
+
+	var unchanged models.Event
+	db.First(&unchanged, "id = ?", event.ID)
+	assert.Equal(t, "Private Trip", unchanged.Title)
+	assert.Nil(t, unchanged.DeletedAt)
+}
+
+// errorBody keeps the parts of an error response that do not vary per request
+func errorBody(t *testing.T, w *httptest.ResponseRecorder) map[string]interface{} {
+	var body map[string]interface{}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	delete(body, "request_id")
+	delete(body, "timestamp")
+	return body
+}
+
+func serveEventRequest(router *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
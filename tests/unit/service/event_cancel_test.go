@@ -0,0 +1,127 @@
+package service_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelEvent_CreatorCancelsWithReason(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+	webhookService := service.NewWebhookService()
+
+	creator := createTestUser(t, db, "cancel-creator")
+	confirmed := createTestUser(t, db, "cancel-confirmed")
+	pending := createTestUser(t, db, "cancel-pending")
+	event := createTestEvent(t, db, creator, "Rainy hike")
+	addTestMember(t, db, event, confirmed, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	addTestMember(t, db, event, pending, models.MemberRoleParticipant, models.MemberStatusPending)
+
+	_, err := webhookService.CreateWebhook(creator.ID.String(), dto.CreateWebhookRequest{
+		URL:    "https://example.com/hooks",
+		Events: []string{models.WebhookEventCancelled},
+	})
+	require.NoError(t, err)
+
+	reason := "  Storm warning  "
+	require.NoError(t, eventService.CancelEvent(event.ID.String(), creator.ID.String(), &reason))
+
+	var stored models.Event
+	require.NoError(t, db.First(&stored, "id = ?", event.ID).Error)
+	assert.Equal(t, models.EventStatusCancelled, stored.Status)
+	require.NotNil(t, stored.CancelReason)
+	assert.Equal(t, "Storm warning", *stored.CancelReason)
+	assert.NotNil(t, stored.CancelledAt)
+
+	// Pending requests are released; confirmed members stay on record
+	var pendingMember, confirmedMember models.EventMember
+	require.NoError(t, db.First(&pendingMember, "event_id = ? AND user_id = ?", event.ID, pending.ID).Error)
+	assert.Equal(t, models.MemberStatusDeclined, pendingMember.Status)
+	require.NoError(t, db.First(&confirmedMember, "event_id = ? AND user_id = ?", event.ID, confirmed.ID).Error)
+	assert.Equal(t, models.MemberStatusConfirmed, confirmedMember.Status)
+
+	// Confirmed members are told why
+	var notification models.Notification
+	require.NoError(t, db.First(&notification, "user_id = ?", confirmed.ID).Error)
+	assert.Equal(t, "Event Cancelled", notification.Title)
+	assert.Contains(t, notification.Body, "Reason: Storm warning")
+	assert.Equal(t, "event_cancelled", notification.Data["type"])
+	var pendingNotifications int64
+	require.NoError(t, db.Model(&models.Notification{}).Where("user_id = ?", pending.ID).Count(&pendingNotifications).Error)
+	assert.Zero(t, pendingNotifications)
+
+	// Downstream integrations get the reason
+	var delivery models.WebhookDelivery
+	require.NoError(t, db.First(&delivery, "event_name = ?", models.WebhookEventCancelled).Error)
+	var payload dto.WebhookPayload
+	require.NoError(t, json.Unmarshal([]byte(delivery.Payload), &payload))
+	require.NotNil(t, payload.Data.CancellationReason)
+	assert.Equal(t, "Storm warning", *payload.Data.CancellationReason)
+	assert.Equal(t, string(models.EventStatusCancelled), payload.Data.Status)
+
+	var auditLog models.AuditLog
+	require.NoError(t, db.Where("entity_id = ? AND action = ?", event.ID, "CANCEL").First(&auditLog).Error)
+	assert.Equal(t, creator.ID, *auditLog.ActorUserID)
+
+	t.Run("cannot cancel twice", func(t *testing.T) {
+		err := eventService.CancelEvent(event.ID.String(), creator.ID.String(), nil)
+		assert.EqualError(t, err, "event already cancelled")
+	})
+}
+
+func TestCancelEvent_Guards(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+	creator := createTestUser(t, db, "guard-creator")
+	member := createTestUser(t, db, "guard-member")
+	event := createTestEvent(t, db, creator, "Finished trip")
+	addTestMember(t, db, event, member, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+
+	err := eventService.CancelEvent(event.ID.String(), member.ID.String(), nil)
+	assert.EqualError(t, err, "permission denied")
+
+	require.NoError(t, db.Model(event).Update("status", models.EventStatusCompleted).Error)
+	err = eventService.CancelEvent(event.ID.String(), creator.ID.String(), nil)
+	assert.EqualError(t, err, "event already completed")
+
+	var stored models.Event
+	require.NoError(t, db.First(&stored, "id = ?", event.ID).Error)
+	assert.Equal(t, models.EventStatusCompleted, stored.Status)
+	assert.Nil(t, stored.CancelledAt)
+
+	// A blank reason is stored as no reason
+	other := createTestEvent(t, db, creator, "Blank reason")
+	blank := "   "
+	require.NoError(t, eventService.CancelEvent(other.ID.String(), creator.ID.String(), &blank))
+	var blankStored models.Event
+	require.NoError(t, db.First(&blankStored, "id = ?", other.ID).Error)
+	assert.Equal(t, models.EventStatusCancelled, blankStored.Status)
+	assert.Nil(t, blankStored.CancelReason)
+}
+
+func TestUpdateEvent_RejectsStatus(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+	creator := createTestUser(t, db, "status-creator")
+	event := createTestEvent(t, db, creator, "Sneaky cancel")
+
+	for _, status := range []string{"cancelled", "completed", "published"} {
+		title := "Renamed"
+		_, err := eventService.UpdateEvent(event.ID.String(), creator.ID.String(), dto.UpdateEventRequest{Title: &title, Status: &status})
+		require.Error(t, err)
+		assert.Equal(t, "status cannot be updated; use the cancel or complete endpoint", err.Error())
+	}
+
+	// Nothing was applied, not even the other fields
+	var stored models.Event
+	require.NoError(t, db.First(&stored, "id = ?", event.ID).Error)
+	assert.Equal(t, models.EventStatusPublished, stored.Status)
+	assert.Equal(t, "Sneaky cancel", stored.Title)
+}
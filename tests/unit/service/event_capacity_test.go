@@ -0,0 +1,118 @@
+package service_test
+
+import (
+	"sync"
+	"testing"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateEvent_CapacityBelowConfirmedMembers(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "capacity-creator")
+	alice := createTestUser(t, db, "capacity-alice")
+	bob := createTestUser(t, db, "capacity-bob")
+	waiting := createTestUser(t, db, "capacity-waiting")
+	event := createTestEvent(t, db, creator, "Cooking class")
+	addTestMember(t, db, event, alice, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	addTestMember(t, db, event, bob, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	addTestMember(t, db, event, waiting, models.MemberRoleParticipant, models.MemberStatusPending)
+	eventID := event.ID.String()
+
+	// Three confirmed members including the creator
+	tooSmall := 2
+	_, err := eventService.UpdateEvent(eventID, creator.ID.String(), dto.UpdateEventRequest{Capacity: &tooSmall})
+	require.Error(t, err)
+	assert.Equal(t, "capacity cannot be less than confirmed member count (3)", err.Error())
+
+	var unchanged models.Event
+	require.NoError(t, db.First(&unchanged, "id = ?", event.ID).Error)
+	assert.Nil(t, unchanged.Capacity)
+
+	// Pending members do not count towards the limit
+	exact := 3
+	updated, err := eventService.UpdateEvent(eventID, creator.ID.String(), dto.UpdateEventRequest{Capacity: &exact})
+	require.NoError(t, err)
+	require.NotNil(t, updated.Capacity)
+	assert.Equal(t, 3, *updated.Capacity)
+
+	zero := 0
+	_, err = eventService.UpdateEvent(eventID, creator.ID.String(), dto.UpdateEventRequest{Capacity: &zero})
+	require.Error(t, err)
+	assert.Equal(t, "capacity must be at least 1", err.Error())
+}
+
+func TestJoinEvent_RejectsFullEvent(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "join-full-creator")
+	alice := createTestUser(t, db, "join-full-alice")
+	bob := createTestUser(t, db, "join-full-bob")
+	event := createTestEvent(t, db, creator, "Tandem skydive")
+	require.NoError(t, db.Model(event).Update("capacity", 2).Error)
+	eventID := event.ID.String()
+
+	// A free seat lets anyone join as pending
+	require.NoError(t, eventService.JoinEvent(eventID, alice.ID.String()))
+	require.NoError(t, eventService.ConfirmEventParticipation(eventID, alice.ID.String()))
+
+	// Once confirmed members fill the event, joining is refused
+	err := eventService.JoinEvent(eventID, bob.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "event is full", err.Error())
+	assert.Zero(t, memberCount(t, db, bob, event))
+
+	// A freed seat opens it up again
+	require.NoError(t, eventService.CancelEventParticipation(eventID, alice.ID.String()))
+	require.NoError(t, eventService.JoinEvent(eventID, bob.ID.String()))
+	assert.Equal(t, models.MemberStatusPending, memberStatus(t, db, event, bob))
+}
+
+func TestConfirmEventParticipation_ConcurrentConfirmsRespectCapacity(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	const capacity, pending = 3, 8
+	creator := createTestUser(t, db, "race-creator")
+	event := createTestEvent(t, db, creator, "Last-minute charter")
+	require.NoError(t, db.Model(event).Update("capacity", capacity).Error)
+	members := make([]*models.User, pending)
+	for i := range members {
+		members[i] = createTestUser(t, db, "race-member")
+		addTestMember(t, db, event, members[i], models.MemberRoleParticipant, models.MemberStatusPending)
+	}
+
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	errs := make([]error, pending)
+	for i, member := range members {
+		wg.Add(1)
+		go func(i int, userID string) {
+			defer wg.Done()
+			<-start
+			errs[i] = eventService.ConfirmEventParticipation(event.ID.String(), userID)
+		}(i, member.ID.String())
+	}
+	close(start)
+	wg.Wait()
+
+	// The creator holds one seat, so only two more confirmations fit
+	confirmed := 0
+	for _, err := range errs {
+		if err == nil {
+			confirmed++
+			continue
+		}
+		assert.EqualError(t, err, "event is full")
+	}
+	assert.Equal(t, capacity-1, confirmed)
+	assertConfirmedCount(t, db, event.ID, capacity)
+}
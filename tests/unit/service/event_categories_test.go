@@ -0,0 +1,196 @@
+package service_test
+
+import (
+	"testing"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/pkg/config"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func createKindTag(t *testing.T, db *gorm.DB, name string, kind models.TagKind) *models.Tag {
+	t.Helper()
+	tag := &models.Tag{ID: uuid.New(), Name: name, Kind: string(kind)}
+	require.NoError(t, db.Create(tag).Error)
+	return tag
+}
+
+func tagNames(categories []dto.TagResponse) []string {
+	names := make([]string, 0, len(categories))
+	for _, category := range categories {
+		names = append(names, category.Name)
+	}
+	return names
+}
+
+func TestCreateEvent_PersistsCategories(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+	creator := createTestUser(t, db, "category-creator")
+	food := createKindTag(t, db, "Food trip", models.TagKindCategory)
+	nature := createKindTag(t, db, "Nature", models.TagKindCategory)
+
+	created, err := eventService.CreateEvent(creator.ID.String(), dto.CreateEventRequest{
+		Title:       "Market crawl",
+		EventType:   string(models.EventTypeMeal),
+		CategoryIDs: []string{food.ID.String(), nature.ID.String(), food.ID.String()},
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"Food trip", "Nature"}, tagNames(created.Categories))
+
+	var count int64
+	require.NoError(t, db.Model(&models.EventCategory{}).Where("event_id = ?", created.ID).Count(&count).Error)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestCreateEvent_RejectsInvalidCategories(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+	creator := createTestUser(t, db, "bad-category-creator")
+	category := createKindTag(t, db, "Beach", models.TagKindCategory)
+	activity := createKindTag(t, db, "Hiking", models.TagKindActivity)
+
+	cases := map[string]struct {
+		ids    []string
+		errMsg string
+	}{
+		"malformed id":     {[]string{"not-a-uuid"}, "invalid category ID: not-a-uuid"},
+		"unknown id":       {[]string{category.ID.String(), uuid.Nil.String()}, "category not found: " + uuid.Nil.String()},
+		"non-category tag": {[]string{activity.ID.String()}, "tag is not a category: " + activity.ID.String()},
+	}
+	for name, tc := range cases {
+		_, err := eventService.CreateEvent(creator.ID.String(), dto.CreateEventRequest{
+			Title:       "Rejected " + name,
+			EventType:   string(models.EventTypeMeal),
+			CategoryIDs: tc.ids,
+		})
+		require.Error(t, err, name)
+		assert.Equal(t, tc.errMsg, err.Error(), name)
+	}
+
+	// Nothing is created when categories are rejected
+	var events int64
+	require.NoError(t, db.Model(&models.Event{}).Where("creator_id = ?", creator.ID).Count(&events).Error)
+	assert.Zero(t, events)
+}
+
+func TestUpdateEvent_ReplacesCategories(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+	creator := createTestUser(t, db, "category-updater")
+	event := createTestEvent(t, db, creator, "Weekend away")
+	city := createKindTag(t, db, "City", models.TagKindCategory)
+	mountain := createKindTag(t, db, "Mountain", models.TagKindCategory)
+	location := createKindTag(t, db, "Chiang Mai", models.TagKindLocation)
+	require.NoError(t, db.Create(&models.EventCategory{EventID: event.ID, TagID: city.ID}).Error)
+
+	updated, err := eventService.UpdateEvent(event.ID.String(), creator.ID.String(), dto.UpdateEventRequest{
+		CategoryIDs: []string{mountain.ID.String()},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Mountain"}, tagNames(updated.Categories))
+
+	// A rejected update leaves the existing categories and fields untouched
+	title := "Renamed"
+	_, err = eventService.UpdateEvent(event.ID.String(), creator.ID.String(), dto.UpdateEventRequest{
+		Title:       &title,
+		CategoryIDs: []string{location.ID.String()},
+	})
+	require.Error(t, err)
+	assert.Equal(t, "tag is not a category: "+location.ID.String(), err.Error())
+
+	var stored models.Event
+	require.NoError(t, db.Preload("Categories").First(&stored, "id = ?", event.ID).Error)
+	assert.Equal(t, "Weekend away", stored.Title)
+	require.Len(t, stored.Categories, 1)
+	assert.Equal(t, mountain.ID, stored.Categories[0].TagID)
+
+	// An empty list clears all categories; omitting the field keeps them
+	_, err = eventService.UpdateEvent(event.ID.String(), creator.ID.String(), dto.UpdateEventRequest{Title: &title})
+	require.NoError(t, err)
+	var count int64
+	require.NoError(t, db.Model(&models.EventCategory{}).Where("event_id = ?", event.ID).Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+
+	updated, err = eventService.UpdateEvent(event.ID.String(), creator.ID.String(), dto.UpdateEventRequest{CategoryIDs: []string{}})
+	require.NoError(t, err)
+	assert.Empty(t, updated.Categories)
+}
+
+// setRequireCategory turns the EVENTS_REQUIRE_CATEGORY policy on or off for one test
+func setRequireCategory(t *testing.T, on bool) {
+	t.Helper()
+	previous := config.AppConfig.Event.RequireCategory
+	config.AppConfig.Event.RequireCategory = on
+	t.Cleanup(func() { config.AppConfig.Event.RequireCategory = previous })
+}
+
+func TestCreateEvent_RequireCategoryPolicy(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+	creator := createTestUser(t, db, "policy-creator")
+	category := createKindTag(t, db, "City walk", models.TagKindCategory)
+
+	// Off: events without categories are created as before
+	setRequireCategory(t, false)
+	_, err := eventService.CreateEvent(creator.ID.String(), dto.CreateEventRequest{
+		Title:     "Uncategorized",
+		EventType: string(models.EventTypeMeal),
+	})
+	require.NoError(t, err)
+
+	// On: missing or empty categories are rejected and nothing is saved
+	setRequireCategory(t, true)
+	for _, ids := range [][]string{nil, {}} {
+		_, err = eventService.CreateEvent(creator.ID.String(), dto.CreateEventRequest{
+			Title:       "Rejected",
+			EventType:   string(models.EventTypeMeal),
+			CategoryIDs: ids,
+		})
+		require.Error(t, err)
+		assert.Equal(t, "at least one category is required", err.Error())
+	}
+	var rejected int64
+	require.NoError(t, db.Model(&models.Event{}).Where("title = ?", "Rejected").Count(&rejected).Error)
+	assert.Zero(t, rejected)
+
+	created, err := eventService.CreateEvent(creator.ID.String(), dto.CreateEventRequest{
+		Title:       "Categorized",
+		EventType:   string(models.EventTypeMeal),
+		CategoryIDs: []string{category.ID.String()},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"City walk"}, tagNames(created.Categories))
+}
+
+func TestUpdateEvent_RequireCategoryPolicy(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+	creator := createTestUser(t, db, "policy-update-creator")
+	category := createKindTag(t, db, "Museum", models.TagKindCategory)
+	setRequireCategory(t, true)
+
+	created, err := eventService.CreateEvent(creator.ID.String(), dto.CreateEventRequest{
+		Title:       "Gallery day",
+		EventType:   string(models.EventTypeOvernight),
+		CategoryIDs: []string{category.ID.String()},
+	})
+	require.NoError(t, err)
+
+	// Clearing the categories is refused while the policy is on
+	_, err = eventService.UpdateEvent(created.ID, creator.ID.String(), dto.UpdateEventRequest{CategoryIDs: []string{}})
+	require.Error(t, err)
+	assert.Equal(t, "at least one category is required", err.Error())
+
+	// Updates that leave categories alone still work
+	title := "Gallery night"
+	updated, err := eventService.UpdateEvent(created.ID, creator.ID.String(), dto.UpdateEventRequest{Title: &title})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Museum"}, tagNames(updated.Categories))
+}
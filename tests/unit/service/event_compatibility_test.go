@@ -0,0 +1,134 @@
+package service_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEventCompatibility_AggregatesConfirmedMembers(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "compat-creator")
+	viewer := createTestUser(t, db, "compat-viewer")
+	alice := createTestUser(t, db, "compat-alice")
+	pending := createTestUser(t, db, "compat-pending")
+	deleted := createTestUser(t, db, "compat-deleted")
+
+	hiking := createKindTag(t, db, "Hiking", models.TagKindActivity)
+	museum := createKindTag(t, db, "Museum", models.TagKindActivity)
+	karaoke := createKindTag(t, db, "Karaoke", models.TagKindActivity)
+	cafe := createTestInterest(t, db, "cafe_hopping", "cafe")
+	climbing := createTestInterest(t, db, "climbing", "sport")
+	for _, link := range []models.UserTag{
+		{UserID: viewer.ID, TagID: hiking.ID},
+		{UserID: viewer.ID, TagID: museum.ID},
+		{UserID: creator.ID, TagID: hiking.ID},
+		{UserID: creator.ID, TagID: karaoke.ID},
+		{UserID: alice.ID, TagID: hiking.ID},
+		{UserID: alice.ID, TagID: museum.ID},
+		{UserID: pending.ID, TagID: museum.ID},
+		{UserID: deleted.ID, TagID: museum.ID},
+	} {
+		require.NoError(t, db.Create(&link).Error)
+	}
+	for _, link := range []models.UserInterest{
+		{UserID: viewer.ID, InterestID: cafe.ID},
+		{UserID: viewer.ID, InterestID: climbing.ID},
+		{UserID: creator.ID, InterestID: cafe.ID},
+	} {
+		require.NoError(t, db.Create(&link).Error)
+	}
+
+	event := createTestEvent(t, db, creator, "Museum then hike")
+	addTestMember(t, db, event, viewer, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	addTestMember(t, db, event, alice, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	addTestMember(t, db, event, pending, models.MemberRoleParticipant, models.MemberStatusPending)
+	addTestMember(t, db, event, deleted, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	require.NoError(t, db.Model(deleted).Update("deleted_at", time.Now()).Error)
+
+	compatibility, err := service.NewTagService().GetEventCompatibility(viewer.ID.String(), event.ID.String())
+	require.NoError(t, err)
+
+	// The creator and Alice; not the viewer, pending members or deleted accounts
+	assert.Equal(t, 2, compatibility.MembersCompared)
+
+	// Tags: creator shares Hiking (50), Alice shares both (100).
+	// Interests: creator shares the cafe interest (0.9 of 1.7), Alice none.
+	assert.Equal(t, 75.0, compatibility.Components[service.MatchComponentTags])
+	assert.InDelta(t, 26.47, compatibility.Components[service.MatchComponentInterests], 0.01)
+	require.NotNil(t, compatibility.CompatibilityScore)
+	assert.InDelta(t, 50.74, *compatibility.CompatibilityScore, 0.01)
+
+	// Only what the viewer has in common, most widely shared first
+	require.Len(t, compatibility.SharedTags, 2)
+	assert.Equal(t, "Hiking", compatibility.SharedTags[0].Name)
+	assert.Equal(t, 2, compatibility.SharedTags[0].MemberCount)
+	assert.Equal(t, "Museum", compatibility.SharedTags[1].Name)
+	assert.Equal(t, 1, compatibility.SharedTags[1].MemberCount)
+	require.Len(t, compatibility.SharedInterests, 1)
+	assert.Equal(t, "cafe_hopping", compatibility.SharedInterests[0].Code)
+	assert.Equal(t, 1, compatibility.SharedInterests[0].MemberCount)
+}
+
+func TestGetEventCompatibility_TooFewMembers(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "solo-creator")
+	viewer := createTestUser(t, db, "solo-viewer")
+	hiking := createKindTag(t, db, "Hiking", models.TagKindActivity)
+	require.NoError(t, db.Create(&models.UserTag{UserID: viewer.ID, TagID: hiking.ID}).Error)
+	require.NoError(t, db.Create(&models.UserTag{UserID: creator.ID, TagID: hiking.ID}).Error)
+	event := createTestEvent(t, db, creator, "Just me so far")
+
+	// A lone creator's tags would be exposed by the aggregate, so nothing is reported
+	compatibility, err := service.NewTagService().GetEventCompatibility(viewer.ID.String(), event.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, 1, compatibility.MembersCompared)
+	assert.Nil(t, compatibility.CompatibilityScore)
+	assert.Empty(t, compatibility.SharedTags)
+	assert.Empty(t, compatibility.SharedInterests)
+}
+
+func TestGetEventCompatibility_Endpoint(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "endpoint-compat-creator")
+	viewer := createTestUser(t, db, "endpoint-compat-viewer")
+	alice := createTestUser(t, db, "endpoint-compat-alice")
+	hiking := createKindTag(t, db, "Hiking", models.TagKindActivity)
+	for _, user := range []*models.User{viewer, creator, alice} {
+		require.NoError(t, db.Create(&models.UserTag{UserID: user.ID, TagID: hiking.ID}).Error)
+	}
+	event := createTestEvent(t, db, creator, "Ridge walk")
+	addTestMember(t, db, event, alice, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/events/:id/compatibility", func(c *gin.Context) {
+		c.Set("user_id", viewer.ID.String())
+		c.Next()
+	}, handlers.NewEventHandler().GetEventCompatibility)
+
+	w := serveEventRequest(router, http.MethodGet, "/events/"+event.ID.String()+"/compatibility", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	// Full tag overlap; interests score neutral (50) when the viewer has none, as in event matches
+	assert.Contains(t, w.Body.String(), `"compatibility_score":75`)
+
+	// Members are never identified
+	for _, member := range []*models.User{creator, alice} {
+		assert.NotContains(t, w.Body.String(), member.ID.String())
+		assert.NotContains(t, w.Body.String(), *member.DisplayName)
+	}
+
+	w = serveEventRequest(router, http.MethodGet, "/events/not-a-uuid/compatibility", "")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = serveEventRequest(router, http.MethodGet, "/events/"+uuid.New().String()+"/compatibility", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
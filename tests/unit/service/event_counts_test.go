@@ -0,0 +1,82 @@
+package service_test
+
+import (
+	"testing"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventService_GetEventCounts(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "creator")
+	viewer := createTestUser(t, db, "viewer")
+
+	meal1 := createTestEvent(t, db, creator, "Meal 1")
+	createTestEvent(t, db, creator, "Meal 2")
+	trip := createTestEvent(t, db, creator, "Trip")
+	require.NoError(t, db.Model(trip).Update("event_type", models.EventTypeDaytrip).Error)
+	cancelled := createTestEvent(t, db, creator, "Cancelled meal")
+	require.NoError(t, db.Model(cancelled).Update("status", models.EventStatusCancelled).Error)
+	deleted := createTestEvent(t, db, creator, "Deleted")
+	require.NoError(t, db.Model(deleted).Update("deleted_at", deleted.CreatedAt).Error)
+
+	addTestMember(t, db, meal1, viewer, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	addTestMember(t, db, trip, viewer, models.MemberRoleParticipant, models.MemberStatusPending)
+
+	t.Run("All events", func(t *testing.T) {
+		counts, err := eventService.GetEventCounts(viewer.ID.String(), "", "", "", "")
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), counts.Total)
+		assert.Equal(t, int64(3), counts.ByEventType["meal"])
+		assert.Equal(t, int64(1), counts.ByEventType["one_day_trip"])
+		assert.Equal(t, int64(3), counts.ByStatus["published"])
+		assert.Equal(t, int64(1), counts.ByStatus["cancelled"])
+		assert.Len(t, counts.Groups, 3)
+	})
+
+	t.Run("Public scope only counts published", func(t *testing.T) {
+		counts, err := eventService.GetEventCounts("", "public", "", "", "")
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), counts.Total)
+		assert.Zero(t, counts.ByStatus["cancelled"])
+	})
+
+	t.Run("Joined scope with event type filter", func(t *testing.T) {
+		counts, err := eventService.GetEventCounts(viewer.ID.String(), "joined", "", "", "")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), counts.Total)
+
+		counts, err = eventService.GetEventCounts(viewer.ID.String(), "joined", "meal", "", "")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), counts.Total)
+		assert.Equal(t, int64(1), counts.ByEventType["meal"])
+	})
+
+	t.Run("Search narrows the counts", func(t *testing.T) {
+		counts, err := eventService.GetEventCounts(viewer.ID.String(), "", "", "", "MEAL")
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), counts.Total)
+		assert.Equal(t, int64(3), counts.ByEventType["meal"])
+		assert.Zero(t, counts.ByEventType["one_day_trip"])
+
+		counts, err = eventService.GetEventCounts(viewer.ID.String(), "joined", "", "", "meal 1")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), counts.Total)
+
+		counts, err = eventService.GetEventCounts("", "public", "", "", "nothing like this")
+		require.NoError(t, err)
+		assert.Zero(t, counts.Total)
+		assert.Empty(t, counts.Groups)
+	})
+
+	t.Run("Invalid scope", func(t *testing.T) {
+		_, err := eventService.GetEventCounts("", "everything", "", "", "")
+		assert.EqualError(t, err, "invalid scope")
+	})
+}
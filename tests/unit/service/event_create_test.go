@@ -0,0 +1,72 @@
+package service_test
+
+import (
+	"testing"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateEvent_CreatorMemberInsertIsRetrySafe(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+	creator := createTestUser(t, db, "retry-creator")
+
+	created, err := eventService.CreateEvent(creator.ID.String(), dto.CreateEventRequest{
+		Title:     "Retry dinner",
+		EventType: string(models.EventTypeMeal),
+	})
+	require.NoError(t, err)
+	eventID := uuid.MustParse(created.ID)
+
+	// Replaying the creator insert, as a retried transaction would, must not fail or duplicate
+	require.NoError(t, service.AddCreatorMember(db, eventID, creator.ID))
+	require.NoError(t, service.AddCreatorMember(db, eventID, creator.ID))
+
+	var members []models.EventMember
+	require.NoError(t, db.Where("event_id = ?", eventID).Find(&members).Error)
+	require.Len(t, members, 1)
+	assert.Equal(t, models.MemberRoleCreator, members[0].Role)
+	assert.Equal(t, models.MemberStatusConfirmed, members[0].Status)
+}
+
+func TestAddCreatorMember_PromotesExistingRow(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "promote-creator")
+	event := &models.Event{CreatorID: creator.ID, Title: "Hike", EventType: models.EventTypeMeal, Status: models.EventStatusPublished}
+	require.NoError(t, db.Create(event).Error)
+	addTestMember(t, db, event, creator, models.MemberRoleParticipant, models.MemberStatusPending)
+
+	require.NoError(t, service.AddCreatorMember(db, event.ID, creator.ID))
+
+	var member models.EventMember
+	require.NoError(t, db.Where("event_id = ? AND user_id = ?", event.ID, creator.ID).First(&member).Error)
+	assert.Equal(t, models.MemberRoleCreator, member.Role)
+	assert.Equal(t, models.MemberStatusConfirmed, member.Status)
+}
+
+func TestCreateEvent_RollsBackOnFailure(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+	creator := createTestUser(t, db, "rollback-creator")
+
+	// Break the last step of the creation transaction
+	require.NoError(t, db.Exec("DROP TABLE chat_rooms").Error)
+
+	_, err := eventService.CreateEvent(creator.ID.String(), dto.CreateEventRequest{
+		Title:     "Doomed trip",
+		EventType: string(models.EventTypeMeal),
+	})
+	require.Error(t, err)
+
+	var events, members int64
+	db.Model(&models.Event{}).Where("creator_id = ?", creator.ID).Count(&events)
+	db.Model(&models.EventMember{}).Where("user_id = ?", creator.ID).Count(&members)
+	assert.Zero(t, events)
+	assert.Zero(t, members)
+}
@@ -0,0 +1,173 @@
+package service_test
+
+import (
+	"testing"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// applySettlements returns the balances left after making every settlement payment
+func applySettlements(balances map[string]int64, settlements []dto.ExpenseSettlementResponse) map[string]int64 {
+	left := make(map[string]int64, len(balances))
+	for userID, balance := range balances {
+		left[userID] = balance
+	}
+	for _, s := range settlements {
+		left[s.FromUserID] += s.Amount
+		left[s.ToUserID] -= s.Amount
+	}
+	return left
+}
+
+func TestComputeSettlements(t *testing.T) {
+	t.Run("Nothing to settle", func(t *testing.T) {
+		settlements := service.ComputeSettlements(map[string]int64{"a": 0, "b": 0})
+		assert.Empty(t, settlements)
+		assert.NotNil(t, settlements)
+	})
+
+	t.Run("One payer covers everyone", func(t *testing.T) {
+		settlements := service.ComputeSettlements(map[string]int64{"a": 200, "b": -100, "c": -100})
+		assert.Equal(t, []dto.ExpenseSettlementResponse{
+			{FromUserID: "b", ToUserID: "a", Amount: 100},
+			{FromUserID: "c", ToUserID: "a", Amount: 100},
+		}, settlements)
+	})
+
+	t.Run("Matching debts are paired directly", func(t *testing.T) {
+		// Greedy alone would route d's 50 through a and need three payments
+		settlements := service.ComputeSettlements(map[string]int64{"a": 50, "b": 30, "c": -30, "d": -50})
+		assert.ElementsMatch(t, []dto.ExpenseSettlementResponse{
+			{FromUserID: "c", ToUserID: "b", Amount: 30},
+			{FromUserID: "d", ToUserID: "a", Amount: 50},
+		}, settlements)
+	})
+
+	t.Run("Everyone ends at zero in at most n-1 payments", func(t *testing.T) {
+		balances := map[string]int64{"a": 731, "b": -120, "c": 89, "d": -455, "e": -245, "f": 0}
+		settlements := service.ComputeSettlements(balances)
+		assert.LessOrEqual(t, len(settlements), 4)
+		for userID, left := range applySettlements(balances, settlements) {
+			assert.Zero(t, left, userID)
+		}
+		for _, s := range settlements {
+			assert.Positive(t, s.Amount)
+		}
+	})
+}
+
+func TestEventExpenseService_Summary(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Creator")
+	alice := createTestUser(t, db, "Alice")
+	bob := createTestUser(t, db, "Bob")
+	event := createTestEvent(t, db, creator, "Island Trip")
+	addTestMember(t, db, event, alice, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	addTestMember(t, db, event, bob, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+
+	svc := service.NewEventExpenseService()
+	boat, err := svc.AddExpense(event.ID.String(), alice.ID.String(), dto.CreateEventExpenseRequest{
+		Description: "Boat",
+		Amount:      100,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, alice.ID.String(), boat.PayerID)
+	assert.Equal(t, "Alice", boat.PayerName)
+	require.Len(t, boat.Shares, 3)
+	var shareTotal int64
+	for _, share := range boat.Shares {
+		shareTotal += share.Amount
+		assert.Contains(t, []int64{33, 34}, share.Amount)
+	}
+	assert.Equal(t, int64(100), shareTotal)
+
+	bobID := bob.ID.String()
+	_, err = svc.AddExpense(event.ID.String(), alice.ID.String(), dto.CreateEventExpenseRequest{
+		Description: "Drinks",
+		Amount:      60,
+		PayerID:     &bobID,
+		SplitAmong:  []string{alice.ID.String(), bobID, alice.ID.String()},
+	})
+	require.NoError(t, err)
+
+	summary, err := svc.GetSummary(event.ID.String(), creator.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, int64(160), summary.Total)
+	require.Len(t, summary.Balances, 3)
+
+	balances := make(map[string]int64)
+	var net int64
+	for _, b := range summary.Balances {
+		assert.Equal(t, b.Paid-b.Owed, b.Balance)
+		balances[b.UserID] = b.Balance
+		net += b.Balance
+	}
+	assert.Zero(t, net)
+	assert.Less(t, balances[creator.ID.String()], int64(0))
+	assert.Greater(t, balances[alice.ID.String()], int64(0))
+
+	require.NotEmpty(t, summary.Settlements)
+	for userID, left := range applySettlements(balances, summary.Settlements) {
+		assert.Zero(t, left, userID)
+	}
+
+	expenses, err := svc.ListExpenses(event.ID.String(), bobID)
+	require.NoError(t, err)
+	require.Len(t, expenses, 2)
+	shareCounts := make(map[string]int)
+	for _, expense := range expenses {
+		shareCounts[expense.Description] = len(expense.Shares)
+	}
+	assert.Equal(t, map[string]int{"Boat": 3, "Drinks": 2}, shareCounts)
+}
+
+func TestEventExpenseService_Validation(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Creator")
+	member := createTestUser(t, db, "Member")
+	pending := createTestUser(t, db, "Pending")
+	outsider := createTestUser(t, db, "Outsider")
+	event := createTestEvent(t, db, creator, "Island Trip")
+	addTestMember(t, db, event, member, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	addTestMember(t, db, event, pending, models.MemberRoleParticipant, models.MemberStatusPending)
+
+	svc := service.NewEventExpenseService()
+	req := dto.CreateEventExpenseRequest{Description: "Fuel", Amount: 500}
+
+	_, err := svc.AddExpense(event.ID.String(), outsider.ID.String(), req)
+	assert.EqualError(t, err, "not a member")
+	_, err = svc.GetSummary(event.ID.String(), pending.ID.String())
+	assert.EqualError(t, err, "not a confirmed member")
+
+	pendingID := pending.ID.String()
+	withPayer := req
+	withPayer.PayerID = &pendingID
+	_, err = svc.AddExpense(event.ID.String(), member.ID.String(), withPayer)
+	assert.EqualError(t, err, "payer must be a confirmed member")
+
+	withSplit := req
+	withSplit.SplitAmong = []string{member.ID.String(), outsider.ID.String()}
+	_, err = svc.AddExpense(event.ID.String(), member.ID.String(), withSplit)
+	assert.EqualError(t, err, "split members must be confirmed members")
+
+	t.Run("Only the payer, recorder or creator can delete", func(t *testing.T) {
+		expense, err := svc.AddExpense(event.ID.String(), creator.ID.String(), req)
+		require.NoError(t, err)
+
+		err = svc.DeleteExpense(event.ID.String(), expense.ID, member.ID.String())
+		assert.EqualError(t, err, "not authorized")
+
+		require.NoError(t, svc.DeleteExpense(event.ID.String(), expense.ID, creator.ID.String()))
+		var shares int64
+		db.Model(&models.EventExpenseShare{}).Where("expense_id = ?", expense.ID).Count(&shares)
+		assert.Zero(t, shares)
+
+		err = svc.DeleteExpense(event.ID.String(), expense.ID, creator.ID.String())
+		assert.EqualError(t, err, "expense not found")
+	})
+}
@@ -0,0 +1,479 @@
+package service_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/database"
+	"TinderTrip-Backend/tests/testdb"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupEventDomainDB creates an isolated database with the event related tables
+func setupEventDomainDB(t *testing.T) *gorm.DB {
+	// Each test gets its own database file
+	db, err := gorm.Open(sqlite.Open(testdb.SQLiteDSN(t)), &gorm.Config{
+		DisableForeignKeyConstraintWhenMigrating: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sqlDB, _ := db.DB()
+	t.Cleanup(func() { sqlDB.Close() })
+
+	// Create simplified tables for testing (SQLite compatible)
+	tables := map[string]string{
+		"users": `CREATE TABLE IF NOT EXISTS users (
+			id TEXT PRIMARY KEY,
+			email TEXT UNIQUE,
+			provider TEXT NOT NULL,
+			password_hash TEXT,
+			email_verified BOOLEAN NOT NULL DEFAULT 0,
+			google_id TEXT,
+			display_name TEXT,
+			display_name_shared BOOLEAN NOT NULL DEFAULT 0,
+			handle TEXT,
+			handle_changed_at DATETIME,
+			phone TEXT,
+			phone_verified BOOLEAN NOT NULL DEFAULT 0,
+			otp_channel TEXT,
+			last_login_at DATETIME,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			deleted_at DATETIME
+		)`,
+		"user_profiles": `CREATE TABLE IF NOT EXISTS user_profiles (
+			id TEXT PRIMARY KEY,
+			user_id TEXT NOT NULL UNIQUE,
+			bio TEXT,
+			languages TEXT,
+			date_of_birth DATE,
+			gender TEXT,
+			job_title TEXT,
+			smoking TEXT,
+			interests_note TEXT,
+			avatar_url TEXT,
+			home_location TEXT,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			deleted_at DATETIME
+		)`,
+		"events": `CREATE TABLE IF NOT EXISTS events (
+			id TEXT PRIMARY KEY,
+			creator_id TEXT NOT NULL,
+			title TEXT NOT NULL,
+			description TEXT,
+			event_type TEXT NOT NULL DEFAULT 'meal',
+			address_text TEXT,
+			lat REAL,
+			lng REAL,
+			start_at DATETIME,
+			end_at DATETIME,
+			capacity INTEGER,
+			min_attendees INTEGER,
+			confirmed_member_count INTEGER NOT NULL DEFAULT 0,
+			budget_min INTEGER,
+			budget_max INTEGER,
+			currency TEXT DEFAULT 'THB',
+			status TEXT NOT NULL DEFAULT 'published',
+			cover_image_url TEXT,
+			checkin_code TEXT,
+			allow_member_invites BOOLEAN NOT NULL DEFAULT 1,
+			pending_chat_access BOOLEAN NOT NULL DEFAULT 1,
+			members_visible BOOLEAN NOT NULL DEFAULT 1,
+			cancellation_reason TEXT,
+			cancelled_at DATETIME,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			deleted_at DATETIME
+		)`,
+		"event_members": `CREATE TABLE IF NOT EXISTS event_members (
+			event_id TEXT NOT NULL,
+			user_id TEXT NOT NULL,
+			role TEXT NOT NULL DEFAULT 'participant',
+			status TEXT NOT NULL DEFAULT 'pending',
+			joined_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			confirmed_at DATETIME,
+			left_at DATETIME,
+			note TEXT,
+			confirmation_message_id TEXT,
+			checked_in_at DATETIME,
+			confirmation_email_at DATETIME,
+			PRIMARY KEY (event_id, user_id)
+		)`,
+		"event_swipes": `CREATE TABLE IF NOT EXISTS event_swipes (
+			user_id TEXT NOT NULL,
+			event_id TEXT NOT NULL,
+			direction TEXT NOT NULL,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (user_id, event_id)
+		)`,
+		"event_impressions": `CREATE TABLE IF NOT EXISTS event_impressions (
+			event_id TEXT NOT NULL,
+			user_id TEXT NOT NULL,
+			first_served_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			last_served_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			served_count INTEGER NOT NULL DEFAULT 1,
+			PRIMARY KEY (event_id, user_id)
+		)`,
+		"guest_sessions": `CREATE TABLE IF NOT EXISTS guest_sessions (
+			id TEXT PRIMARY KEY,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			last_seen_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			expires_at DATETIME NOT NULL,
+			converted_user_id TEXT,
+			converted_at DATETIME
+		)`,
+		"guest_event_views": `CREATE TABLE IF NOT EXISTS guest_event_views (
+			guest_id TEXT NOT NULL,
+			event_id TEXT NOT NULL,
+			first_seen_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			last_seen_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			seen_count INTEGER NOT NULL DEFAULT 1,
+			PRIMARY KEY (guest_id, event_id)
+		)`,
+		"user_event_interests": `CREATE TABLE IF NOT EXISTS user_event_interests (
+			user_id TEXT NOT NULL,
+			event_id TEXT NOT NULL,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (user_id, event_id)
+		)`,
+		"event_photos": `CREATE TABLE IF NOT EXISTS event_photos (
+			id TEXT PRIMARY KEY,
+			event_id TEXT NOT NULL,
+			url TEXT NOT NULL,
+			sort_no INTEGER,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		"tags": `CREATE TABLE IF NOT EXISTS tags (
+			id TEXT PRIMARY KEY,
+			name TEXT NOT NULL UNIQUE,
+			kind TEXT NOT NULL,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		"event_categories": `CREATE TABLE IF NOT EXISTS event_categories (
+			event_id TEXT NOT NULL,
+			tag_id TEXT NOT NULL,
+			PRIMARY KEY (event_id, tag_id)
+		)`,
+		"event_tags": `CREATE TABLE IF NOT EXISTS event_tags (
+			event_id TEXT NOT NULL,
+			tag_id TEXT NOT NULL,
+			PRIMARY KEY (event_id, tag_id)
+		)`,
+		"user_tags": `CREATE TABLE IF NOT EXISTS user_tags (
+			user_id TEXT NOT NULL,
+			tag_id TEXT NOT NULL,
+			PRIMARY KEY (user_id, tag_id)
+		)`,
+		"interests": `CREATE TABLE IF NOT EXISTS interests (
+			id TEXT PRIMARY KEY,
+			code TEXT NOT NULL UNIQUE,
+			display_name TEXT NOT NULL,
+			icon TEXT,
+			category TEXT NOT NULL,
+			sort_order INTEGER DEFAULT 0,
+			is_active BOOLEAN DEFAULT 1,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		"user_interests": `CREATE TABLE IF NOT EXISTS user_interests (
+			user_id TEXT NOT NULL,
+			interest_id TEXT NOT NULL,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (user_id, interest_id)
+		)`,
+		"event_interests": `CREATE TABLE IF NOT EXISTS event_interests (
+			event_id TEXT NOT NULL,
+			interest_id TEXT NOT NULL,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (event_id, interest_id)
+		)`,
+		"pref_budget": `CREATE TABLE IF NOT EXISTS pref_budget (
+			id TEXT PRIMARY KEY,
+			user_id TEXT NOT NULL UNIQUE,
+			meal_min INTEGER,
+			meal_max INTEGER,
+			daytrip_min INTEGER,
+			daytrip_max INTEGER,
+			overnight_min INTEGER,
+			overnight_max INTEGER,
+			unlimited BOOLEAN NOT NULL DEFAULT 0,
+			currency TEXT NOT NULL DEFAULT 'THB',
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		"travel_preferences": `CREATE TABLE IF NOT EXISTS travel_preferences (
+			id TEXT PRIMARY KEY,
+			user_id TEXT NOT NULL,
+			travel_style TEXT NOT NULL,
+			created_at DATETIME,
+			updated_at DATETIME,
+			deleted_at DATETIME
+		)`,
+		"food_preferences": `CREATE TABLE IF NOT EXISTS food_preferences (
+			id TEXT PRIMARY KEY,
+			user_id TEXT NOT NULL,
+			food_category TEXT NOT NULL,
+			preference_level INTEGER NOT NULL,
+			created_at DATETIME,
+			updated_at DATETIME,
+			deleted_at DATETIME
+		)`,
+		"user_top_picks": `CREATE TABLE IF NOT EXISTS user_top_picks (
+			user_id TEXT NOT NULL,
+			event_id TEXT NOT NULL,
+			rank INTEGER NOT NULL,
+			match_score REAL NOT NULL,
+			computed_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (user_id, event_id)
+		)`,
+		"event_items": `CREATE TABLE IF NOT EXISTS event_items (
+			id TEXT PRIMARY KEY,
+			event_id TEXT NOT NULL,
+			name TEXT NOT NULL,
+			added_by TEXT NOT NULL,
+			claimed_by TEXT,
+			claimed_at DATETIME,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		"event_expenses": `CREATE TABLE IF NOT EXISTS event_expenses (
+			id TEXT PRIMARY KEY,
+			event_id TEXT NOT NULL,
+			payer_id TEXT NOT NULL,
+			amount INTEGER NOT NULL,
+			description TEXT NOT NULL,
+			created_by TEXT NOT NULL,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		"event_expense_shares": `CREATE TABLE IF NOT EXISTS event_expense_shares (
+			expense_id TEXT NOT NULL,
+			user_id TEXT NOT NULL,
+			amount INTEGER NOT NULL,
+			PRIMARY KEY (expense_id, user_id)
+		)`,
+		"chat_rooms": `CREATE TABLE IF NOT EXISTS chat_rooms (
+			id TEXT PRIMARY KEY,
+			event_id TEXT NOT NULL UNIQUE,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		"chat_messages": `CREATE TABLE IF NOT EXISTS chat_messages (
+			id TEXT PRIMARY KEY,
+			room_id TEXT NOT NULL,
+			sender_id TEXT NOT NULL,
+			body TEXT,
+			message_type TEXT,
+			image_url TEXT,
+			file_url TEXT,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		"device_tokens": `CREATE TABLE IF NOT EXISTS device_tokens (
+			id TEXT PRIMARY KEY,
+			user_id TEXT NOT NULL,
+			token TEXT NOT NULL UNIQUE,
+			platform TEXT NOT NULL,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			last_seen_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		"reminder_snoozes": `CREATE TABLE IF NOT EXISTS reminder_snoozes (
+			id TEXT PRIMARY KEY,
+			user_id TEXT NOT NULL,
+			event_id TEXT NOT NULL,
+			notification_id TEXT NOT NULL UNIQUE,
+			due_at DATETIME NOT NULL,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		"chat_message_mentions": `CREATE TABLE IF NOT EXISTS chat_message_mentions (
+			message_id TEXT NOT NULL,
+			user_id TEXT NOT NULL,
+			start_offset INTEGER NOT NULL,
+			length INTEGER NOT NULL,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (message_id, user_id)
+		)`,
+		"user_event_history": `CREATE TABLE IF NOT EXISTS user_event_history (
+			id TEXT PRIMARY KEY,
+			event_id TEXT NOT NULL,
+			user_id TEXT NOT NULL,
+			completed BOOLEAN NOT NULL DEFAULT 0,
+			completed_at DATETIME,
+			attended BOOLEAN NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		"audit_logs": `CREATE TABLE IF NOT EXISTS audit_logs (
+			id TEXT PRIMARY KEY,
+			actor_user_id TEXT,
+			entity_table TEXT NOT NULL,
+			entity_id TEXT,
+			action TEXT NOT NULL,
+			before_data TEXT,
+			after_data TEXT,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		"notifications": `CREATE TABLE IF NOT EXISTS notifications (
+			id TEXT PRIMARY KEY,
+			user_id TEXT NOT NULL,
+			title TEXT NOT NULL,
+			body TEXT NOT NULL,
+			type TEXT NOT NULL,
+			data TEXT,
+			read BOOLEAN DEFAULT 0,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			read_at DATETIME
+		)`,
+		"notification_deliveries": `CREATE TABLE IF NOT EXISTS notification_deliveries (
+			id TEXT PRIMARY KEY,
+			notification_id TEXT NOT NULL,
+			channel TEXT NOT NULL,
+			status TEXT NOT NULL,
+			detail TEXT,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		"webhooks": `CREATE TABLE IF NOT EXISTS webhooks (
+			id TEXT PRIMARY KEY,
+			owner_user_id TEXT NOT NULL,
+			url TEXT NOT NULL,
+			secret TEXT NOT NULL,
+			events TEXT,
+			active BOOLEAN NOT NULL DEFAULT 1,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			deleted_at DATETIME
+		)`,
+		"webhook_deliveries": `CREATE TABLE IF NOT EXISTS webhook_deliveries (
+			id TEXT PRIMARY KEY,
+			webhook_id TEXT NOT NULL,
+			event_name TEXT NOT NULL,
+			payload TEXT NOT NULL,
+			status TEXT NOT NULL DEFAULT 'pending',
+			attempts INTEGER NOT NULL DEFAULT 0,
+			last_status_code INTEGER,
+			last_error TEXT,
+			next_attempt_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			delivered_at DATETIME,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		"storage_deletions": `CREATE TABLE IF NOT EXISTS storage_deletions (
+			id TEXT PRIMARY KEY,
+			object_url TEXT NOT NULL,
+			status TEXT NOT NULL DEFAULT 'pending',
+			attempts INTEGER NOT NULL DEFAULT 0,
+			last_error TEXT,
+			next_attempt_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		"email_outbox": `CREATE TABLE IF NOT EXISTS email_outbox (
+			id TEXT PRIMARY KEY,
+			kind TEXT NOT NULL,
+			recipient TEXT NOT NULL,
+			data TEXT,
+			status TEXT NOT NULL DEFAULT 'pending',
+			attempts INTEGER NOT NULL DEFAULT 0,
+			last_error TEXT,
+			next_attempt_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		"phone_verifications": `CREATE TABLE IF NOT EXISTS phone_verifications (
+			id TEXT PRIMARY KEY,
+			user_id TEXT NOT NULL,
+			phone TEXT NOT NULL,
+			otp TEXT NOT NULL,
+			expires_at DATETIME NOT NULL,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		"event_no_shows": `CREATE TABLE IF NOT EXISTS event_no_shows (
+			event_id TEXT NOT NULL,
+			reported_user_id TEXT NOT NULL,
+			reporter_id TEXT NOT NULL,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (event_id, reported_user_id, reporter_id)
+		)`,
+		"event_broadcasts": `CREATE TABLE IF NOT EXISTS event_broadcasts (
+			id TEXT PRIMARY KEY,
+			event_id TEXT NOT NULL,
+			sender_id TEXT NOT NULL,
+			title TEXT NOT NULL,
+			body TEXT NOT NULL,
+			recipients INTEGER NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+	}
+	for name, ddl := range tables {
+		if _, err := sqlDB.Exec(ddl); err != nil {
+			t.Fatalf("Failed to create %s table: %v", name, err)
+		}
+	}
+
+	// Set global DB and config for testing. Notifications the test sent in the background
+	// finish against this database before the next test replaces it.
+	database.DB = db
+	if config.AppConfig == nil {
+		config.AppConfig = &config.Config{}
+	}
+	t.Cleanup(service.WaitForBackgroundSends)
+
+	return db
+}
+
+// createTestUser inserts a password user with a unique email
+func createTestUser(t *testing.T, db *gorm.DB, displayName string) *models.User {
+	email := fmt.Sprintf("%s-%d@example.com", uuid.New().String()[:8], time.Now().UnixNano())
+	user := &models.User{
+		Email:       &email,
+		Provider:    models.AuthProviderPassword,
+		DisplayName: &displayName,
+	}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	return user
+}
+
+// createTestEvent inserts a published event owned by creator, with the creator as confirmed member
+func createTestEvent(t *testing.T, db *gorm.DB, creator *models.User, title string) *models.Event {
+	event := &models.Event{
+		CreatorID: creator.ID,
+		Title:     title,
+		EventType: models.EventTypeMeal,
+		Status:    models.EventStatusPublished,
+	}
+	if err := db.Create(event).Error; err != nil {
+		t.Fatalf("Failed to create event: %v", err)
+	}
+	addTestMember(t, db, event, creator, models.MemberRoleCreator, models.MemberStatusConfirmed)
+	return event
+}
+
+// addTestMember inserts a member row for the given event and user, counting confirmed members
+func addTestMember(t *testing.T, db *gorm.DB, event *models.Event, user *models.User, role models.MemberRole, status models.MemberStatus) {
+	member := &models.EventMember{
+		EventID:  event.ID,
+		UserID:   user.ID,
+		Role:     role,
+		Status:   status,
+		JoinedAt: time.Now(),
+	}
+	if err := db.Create(member).Error; err != nil {
+		t.Fatalf("Failed to create member: %v", err)
+	}
+	// Keep the counter the member services maintain in step with the inserted row
+	if status == models.MemberStatusConfirmed {
+		err := db.Model(&models.Event{}).Where("id = ?", event.ID).
+			UpdateColumn("confirmed_member_count", gorm.Expr("confirmed_member_count + 1")).Error
+		if err != nil {
+			t.Fatalf("Failed to count member: %v", err)
+		}
+	}
+}
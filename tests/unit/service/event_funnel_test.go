@@ -0,0 +1,148 @@
+package service_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// funnelServedAt is when the seeded funnel's suggestions are served
+var funnelServedAt = time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+// seedFunnel serves the event to four users, three of whom like it, two join, one is confirmed
+// and attends. Returns the creator and the event.
+func seedFunnel(t *testing.T, db *gorm.DB) (*models.User, *models.Event) {
+	t.Helper()
+	eventService := service.NewEventService()
+	tagService := service.NewTagServiceWithClock(utils.NewFakeClock(funnelServedAt))
+
+	creator := createTestUser(t, db, "funnel-creator")
+	event := createTestEvent(t, db, creator, "Sunrise hike")
+
+	var users []*models.User
+	for _, name := range []string{"seen-only", "liked-only", "joined", "attended"} {
+		user := createTestUser(t, db, name)
+		users = append(users, user)
+		_, _, err := tagService.GetEventSuggestions(user.ID.String(), 1, 10)
+		require.NoError(t, err)
+	}
+	// Served again to the same user is still one impression
+	_, _, err := tagService.GetEventSuggestions(users[0].ID.String(), 1, 10)
+	require.NoError(t, err)
+	// Impressions are recorded in the background
+	service.WaitForBackgroundSends()
+
+	for _, user := range users[1:] {
+		require.NoError(t, eventService.SwipeEvent(event.ID.String(), user.ID.String(), "like"))
+	}
+	addTestMember(t, db, event, users[2], models.MemberRoleParticipant, models.MemberStatusPending)
+	addTestMember(t, db, event, users[3], models.MemberRoleParticipant, models.MemberStatusConfirmed)
+
+	now := time.Now()
+	require.NoError(t, db.Create(&models.UserEventHistory{EventID: event.ID, UserID: users[3].ID, Completed: true, CompletedAt: &now, Attended: true}).Error)
+	require.NoError(t, db.Create(&models.UserEventHistory{EventID: event.ID, UserID: creator.ID, Completed: true, CompletedAt: &now, Attended: true}).Error)
+	require.NoError(t, db.Create(&models.UserEventHistory{EventID: event.ID, UserID: users[2].ID, Completed: true, CompletedAt: &now}).Error)
+
+	return creator, event
+}
+
+func TestGetEventFunnel(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+	creator, event := seedFunnel(t, db)
+
+	var served models.EventImpression
+	require.NoError(t, db.Joins("JOIN users ON users.id = event_impressions.user_id").
+		Where("event_impressions.event_id = ? AND users.display_name = ?", event.ID, "seen-only").
+		First(&served).Error)
+	assert.Equal(t, 2, served.ServedCount)
+	assert.True(t, served.FirstServedAt.Equal(funnelServedAt))
+
+	funnel, err := eventService.GetEventFunnel(event.ID.String(), creator.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, event.ID.String(), funnel.EventID)
+
+	var stages []string
+	var counts []int64
+	for _, stage := range funnel.Stages {
+		stages = append(stages, stage.Stage)
+		counts = append(counts, stage.Count)
+	}
+	assert.Equal(t, []string{"impressions", "likes", "joins", "confirmations", "attended"}, stages)
+	assert.Equal(t, []int64{4, 3, 2, 1, 1}, counts)
+
+	assert.Nil(t, funnel.Stages[0].DropOff)
+	assert.Nil(t, funnel.Stages[0].ConversionRate)
+	require.NotNil(t, funnel.Stages[1].DropOff)
+	assert.Equal(t, int64(1), *funnel.Stages[1].DropOff)
+	assert.Equal(t, 0.75, *funnel.Stages[1].ConversionRate)
+	assert.Equal(t, 0.667, *funnel.Stages[2].ConversionRate)
+	assert.Equal(t, 0.5, *funnel.Stages[3].ConversionRate)
+	assert.Equal(t, int64(0), *funnel.Stages[4].DropOff)
+	assert.Equal(t, 1.0, *funnel.Stages[4].ConversionRate)
+}
+
+func TestGetEventFunnel_EmptyAndAccess(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+	creator := createTestUser(t, db, "quiet-creator")
+	other := createTestUser(t, db, "curious")
+	event := createTestEvent(t, db, creator, "Quiet event")
+
+	// No conversion rate without anyone at the stage before
+	funnel, err := eventService.GetEventFunnel(event.ID.String(), creator.ID.String())
+	require.NoError(t, err)
+	for _, stage := range funnel.Stages {
+		assert.Zero(t, stage.Count, stage.Stage)
+		assert.Nil(t, stage.ConversionRate, stage.Stage)
+	}
+
+	_, err = eventService.GetEventFunnel(event.ID.String(), other.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "permission denied", err.Error())
+	_, err = eventService.GetEventFunnel(uuid.New().String(), creator.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "event not found", err.Error())
+}
+
+func TestGetEventFunnelHandler(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator, event := seedFunnel(t, db)
+	other := createTestUser(t, db, "not-the-creator")
+
+	gin.SetMode(gin.TestMode)
+	currentUser := creator
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", currentUser.ID.String())
+		c.Next()
+	})
+	router.GET("/events/:id/funnel", handlers.NewEventHandler().GetEventFunnel)
+
+	w := serveEventRequest(router, "GET", "/events/"+event.ID.String()+"/funnel", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Data dto.EventFunnelResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Len(t, body.Data.Stages, 5)
+	assert.Equal(t, "impressions", body.Data.Stages[0].Stage)
+	assert.Equal(t, int64(4), body.Data.Stages[0].Count)
+
+	currentUser = other
+	w = serveEventRequest(router, "GET", "/events/"+event.ID.String()+"/funnel", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,207 @@
+package service_test
+
+import (
+	"net/http"
+	"testing"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// isInterested reports whether the user has an interest row for the event
+func isInterested(t *testing.T, db *gorm.DB, user *models.User, event *models.Event) bool {
+	t.Helper()
+	var count int64
+	require.NoError(t, db.Model(&models.UserEventInterest{}).
+		Where("user_id = ? AND event_id = ?", user.ID, event.ID).Count(&count).Error)
+	return count > 0
+}
+
+// memberCount counts the user's member rows for the event, in any status
+func memberCount(t *testing.T, db *gorm.DB, user *models.User, event *models.Event) int64 {
+	t.Helper()
+	var count int64
+	require.NoError(t, db.Model(&models.EventMember{}).
+		Where("user_id = ? AND event_id = ?", user.ID, event.ID).Count(&count).Error)
+	return count
+}
+
+func TestSwipeLike_RecordsInterestWithoutMembership(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "interest-creator")
+	swiper := createTestUser(t, db, "interest-swiper")
+	event := createTestEvent(t, db, creator, "Night market walk")
+
+	require.NoError(t, eventService.SwipeEvent(event.ID.String(), swiper.ID.String(), "like"))
+	assert.True(t, isInterested(t, db, swiper, event))
+	assert.Zero(t, memberCount(t, db, swiper, event), "liking must not make the user a member")
+
+	// Liking again is idempotent
+	require.NoError(t, eventService.SwipeEvent(event.ID.String(), swiper.ID.String(), "like"))
+	assert.True(t, isInterested(t, db, swiper, event))
+	assert.Zero(t, memberCount(t, db, swiper, event))
+
+	// No joined notification goes to the creator
+	var notifications int64
+	require.NoError(t, db.Model(&models.Notification{}).Where("user_id = ?", creator.ID).Count(&notifications).Error)
+	assert.Zero(t, notifications)
+}
+
+func TestSwipePass_WithdrawsInterest(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "pass-creator")
+	swiper := createTestUser(t, db, "pass-swiper")
+	event := createTestEvent(t, db, creator, "Temple run")
+
+	require.NoError(t, eventService.SwipeEvent(event.ID.String(), swiper.ID.String(), "like"))
+	require.NoError(t, eventService.SwipeEvent(event.ID.String(), swiper.ID.String(), "pass"))
+	assert.False(t, isInterested(t, db, swiper, event))
+
+	var swipe models.EventSwipe
+	require.NoError(t, db.Where("user_id = ? AND event_id = ?", swiper.ID, event.ID).First(&swipe).Error)
+	assert.Equal(t, models.SwipeDirectionPass, swipe.Direction)
+}
+
+func TestJoinEvent_AfterLike(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "join-creator")
+	swiper := createTestUser(t, db, "join-swiper")
+	event := createTestEvent(t, db, creator, "Cooking class")
+
+	// Joining used to fail with "user is already a member" after a like
+	require.NoError(t, eventService.SwipeEvent(event.ID.String(), swiper.ID.String(), "like"))
+	require.NoError(t, eventService.JoinEvent(event.ID.String(), swiper.ID.String()))
+
+	var member models.EventMember
+	require.NoError(t, db.Where("user_id = ? AND event_id = ?", swiper.ID, event.ID).First(&member).Error)
+	assert.Equal(t, models.MemberStatusPending, member.Status)
+	assert.True(t, isInterested(t, db, swiper, event))
+
+	err := eventService.JoinEvent(event.ID.String(), swiper.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "user is already a member", err.Error())
+}
+
+// hasSwipe reports whether the user has a swipe on the event
+func hasSwipe(t *testing.T, db *gorm.DB, user *models.User, event *models.Event) bool {
+	t.Helper()
+	var count int64
+	require.NoError(t, db.Model(&models.EventSwipe{}).
+		Where("user_id = ? AND event_id = ?", user.ID, event.ID).Count(&count).Error)
+	return count > 0
+}
+
+func TestUndoSwipe_LikeWithdrawsInterest(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "undo-creator")
+	swiper := createTestUser(t, db, "undo-swiper")
+	event := createTestEvent(t, db, creator, "Street food tour")
+
+	require.NoError(t, eventService.SwipeEvent(event.ID.String(), swiper.ID.String(), "like"))
+
+	require.NoError(t, eventService.UndoSwipe(event.ID.String(), swiper.ID.String()))
+	assert.False(t, hasSwipe(t, db, swiper, event))
+	assert.False(t, isInterested(t, db, swiper, event))
+	assert.Zero(t, memberCount(t, db, swiper, event))
+	assert.Equal(t, models.MemberStatusConfirmed, memberStatus(t, db, event, creator))
+
+	// Nothing left to undo
+	err := eventService.UndoSwipe(event.ID.String(), swiper.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "swipe not found", err.Error())
+
+	// The event can be swiped on again
+	require.NoError(t, eventService.SwipeEvent(event.ID.String(), swiper.ID.String(), "pass"))
+	assert.True(t, hasSwipe(t, db, swiper, event))
+}
+
+func TestUndoSwipe_KeepsJoinRequestAfterLike(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "undo-join-creator")
+	swiper := createTestUser(t, db, "undo-join-swiper")
+	event := createTestEvent(t, db, creator, "Night hike")
+
+	require.NoError(t, eventService.SwipeEvent(event.ID.String(), swiper.ID.String(), "like"))
+	require.NoError(t, eventService.JoinEvent(event.ID.String(), swiper.ID.String()))
+
+	require.NoError(t, eventService.UndoSwipe(event.ID.String(), swiper.ID.String()))
+	assert.False(t, hasSwipe(t, db, swiper, event))
+	assert.False(t, isInterested(t, db, swiper, event))
+	assert.Equal(t, models.MemberStatusPending, memberStatus(t, db, event, swiper), "the join request must survive")
+}
+
+func TestUndoSwipe_KeepsConfirmedMembership(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "keep-creator")
+	swiper := createTestUser(t, db, "keep-swiper")
+	event := createTestEvent(t, db, creator, "Boat trip")
+
+	require.NoError(t, eventService.SwipeEvent(event.ID.String(), swiper.ID.String(), "like"))
+	addTestMember(t, db, event, swiper, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+
+	require.NoError(t, eventService.UndoSwipe(event.ID.String(), swiper.ID.String()))
+	assert.False(t, hasSwipe(t, db, swiper, event))
+	assert.False(t, isInterested(t, db, swiper, event))
+	assert.Equal(t, models.MemberStatusConfirmed, memberStatus(t, db, event, swiper))
+}
+
+func TestUndoSwipe_PassLeavesMembershipAlone(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "pass-undo-creator")
+	swiper := createTestUser(t, db, "pass-undo-swiper")
+	event := createTestEvent(t, db, creator, "Market run")
+
+	addTestMember(t, db, event, swiper, models.MemberRoleParticipant, models.MemberStatusPending)
+	require.NoError(t, eventService.SwipeEvent(event.ID.String(), swiper.ID.String(), "pass"))
+
+	require.NoError(t, eventService.UndoSwipe(event.ID.String(), swiper.ID.String()))
+	assert.False(t, hasSwipe(t, db, swiper, event))
+	assert.Equal(t, models.MemberStatusPending, memberStatus(t, db, event, swiper))
+}
+
+func TestUndoSwipe_Endpoint(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "endpoint-creator")
+	swiper := createTestUser(t, db, "endpoint-swiper")
+	event := createTestEvent(t, db, creator, "Rooftop dinner")
+	require.NoError(t, eventService.SwipeEvent(event.ID.String(), swiper.ID.String(), "like"))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.DELETE("/events/:id/swipe", func(c *gin.Context) {
+		c.Set("user_id", swiper.ID.String())
+		c.Next()
+	}, handlers.NewEventHandler().UndoSwipe)
+
+	w := serveEventRequest(router, http.MethodDelete, "/events/"+event.ID.String()+"/swipe", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.False(t, hasSwipe(t, db, swiper, event))
+
+	w = serveEventRequest(router, http.MethodDelete, "/events/"+event.ID.String()+"/swipe", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = serveEventRequest(router, http.MethodDelete, "/events/not-a-uuid/swipe", "")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,183 @@
+package service_test
+
+import (
+	"sync"
+	"testing"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventItemService_AddAndList(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Creator")
+	member := createTestUser(t, db, "Member")
+	event := createTestEvent(t, db, creator, "Picnic")
+	addTestMember(t, db, event, member, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+
+	svc := service.NewEventItemService()
+	item, err := svc.AddItem(event.ID.String(), member.ID.String(), dto.AddEventItemRequest{Name: "  Blanket "})
+	require.NoError(t, err)
+	assert.Equal(t, "Blanket", item.Name)
+	assert.Equal(t, member.ID.String(), item.AddedBy)
+	assert.Nil(t, item.ClaimedBy)
+
+	_, err = svc.AddItem(event.ID.String(), member.ID.String(), dto.AddEventItemRequest{Name: "   "})
+	assert.EqualError(t, err, "item name is required")
+
+	items, err := svc.ListItems(event.ID.String(), creator.ID.String())
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, item.ID, items[0].ID)
+}
+
+func TestEventItemService_MembersOnly(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Creator")
+	pending := createTestUser(t, db, "Pending")
+	outsider := createTestUser(t, db, "Outsider")
+	event := createTestEvent(t, db, creator, "Picnic")
+	addTestMember(t, db, event, pending, models.MemberRoleParticipant, models.MemberStatusPending)
+
+	svc := service.NewEventItemService()
+	item, err := svc.AddItem(event.ID.String(), creator.ID.String(), dto.AddEventItemRequest{Name: "Drinks"})
+	require.NoError(t, err)
+
+	_, err = svc.ListItems(event.ID.String(), outsider.ID.String())
+	assert.EqualError(t, err, "not a member")
+	_, err = svc.ClaimItem(event.ID.String(), item.ID, outsider.ID.String())
+	assert.EqualError(t, err, "not a member")
+	_, err = svc.ClaimItem(event.ID.String(), item.ID, pending.ID.String())
+	assert.EqualError(t, err, "not a confirmed member")
+}
+
+func TestEventItemService_ClaimAndUnclaim(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Creator")
+	alice := createTestUser(t, db, "Alice")
+	bob := createTestUser(t, db, "Bob")
+	event := createTestEvent(t, db, creator, "Picnic")
+	addTestMember(t, db, event, alice, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	addTestMember(t, db, event, bob, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+
+	svc := service.NewEventItemService()
+	item, err := svc.AddItem(event.ID.String(), creator.ID.String(), dto.AddEventItemRequest{Name: "Speaker"})
+	require.NoError(t, err)
+
+	claimed, err := svc.ClaimItem(event.ID.String(), item.ID, alice.ID.String())
+	require.NoError(t, err)
+	require.NotNil(t, claimed.ClaimedBy)
+	assert.Equal(t, alice.ID.String(), *claimed.ClaimedBy)
+	require.NotNil(t, claimed.ClaimedByName)
+	assert.Equal(t, "Alice", *claimed.ClaimedByName)
+
+	t.Run("Claiming again is a no-op", func(t *testing.T) {
+		again, err := svc.ClaimItem(event.ID.String(), item.ID, alice.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, alice.ID.String(), *again.ClaimedBy)
+	})
+
+	t.Run("Another member cannot take or release the claim", func(t *testing.T) {
+		_, err := svc.ClaimItem(event.ID.String(), item.ID, bob.ID.String())
+		assert.EqualError(t, err, "already claimed")
+		_, err = svc.UnclaimItem(event.ID.String(), item.ID, bob.ID.String())
+		assert.EqualError(t, err, "item claimed by another member")
+	})
+
+	t.Run("Claimer unclaims and someone else claims", func(t *testing.T) {
+		released, err := svc.UnclaimItem(event.ID.String(), item.ID, alice.ID.String())
+		require.NoError(t, err)
+		assert.Nil(t, released.ClaimedBy)
+		assert.Nil(t, released.ClaimedAt)
+
+		claimed, err := svc.ClaimItem(event.ID.String(), item.ID, bob.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, bob.ID.String(), *claimed.ClaimedBy)
+	})
+
+	t.Run("Creator can release any claim", func(t *testing.T) {
+		released, err := svc.UnclaimItem(event.ID.String(), item.ID, creator.ID.String())
+		require.NoError(t, err)
+		assert.Nil(t, released.ClaimedBy)
+	})
+
+	t.Run("Item from another event is not found", func(t *testing.T) {
+		other := createTestEvent(t, db, creator, "Other")
+		_, err := svc.ClaimItem(other.ID.String(), item.ID, creator.ID.String())
+		assert.EqualError(t, err, "item not found")
+	})
+}
+
+func TestEventItemService_ConcurrentClaims(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Creator")
+	event := createTestEvent(t, db, creator, "Picnic")
+
+	const claimers = 8
+	members := make([]*models.User, claimers)
+	for i := range members {
+		members[i] = createTestUser(t, db, "Member")
+		addTestMember(t, db, event, members[i], models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	}
+
+	svc := service.NewEventItemService()
+	item, err := svc.AddItem(event.ID.String(), creator.ID.String(), dto.AddEventItemRequest{Name: "Grill"})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	errs := make([]error, claimers)
+	for i, member := range members {
+		wg.Add(1)
+		go func(i int, userID string) {
+			defer wg.Done()
+			<-start
+			_, errs[i] = svc.ClaimItem(event.ID.String(), item.ID, userID)
+		}(i, member.ID.String())
+	}
+	close(start)
+	wg.Wait()
+
+	winners := 0
+	for _, err := range errs {
+		if err == nil {
+			winners++
+			continue
+		}
+		assert.EqualError(t, err, "already claimed")
+	}
+	assert.Equal(t, 1, winners)
+
+	var stored models.EventItem
+	require.NoError(t, db.First(&stored, "id = ?", item.ID).Error)
+	require.NotNil(t, stored.ClaimedBy)
+	for i, member := range members {
+		if errs[i] == nil {
+			assert.Equal(t, member.ID, *stored.ClaimedBy)
+		}
+	}
+}
+
+func TestEventItemService_ItemsInEventDetail(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Creator")
+	outsider := createTestUser(t, db, "Outsider")
+	event := createTestEvent(t, db, creator, "Picnic")
+
+	_, err := service.NewEventItemService().AddItem(event.ID.String(), creator.ID.String(), dto.AddEventItemRequest{Name: "Cups"})
+	require.NoError(t, err)
+
+	eventService := service.NewEventService()
+	detail, err := eventService.GetEvent(event.ID.String(), creator.ID.String())
+	require.NoError(t, err)
+	require.Len(t, detail.Items, 1)
+	assert.Equal(t, "Cups", detail.Items[0].Name)
+
+	detail, err = eventService.GetEvent(event.ID.String(), outsider.ID.String())
+	require.NoError(t, err)
+	assert.Empty(t, detail.Items)
+}
@@ -0,0 +1,194 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// createTestInterest inserts an active interest in the given category
+func createTestInterest(t *testing.T, db *gorm.DB, code, category string) *models.Interest {
+	t.Helper()
+	interest := &models.Interest{ID: uuid.New(), Code: code, DisplayName: code, Category: category, IsActive: true}
+	require.NoError(t, db.Create(interest).Error)
+	return interest
+}
+
+func TestGetEventMatch_MatchesSuggestionList(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Creator")
+	viewer := createTestUser(t, db, "Viewer")
+
+	cafe := createTestInterest(t, db, "cafe_hopping", "cafe")
+	climbing := createTestInterest(t, db, "climbing", "sport")
+	karaoke := createTestInterest(t, db, "karaoke", "activity")
+	for _, interest := range []*models.Interest{cafe, climbing} {
+		require.NoError(t, db.Create(&models.UserInterest{UserID: viewer.ID, InterestID: interest.ID}).Error)
+	}
+
+	hiking := createKindTag(t, db, "Hiking", models.TagKindActivity)
+	createKindTag(t, db, "Museum", models.TagKindActivity)
+	require.NoError(t, db.Create(&models.UserTag{UserID: viewer.ID, TagID: hiking.ID}).Error)
+	require.NoError(t, db.Create(&models.TravelPreference{ID: uuid.New(), UserID: viewer.ID, TravelStyle: "outdoor_activity"}).Error)
+	require.NoError(t, db.Create(&models.PrefBudget{UserID: viewer.ID, Unlimited: true}).Error)
+
+	strong := createTestEvent(t, db, creator, "Cafe and climbing")
+	weak := createTestEvent(t, db, creator, "Karaoke night")
+	for _, link := range []models.EventInterest{
+		{EventID: strong.ID, InterestID: cafe.ID},
+		{EventID: strong.ID, InterestID: climbing.ID},
+		{EventID: weak.ID, InterestID: cafe.ID},
+		{EventID: weak.ID, InterestID: karaoke.ID},
+	} {
+		require.NoError(t, db.Create(&link).Error)
+	}
+	require.NoError(t, db.Create(&models.EventTag{EventID: strong.ID, TagID: hiking.ID}).Error)
+
+	tagService := service.NewTagService()
+	suggestions, _, err := tagService.GetEventSuggestions(viewer.ID.String(), 1, 10)
+	require.NoError(t, err)
+	listed := make(map[string]float64)
+	for _, suggestion := range suggestions {
+		listed[suggestion.Event.ID] = suggestion.MatchScore
+	}
+
+	for _, event := range []*models.Event{strong, weak} {
+		match, err := tagService.GetEventMatch(viewer.ID.String(), event.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, event.ID.String(), match.EventID)
+		assert.Equal(t, listed[event.ID.String()], match.MatchScore, event.Title)
+		assert.Equal(t, match.Components[service.MatchComponentInterests], match.MatchScore, event.Title)
+		assert.Len(t, match.Components, len(match.Weights))
+	}
+
+	match, err := tagService.GetEventMatch(viewer.ID.String(), strong.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, match.MatchScore)
+	assert.Equal(t, 100.0, match.Components[service.MatchComponentTags])
+	assert.Equal(t, 100.0, match.Components[service.MatchComponentBudget])
+	assert.Equal(t, 100.0, match.Components[service.MatchComponentTravelPreferences])
+	assert.Equal(t, 50.0, match.Components[service.MatchComponentFoodPreferences])
+	assert.Len(t, match.MatchedInterests, 2)
+	require.Len(t, match.MatchedTags, 1)
+	assert.Equal(t, "Hiking", match.MatchedTags[0].Name)
+
+	weakMatch, err := tagService.GetEventMatch(viewer.ID.String(), weak.ID.String())
+	require.NoError(t, err)
+	assert.Less(t, weakMatch.MatchScore, match.MatchScore)
+	assert.Empty(t, weakMatch.MatchedTags)
+}
+
+func TestGetEventMatch_Errors(t *testing.T) {
+	db := setupEventDomainDB(t)
+	viewer := createTestUser(t, db, "Viewer")
+	tagService := service.NewTagService()
+
+	_, err := tagService.GetEventMatch(viewer.ID.String(), "not-a-uuid")
+	require.Error(t, err)
+	assert.Equal(t, "invalid event ID", err.Error())
+
+	_, err = tagService.GetEventMatch(viewer.ID.String(), uuid.New().String())
+	require.Error(t, err)
+	assert.Equal(t, "event not found", err.Error())
+}
+
+func TestGetEventSuggestions_OrdersByScoreThenNewest(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Creator")
+	viewer := createTestUser(t, db, "Viewer")
+
+	cafe := createTestInterest(t, db, "cafe_hopping", "cafe")
+	climbing := createTestInterest(t, db, "climbing", "sport")
+	karaoke := createTestInterest(t, db, "karaoke", "activity")
+	for _, interest := range []*models.Interest{cafe, climbing} {
+		require.NoError(t, db.Create(&models.UserInterest{UserID: viewer.ID, InterestID: interest.ID}).Error)
+	}
+
+	// Two strong and two weak matches, created in an order that disagrees with the ranking
+	base := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+	events := map[string]*models.Event{}
+	for i, spec := range []struct {
+		title     string
+		interests []*models.Interest
+	}{
+		{"Weak newest", []*models.Interest{cafe, karaoke}},
+		{"Strong older", []*models.Interest{cafe, climbing}},
+		{"Weak older", []*models.Interest{cafe, karaoke}},
+		{"Strong newer", []*models.Interest{cafe, climbing}},
+	} {
+		event := createTestEvent(t, db, creator, spec.title)
+		createdAt := base.Add(time.Duration([]int{4, 1, 0, 2}[i]) * time.Hour)
+		require.NoError(t, db.Model(event).Update("created_at", createdAt).Error)
+		for _, interest := range spec.interests {
+			require.NoError(t, db.Create(&models.EventInterest{EventID: event.ID, InterestID: interest.ID}).Error)
+		}
+		events[spec.title] = event
+	}
+
+	tagService := service.NewTagService()
+	suggestions, total, err := tagService.GetEventSuggestions(viewer.ID.String(), 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), total)
+	titles := make([]string, len(suggestions))
+	for i, suggestion := range suggestions {
+		titles[i] = suggestion.Event.Title
+	}
+	assert.Equal(t, []string{"Strong newer", "Strong older", "Weak newest", "Weak older"}, titles)
+	assert.Greater(t, suggestions[1].MatchScore, suggestions[2].MatchScore)
+
+	// Pages split the same ordering without repeating or skipping ties
+	for page, want := range map[int][]string{1: {"Strong newer", "Strong older"}, 2: {"Weak newest", "Weak older"}} {
+		suggestions, _, err := tagService.GetEventSuggestions(viewer.ID.String(), page, 2)
+		require.NoError(t, err)
+		require.Len(t, suggestions, 2)
+		for i, suggestion := range suggestions {
+			assert.Equal(t, events[want[i]].ID.String(), suggestion.Event.ID, "page %d", page)
+		}
+	}
+}
+
+func TestGetEventSuggestions_ExcludesEventsTheUserActedOn(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Creator")
+	viewer := createTestUser(t, db, "Viewer")
+
+	fresh := createTestEvent(t, db, creator, "Fresh")
+	declined := createTestEvent(t, db, creator, "Declined invite")
+	addTestMember(t, db, declined, viewer, models.MemberRoleParticipant, models.MemberStatusDeclined)
+	own := createTestEvent(t, db, viewer, "Own event")
+	confirmed := createTestEvent(t, db, creator, "Joined")
+	addTestMember(t, db, confirmed, viewer, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	pending := createTestEvent(t, db, creator, "Requested")
+	addTestMember(t, db, pending, viewer, models.MemberRoleParticipant, models.MemberStatusPending)
+	liked := createTestEvent(t, db, creator, "Liked")
+	passed := createTestEvent(t, db, creator, "Passed")
+	for event, direction := range map[*models.Event]models.SwipeDirection{liked: models.SwipeDirectionLike, passed: models.SwipeDirectionPass} {
+		require.NoError(t, db.Create(&models.EventSwipe{UserID: viewer.ID, EventID: event.ID, Direction: direction}).Error)
+	}
+
+	suggestions, total, err := service.NewTagService().GetEventSuggestions(viewer.ID.String(), 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	listed := make(map[string]bool)
+	for _, suggestion := range suggestions {
+		listed[suggestion.Event.ID] = true
+	}
+	assert.True(t, listed[fresh.ID.String()])
+	assert.True(t, listed[declined.ID.String()])
+	for _, event := range []*models.Event{own, confirmed, pending, liked, passed} {
+		assert.False(t, listed[event.ID.String()], event.Title)
+	}
+
+	// Other users still get them
+	suggestions, _, err = service.NewTagService().GetEventSuggestions(creator.ID.String(), 1, 10)
+	require.NoError(t, err)
+	assert.Len(t, suggestions, 1)
+	assert.Equal(t, own.ID.String(), suggestions[0].Event.ID)
+}
@@ -0,0 +1,160 @@
+package service_test
+
+import (
+	"testing"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// assertConfirmedCount checks the event's counter against its member rows
+func assertConfirmedCount(t *testing.T, db *gorm.DB, eventID uuid.UUID, want int) {
+	t.Helper()
+	var event models.Event
+	require.NoError(t, db.First(&event, "id = ?", eventID).Error)
+	var actual int64
+	require.NoError(t, db.Model(&models.EventMember{}).
+		Where("event_id = ? AND status = ?", eventID, models.MemberStatusConfirmed).Count(&actual).Error)
+	assert.Equal(t, want, event.ConfirmedMemberCount, "counter")
+	assert.Equal(t, int64(want), actual, "member rows")
+}
+
+func TestConfirmedMemberCount_CreateEventCountsCreator(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+	creator := createTestUser(t, db, "count-creator")
+
+	created, err := eventService.CreateEvent(creator.ID.String(), dto.CreateEventRequest{
+		Title:     "Counted dinner",
+		EventType: string(models.EventTypeMeal),
+	})
+	require.NoError(t, err)
+
+	assertConfirmedCount(t, db, uuid.MustParse(created.ID), 1)
+	assert.Equal(t, 1, created.MemberCount)
+}
+
+func TestConfirmedMemberCount_MemberTransitions(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "transition-creator")
+	alice := createTestUser(t, db, "transition-alice")
+	bob := createTestUser(t, db, "transition-bob")
+	carol := createTestUser(t, db, "transition-carol")
+	event := createTestEvent(t, db, creator, "Island hopping")
+	addTestMember(t, db, event, alice, models.MemberRoleParticipant, models.MemberStatusPending)
+	addTestMember(t, db, event, bob, models.MemberRoleParticipant, models.MemberStatusPending)
+	addTestMember(t, db, event, carol, models.MemberRoleParticipant, models.MemberStatusPending)
+	eventID := event.ID.String()
+	assertConfirmedCount(t, db, event.ID, 1)
+
+	// Confirming takes a seat
+	require.NoError(t, eventService.ConfirmEventParticipation(eventID, alice.ID.String()))
+	require.NoError(t, eventService.ConfirmEventParticipation(eventID, bob.ID.String()))
+	assertConfirmedCount(t, db, event.ID, 3)
+
+	// Confirming twice does not count twice
+	err := eventService.ConfirmEventParticipation(eventID, alice.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "already confirmed", err.Error())
+	assertConfirmedCount(t, db, event.ID, 3)
+
+	// A pending member cancelling frees nothing
+	require.NoError(t, eventService.CancelEventParticipation(eventID, carol.ID.String()))
+	assertConfirmedCount(t, db, event.ID, 3)
+
+	// A confirmed member cancelling frees their seat
+	require.NoError(t, eventService.CancelEventParticipation(eventID, bob.ID.String()))
+	assertConfirmedCount(t, db, event.ID, 2)
+
+	// Declined members can confirm again
+	require.NoError(t, eventService.ConfirmEventParticipation(eventID, bob.ID.String()))
+	assertConfirmedCount(t, db, event.ID, 3)
+
+	// A confirmed member leaving frees their seat
+	err, deleted := eventService.LeaveEvent(eventID, alice.ID.String())
+	require.NoError(t, err)
+	assert.False(t, deleted)
+	assertConfirmedCount(t, db, event.ID, 2)
+
+	seen, err := eventService.GetEvent(eventID, creator.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, 2, seen.MemberCount)
+	assert.Nil(t, seen.SpotsLeft)
+}
+
+func TestConfirmedMemberCount_CapacityUsesCounter(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "full-creator")
+	alice := createTestUser(t, db, "full-alice")
+	bob := createTestUser(t, db, "full-bob")
+	event := createTestEvent(t, db, creator, "Two seat kayak")
+	require.NoError(t, db.Model(event).Update("capacity", 2).Error)
+	addTestMember(t, db, event, alice, models.MemberRoleParticipant, models.MemberStatusPending)
+	addTestMember(t, db, event, bob, models.MemberRoleParticipant, models.MemberStatusPending)
+	eventID := event.ID.String()
+
+	seen, err := eventService.GetEvent(eventID, creator.ID.String())
+	require.NoError(t, err)
+	require.NotNil(t, seen.SpotsLeft)
+	assert.Equal(t, 1, *seen.SpotsLeft)
+
+	require.NoError(t, eventService.ConfirmEventParticipation(eventID, alice.ID.String()))
+
+	err = eventService.ConfirmEventParticipation(eventID, bob.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "event is full", err.Error())
+
+	// The refused confirmation is rolled back with the seat
+	var member models.EventMember
+	require.NoError(t, db.Where("event_id = ? AND user_id = ?", event.ID, bob.ID).First(&member).Error)
+	assert.Equal(t, models.MemberStatusPending, member.Status)
+	assertConfirmedCount(t, db, event.ID, 2)
+
+	seen, err = eventService.GetEvent(eventID, creator.ID.String())
+	require.NoError(t, err)
+	require.NotNil(t, seen.SpotsLeft)
+	assert.Equal(t, 0, *seen.SpotsLeft)
+
+	// A freed seat can be taken
+	require.NoError(t, eventService.CancelEventParticipation(eventID, alice.ID.String()))
+	require.NoError(t, eventService.ConfirmEventParticipation(eventID, bob.ID.String()))
+	assertConfirmedCount(t, db, event.ID, 2)
+}
+
+func TestReconcileConfirmedMemberCounts(t *testing.T) {
+	db := setupEventDomainDB(t)
+
+	creator := createTestUser(t, db, "reconcile-creator")
+	alice := createTestUser(t, db, "reconcile-alice")
+	drifted := createTestEvent(t, db, creator, "Drifted")
+	removed := createTestEvent(t, db, creator, "Member removed")
+	accurate := createTestEvent(t, db, creator, "Accurate")
+	addTestMember(t, db, removed, alice, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+
+	// Drift the way it happens outside the services: a bad counter, and a row deleted by cascade
+	require.NoError(t, db.Model(drifted).UpdateColumn("confirmed_member_count", 7).Error)
+	require.NoError(t, db.Where("event_id = ? AND user_id = ?", removed.ID, alice.ID).Delete(&models.EventMember{}).Error)
+
+	corrected, err := service.ReconcileConfirmedMemberCounts()
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), corrected)
+
+	assertConfirmedCount(t, db, drifted.ID, 1)
+	assertConfirmedCount(t, db, removed.ID, 1)
+	assertConfirmedCount(t, db, accurate.ID, 1)
+
+	// Nothing left to correct
+	corrected, err = service.ReconcileConfirmedMemberCounts()
+	require.NoError(t, err)
+	assert.Zero(t, corrected)
+}
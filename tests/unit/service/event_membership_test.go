@@ -0,0 +1,70 @@
+package service_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventService_GetEventsWithMembership(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "creator")
+	viewer := createTestUser(t, db, "viewer")
+	event := createTestEvent(t, db, creator, "Dinner")
+	other := createTestEvent(t, db, creator, "Hike")
+
+	// Many other members that the viewer does not need to see
+	for i := 0; i < 5; i++ {
+		member := createTestUser(t, db, "member-"+string(rune('a'+i)))
+		addTestMember(t, db, event, member, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	}
+	addTestMember(t, db, event, viewer, models.MemberRoleParticipant, models.MemberStatusPending)
+	require.NoError(t, db.Create(&models.EventSwipe{UserID: viewer.ID, EventID: event.ID, Direction: models.SwipeDirectionLike}).Error)
+
+	full, total, err := eventService.GetEvents(viewer.ID.String(), 1, 10, "", "", "")
+	require.NoError(t, err)
+	compact, compactTotal, err := eventService.GetEventsWithMembership(viewer.ID.String(), 1, 10, "", "", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, total, compactTotal)
+	require.Len(t, compact, 2)
+
+	for i := range compact {
+		// Same viewer annotations as the fully preloaded query
+		assert.Equal(t, full[i].ID, compact[i].ID)
+		assert.Equal(t, full[i].MemberCount, compact[i].MemberCount)
+		assert.Equal(t, full[i].IsJoined, compact[i].IsJoined)
+		assert.Equal(t, full[i].MemberStatus, compact[i].MemberStatus)
+		assert.Equal(t, full[i].UserSwipe == nil, compact[i].UserSwipe == nil)
+		assert.Empty(t, compact[i].Members)
+	}
+
+	byID := map[string]int{}
+	for i, e := range compact {
+		byID[e.ID] = i
+	}
+	annotated := compact[byID[event.ID.String()]]
+	assert.Equal(t, 6, annotated.MemberCount)
+	assert.False(t, annotated.IsJoined)
+	require.NotNil(t, annotated.MemberStatus)
+	assert.Equal(t, string(models.MemberStatusPending), *annotated.MemberStatus)
+	require.NotNil(t, annotated.UserSwipe)
+	assert.Equal(t, string(models.SwipeDirectionLike), annotated.UserSwipe.Direction)
+
+	untouched := compact[byID[other.ID.String()]]
+	assert.Nil(t, untouched.MemberStatus)
+	assert.Nil(t, untouched.UserSwipe)
+
+	// Payload should shrink without the member arrays
+	fullJSON, _ := json.Marshal(full)
+	compactJSON, _ := json.Marshal(compact)
+	assert.Less(t, len(compactJSON), len(fullJSON))
+	assert.NotContains(t, string(compactJSON), `"members"`)
+}
@@ -0,0 +1,98 @@
+package service_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// setEventLocation places the event at the coordinate
+func setEventLocation(t *testing.T, db *gorm.DB, event *models.Event, lat, lng float64) {
+	t.Helper()
+	require.NoError(t, db.Model(event).Updates(map[string]interface{}{"lat": lat, "lng": lng}).Error)
+}
+
+func TestGetNearbyEvents(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "nearby-creator")
+	viewer := createTestUser(t, db, "nearby-viewer")
+
+	// Around Siam, Bangkok (13.7460, 100.5340)
+	far := createTestEvent(t, db, creator, "Chatuchak")
+	setEventLocation(t, db, far, 13.7999, 100.5500)
+	nearer := createTestEvent(t, db, creator, "Lumphini")
+	setEventLocation(t, db, nearer, 13.7310, 100.5410)
+	closest := createTestEvent(t, db, creator, "Siam")
+	setEventLocation(t, db, closest, 13.7465, 100.5345)
+	outside := createTestEvent(t, db, creator, "Ayutthaya")
+	setEventLocation(t, db, outside, 14.3532, 100.5684)
+	createTestEvent(t, db, creator, "No location")
+	cancelled := createTestEvent(t, db, creator, "Cancelled")
+	setEventLocation(t, db, cancelled, 13.7461, 100.5341)
+	require.NoError(t, db.Model(cancelled).Update("status", models.EventStatusCancelled).Error)
+	deleted := createTestEvent(t, db, creator, "Deleted")
+	setEventLocation(t, db, deleted, 13.7461, 100.5341)
+	require.NoError(t, db.Model(deleted).Update("deleted_at", time.Now()).Error)
+
+	items, total, err := eventService.GetNearbyEvents(viewer.ID.String(), 13.7460, 100.5340, 10, 1, 20)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	var titles []string
+	for _, item := range items {
+		titles = append(titles, item.Event.Title)
+	}
+	assert.Equal(t, []string{"Siam", "Lumphini", "Chatuchak"}, titles)
+	assert.InDelta(t, 0.08, items[0].DistanceKm, 0.01)
+	assert.InDelta(t, 1.86, items[1].DistanceKm, 0.05)
+	assert.InDelta(t, 6.24, items[2].DistanceKm, 0.05)
+
+	// A tighter radius drops the furthest, and pages follow distance order
+	items, total, err = eventService.GetNearbyEvents(viewer.ID.String(), 13.7460, 100.5340, 5, 2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, items, 1)
+	assert.Equal(t, "Lumphini", items[0].Event.Title)
+
+	_, _, err = eventService.GetNearbyEvents(viewer.ID.String(), 91, 100.5340, 10, 1, 20)
+	require.Error(t, err)
+	assert.Equal(t, "invalid coordinates", err.Error())
+	_, _, err = eventService.GetNearbyEvents(viewer.ID.String(), 13.7460, 100.5340, 0, 1, 20)
+	require.Error(t, err)
+	assert.Equal(t, "invalid radius", err.Error())
+}
+
+func TestGetNearbyEventsHandler(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "nearby-handler-creator")
+	event := createTestEvent(t, db, creator, "Riverside")
+	setEventLocation(t, db, event, 13.7200, 100.5130)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", creator.ID.String())
+		c.Next()
+	})
+	router.GET("/events/nearby", handlers.NewEventHandler().GetNearbyEvents)
+
+	w := serveEventRequest(router, "GET", "/events/nearby?lat=13.72&lng=100.513", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"title":"Riverside"`)
+	assert.Contains(t, w.Body.String(), `"distance_km":0`)
+
+	w = serveEventRequest(router, "GET", "/events/nearby?lat=13.72", "")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = serveEventRequest(router, "GET", "/events/nearby?lat=13.72&lng=100.513&radius_km=500", "")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,93 @@
+package service_test
+
+import (
+	"net/http"
+	"testing"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// addTestNotification stores an unread notification for the user with the given data
+func addTestNotification(t *testing.T, db *gorm.DB, user *models.User, data map[string]interface{}) *models.Notification {
+	t.Helper()
+	notification := &models.Notification{
+		ID:     uuid.New(),
+		UserID: user.ID,
+		Title:  "Title",
+		Body:   "Body",
+		Type:   "push",
+		Data:   data,
+	}
+	require.NoError(t, db.Create(notification).Error)
+	return notification
+}
+
+func TestMarkEventNotificationsRead(t *testing.T) {
+	db := setupEventDomainDB(t)
+	notificationService := service.NewNotificationService()
+
+	user := createTestUser(t, db, "reader")
+	other := createTestUser(t, db, "other-reader")
+	event := createTestEvent(t, db, user, "Boat trip")
+	otherEvent := createTestEvent(t, db, user, "Cooking class")
+
+	first := addTestNotification(t, db, user, map[string]interface{}{"event_id": event.ID.String(), "type": "event_update"})
+	second := addTestNotification(t, db, user, map[string]interface{}{"event_id": event.ID.String(), "type": "chat_mention"})
+	otherEventNotification := addTestNotification(t, db, user, map[string]interface{}{"event_id": otherEvent.ID.String()})
+	welcome := addTestNotification(t, db, user, map[string]interface{}{"type": "welcome"})
+	otherUserNotification := addTestNotification(t, db, other, map[string]interface{}{"event_id": event.ID.String()})
+
+	updated, err := notificationService.MarkEventNotificationsRead(user.ID.String(), event.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), updated)
+
+	read := func(notification *models.Notification) bool {
+		var stored models.Notification
+		require.NoError(t, db.First(&stored, "id = ?", notification.ID).Error)
+		return stored.Read && stored.ReadAt != nil
+	}
+	assert.True(t, read(first))
+	assert.True(t, read(second))
+	assert.False(t, read(otherEventNotification))
+	assert.False(t, read(welcome))
+	assert.False(t, read(otherUserNotification))
+
+	// Already read notifications are not touched again
+	updated, err = notificationService.MarkEventNotificationsRead(user.ID.String(), event.ID.String())
+	require.NoError(t, err)
+	assert.Zero(t, updated)
+
+	_, err = notificationService.MarkEventNotificationsRead(user.ID.String(), "not-a-uuid")
+	assert.EqualError(t, err, "invalid event ID")
+}
+
+func TestMarkEventNotificationsReadHandler(t *testing.T) {
+	db := setupEventDomainDB(t)
+	user := createTestUser(t, db, "handler-reader")
+	event := createTestEvent(t, db, user, "Street food")
+	addTestNotification(t, db, user, map[string]interface{}{"event_id": event.ID.String()})
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", user.ID.String())
+		c.Next()
+	})
+	notificationHandler := handlers.NewNotificationHandler()
+	router.POST("/events/:id/notifications/read", notificationHandler.MarkEventNotificationsRead)
+
+	w := serveEventRequest(router, "POST", "/events/"+event.ID.String()+"/notifications/read", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"updated":1`)
+
+	w = serveEventRequest(router, "POST", "/events/not-a-uuid/notifications/read", "")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,123 @@
+package service_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventParticipation_PastStartEvent(t *testing.T) {
+	db := setupEventDomainDB(t)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := utils.NewFakeClock(now)
+	eventService := service.NewEventServiceWithClock(clock)
+
+	creator := createTestUser(t, db, "started-creator")
+	pending := createTestUser(t, db, "started-pending")
+	latecomer := createTestUser(t, db, "started-latecomer")
+	event := createTestEvent(t, db, creator, "Morning ride")
+	startAt := now.Add(time.Hour)
+	require.NoError(t, db.Model(event).Update("start_at", startAt).Error)
+	addTestMember(t, db, event, pending, models.MemberRoleParticipant, models.MemberStatusPending)
+	eventID := event.ID.String()
+
+	// Before the start everything is allowed
+	require.NoError(t, eventService.SwipeEvent(eventID, latecomer.ID.String(), "like"))
+
+	// From the start time on, the event takes no more participants
+	clock.Advance(time.Hour)
+	err := eventService.JoinEvent(eventID, latecomer.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "event has already started", err.Error())
+	assert.Zero(t, memberCount(t, db, latecomer, event))
+
+	err = eventService.SwipeEvent(eventID, latecomer.ID.String(), "pass")
+	require.Error(t, err)
+	assert.Equal(t, "event has already started", err.Error())
+	assert.True(t, isInterested(t, db, latecomer, event))
+
+	err = eventService.ConfirmEventParticipation(eventID, pending.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "event has already started", err.Error())
+	assert.Equal(t, models.MemberStatusPending, memberStatus(t, db, event, pending))
+
+	// Reads are unaffected
+	seen, err := eventService.GetEvent(eventID, creator.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, "Morning ride", seen.Title)
+}
+
+func TestEventParticipation_CancelledAndCompletedEvents(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "closed-creator")
+	pending := createTestUser(t, db, "closed-pending")
+	visitor := createTestUser(t, db, "closed-visitor")
+	cancelled := createTestEvent(t, db, creator, "Called off")
+	completed := createTestEvent(t, db, creator, "All done")
+	require.NoError(t, db.Model(cancelled).Update("status", models.EventStatusCancelled).Error)
+	require.NoError(t, db.Model(completed).Update("status", models.EventStatusCompleted).Error)
+	addTestMember(t, db, cancelled, pending, models.MemberRoleParticipant, models.MemberStatusPending)
+	addTestMember(t, db, completed, pending, models.MemberRoleParticipant, models.MemberStatusPending)
+
+	for _, tc := range []struct {
+		event *models.Event
+		err   string
+	}{
+		{cancelled, "event is cancelled"},
+		{completed, "event is completed"},
+	} {
+		eventID := tc.event.ID.String()
+		assert.EqualError(t, eventService.JoinEvent(eventID, visitor.ID.String()), tc.err)
+		assert.EqualError(t, eventService.SwipeEvent(eventID, visitor.ID.String(), "like"), tc.err)
+		assert.EqualError(t, eventService.ConfirmEventParticipation(eventID, pending.ID.String()), tc.err)
+		assert.False(t, isInterested(t, db, visitor, tc.event))
+		assert.Equal(t, models.MemberStatusPending, memberStatus(t, db, tc.event, pending))
+
+		_, err := eventService.GetEvent(eventID, creator.ID.String())
+		assert.NoError(t, err)
+	}
+}
+
+func TestEventParticipation_ClosedEventResponses(t *testing.T) {
+	db := setupEventDomainDB(t)
+
+	creator := createTestUser(t, db, "responses-creator")
+	visitor := createTestUser(t, db, "responses-visitor")
+	cancelled := createTestEvent(t, db, creator, "Rained out")
+	started := createTestEvent(t, db, creator, "Already underway")
+	require.NoError(t, db.Model(cancelled).Update("status", models.EventStatusCancelled).Error)
+	require.NoError(t, db.Model(started).Update("start_at", time.Now().Add(-time.Hour)).Error)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", visitor.ID.String())
+		c.Next()
+	})
+	eventHandler := handlers.NewEventHandler()
+	router.POST("/events/:id/join", eventHandler.JoinEvent)
+	router.POST("/events/:id/swipe", eventHandler.SwipeEvent)
+
+	w := serveEventRequest(router, http.MethodPost, "/events/"+cancelled.ID.String()+"/join", "")
+	assert.Equal(t, http.StatusConflict, w.Code)
+	w = serveEventRequest(router, http.MethodPost, "/events/"+cancelled.ID.String()+"/swipe",
+		`{"event_id":"`+cancelled.ID.String()+`","direction":"like"}`)
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	w = serveEventRequest(router, http.MethodPost, "/events/"+started.ID.String()+"/join", "")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = serveEventRequest(router, http.MethodPost, "/events/"+started.ID.String()+"/swipe",
+		`{"event_id":"`+started.ID.String()+`","direction":"like"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,99 @@
+package service_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/api/middleware"
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// getPublic requests path on the public routes, signed in when token is set, and decodes the data
+func getPublic(t *testing.T, router *gin.Engine, path, token string, data interface{}) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var body struct {
+		Data json.RawMessage `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.NoError(t, json.Unmarshal(body.Data, data))
+}
+
+func TestPublicEvents_OptionalViewer(t *testing.T) {
+	db := setupGuestTest(t)
+	creator := createTestUser(t, db, "public-creator")
+	viewer := createTestUser(t, db, "public-viewer")
+	joined := createTestEvent(t, db, creator, "Joined trip")
+	liked := createTestEvent(t, db, creator, "Liked trip")
+	addTestMember(t, db, joined, viewer, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	require.NoError(t, db.Create(&models.EventSwipe{UserID: viewer.ID, EventID: liked.ID, Direction: models.SwipeDirectionLike}).Error)
+
+	token, err := utils.GenerateToken(viewer.ID.String(), *viewer.Email, string(viewer.Provider))
+	require.NoError(t, err)
+
+	// Mounted as in the server: AuthContext runs globally, optional auth on the public group
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.AuthContext())
+	public := router.Group("/public", middleware.OptionalAuthMiddleware(), middleware.GuestContext())
+	eventHandler := handlers.NewEventHandler()
+	public.GET("/events", eventHandler.GetPublicEvents)
+	public.GET("/events/:id", eventHandler.GetPublicEvent)
+
+	// Signed in: the viewer's membership and swipe are included
+	var detail dto.EventResponse
+	getPublic(t, router, "/public/events/"+joined.ID.String(), token, &detail)
+	assert.True(t, detail.IsJoined)
+	require.NotNil(t, detail.MemberStatus)
+	assert.Equal(t, string(models.MemberStatusConfirmed), *detail.MemberStatus)
+
+	detail = dto.EventResponse{}
+	getPublic(t, router, "/public/events/"+liked.ID.String(), token, &detail)
+	assert.False(t, detail.IsJoined)
+	require.NotNil(t, detail.UserSwipe)
+	assert.Equal(t, string(models.SwipeDirectionLike), detail.UserSwipe.Direction)
+
+	var list []dto.EventResponse
+	getPublic(t, router, "/public/events", token, &list)
+	require.Len(t, list, 2)
+	for _, event := range list {
+		assert.Equal(t, event.ID == joined.ID.String(), event.IsJoined, event.Title)
+		assert.Equal(t, event.ID == liked.ID.String(), event.UserSwipe != nil, event.Title)
+	}
+
+	// Anonymous, or with a token that doesn't validate: the same events, not personalized
+	for _, token := range []string{"", "not-a-jwt"} {
+		for _, event := range []*models.Event{joined, liked} {
+			detail = dto.EventResponse{}
+			getPublic(t, router, "/public/events/"+event.ID.String(), token, &detail)
+			assert.Equal(t, event.Title, detail.Title)
+			assert.False(t, detail.IsJoined)
+			assert.Nil(t, detail.MemberStatus)
+			assert.Nil(t, detail.UserSwipe)
+		}
+
+		list = nil
+		getPublic(t, router, "/public/events", token, &list)
+		require.Len(t, list, 2)
+		for _, event := range list {
+			assert.False(t, event.IsJoined, event.Title)
+			assert.Nil(t, event.UserSwipe, event.Title)
+		}
+	}
+}
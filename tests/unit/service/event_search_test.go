@@ -0,0 +1,91 @@
+package service_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEvents_Search(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "search-creator")
+	viewer := createTestUser(t, db, "search-viewer")
+	createTestEvent(t, db, creator, "Night MARKET crawl")
+	described := createTestEvent(t, db, creator, "Saturday food walk")
+	require.NoError(t, db.Model(described).Update("description", "Ending at the Chatuchak market").Error)
+	createTestEvent(t, db, creator, "Beach day")
+	createTestEvent(t, db, creator, "100% vegan dinner")
+	createTestEvent(t, db, creator, "snake_case meetup")
+	other := createTestEvent(t, db, creator, "Market bike tour")
+	require.NoError(t, db.Model(other).Update("event_type", models.EventTypeOvernight).Error)
+
+	titles := func(eventType, search string, page, limit int) ([]string, int64) {
+		t.Helper()
+		events, total, err := eventService.GetEvents(viewer.ID.String(), page, limit, eventType, "", search)
+		require.NoError(t, err)
+		var got []string
+		for _, event := range events {
+			got = append(got, event.Title)
+		}
+		return got, total
+	}
+
+	// Title or description, in any case
+	got, total := titles("", "market", 1, 10)
+	assert.ElementsMatch(t, []string{"Night MARKET crawl", "Saturday food walk", "Market bike tour"}, got)
+	assert.Equal(t, int64(3), total)
+
+	// Combines with the type filter, and the total counts every match rather than the page
+	got, total = titles(string(models.EventTypeMeal), "  Market ", 1, 1)
+	assert.Len(t, got, 1)
+	assert.Equal(t, int64(2), total)
+
+	// Wildcards in the search match literally
+	got, total = titles("", "%", 1, 10)
+	assert.Equal(t, []string{"100% vegan dinner"}, got)
+	assert.Equal(t, int64(1), total)
+	got, _ = titles("", "e_c", 1, 10)
+	assert.Equal(t, []string{"snake_case meetup"}, got)
+	got, _ = titles("", "nothing like this", 1, 10)
+	assert.Empty(t, got)
+
+	// The compact listing filters the same way
+	compact, compactTotal, err := eventService.GetEventsWithMembership(viewer.ID.String(), 1, 10, "", "", "MARKET")
+	require.NoError(t, err)
+	assert.Len(t, compact, 3)
+	assert.Equal(t, int64(3), compactTotal)
+}
+
+func TestGetEventsHandler_Search(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "search-handler-creator")
+	createTestEvent(t, db, creator, "Temple tour")
+	createTestEvent(t, db, creator, "Cooking class")
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", creator.ID.String())
+		c.Next()
+	})
+	router.GET("/events", handlers.NewEventHandler().GetEvents)
+
+	// Searching lists matches even without sort=created
+	w := serveEventRequest(router, "GET", "/events?q=temple", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"title":"Temple tour"`)
+	assert.NotContains(t, w.Body.String(), "Cooking class")
+
+	w = serveEventRequest(router, "GET", "/events?q="+strings.Repeat("a", 101), "")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -38,7 +38,7 @@ func TestEventService_Basic(t *testing.T) {
 
 	t.Run("Get public events with empty database", func(t *testing.T) {
 		// This will fail gracefully since tables don't exist
-		_, _, err := eventService.GetPublicEvents(1, 10, "")
+		_, _, err := eventService.GetPublicEvents(1, 10, "", "")
 		// We expect an error here since tables aren't created
 		assert.Error(t, err)
 	})
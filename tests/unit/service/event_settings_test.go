@@ -0,0 +1,141 @@
+package service_test
+
+import (
+	"testing"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func updateSettings(t *testing.T, eventService *service.EventService, eventID, creatorID string, settings dto.EventSettingsRequest) {
+	t.Helper()
+	_, err := eventService.UpdateEvent(eventID, creatorID, dto.UpdateEventRequest{Settings: &settings})
+	require.NoError(t, err)
+}
+
+func TestEventSettings_DefaultsAndCreate(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+	creator := createTestUser(t, db, "settings-creator")
+
+	created, err := eventService.CreateEvent(creator.ID.String(), dto.CreateEventRequest{
+		Title:     "Closed dinner",
+		EventType: "meal",
+		Settings:  &dto.EventSettingsRequest{MembersVisible: boolPtr(false)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, dto.EventSettingsResponse{
+		AllowMemberInvites: true,
+		PendingChatAccess:  true,
+		MembersVisible:     false,
+	}, created.Settings)
+
+	var stored models.Event
+	require.NoError(t, db.First(&stored, "id = ?", created.ID).Error)
+	assert.False(t, stored.Settings.MemberListVisible())
+	assert.True(t, stored.Settings.MembersCanInvite())
+}
+
+func TestEventSettings_MemberInvites(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "invite-creator")
+	member := createTestUser(t, db, "invite-member")
+	friend := createTestUser(t, db, "invite-friend")
+	event := createTestEvent(t, db, creator, "Temple run")
+	addTestMember(t, db, event, member, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	eventID := event.ID.String()
+
+	require.NoError(t, eventService.InviteToEvent(eventID, member.ID.String(), friend.ID.String()))
+
+	updateSettings(t, eventService, eventID, creator.ID.String(), dto.EventSettingsRequest{AllowMemberInvites: boolPtr(false)})
+
+	err := eventService.InviteToEvent(eventID, member.ID.String(), friend.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "invites are disabled", err.Error())
+
+	// The creator can still invite
+	assert.NoError(t, eventService.InviteToEvent(eventID, creator.ID.String(), friend.ID.String()))
+
+	err = eventService.InviteToEvent(eventID, creator.ID.String(), member.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "already a member", err.Error())
+}
+
+func TestEventSettings_PendingChatAccess(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+	chatService := service.NewChatService()
+
+	creator := createTestUser(t, db, "chat-creator")
+	pending := createTestUser(t, db, "chat-pending")
+	event := createTestEvent(t, db, creator, "Food tour")
+	addTestMember(t, db, event, pending, models.MemberRoleParticipant, models.MemberStatusPending)
+	room := &models.ChatRoom{ID: uuid.New(), EventID: event.ID}
+	require.NoError(t, db.Create(room).Error)
+
+	_, _, err := chatService.GetMessages(room.ID.String(), pending.ID.String(), 1, 20)
+	require.NoError(t, err)
+	rooms, err := chatService.GetChatRooms(pending.ID.String())
+	require.NoError(t, err)
+	assert.Len(t, rooms, 1)
+
+	updateSettings(t, eventService, event.ID.String(), creator.ID.String(), dto.EventSettingsRequest{PendingChatAccess: boolPtr(false)})
+
+	_, _, err = chatService.GetMessages(room.ID.String(), pending.ID.String(), 1, 20)
+	require.Error(t, err)
+	assert.Equal(t, "unauthorized", err.Error())
+	_, err = chatService.SendMessage(room.ID.String(), pending.ID.String(), dto.SendMessageRequest{Body: "hi", MessageType: "text"})
+	require.Error(t, err)
+	assert.Equal(t, "unauthorized", err.Error())
+	rooms, err = chatService.GetChatRooms(pending.ID.String())
+	require.NoError(t, err)
+	assert.Empty(t, rooms)
+
+	// Confirmed members are unaffected
+	_, err = chatService.SendMessage(room.ID.String(), creator.ID.String(), dto.SendMessageRequest{Body: "hi", MessageType: "text"})
+	assert.NoError(t, err)
+}
+
+func TestEventSettings_MembersVisible(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "visible-creator")
+	alice := createTestUser(t, db, "visible-alice")
+	bob := createTestUser(t, db, "visible-bob")
+	event := createTestEvent(t, db, creator, "Sunset kayak")
+	addTestMember(t, db, event, alice, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	addTestMember(t, db, event, bob, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	eventID := event.ID.String()
+
+	seen, err := eventService.GetEvent(eventID, alice.ID.String())
+	require.NoError(t, err)
+	assert.Len(t, seen.Members, 3)
+
+	updateSettings(t, eventService, eventID, creator.ID.String(), dto.EventSettingsRequest{MembersVisible: boolPtr(false)})
+
+	seen, err = eventService.GetEvent(eventID, alice.ID.String())
+	require.NoError(t, err)
+	var visible []string
+	for _, m := range seen.Members {
+		visible = append(visible, m.UserID)
+	}
+	assert.ElementsMatch(t, []string{creator.ID.String(), alice.ID.String()}, visible)
+	assert.Equal(t, 3, seen.MemberCount)
+
+	// The creator still sees everyone
+	seen, err = eventService.GetEvent(eventID, creator.ID.String())
+	require.NoError(t, err)
+	assert.Len(t, seen.Members, 3)
+}
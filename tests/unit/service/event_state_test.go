@@ -0,0 +1,91 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEventStates_MixedEvents(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Creator")
+	viewer := createTestUser(t, db, "Viewer")
+
+	joined := createTestEvent(t, db, creator, "Joined Trip")
+	pending := createTestEvent(t, db, creator, "Pending Trip")
+	swiped := createTestEvent(t, db, creator, "Swiped Trip")
+	untouched := createTestEvent(t, db, creator, "Untouched Trip")
+
+	addTestMember(t, db, joined, viewer, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	addTestMember(t, db, pending, viewer, models.MemberRoleParticipant, models.MemberStatusPending)
+	require.NoError(t, db.Create(&models.EventSwipe{UserID: viewer.ID, EventID: pending.ID, Direction: models.SwipeDirectionLike}).Error)
+	require.NoError(t, db.Create(&models.EventSwipe{UserID: viewer.ID, EventID: swiped.ID, Direction: models.SwipeDirectionPass}).Error)
+
+	eventService := service.NewEventService()
+	missingID := uuid.New().String()
+	states, err := eventService.GetEventStates(viewer.ID.String(), []string{
+		untouched.ID.String(), joined.ID.String(), pending.ID.String(), swiped.ID.String(), missingID, joined.ID.String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, states, 5)
+
+	// Results follow request order with duplicates collapsed
+	assert.Equal(t, untouched.ID.String(), states[0].EventID)
+	assert.False(t, states[0].IsJoined)
+	assert.Nil(t, states[0].MemberStatus)
+	assert.Nil(t, states[0].SwipeDirection)
+
+	assert.Equal(t, joined.ID.String(), states[1].EventID)
+	assert.True(t, states[1].IsJoined)
+	require.NotNil(t, states[1].MemberStatus)
+	assert.Equal(t, "confirmed", *states[1].MemberStatus)
+	assert.Nil(t, states[1].SwipeDirection)
+
+	assert.Equal(t, pending.ID.String(), states[2].EventID)
+	assert.False(t, states[2].IsJoined)
+	require.NotNil(t, states[2].MemberStatus)
+	assert.Equal(t, "pending", *states[2].MemberStatus)
+	require.NotNil(t, states[2].SwipeDirection)
+	assert.Equal(t, "like", *states[2].SwipeDirection)
+
+	assert.Equal(t, swiped.ID.String(), states[3].EventID)
+	assert.False(t, states[3].IsJoined)
+	assert.Nil(t, states[3].MemberStatus)
+	require.NotNil(t, states[3].SwipeDirection)
+	assert.Equal(t, "pass", *states[3].SwipeDirection)
+
+	assert.Equal(t, missingID, states[4].EventID)
+	assert.Nil(t, states[4].MemberStatus)
+	assert.Nil(t, states[4].SwipeDirection)
+}
+
+func TestGetEventStates_DeletedEventIsUntouched(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Creator")
+	viewer := createTestUser(t, db, "Viewer")
+	event := createTestEvent(t, db, creator, "Deleted Trip")
+	addTestMember(t, db, event, viewer, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	require.NoError(t, db.Create(&models.EventSwipe{UserID: viewer.ID, EventID: event.ID, Direction: models.SwipeDirectionLike}).Error)
+	require.NoError(t, db.Model(&models.Event{}).Where("id = ?", event.ID).Update("deleted_at", time.Now()).Error)
+
+	states, err := service.NewEventService().GetEventStates(viewer.ID.String(), []string{event.ID.String()})
+	require.NoError(t, err)
+	require.Len(t, states, 1)
+	assert.False(t, states[0].IsJoined)
+	assert.Nil(t, states[0].MemberStatus)
+	assert.Nil(t, states[0].SwipeDirection)
+}
+
+func TestGetEventStates_InvalidEventID(t *testing.T) {
+	setupEventDomainDB(t)
+
+	_, err := service.NewEventService().GetEventStates(uuid.New().String(), []string{"not-a-uuid"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid event ID")
+}
@@ -0,0 +1,77 @@
+package service_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// countSwipeRowsLoaded counts the event_swipes rows read by queries from here on
+func countSwipeRowsLoaded(t *testing.T, db *gorm.DB) func() int64 {
+	t.Helper()
+	var mu sync.Mutex
+	var loaded int64
+	err := db.Callback().Query().After("gorm:query").Register("test:count_swipes", func(tx *gorm.DB) {
+		if tx.Statement.Table == "event_swipes" {
+			mu.Lock()
+			loaded += tx.RowsAffected
+			mu.Unlock()
+		}
+	})
+	require.NoError(t, err)
+	return func() int64 {
+		mu.Lock()
+		defer mu.Unlock()
+		return loaded
+	}
+}
+
+func TestEventLists_LoadOnlyViewerSwipes(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "swipe-creator")
+	viewer := createTestUser(t, db, "swipe-viewer")
+	popular := createTestEvent(t, db, creator, "Popular trip")
+	quiet := createTestEvent(t, db, creator, "Quiet trip")
+	for i := 0; i < 5; i++ {
+		fan := createTestUser(t, db, fmt.Sprintf("swipe-fan-%d", i))
+		require.NoError(t, db.Create(&models.EventSwipe{UserID: fan.ID, EventID: popular.ID, Direction: models.SwipeDirectionLike}).Error)
+		require.NoError(t, db.Create(&models.EventSwipe{UserID: fan.ID, EventID: quiet.ID, Direction: models.SwipeDirectionPass}).Error)
+	}
+	require.NoError(t, db.Create(&models.EventSwipe{UserID: viewer.ID, EventID: popular.ID, Direction: models.SwipeDirectionLike}).Error)
+
+	loaded := countSwipeRowsLoaded(t, db)
+
+	events, _, err := eventService.GetEvents(viewer.ID.String(), 1, 10, "", "", "")
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	for _, event := range events {
+		if event.ID == popular.ID.String() {
+			require.NotNil(t, event.UserSwipe)
+			assert.Equal(t, viewer.ID.String(), event.UserSwipe.UserID)
+			assert.Equal(t, string(models.SwipeDirectionLike), event.UserSwipe.Direction)
+		} else {
+			assert.Nil(t, event.UserSwipe)
+		}
+	}
+	// Only the viewer's one swipe is read, not the other ten
+	assert.Equal(t, int64(1), loaded())
+
+	// Anonymous public lists read no swipes
+	_, _, err = eventService.GetPublicEvents(1, 10, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), loaded())
+
+	detail, err := eventService.GetEvent(popular.ID.String(), viewer.ID.String())
+	require.NoError(t, err)
+	require.NotNil(t, detail.UserSwipe)
+	assert.Equal(t, int64(2), loaded())
+}
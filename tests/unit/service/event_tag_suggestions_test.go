@@ -0,0 +1,82 @@
+package service_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggestEventTags_MapsDraftTextToTags(t *testing.T) {
+	db := setupEventDomainDB(t)
+	createKindTag(t, db, "Hiking", models.TagKindActivity)
+	createKindTag(t, db, "Street Food", models.TagKindFood)
+	createKindTag(t, db, "Waterfall", models.TagKindLocation)
+	createKindTag(t, db, "Photography", models.TagKindInterest)
+	createKindTag(t, db, "Camping", models.TagKindActivity)
+	createKindTag(t, db, "Art", models.TagKindInterest)
+	createKindTag(t, db, "Bus", models.TagKindTransport)
+	createKindTag(t, db, "Outdoor", models.TagKindCategory)
+
+	tagService := service.NewTagService()
+	for _, tc := range []struct {
+		title, description string
+		want               []string
+	}{
+		{
+			// Title matches come first; plurals match and the case is ignored
+			title:       "Waterfall hiking day",
+			description: "We'll hike to two WATERFALLS and grab street food after. Bring a camera for photography!",
+			want:        []string{"Hiking", "Waterfall", "Photography", "Street Food"},
+		},
+		{
+			// No partial words, nothing after a negation, and categories are not suggested
+			title:       "Smart outdoor weekend",
+			description: "Hotel stay, no camping. Buses leave at 8.",
+			want:        []string{"Bus"},
+		},
+		{
+			title: "Quiet dinner",
+			want:  []string{},
+		},
+		{
+			description: "Hiking only",
+			want:        []string{"Hiking"},
+		},
+	} {
+		tags, err := tagService.SuggestEventTags(tc.title, tc.description)
+		require.NoError(t, err)
+		assert.Equal(t, tc.want, tagNames(tags), "title %q, description %q", tc.title, tc.description)
+	}
+
+	// An empty draft has nothing to match
+	tags, err := tagService.SuggestEventTags("  ", "")
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+}
+
+func TestSuggestEventTags_LimitsAndValidation(t *testing.T) {
+	db := setupEventDomainDB(t)
+	setEventTextLimits(t, 50, 200)
+	var words []string
+	for i := 0; i < 15; i++ {
+		name := fmt.Sprintf("Tour%02d", i)
+		createKindTag(t, db, name, models.TagKindActivity)
+		words = append(words, name)
+	}
+
+	tagService := service.NewTagService()
+	tags, err := tagService.SuggestEventTags("", strings.Join(words, " "))
+	require.NoError(t, err)
+	assert.Len(t, tags, 10)
+
+	_, err = tagService.SuggestEventTags(strings.Repeat("a", 51), "")
+	assert.EqualError(t, err, "title must be at most 50 characters")
+	_, err = tagService.SuggestEventTags("", strings.Repeat("a", 201))
+	assert.EqualError(t, err, "description must be at most 200 characters")
+}
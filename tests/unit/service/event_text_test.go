@@ -0,0 +1,139 @@
+package service_test
+
+import (
+	"strings"
+	"testing"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/pkg/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setEventTextLimits configures the title and description limits for one test
+func setEventTextLimits(t *testing.T, title, description int) {
+	t.Helper()
+	previous := config.AppConfig.Event
+	config.AppConfig.Event.TitleMaxLength = title
+	config.AppConfig.Event.DescriptionMaxLength = description
+	t.Cleanup(func() { config.AppConfig.Event = previous })
+}
+
+func TestCreateEvent_TextLengthLimits(t *testing.T) {
+	db := setupEventDomainDB(t)
+	setEventTextLimits(t, 10, 20)
+	eventService := service.NewEventService()
+	creator := createTestUser(t, db, "limits-creator")
+
+	create := func(title string, description *string) (*dto.EventResponse, error) {
+		return eventService.CreateEvent(creator.ID.String(), dto.CreateEventRequest{
+			Title:       title,
+			Description: description,
+			EventType:   string(models.EventTypeMeal),
+		})
+	}
+
+	// Exactly at the limit, counted in characters rather than bytes
+	atLimit := strings.Repeat("ก", 20)
+	created, err := create(strings.Repeat("ท", 10), &atLimit)
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("ท", 10), created.Title)
+	require.NotNil(t, created.Description)
+	assert.Equal(t, atLimit, *created.Description)
+
+	// One over
+	_, err = create(strings.Repeat("a", 11), nil)
+	require.Error(t, err)
+	assert.Equal(t, "title must be at most 10 characters", err.Error())
+
+	overLimit := strings.Repeat("a", 21)
+	_, err = create("Picnic", &overLimit)
+	require.Error(t, err)
+	assert.Equal(t, "description must be at most 20 characters", err.Error())
+
+	// Surrounding whitespace does not count against the limit
+	padded := "   " + strings.Repeat("b", 20) + "\n\n"
+	created, err = create("  Picnic  ", &padded)
+	require.NoError(t, err)
+	assert.Equal(t, "Picnic", created.Title)
+	assert.Equal(t, strings.Repeat("b", 20), *created.Description)
+
+	_, err = create(" \t ", nil)
+	require.Error(t, err)
+	assert.Equal(t, "title is required", err.Error())
+}
+
+func TestCreateEvent_SanitizesText(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+	creator := createTestUser(t, db, "sanitize-creator")
+
+	description := "Bring snacks\r\n\tand water\x00\x07 🙂\n"
+	created, err := eventService.CreateEvent(creator.ID.String(), dto.CreateEventRequest{
+		Title:       "Sunset\x1b[31m  hike\n\ttogether",
+		Description: &description,
+		EventType:   string(models.EventTypeMeal),
+	})
+	require.NoError(t, err)
+
+	// Titles are a single line; descriptions keep their line breaks and tabs
+	assert.Equal(t, "Sunset[31m hike together", created.Title)
+	require.NotNil(t, created.Description)
+	assert.Equal(t, "Bring snacks\n\tand water 🙂", *created.Description)
+
+	// A description that is only whitespace is not stored
+	blank := " \n "
+	created, err = eventService.CreateEvent(creator.ID.String(), dto.CreateEventRequest{
+		Title:       "No details",
+		Description: &blank,
+		EventType:   string(models.EventTypeMeal),
+	})
+	require.NoError(t, err)
+	assert.Nil(t, created.Description)
+}
+
+func TestUpdateEvent_TextLengthLimits(t *testing.T) {
+	db := setupEventDomainDB(t)
+	setEventTextLimits(t, 10, 20)
+	eventService := service.NewEventService()
+	creator := createTestUser(t, db, "update-limits-creator")
+	event := createTestEvent(t, db, creator, "Original")
+	eventID := event.ID.String()
+
+	tooLong := strings.Repeat("a", 11)
+	_, err := eventService.UpdateEvent(eventID, creator.ID.String(), dto.UpdateEventRequest{Title: &tooLong})
+	require.Error(t, err)
+	assert.Equal(t, "title must be at most 10 characters", err.Error())
+
+	overLimit := strings.Repeat("a", 21)
+	_, err = eventService.UpdateEvent(eventID, creator.ID.String(), dto.UpdateEventRequest{Description: &overLimit})
+	require.Error(t, err)
+	assert.Equal(t, "description must be at most 20 characters", err.Error())
+
+	var unchanged models.Event
+	require.NoError(t, db.First(&unchanged, "id = ?", event.ID).Error)
+	assert.Equal(t, "Original", unchanged.Title)
+
+	title, description := " Renamed\x00 ", strings.Repeat("d", 20)
+	updated, err := eventService.UpdateEvent(eventID, creator.ID.String(), dto.UpdateEventRequest{
+		Title:       &title,
+		Description: &description,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", updated.Title)
+	assert.Equal(t, description, *updated.Description)
+
+	// A blank description clears it
+	blank := "  "
+	updated, err = eventService.UpdateEvent(eventID, creator.ID.String(), dto.UpdateEventRequest{Description: &blank})
+	require.NoError(t, err)
+	assert.Nil(t, updated.Description)
+
+	empty := ""
+	_, err = eventService.UpdateEvent(eventID, creator.ID.String(), dto.UpdateEventRequest{Title: &empty})
+	require.Error(t, err)
+	assert.Equal(t, "title is required", err.Error())
+}
@@ -0,0 +1,112 @@
+package service_test
+
+import (
+	"testing"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventService_TransferOwnership(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "transfer-creator")
+	cohost := createTestUser(t, db, "transfer-cohost")
+	guest := createTestUser(t, db, "transfer-guest")
+	event := createTestEvent(t, db, creator, "Island hopping")
+	addTestMember(t, db, event, cohost, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	addTestMember(t, db, event, guest, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	eventID := event.ID.String()
+
+	require.NoError(t, eventService.TransferOwnership(eventID, creator.ID.String(), cohost.ID.String()))
+
+	var updated models.Event
+	require.NoError(t, db.First(&updated, "id = ?", event.ID).Error)
+	assert.Equal(t, cohost.ID, updated.CreatorID)
+
+	var oldMember, newMember models.EventMember
+	require.NoError(t, db.Where("event_id = ? AND user_id = ?", event.ID, creator.ID).First(&oldMember).Error)
+	require.NoError(t, db.Where("event_id = ? AND user_id = ?", event.ID, cohost.ID).First(&newMember).Error)
+	assert.Equal(t, models.MemberRoleParticipant, oldMember.Role)
+	assert.Equal(t, models.MemberStatusConfirmed, oldMember.Status)
+	assert.Equal(t, models.MemberRoleCreator, newMember.Role)
+
+	// Members other than the previous creator are notified
+	var notified []string
+	require.NoError(t, db.Model(&models.Notification{}).Pluck("user_id", &notified).Error)
+	assert.ElementsMatch(t, []string{cohost.ID.String(), guest.ID.String()}, notified)
+}
+
+func TestEventService_TransferOwnershipPermissions(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "perm-creator")
+	cohost := createTestUser(t, db, "perm-cohost")
+	event := createTestEvent(t, db, creator, "Night market")
+	addTestMember(t, db, event, cohost, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	eventID := event.ID.String()
+
+	require.NoError(t, eventService.TransferOwnership(eventID, creator.ID.String(), cohost.ID.String()))
+
+	// The previous creator loses creator-only actions
+	title := "Renamed"
+	_, err := eventService.UpdateEvent(eventID, creator.ID.String(), dto.UpdateEventRequest{Title: &title})
+	require.Error(t, err)
+	assert.Equal(t, "unauthorized", err.Error())
+
+	err = eventService.TransferOwnership(eventID, creator.ID.String(), creator.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "permission denied", err.Error())
+
+	// The new creator gains them
+	_, err = eventService.UpdateEvent(eventID, cohost.ID.String(), dto.UpdateEventRequest{Title: &title})
+	assert.NoError(t, err)
+
+	// Leaving as the previous creator no longer deletes the event
+	err, deleted := eventService.LeaveEvent(eventID, creator.ID.String())
+	require.NoError(t, err)
+	assert.False(t, deleted)
+
+	var stillThere models.Event
+	require.NoError(t, db.First(&stillThere, "id = ?", event.ID).Error)
+	assert.Nil(t, stillThere.DeletedAt)
+}
+
+func TestEventService_TransferOwnershipValidation(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "valid-creator")
+	pending := createTestUser(t, db, "valid-pending")
+	outsider := createTestUser(t, db, "valid-outsider")
+	event := createTestEvent(t, db, creator, "Museum day")
+	addTestMember(t, db, event, pending, models.MemberRoleParticipant, models.MemberStatusPending)
+	eventID := event.ID.String()
+
+	tests := []struct {
+		name       string
+		actor      string
+		newCreator string
+		errMsg     string
+	}{
+		{name: "Non creator", actor: outsider.ID.String(), newCreator: pending.ID.String(), errMsg: "permission denied"},
+		{name: "Pending member", actor: creator.ID.String(), newCreator: pending.ID.String(), errMsg: "new creator must be a confirmed member"},
+		{name: "Not a member", actor: creator.ID.String(), newCreator: outsider.ID.String(), errMsg: "new creator must be a confirmed member"},
+		{name: "Self", actor: creator.ID.String(), newCreator: creator.ID.String(), errMsg: "already the creator"},
+		{name: "Invalid ID", actor: creator.ID.String(), newCreator: "nope", errMsg: "invalid new creator id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := eventService.TransferOwnership(eventID, tt.actor, tt.newCreator)
+			require.Error(t, err)
+			assert.Equal(t, tt.errMsg, err.Error())
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package service_test
+
+import (
+	"testing"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateEvent_EventTypeValidation(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+	creator := createTestUser(t, db, "type-creator")
+
+	for _, eventType := range models.EventTypes {
+		created, err := eventService.CreateEvent(creator.ID.String(), dto.CreateEventRequest{
+			Title:     "Typed event",
+			EventType: string(eventType),
+		})
+		require.NoError(t, err, "event type %q should be accepted", eventType)
+		assert.Equal(t, string(eventType), created.EventType)
+	}
+
+	for _, eventType := range []string{"daytrip", "activity", "MEAL", "party", ""} {
+		_, err := eventService.CreateEvent(creator.ID.String(), dto.CreateEventRequest{
+			Title:     "Bogus event",
+			EventType: eventType,
+		})
+		require.Error(t, err, "event type %q should be rejected", eventType)
+		assert.Contains(t, err.Error(), "invalid event_type")
+	}
+
+	var count int64
+	require.NoError(t, db.Model(&models.Event{}).Where("title = ?", "Bogus event").Count(&count).Error)
+	assert.Zero(t, count)
+}
+
+func TestUpdateEvent_EventTypeValidation(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+	creator := createTestUser(t, db, "type-updater")
+	event := createTestEvent(t, db, creator, "Retyped trip")
+
+	daytrip := string(models.EventTypeDaytrip)
+	updated, err := eventService.UpdateEvent(event.ID.String(), creator.ID.String(), dto.UpdateEventRequest{EventType: &daytrip})
+	require.NoError(t, err)
+	assert.Equal(t, daytrip, updated.EventType)
+
+	bogus := "road_trip"
+	_, err = eventService.UpdateEvent(event.ID.String(), creator.ID.String(), dto.UpdateEventRequest{EventType: &bogus})
+	require.Error(t, err)
+	assert.Equal(t, `invalid event_type "road_trip"`, err.Error())
+
+	var stored models.Event
+	require.NoError(t, db.First(&stored, "id = ?", event.ID).Error)
+	assert.Equal(t, models.EventTypeDaytrip, stored.EventType)
+}
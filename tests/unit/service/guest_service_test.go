@@ -0,0 +1,217 @@
+package service_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/api/middleware"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// setupGuestTest sets up the event domain database with a JWT secret for signing guest tokens
+func setupGuestTest(t *testing.T) *gorm.DB {
+	t.Helper()
+	db := setupEventDomainDB(t)
+	previous := config.AppConfig.JWT
+	config.AppConfig.JWT = config.JWTConfig{Secret: "test-secret", ExpireHours: 24}
+	t.Cleanup(func() { config.AppConfig.JWT = previous })
+	return db
+}
+
+// guestViews returns how often the guest has seen each event, by event title
+func guestViews(t *testing.T, db *gorm.DB) map[string]int {
+	t.Helper()
+	var rows []struct {
+		Title     string
+		SeenCount int
+	}
+	require.NoError(t, db.Table("guest_event_views").
+		Select("events.title, guest_event_views.seen_count").
+		Joins("JOIN events ON events.id = guest_event_views.event_id").
+		Scan(&rows).Error)
+	views := make(map[string]int)
+	for _, row := range rows {
+		views[row.Title] = row.SeenCount
+	}
+	return views
+}
+
+func TestIssueGuestToken(t *testing.T) {
+	db := setupGuestTest(t)
+	now := time.Now().Truncate(time.Second)
+	guestService := service.NewGuestServiceWithClock(utils.NewFakeClock(now))
+
+	issued, err := guestService.IssueGuestToken()
+	require.NoError(t, err)
+	assert.True(t, issued.ExpiresAt.Equal(now.Add(30*24*time.Hour)))
+
+	claims, err := utils.ValidateGuestToken(issued.GuestToken)
+	require.NoError(t, err)
+	var guest models.GuestSession
+	require.NoError(t, db.First(&guest, "id = ?", claims.GuestID).Error)
+	assert.False(t, guest.IsConverted())
+
+	// A guest token is never accepted as a user's token, nor the other way round
+	_, err = utils.ValidateToken(issued.GuestToken)
+	assert.Error(t, err)
+	userToken, err := utils.GenerateToken(createTestUser(t, db, "signed-in").ID.String(), "signed-in@example.com", "password")
+	require.NoError(t, err)
+	_, err = utils.ValidateGuestToken(userToken)
+	assert.Error(t, err)
+}
+
+func TestCleanupExpiredGuestSessions(t *testing.T) {
+	db := setupGuestTest(t)
+	clock := utils.NewFakeClock(time.Now().Truncate(time.Second))
+	guestService := service.NewGuestServiceWithClock(clock)
+
+	creator := createTestUser(t, db, "cleanup-creator")
+	event := createTestEvent(t, db, creator, "Old town walk")
+
+	old, err := guestService.IssueGuestToken()
+	require.NoError(t, err)
+	oldClaims, err := utils.ValidateGuestToken(old.GuestToken)
+	require.NoError(t, err)
+	guestService.RecordGuestViews(oldClaims.GuestID, []string{event.ID.String()})
+
+	clock.Advance(10 * 24 * time.Hour)
+	recent, err := guestService.IssueGuestToken()
+	require.NoError(t, err)
+	recentClaims, err := utils.ValidateGuestToken(recent.GuestToken)
+	require.NoError(t, err)
+	guestService.RecordGuestViews(recentClaims.GuestID, []string{event.ID.String()})
+
+	// Nothing has expired yet
+	deleted, err := guestService.CleanupExpiredGuestSessions()
+	require.NoError(t, err)
+	assert.Zero(t, deleted)
+
+	// Only the first token has expired
+	clock.Advance(25 * 24 * time.Hour)
+	deleted, err = guestService.CleanupExpiredGuestSessions()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	var remaining []models.GuestSession
+	require.NoError(t, db.Find(&remaining).Error)
+	require.Len(t, remaining, 1)
+	assert.Equal(t, recentClaims.GuestID, remaining[0].ID.String())
+
+	var views []models.GuestEventView
+	require.NoError(t, db.Find(&views).Error)
+	require.Len(t, views, 1)
+	assert.Equal(t, recentClaims.GuestID, views[0].GuestID.String())
+}
+
+func TestConvertGuest(t *testing.T) {
+	db := setupGuestTest(t)
+	guestService := service.NewGuestService()
+
+	creator := createTestUser(t, db, "guest-creator")
+	user := createTestUser(t, db, "converted")
+	other := createTestUser(t, db, "latecomer")
+	beach := createTestEvent(t, db, creator, "Beach")
+	market := createTestEvent(t, db, creator, "Market")
+
+	issued, err := guestService.IssueGuestToken()
+	require.NoError(t, err)
+	claims, err := utils.ValidateGuestToken(issued.GuestToken)
+	require.NoError(t, err)
+
+	guestService.RecordGuestViews(claims.GuestID, []string{beach.ID.String(), market.ID.String()})
+	guestService.RecordGuestViews(claims.GuestID, []string{beach.ID.String()})
+	assert.Equal(t, map[string]int{"Beach": 2, "Market": 1}, guestViews(t, db))
+
+	// The user was already served the market once before signing in
+	require.NoError(t, db.Create(&models.EventImpression{
+		EventID: market.ID, UserID: user.ID, FirstServedAt: time.Now(), LastServedAt: time.Now(), ServedCount: 1,
+	}).Error)
+
+	converted, err := guestService.ConvertGuest(user.ID.String(), issued.GuestToken)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), converted.SeenEvents)
+
+	served := func(event *models.Event) int {
+		var impression models.EventImpression
+		require.NoError(t, db.Where("event_id = ? AND user_id = ?", event.ID, user.ID).First(&impression).Error)
+		return impression.ServedCount
+	}
+	assert.Equal(t, 2, served(beach))
+	assert.Equal(t, 2, served(market))
+	assert.Empty(t, guestViews(t, db))
+
+	// A converted guest no longer browses as a guest
+	guestService.RecordGuestViews(claims.GuestID, []string{beach.ID.String()})
+	assert.Empty(t, guestViews(t, db))
+
+	again, err := guestService.ConvertGuest(user.ID.String(), issued.GuestToken)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), again.SeenEvents)
+	assert.Equal(t, 2, served(beach))
+
+	_, err = guestService.ConvertGuest(other.ID.String(), issued.GuestToken)
+	require.Error(t, err)
+	assert.Equal(t, "guest already converted", err.Error())
+
+	_, err = guestService.ConvertGuest(user.ID.String(), "not-a-token")
+	require.Error(t, err)
+	assert.Equal(t, "invalid guest token", err.Error())
+}
+
+func TestGuestHandlers(t *testing.T) {
+	db := setupGuestTest(t)
+	creator := createTestUser(t, db, "public-creator")
+	user := createTestUser(t, db, "guest-signup")
+	event := createTestEvent(t, db, creator, "Night market")
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	guestHandler := handlers.NewGuestHandler()
+	eventHandler := handlers.NewEventHandler()
+	router.POST("/auth/guest", guestHandler.IssueGuestToken)
+	router.GET("/public/events/:id", middleware.GuestContext(), eventHandler.GetPublicEvent)
+	router.POST("/auth/guest/convert", func(c *gin.Context) {
+		c.Set("user_id", user.ID.String())
+		c.Next()
+	}, guestHandler.ConvertGuest)
+
+	w := serveEventRequest(router, "POST", "/auth/guest", "")
+	require.Equal(t, http.StatusCreated, w.Code)
+	var issued struct {
+		Data struct {
+			GuestToken string `json:"guest_token"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &issued))
+	require.NotEmpty(t, issued.Data.GuestToken)
+
+	// Anonymous views are not recorded, a guest's are
+	w = serveEventRequest(router, "GET", "/public/events/"+event.ID.String(), "")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, guestViews(t, db))
+
+	req := httptest.NewRequest("GET", "/public/events/"+event.ID.String(), nil)
+	req.Header.Set(middleware.GuestTokenHeader, issued.Data.GuestToken)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, map[string]int{"Night market": 1}, guestViews(t, db))
+
+	w = serveEventRequest(router, "POST", "/auth/guest/convert", `{"guest_token": "`+issued.Data.GuestToken+`"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"seen_events":1`)
+	w = serveEventRequest(router, "POST", "/auth/guest/convert", `{"guest_token": "nope"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
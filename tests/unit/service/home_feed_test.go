@@ -0,0 +1,68 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHomeService_GetHomeFeed(t *testing.T) {
+	db := setupEventDomainDB(t)
+	homeService := service.NewHomeService()
+
+	viewer := createTestUser(t, db, "home-viewer")
+	creator := createTestUser(t, db, "home-creator")
+	fanA := createTestUser(t, db, "home-fan-a")
+	fanB := createTestUser(t, db, "home-fan-b")
+
+	tomorrow := time.Now().Add(24 * time.Hour)
+	joined := createTestEvent(t, db, creator, "Joined picnic")
+	require.NoError(t, db.Model(joined).Update("start_at", tomorrow).Error)
+	addTestMember(t, db, joined, viewer, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+
+	popular := createTestEvent(t, db, creator, "Popular rooftop")
+	for _, fan := range []*models.User{fanA, fanB} {
+		require.NoError(t, db.Create(&models.EventSwipe{UserID: fan.ID, EventID: popular.ID, Direction: models.SwipeDirectionLike}).Error)
+	}
+	quiet := createTestEvent(t, db, creator, "Quiet museum")
+
+	feed, err := homeService.GetHomeFeed(viewer.ID.String(), 5, dto.HomeFeedTokens{})
+	require.NoError(t, err)
+
+	// The joined event is only upcoming, never suggested
+	assert.Len(t, feed.Suggestions.Items, 2)
+	assert.Nil(t, feed.Suggestions.NextToken)
+
+	require.Len(t, feed.Upcoming.Items, 1)
+	assert.Equal(t, joined.ID.String(), feed.Upcoming.Items[0].ID)
+
+	// Joined and inactive events are not trending; the creator's own membership is not activity
+	require.Len(t, feed.Trending.Items, 1)
+	assert.Equal(t, popular.ID.String(), feed.Trending.Items[0].ID)
+	assert.NotEqual(t, quiet.ID.String(), feed.Trending.Items[0].ID)
+
+	t.Run("sections paginate independently", func(t *testing.T) {
+		first, err := homeService.GetHomeFeed(viewer.ID.String(), 1, dto.HomeFeedTokens{})
+		require.NoError(t, err)
+		require.NotNil(t, first.Suggestions.NextToken)
+		assert.Nil(t, first.Upcoming.NextToken)
+
+		second, err := homeService.GetHomeFeed(viewer.ID.String(), 1, dto.HomeFeedTokens{Suggestions: *first.Suggestions.NextToken})
+		require.NoError(t, err)
+		require.Len(t, second.Suggestions.Items, 1)
+		assert.NotEqual(t, first.Suggestions.Items[0].Event.ID, second.Suggestions.Items[0].Event.ID)
+		assert.Len(t, second.Upcoming.Items, 1)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		_, err := homeService.GetHomeFeed(viewer.ID.String(), 5, dto.HomeFeedTokens{Trending: "not-a-token"})
+		require.Error(t, err)
+		assert.Equal(t, "invalid page token", err.Error())
+	})
+}
@@ -0,0 +1,96 @@
+package service_test
+
+import (
+	"testing"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// setInterestsNote creates the user's profile with the given interests note
+func setInterestsNote(t *testing.T, db *gorm.DB, user *models.User, note string) {
+	t.Helper()
+	profile := &models.UserProfile{ID: uuid.New(), UserID: user.ID, InterestsNote: &note}
+	require.NoError(t, db.Create(profile).Error)
+}
+
+func TestGetInterestNoteTags_MapsNoteToKnownTags(t *testing.T) {
+	db := setupEventDomainDB(t)
+	user := createTestUser(t, db, "Traveler")
+
+	createKindTag(t, db, "Hiking", models.TagKindActivity)
+	createKindTag(t, db, "Street Food", models.TagKindFood)
+	createKindTag(t, db, "Museum", models.TagKindActivity)
+	createKindTag(t, db, "Beach", models.TagKindLocation)
+	createKindTag(t, db, "Art", models.TagKindInterest)
+	createKindTag(t, db, "Camping", models.TagKindActivity)
+	createKindTag(t, db, "Spa", models.TagKindActivity)
+
+	setInterestsNote(t, db, user, "I love HIKING, street food and old museums! Not camping though. Smartphones & spas?")
+
+	tags, err := service.NewTagService().GetInterestNoteTags(user.ID.String())
+	require.NoError(t, err)
+
+	// "Art" is not matched inside "smartphones", "camping" follows a negation,
+	// and plurals such as "museums" and "spas" match their singular tag
+	assert.ElementsMatch(t, []string{"Hiking", "Museum", "Spa", "Street Food"}, tagNames(tags))
+}
+
+func TestGetInterestNoteTags_SkipsPickedTagsAndEmptyNote(t *testing.T) {
+	db := setupEventDomainDB(t)
+	user := createTestUser(t, db, "Traveler")
+	hiking := createKindTag(t, db, "Hiking", models.TagKindActivity)
+	createKindTag(t, db, "Kayaking", models.TagKindActivity)
+
+	tagService := service.NewTagService()
+
+	// No profile yet
+	tags, err := tagService.GetInterestNoteTags(user.ID.String())
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+
+	setInterestsNote(t, db, user, "hiking and kayaking")
+	require.NoError(t, db.Create(&models.UserTag{UserID: user.ID, TagID: hiking.ID}).Error)
+
+	tags, err = tagService.GetInterestNoteTags(user.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Kayaking"}, tagNames(tags))
+}
+
+func TestAddInterestNoteTags_AddsOnlyConfirmedSuggestions(t *testing.T) {
+	db := setupEventDomainDB(t)
+	user := createTestUser(t, db, "Traveler")
+	hiking := createKindTag(t, db, "Hiking", models.TagKindActivity)
+	kayaking := createKindTag(t, db, "Kayaking", models.TagKindActivity)
+	beach := createKindTag(t, db, "Beach", models.TagKindLocation)
+	setInterestsNote(t, db, user, "hiking, kayaking")
+
+	tagService := service.NewTagService()
+
+	// A tag the note does not mention is rejected and nothing is saved
+	_, err := tagService.AddInterestNoteTags(user.ID.String(), []string{hiking.ID.String(), beach.ID.String()})
+	require.Error(t, err)
+	assert.Equal(t, "tag not suggested", err.Error())
+
+	var count int64
+	db.Model(&models.UserTag{}).Where("user_id = ?", user.ID).Count(&count)
+	assert.Equal(t, int64(0), count)
+
+	// The user confirms only one of the two suggestions
+	added, err := tagService.AddInterestNoteTags(user.ID.String(), []string{hiking.ID.String(), hiking.ID.String()})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Hiking"}, tagNames(added))
+
+	db.Model(&models.UserTag{}).Where("user_id = ?", user.ID).Count(&count)
+	assert.Equal(t, int64(1), count)
+
+	// Once added, the tag is no longer suggested
+	remaining, err := tagService.GetInterestNoteTags(user.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, []string{kayaking.Name}, tagNames(remaining))
+}
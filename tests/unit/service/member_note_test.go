@@ -0,0 +1,110 @@
+package service_test
+
+import (
+	"strings"
+	"testing"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+// notesByUser maps each listed member to their note as returned to the viewer
+func notesByUser(members []dto.EventMemberResponse) map[string]*string {
+	notes := make(map[string]*string, len(members))
+	for _, member := range members {
+		notes[member.UserID] = member.Note
+	}
+	return notes
+}
+
+func TestSetMemberNote(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "note-creator")
+	member := createTestUser(t, db, "note-member")
+	outsider := createTestUser(t, db, "note-outsider")
+	event := createTestEvent(t, db, creator, "Street food tour")
+	addTestMember(t, db, event, member, models.MemberRoleParticipant, models.MemberStatusPending)
+	eventID := event.ID.String()
+
+	stored, err := eventService.SetMemberNote(eventID, member.ID.String(), strPtr("  Vegetarian, no peanuts  "))
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, "Vegetarian, no peanuts", *stored)
+
+	var row models.EventMember
+	require.NoError(t, db.Where("event_id = ? AND user_id = ?", event.ID, member.ID).First(&row).Error)
+	require.NotNil(t, row.Note)
+	assert.Equal(t, "Vegetarian, no peanuts", *row.Note)
+
+	// Too long notes are refused and the stored note is kept
+	_, err = eventService.SetMemberNote(eventID, member.ID.String(), strPtr(strings.Repeat("ก", 501)))
+	require.Error(t, err)
+	assert.Equal(t, "note is too long", err.Error())
+
+	// Blank and null notes clear it
+	for _, note := range []*string{strPtr("   "), nil} {
+		require.NoError(t, db.Model(&row).Update("note", "something").Error)
+		stored, err = eventService.SetMemberNote(eventID, member.ID.String(), note)
+		require.NoError(t, err)
+		assert.Nil(t, stored)
+		require.NoError(t, db.Where("event_id = ? AND user_id = ?", event.ID, member.ID).First(&row).Error)
+		assert.Nil(t, row.Note)
+	}
+
+	// Only members have a note to set
+	_, err = eventService.SetMemberNote(eventID, outsider.ID.String(), strPtr("Let me in"))
+	require.Error(t, err)
+	assert.Equal(t, "member not found", err.Error())
+}
+
+func TestMemberNote_VisibleToCreatorAndSelfOnly(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "scope-creator")
+	alice := createTestUser(t, db, "scope-alice")
+	bob := createTestUser(t, db, "scope-bob")
+	event := createTestEvent(t, db, creator, "Cooking class")
+	addTestMember(t, db, event, alice, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	addTestMember(t, db, event, bob, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	eventID := event.ID.String()
+
+	_, err := eventService.SetMemberNote(eventID, alice.ID.String(), strPtr("Allergic to shellfish"))
+	require.NoError(t, err)
+	_, err = eventService.SetMemberNote(eventID, bob.ID.String(), strPtr("Arriving late"))
+	require.NoError(t, err)
+
+	// The creator sees every note
+	seen, err := eventService.GetEvent(eventID, creator.ID.String())
+	require.NoError(t, err)
+	notes := notesByUser(seen.Members)
+	require.NotNil(t, notes[alice.ID.String()])
+	assert.Equal(t, "Allergic to shellfish", *notes[alice.ID.String()])
+	require.NotNil(t, notes[bob.ID.String()])
+	assert.Equal(t, "Arriving late", *notes[bob.ID.String()])
+
+	// Members see only their own
+	seen, err = eventService.GetEvent(eventID, alice.ID.String())
+	require.NoError(t, err)
+	notes = notesByUser(seen.Members)
+	require.NotNil(t, notes[alice.ID.String()])
+	assert.Equal(t, "Allergic to shellfish", *notes[alice.ID.String()])
+	assert.Nil(t, notes[bob.ID.String()])
+
+	// The public member list has none
+	public, err := eventService.GetPublicEvent(eventID, "")
+	require.NoError(t, err)
+	for _, member := range public.Members {
+		assert.Nil(t, member.Note, member.UserID)
+	}
+}
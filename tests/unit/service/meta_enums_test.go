@@ -0,0 +1,77 @@
+package service_test
+
+import (
+	"testing"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func enumValues(options []dto.EnumOption) []string {
+	values := make([]string, 0, len(options))
+	for _, option := range options {
+		values = append(values, option.Value)
+	}
+	return values
+}
+
+func TestGetEnums_ContainsKnownValues(t *testing.T) {
+	enums := service.NewMetaService().GetEnums("en")
+
+	assert.Equal(t, "en", enums.Locale)
+	assert.Equal(t, []string{"meal", "one_day_trip", "overnight"}, enumValues(enums.EventTypes))
+	assert.Equal(t, []string{"published", "cancelled", "completed"}, enumValues(enums.EventStatuses))
+	assert.Equal(t, []string{"creator", "participant"}, enumValues(enums.MemberRoles))
+	assert.Equal(t, []string{"pending", "confirmed", "declined", "kicked", "left"}, enumValues(enums.MemberStatuses))
+	assert.Contains(t, enumValues(enums.TagKinds), "interest")
+	assert.Contains(t, enumValues(enums.TagKinds), "category")
+	assert.Equal(t, []string{"male", "female", "nonbinary", "prefer_not_say"}, enumValues(enums.Genders))
+	assert.Equal(t, []string{"no", "yes", "occasionally"}, enumValues(enums.SmokingOptions))
+	assert.Equal(t, []string{"like", "pass"}, enumValues(enums.SwipeDirections))
+	assert.Len(t, enums.TravelStyles, 16)
+	assert.Contains(t, enumValues(enums.FoodCategories), "thai_food")
+
+	assert.Equal(t, "Day Trip", enums.EventTypes[1].Label)
+	assert.Equal(t, "Prefer not to say", enums.Genders[3].Label)
+}
+
+func TestGetEnums_EveryValueHasALabel(t *testing.T) {
+	for _, locale := range []string{"en", "th"} {
+		enums := service.NewMetaService().GetEnums(locale)
+		groups := [][]dto.EnumOption{
+			enums.EventTypes, enums.EventStatuses, enums.MemberRoles, enums.MemberStatuses, enums.TagKinds,
+			enums.Genders, enums.SmokingOptions, enums.SwipeDirections, enums.TravelStyles, enums.FoodCategories,
+		}
+		for _, group := range groups {
+			for _, option := range group {
+				assert.NotEqual(t, option.Value, option.Label, "%s label missing for %q", locale, option.Value)
+			}
+		}
+	}
+}
+
+func TestGetEnums_LocalizedAndCached(t *testing.T) {
+	metaService := service.NewMetaService()
+
+	th := metaService.GetEnums("th")
+	assert.Equal(t, "th", th.Locale)
+	assert.Equal(t, "meal", th.EventTypes[0].Value)
+	assert.Equal(t, "กินข้าว", th.EventTypes[0].Label)
+
+	// Unsupported locales fall back to English
+	assert.Equal(t, "en", metaService.GetEnums("fr").Locale)
+
+	// The same locale is served from the cache
+	assert.Same(t, th, metaService.GetEnums("th"))
+}
+
+func TestResolveLocale(t *testing.T) {
+	assert.Equal(t, "th", service.ResolveLocale("th", ""))
+	assert.Equal(t, "th", service.ResolveLocale("", "th-TH,th;q=0.9,en;q=0.8"))
+	assert.Equal(t, "en", service.ResolveLocale("en", "th-TH"))
+	assert.Equal(t, "th", service.ResolveLocale("de", "fr-FR, th;q=0.5"))
+	assert.Equal(t, "en", service.ResolveLocale("", ""))
+	assert.Equal(t, "en", service.ResolveLocale("", "ja-JP"))
+}
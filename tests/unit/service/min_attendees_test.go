@@ -0,0 +1,159 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// setMinAttendees sets the event's start and minimum attendees
+func setMinAttendees(t *testing.T, db *gorm.DB, event *models.Event, startAt time.Time, minAttendees int) {
+	t.Helper()
+	require.NoError(t, db.Model(event).Updates(map[string]interface{}{
+		"start_at":      startAt,
+		"min_attendees": minAttendees,
+	}).Error)
+}
+
+// eventStatus returns the stored status of the event
+func eventStatus(t *testing.T, db *gorm.DB, event *models.Event) models.EventStatus {
+	t.Helper()
+	var stored models.Event
+	require.NoError(t, db.First(&stored, "id = ?", event.ID).Error)
+	return stored.Status
+}
+
+func TestCancelUnderfilledEvents_Threshold(t *testing.T) {
+	db := setupEventDomainDB(t)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	eventService := service.NewEventServiceWithClock(utils.NewFakeClock(now))
+
+	creator := createTestUser(t, db, "min-creator")
+	confirmed := createTestUser(t, db, "min-confirmed")
+	pending := createTestUser(t, db, "min-pending")
+
+	// Two confirmed of three, starting within the 24 hour deadline
+	underfilled := createTestEvent(t, db, creator, "Boat trip")
+	setMinAttendees(t, db, underfilled, now.Add(20*time.Hour), 3)
+	addTestMember(t, db, underfilled, confirmed, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	addTestMember(t, db, underfilled, pending, models.MemberRoleParticipant, models.MemberStatusPending)
+
+	// Exactly at the threshold
+	full := createTestEvent(t, db, creator, "Cooking class")
+	setMinAttendees(t, db, full, now.Add(20*time.Hour), 2)
+	addTestMember(t, db, full, confirmed, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+
+	// Short of the threshold but still before the deadline
+	early := createTestEvent(t, db, creator, "Island hopping")
+	setMinAttendees(t, db, early, now.Add(25*time.Hour), 3)
+
+	// Already started
+	started := createTestEvent(t, db, creator, "Night market")
+	setMinAttendees(t, db, started, now.Add(-time.Hour), 3)
+
+	// No threshold
+	open := createTestEvent(t, db, creator, "Street food walk")
+	require.NoError(t, db.Model(open).Update("start_at", now.Add(time.Hour)).Error)
+
+	cancelled, err := eventService.CancelUnderfilledEvents()
+	require.NoError(t, err)
+	assert.Equal(t, 1, cancelled)
+
+	assert.Equal(t, models.EventStatusCancelled, eventStatus(t, db, underfilled))
+	assert.Equal(t, models.EventStatusPublished, eventStatus(t, db, full))
+	assert.Equal(t, models.EventStatusPublished, eventStatus(t, db, early))
+	assert.Equal(t, models.EventStatusPublished, eventStatus(t, db, started))
+	assert.Equal(t, models.EventStatusPublished, eventStatus(t, db, open))
+
+	// Everyone is released: pending requests declined, confirmed participants freed, and confirmed
+	// members and the creator told why
+	assert.Equal(t, models.MemberStatusDeclined, memberStatus(t, db, underfilled, pending))
+	assert.Equal(t, models.MemberStatusLeft, memberStatus(t, db, underfilled, confirmed))
+	assert.Equal(t, models.MemberStatusConfirmed, memberStatus(t, db, underfilled, creator))
+	assert.Equal(t, models.MemberStatusConfirmed, memberStatus(t, db, full, confirmed))
+	var stored models.Event
+	require.NoError(t, db.First(&stored, "id = ?", underfilled.ID).Error)
+	assert.Equal(t, 1, stored.ConfirmedMemberCount)
+	require.NotNil(t, stored.CancelReason)
+	assert.Equal(t, "Only 2 of the minimum 3 attendees confirmed before the deadline", *stored.CancelReason)
+	for _, user := range []*models.User{creator, confirmed} {
+		var notification models.Notification
+		require.NoError(t, db.First(&notification, "user_id = ? AND title = ?", user.ID, "Event Cancelled").Error)
+		assert.Contains(t, notification.Body, "Boat trip")
+	}
+
+	// Nothing left to cancel
+	cancelled, err = eventService.CancelUnderfilledEvents()
+	require.NoError(t, err)
+	assert.Zero(t, cancelled)
+}
+
+func TestCancelUnderfilledEvents_DeadlineReachedLater(t *testing.T) {
+	db := setupEventDomainDB(t)
+	clock := utils.NewFakeClock(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+	eventService := service.NewEventServiceWithClock(clock)
+
+	creator := createTestUser(t, db, "later-creator")
+	event := createTestEvent(t, db, creator, "Temple tour")
+	setMinAttendees(t, db, event, clock.Now().Add(48*time.Hour), 2)
+
+	cancelled, err := eventService.CancelUnderfilledEvents()
+	require.NoError(t, err)
+	assert.Zero(t, cancelled)
+
+	clock.Advance(24 * time.Hour)
+	cancelled, err = eventService.CancelUnderfilledEvents()
+	require.NoError(t, err)
+	assert.Equal(t, 1, cancelled)
+	assert.Equal(t, models.EventStatusCancelled, eventStatus(t, db, event))
+}
+
+func TestMinAttendees_Validation(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+	creator := createTestUser(t, db, "min-validation")
+
+	capacity, tooMany, zero := 4, 5, 0
+	_, err := eventService.CreateEvent(creator.ID.String(), dto.CreateEventRequest{
+		Title: "Too big", EventType: string(models.EventTypeMeal), Capacity: &capacity, MinAttendees: &tooMany,
+	})
+	require.Error(t, err)
+	assert.Equal(t, "min_attendees cannot exceed capacity", err.Error())
+
+	_, err = eventService.CreateEvent(creator.ID.String(), dto.CreateEventRequest{
+		Title: "Nobody", EventType: string(models.EventTypeMeal), MinAttendees: &zero,
+	})
+	require.Error(t, err)
+	assert.Equal(t, "min_attendees must be at least 1", err.Error())
+
+	minAttendees := 3
+	created, err := eventService.CreateEvent(creator.ID.String(), dto.CreateEventRequest{
+		Title: "Just right", EventType: string(models.EventTypeMeal), Capacity: &capacity, MinAttendees: &minAttendees,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, created.MinAttendees)
+	assert.Equal(t, 3, *created.MinAttendees)
+
+	// Lowering the capacity below the stored threshold is rejected
+	smaller := 2
+	_, err = eventService.UpdateEvent(created.ID, creator.ID.String(), dto.UpdateEventRequest{Capacity: &smaller})
+	require.Error(t, err)
+	assert.Equal(t, "min_attendees cannot exceed capacity", err.Error())
+
+	// 0 removes the threshold, after which the capacity can drop
+	updated, err := eventService.UpdateEvent(created.ID, creator.ID.String(), dto.UpdateEventRequest{
+		Capacity: &smaller, MinAttendees: &zero,
+	})
+	require.NoError(t, err)
+	assert.Nil(t, updated.MinAttendees)
+	require.NotNil(t, updated.Capacity)
+	assert.Equal(t, 2, *updated.Capacity)
+}
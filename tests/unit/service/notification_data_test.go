@@ -0,0 +1,95 @@
+package service_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitizeNotificationData(t *testing.T) {
+	t.Run("Nil data stays nil", func(t *testing.T) {
+		data, err := service.SanitizeNotificationData(nil)
+		assert.NoError(t, err)
+		assert.Nil(t, data)
+	})
+
+	t.Run("Plain values pass through", func(t *testing.T) {
+		data, err := service.SanitizeNotificationData(map[string]interface{}{
+			"event_id": "abc",
+			"count":    3,
+			"nested":   map[string]interface{}{"ok": true},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "abc", data["event_id"])
+		assert.Equal(t, 3, data["count"])
+		assert.Equal(t, map[string]interface{}{"ok": true}, data["nested"])
+	})
+
+	t.Run("Non-serializable values are sanitized", func(t *testing.T) {
+		data, err := service.SanitizeNotificationData(map[string]interface{}{
+			"err":      errors.New("boom"),
+			"callback": func() {},
+			"ratio":    math.NaN(),
+			"ch":       make(chan int),
+			"wait":     time.Second,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "boom", data["err"])
+		assert.Equal(t, time.Second, data["wait"])
+		assert.NotContains(t, data, "callback")
+		assert.NotContains(t, data, "ratio")
+		assert.NotContains(t, data, "ch")
+	})
+
+	t.Run("Too many keys", func(t *testing.T) {
+		data := make(map[string]interface{})
+		for i := 0; i <= service.MaxNotificationDataKeys; i++ {
+			data[fmt.Sprintf("key_%d", i)] = i
+		}
+		_, err := service.SanitizeNotificationData(data)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "too many keys")
+	})
+
+	t.Run("Oversized data", func(t *testing.T) {
+		_, err := service.SanitizeNotificationData(map[string]interface{}{
+			"blob": strings.Repeat("x", service.MaxNotificationDataBytes),
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "notification data too large")
+	})
+
+	t.Run("Deeply nested data counts toward the size", func(t *testing.T) {
+		var nested interface{} = "leaf"
+		for i := 0; i < 1000; i++ {
+			nested = map[string]interface{}{"n": nested}
+		}
+		_, err := service.SanitizeNotificationData(map[string]interface{}{"tree": nested})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "notification data too large")
+	})
+}
+
+func TestSendPushNotification_RejectsOversizedData(t *testing.T) {
+	db := setupEventDomainDB(t)
+	user := createTestUser(t, db, "Recipient")
+
+	err := service.NewNotificationService().SendPushNotification(user.ID.String(), "Hello", "World", map[string]interface{}{
+		"blob": strings.Repeat("x", 2*service.MaxNotificationDataBytes),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "notification data too large")
+
+	var count int64
+	db.Model(&models.Notification{}).Where("user_id = ?", user.ID).Count(&count)
+	assert.Zero(t, count)
+}
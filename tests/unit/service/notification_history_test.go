@@ -0,0 +1,189 @@
+package service_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/api/middleware"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/pkg/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// createTestNotification inserts a notification of the given data type for the user
+func createTestNotification(t *testing.T, db *gorm.DB, user *models.User, notificationType string, createdAt time.Time) *models.Notification {
+	t.Helper()
+	notification := &models.Notification{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		Title:     notificationType,
+		Body:      "body",
+		Type:      "push",
+		Data:      map[string]interface{}{"type": notificationType},
+		CreatedAt: createdAt,
+	}
+	require.NoError(t, db.Create(notification).Error)
+	return notification
+}
+
+// deliveryCount waits for the background sends and counts the deliveries they recorded
+func deliveryCount(t *testing.T, db *gorm.DB) int64 {
+	t.Helper()
+	service.WaitForBackgroundSends()
+	var count int64
+	require.NoError(t, db.Model(&models.NotificationDelivery{}).Count(&count).Error)
+	return count
+}
+
+func TestSendPushNotification_RecordsDeliveries(t *testing.T) {
+	db := setupEventDomainDB(t)
+	sender := &mockPushSender{invalid: map[string]bool{"stale-token": true}}
+	mailer, _ := captureEmails()
+	notificationService := service.NewNotificationServiceWithSenders(sender, mailer)
+
+	user := createTestUser(t, db, "delivered")
+	addTestDevice(t, db, user, "good-token")
+	addTestDevice(t, db, user, "stale-token")
+	require.NoError(t, notificationService.SendPushNotification(user.ID.String(), "Trip update", "The boat leaves at 9",
+		map[string]interface{}{"type": "event_update"}))
+
+	// Two devices and the email
+	require.Equal(t, int64(3), deliveryCount(t, db))
+
+	history, total, err := notificationService.GetUserNotificationHistory(user.ID.String(), service.NotificationHistoryQuery{}, 1, 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), total)
+	assert.Equal(t, service.NotificationDeliveryStatusSent, history[0].DeliveryStatus)
+	assert.Equal(t, []string{"push", "email"}, history[0].Channels)
+
+	statuses := map[string]int{}
+	for _, delivery := range history[0].Deliveries {
+		statuses[delivery.Channel+" "+delivery.Status]++
+		if delivery.Status == string(models.NotificationDeliveryFailed) {
+			require.NotNil(t, delivery.Detail)
+			assert.Contains(t, *delivery.Detail, "android device")
+		}
+	}
+	assert.Equal(t, map[string]int{"push sent": 1, "push failed": 1, "email sent": 1}, statuses)
+}
+
+func TestSendPushNotification_RecordsSkippedChannels(t *testing.T) {
+	db := setupEventDomainDB(t)
+	notificationService := service.NewNotificationServiceWithPushSender(nil)
+
+	user := createTestUser(t, db, "unreachable")
+	require.NoError(t, db.Model(user).Update("email", nil).Error)
+	require.NoError(t, notificationService.SendPushNotification(user.ID.String(), "Hello", "Anyone there?", nil))
+
+	require.Equal(t, int64(2), deliveryCount(t, db))
+
+	history, _, err := notificationService.GetUserNotificationHistory(user.ID.String(), service.NotificationHistoryQuery{}, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, service.NotificationDeliveryStatusSkipped, history[0].DeliveryStatus)
+	assert.Empty(t, history[0].Channels)
+	details := []string{}
+	for _, delivery := range history[0].Deliveries {
+		details = append(details, *delivery.Detail)
+	}
+	assert.ElementsMatch(t, []string{"push is not configured", "user has no email address"}, details)
+}
+
+func TestGetUserNotificationHistory_Filters(t *testing.T) {
+	db := setupEventDomainDB(t)
+	notificationService := service.NewNotificationService()
+	user := createTestUser(t, db, "history-user")
+	other := createTestUser(t, db, "history-other")
+
+	base := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	oldReminder := createTestNotification(t, db, user, "event_reminder", base)
+	update := createTestNotification(t, db, user, "event_update", base.Add(24*time.Hour))
+	newReminder := createTestNotification(t, db, user, "event_reminder", base.Add(48*time.Hour))
+	createTestNotification(t, db, other, "event_reminder", base)
+
+	ids := func(query service.NotificationHistoryQuery, page, limit int) ([]string, int64) {
+		t.Helper()
+		history, total, err := notificationService.GetUserNotificationHistory(user.ID.String(), query, page, limit)
+		require.NoError(t, err)
+		got := []string{}
+		for _, notification := range history {
+			got = append(got, notification.ID)
+			// Nothing was recorded for these
+			assert.Equal(t, service.NotificationDeliveryStatusUnknown, notification.DeliveryStatus)
+		}
+		return got, total
+	}
+
+	// Only the user's own, newest first
+	got, total := ids(service.NotificationHistoryQuery{}, 1, 10)
+	assert.Equal(t, int64(3), total)
+	assert.Equal(t, []string{newReminder.ID.String(), update.ID.String(), oldReminder.ID.String()}, got)
+
+	reminder := "event_reminder"
+	got, total = ids(service.NotificationHistoryQuery{Type: &reminder}, 1, 10)
+	assert.Equal(t, int64(2), total)
+	assert.Equal(t, []string{newReminder.ID.String(), oldReminder.ID.String()}, got)
+
+	from, to := base.Add(time.Hour), base.Add(24*time.Hour)
+	got, _ = ids(service.NotificationHistoryQuery{From: &from, To: &to}, 1, 10)
+	assert.Equal(t, []string{update.ID.String()}, got)
+
+	got, total = ids(service.NotificationHistoryQuery{}, 2, 2)
+	assert.Equal(t, int64(3), total)
+	assert.Equal(t, []string{oldReminder.ID.String()}, got)
+
+	_, _, err := notificationService.GetUserNotificationHistory(user.ID.String(), service.NotificationHistoryQuery{From: &to, To: &from}, 1, 10)
+	assert.EqualError(t, err, "invalid time range")
+	_, _, err = notificationService.GetUserNotificationHistory(uuid.New().String(), service.NotificationHistoryQuery{}, 1, 10)
+	assert.EqualError(t, err, "user not found")
+
+	// Deleted accounts can still be looked into
+	require.NoError(t, db.Model(user).Update("deleted_at", time.Now()).Error)
+	_, total = ids(service.NotificationHistoryQuery{}, 1, 10)
+	assert.Equal(t, int64(3), total)
+}
+
+func TestGetUserNotifications_AdminOnly(t *testing.T) {
+	db := setupEventDomainDB(t)
+	admin := createTestUser(t, db, "notifications-admin")
+	user := createTestUser(t, db, "notifications-subject")
+	createTestNotification(t, db, user, "event_reminder", time.Now().Add(-time.Hour))
+	createTestNotification(t, db, user, "event_update", time.Now())
+
+	previous := config.AppConfig.Admin
+	config.AppConfig.Admin = config.AdminConfig{UserIDs: []string{admin.ID.String()}}
+	t.Cleanup(func() { config.AppConfig.Admin = previous })
+
+	serveAs := func(caller *models.User, path string) *httptest.ResponseRecorder {
+		gin.SetMode(gin.TestMode)
+		router := gin.New()
+		router.GET("/admin/users/:id/notifications", func(c *gin.Context) {
+			c.Set("user_id", caller.ID.String())
+			c.Next()
+		}, middleware.AdminMiddleware(), handlers.NewAdminHandler().GetUserNotifications)
+		return serveEventRequest(router, http.MethodGet, path, "")
+	}
+
+	// Users can't look at their own delivery log here, let alone anyone else's
+	path := "/admin/users/" + user.ID.String() + "/notifications"
+	assert.Equal(t, http.StatusForbidden, serveAs(user, path).Code)
+
+	w := serveAs(admin, path+"?type=event_update")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"delivery_status":"unknown"`)
+	assert.Contains(t, w.Body.String(), `"title":"event_update"`)
+	assert.NotContains(t, w.Body.String(), `"title":"event_reminder"`)
+
+	assert.Equal(t, http.StatusBadRequest, serveAs(admin, path+"?from=yesterday").Code)
+	assert.Equal(t, http.StatusBadRequest, serveAs(admin, "/admin/users/not-a-uuid/notifications").Code)
+	assert.Equal(t, http.StatusNotFound, serveAs(admin, "/admin/users/"+uuid.New().String()+"/notifications").Code)
+}
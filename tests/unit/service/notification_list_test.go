@@ -0,0 +1,183 @@
+package service_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// addListedNotification stores a notification for the user created the given time ago
+func addListedNotification(t *testing.T, db *gorm.DB, user *models.User, title string, age time.Duration, read bool) {
+	t.Helper()
+	require.NoError(t, db.Create(&models.Notification{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		Title:     title,
+		Body:      "Body",
+		Type:      "push",
+		Data:      map[string]interface{}{"type": "event_reminder", "event_id": title},
+		Read:      read,
+		CreatedAt: time.Now().Add(-age),
+	}).Error)
+}
+
+func TestGetNotifications(t *testing.T) {
+	db := setupEventDomainDB(t)
+	notificationService := service.NewNotificationService()
+
+	user := createTestUser(t, db, "listed")
+	other := createTestUser(t, db, "unlisted")
+	addListedNotification(t, db, user, "oldest", 3*time.Hour, true)
+	addListedNotification(t, db, user, "middle", 2*time.Hour, false)
+	addListedNotification(t, db, user, "newest", time.Hour, false)
+	addListedNotification(t, db, other, "someone else's", time.Minute, false)
+
+	titles := func(cursor *utils.Cursor, limit int, read *bool) ([]string, *utils.Cursor) {
+		t.Helper()
+		notifications, next, err := notificationService.GetNotificationsByCursor(user.ID.String(), cursor, limit, read)
+		require.NoError(t, err)
+		var got []string
+		for _, notification := range notifications {
+			got = append(got, notification.Title)
+		}
+		if next == nil {
+			return got, nil
+		}
+		nextCursor, err := utils.DecodeCursor(*next)
+		require.NoError(t, err)
+		return got, nextCursor
+	}
+
+	got, next := titles(nil, 10, nil)
+	assert.Equal(t, []string{"newest", "middle", "oldest"}, got)
+	assert.Nil(t, next)
+
+	// Pages follow the same order
+	got, next = titles(nil, 2, nil)
+	assert.Equal(t, []string{"newest", "middle"}, got)
+	require.NotNil(t, next)
+	got, next = titles(next, 2, nil)
+	assert.Equal(t, []string{"oldest"}, got)
+	assert.Nil(t, next)
+
+	read, unread := true, false
+	got, _ = titles(nil, 10, &read)
+	assert.Equal(t, []string{"oldest"}, got)
+	got, _ = titles(nil, 10, &unread)
+	assert.Equal(t, []string{"newest", "middle"}, got)
+
+	// The stored data comes back with each item
+	notifications, _, err := notificationService.GetNotificationsByCursor(user.ID.String(), nil, 1, nil)
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	assert.Equal(t, map[string]interface{}{"type": "event_reminder", "event_id": "newest"}, notifications[0].Data)
+
+	_, _, err = notificationService.GetNotificationsByCursor("not-a-uuid", nil, 10, nil)
+	assert.Error(t, err)
+}
+
+func TestGetNotificationsHandler(t *testing.T) {
+	db := setupEventDomainDB(t)
+	user := createTestUser(t, db, "handler-listed")
+	addListedNotification(t, db, user, "read one", 2*time.Hour, true)
+	addListedNotification(t, db, user, "unread one", time.Hour, false)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", user.ID.String())
+		c.Next()
+	})
+	router.GET("/notifications", handlers.NewNotificationHandler().GetNotifications)
+
+	var body struct {
+		Data []struct {
+			Title string                 `json:"title"`
+			Data  map[string]interface{} `json:"data"`
+		} `json:"data"`
+		Meta struct {
+			Limit      int     `json:"limit"`
+			NextCursor *string `json:"next_cursor"`
+		} `json:"meta"`
+	}
+
+	w := serveEventRequest(router, "GET", "/notifications", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Len(t, body.Data, 2)
+	assert.Equal(t, "unread one", body.Data[0].Title)
+	assert.Equal(t, "unread one", body.Data[0].Data["event_id"])
+	assert.Equal(t, 20, body.Meta.Limit)
+	assert.Nil(t, body.Meta.NextCursor)
+
+	w = serveEventRequest(router, "GET", "/notifications?read=true", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Len(t, body.Data, 1)
+	assert.Equal(t, "read one", body.Data[0].Title)
+
+	w = serveEventRequest(router, "GET", "/notifications?read=maybe", "")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGetNotificationsHandler_Cursor(t *testing.T) {
+	db := setupEventDomainDB(t)
+	user := createTestUser(t, db, "handler-cursor")
+	addListedNotification(t, db, user, "oldest", 3*time.Hour, false)
+	addListedNotification(t, db, user, "read", 2*time.Hour, true)
+	addListedNotification(t, db, user, "middle", time.Hour, false)
+	addListedNotification(t, db, user, "newest", time.Minute, false)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", user.ID.String())
+		c.Next()
+	})
+	router.GET("/notifications", handlers.NewNotificationHandler().GetNotifications)
+
+	type page struct {
+		Data []struct {
+			Title string `json:"title"`
+		} `json:"data"`
+		Meta struct {
+			Limit      int     `json:"limit"`
+			NextCursor *string `json:"next_cursor"`
+		} `json:"meta"`
+	}
+	get := func(path string) page {
+		t.Helper()
+		w := serveEventRequest(router, "GET", path, "")
+		require.Equal(t, http.StatusOK, w.Code)
+		var body page
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		return body
+	}
+
+	// The read filter applies across pages
+	first := get("/notifications?limit=2&read=false")
+	require.Len(t, first.Data, 2)
+	assert.Equal(t, "newest", first.Data[0].Title)
+	assert.Equal(t, "middle", first.Data[1].Title)
+	require.NotNil(t, first.Meta.NextCursor)
+
+	second := get("/notifications?limit=2&read=false&cursor=" + *first.Meta.NextCursor)
+	require.Len(t, second.Data, 1)
+	assert.Equal(t, "oldest", second.Data[0].Title)
+	assert.Nil(t, second.Meta.NextCursor)
+
+	w := serveEventRequest(router, "GET", "/notifications?cursor=not-a-cursor", "")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,140 @@
+package service_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// notificationIDByTitle looks up the ID of a notification stored by addListedNotification
+func notificationIDByTitle(t *testing.T, db *gorm.DB, title string) string {
+	t.Helper()
+	var notification models.Notification
+	require.NoError(t, db.Where("title = ?", title).First(&notification).Error)
+	return notification.ID.String()
+}
+
+func TestMarkNotificationRead_Ownership(t *testing.T) {
+	db := setupEventDomainDB(t)
+	notificationService := service.NewNotificationService()
+
+	user := createTestUser(t, db, "reader")
+	other := createTestUser(t, db, "other-reader")
+	addListedNotification(t, db, user, "mine", time.Hour, false)
+	addListedNotification(t, db, other, "theirs", time.Hour, false)
+
+	// Someone else's notification looks the same as a missing one and stays unread
+	_, err := notificationService.MarkNotificationRead(user.ID.String(), notificationIDByTitle(t, db, "theirs"))
+	require.Error(t, err)
+	assert.Equal(t, "notification not found", err.Error())
+	var theirs models.Notification
+	require.NoError(t, db.Where("title = ?", "theirs").First(&theirs).Error)
+	assert.False(t, theirs.Read)
+
+	_, err = notificationService.MarkNotificationRead(user.ID.String(), uuid.New().String())
+	require.Error(t, err)
+	assert.Equal(t, "notification not found", err.Error())
+	_, err = notificationService.MarkNotificationRead(user.ID.String(), "not-a-uuid")
+	require.Error(t, err)
+	assert.Equal(t, "invalid notification ID", err.Error())
+
+	marked, err := notificationService.MarkNotificationRead(user.ID.String(), notificationIDByTitle(t, db, "mine"))
+	require.NoError(t, err)
+	assert.True(t, marked.Read)
+	require.NotNil(t, marked.ReadAt)
+
+	// Marking it again keeps the first read time
+	again, err := notificationService.MarkNotificationRead(user.ID.String(), notificationIDByTitle(t, db, "mine"))
+	require.NoError(t, err)
+	require.NotNil(t, again.ReadAt)
+	assert.True(t, marked.ReadAt.Equal(*again.ReadAt))
+}
+
+func TestUnreadCountAndMarkAllRead(t *testing.T) {
+	db := setupEventDomainDB(t)
+	notificationService := service.NewNotificationService()
+
+	user := createTestUser(t, db, "counted")
+	other := createTestUser(t, db, "uncounted")
+	addListedNotification(t, db, user, "read already", 3*time.Hour, true)
+	addListedNotification(t, db, user, "first", 2*time.Hour, false)
+	addListedNotification(t, db, user, "second", time.Hour, false)
+	addListedNotification(t, db, user, "third", time.Minute, false)
+	addListedNotification(t, db, other, "other unread", time.Minute, false)
+
+	count, err := notificationService.GetUnreadCount(user.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	_, err = notificationService.MarkNotificationRead(user.ID.String(), notificationIDByTitle(t, db, "first"))
+	require.NoError(t, err)
+	count, err = notificationService.GetUnreadCount(user.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	// Only the user's remaining unread notifications change
+	updated, err := notificationService.MarkAllNotificationsRead(user.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), updated)
+	count, err = notificationService.GetUnreadCount(user.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	count, err = notificationService.GetUnreadCount(other.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	updated, err = notificationService.MarkAllNotificationsRead(user.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), updated)
+}
+
+func TestNotificationReadHandlers(t *testing.T) {
+	db := setupEventDomainDB(t)
+	user := createTestUser(t, db, "handler-reader")
+	other := createTestUser(t, db, "handler-other-reader")
+	addListedNotification(t, db, user, "mine", time.Hour, false)
+	addListedNotification(t, db, user, "also mine", time.Minute, false)
+	addListedNotification(t, db, other, "theirs", time.Hour, false)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", user.ID.String())
+		c.Next()
+	})
+	notificationHandler := handlers.NewNotificationHandler()
+	router.GET("/notifications/unread-count", notificationHandler.GetUnreadCount)
+	router.POST("/notifications/read-all", notificationHandler.MarkAllNotificationsRead)
+	router.PATCH("/notifications/:id/read", notificationHandler.MarkNotificationRead)
+
+	w := serveEventRequest(router, "GET", "/notifications/unread-count", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"unread":2`)
+
+	w = serveEventRequest(router, "PATCH", "/notifications/"+notificationIDByTitle(t, db, "theirs")+"/read", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = serveEventRequest(router, "PATCH", "/notifications/not-a-uuid/read", "")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = serveEventRequest(router, "PATCH", "/notifications/"+notificationIDByTitle(t, db, "mine")+"/read", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"read":true`)
+
+	w = serveEventRequest(router, "POST", "/notifications/read-all", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"updated":1`)
+
+	w = serveEventRequest(router, "GET", "/notifications/unread-count", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"unread":0`)
+}
@@ -0,0 +1,87 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// addAgedNotification stores a notification for the user created at the given time
+func addAgedNotification(t *testing.T, db *gorm.DB, user *models.User, createdAt time.Time, read bool) uuid.UUID {
+	t.Helper()
+	notification := &models.Notification{
+		ID: uuid.New(), UserID: user.ID, Title: "Title", Body: "Body", Type: "push", Read: read, CreatedAt: createdAt,
+	}
+	require.NoError(t, db.Create(notification).Error)
+	return notification.ID
+}
+
+// remainingNotifications returns the IDs of the user's notifications still stored
+func remainingNotifications(t *testing.T, db *gorm.DB, user *models.User) []uuid.UUID {
+	t.Helper()
+	var ids []uuid.UUID
+	require.NoError(t, db.Model(&models.Notification{}).Where("user_id = ?", user.ID).Pluck("id", &ids).Error)
+	return ids
+}
+
+func TestCleanupNotifications_RetentionBoundary(t *testing.T) {
+	db := setupEventDomainDB(t)
+	config.AppConfig.Notification.RetentionDays = 30
+	t.Cleanup(func() { config.AppConfig.Notification.RetentionDays = 0 })
+
+	now := time.Now().UTC().Truncate(time.Second)
+	notificationService := service.NewNotificationServiceWithClock(utils.NewFakeClock(now))
+	user := createTestUser(t, db, "retention-user")
+
+	cutoff := now.AddDate(0, 0, -30)
+	atCutoff := addAgedNotification(t, db, user, cutoff, true)
+	pastCutoff := addAgedNotification(t, db, user, cutoff.Add(-time.Second), true)
+	unreadPastCutoff := addAgedNotification(t, db, user, cutoff.Add(-24*time.Hour), false)
+	recent := addAgedNotification(t, db, user, now.Add(-time.Hour), true)
+
+	deleted, err := notificationService.CleanupNotifications()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	// Only read notifications older than the retention go; unread ones are kept
+	remaining := remainingNotifications(t, db, user)
+	assert.ElementsMatch(t, []uuid.UUID{atCutoff, unreadPastCutoff, recent}, remaining)
+	assert.NotContains(t, remaining, pastCutoff)
+}
+
+func TestCleanupNotifications_PerUserCap(t *testing.T) {
+	db := setupEventDomainDB(t)
+	config.AppConfig.Notification.MaxPerUser = 3
+	t.Cleanup(func() { config.AppConfig.Notification.MaxPerUser = 0 })
+
+	now := time.Now().UTC().Truncate(time.Second)
+	notificationService := service.NewNotificationServiceWithClock(utils.NewFakeClock(now))
+	busy := createTestUser(t, db, "busy-user")
+	quiet := createTestUser(t, db, "quiet-user")
+
+	var busyIDs []uuid.UUID
+	for i := 0; i < 5; i++ {
+		busyIDs = append(busyIDs, addAgedNotification(t, db, busy, now.Add(-time.Duration(i)*time.Minute), false))
+	}
+	quietIDs := []uuid.UUID{
+		addAgedNotification(t, db, quiet, now.Add(-2*time.Minute), false),
+		addAgedNotification(t, db, quiet, now.Add(-time.Minute), true),
+	}
+
+	deleted, err := notificationService.CleanupNotifications()
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+
+	// The newest three are kept, read or not; users under the cap are untouched
+	assert.ElementsMatch(t, busyIDs[:3], remainingNotifications(t, db, busy))
+	assert.ElementsMatch(t, quietIDs, remainingNotifications(t, db, quiet))
+}
@@ -0,0 +1,89 @@
+package service_test
+
+import (
+	"testing"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func onboardingTagNames(group dto.OnboardingTagGroup) []string {
+	names := make([]string, 0, len(group.Tags))
+	for _, tag := range group.Tags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
+
+func TestGetOnboardingTags_PopularTagsGroupedByKind(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Creator")
+	fan := createTestUser(t, db, "Fan")
+	event := createTestEvent(t, db, creator, "Weekend Trip")
+
+	hiking := createKindTag(t, db, "Hiking", models.TagKindActivity)
+	createKindTag(t, db, "Kayaking", models.TagKindActivity)
+	ramen := createKindTag(t, db, "Ramen", models.TagKindFood)
+	createKindTag(t, db, "Barbecue", models.TagKindFood)
+	createKindTag(t, db, "Beach", models.TagKindLocation)
+
+	// Hiking is used by two users and an event, Ramen by one user
+	require.NoError(t, db.Create(&models.UserTag{UserID: creator.ID, TagID: hiking.ID}).Error)
+	require.NoError(t, db.Create(&models.UserTag{UserID: fan.ID, TagID: hiking.ID}).Error)
+	require.NoError(t, db.Create(&models.EventTag{EventID: event.ID, TagID: hiking.ID}).Error)
+	require.NoError(t, db.Create(&models.UserTag{UserID: fan.ID, TagID: ramen.ID}).Error)
+
+	suggestions, err := service.NewTagService().GetOnboardingTags("", 8)
+	require.NoError(t, err)
+
+	// Groups follow TagKinds order and skip empty kinds
+	require.Len(t, suggestions.Groups, 3)
+	assert.Equal(t, "activity", suggestions.Groups[0].Kind)
+	assert.Equal(t, "location", suggestions.Groups[1].Kind)
+	assert.Equal(t, "food", suggestions.Groups[2].Kind)
+
+	for _, group := range suggestions.Groups {
+		for _, tag := range group.Tags {
+			assert.Equal(t, group.Kind, tag.Kind)
+			assert.False(t, tag.Matched)
+		}
+	}
+
+	assert.Equal(t, []string{"Hiking", "Kayaking"}, onboardingTagNames(suggestions.Groups[0]))
+	assert.Equal(t, int64(3), suggestions.Groups[0].Tags[0].Popularity)
+	assert.Equal(t, []string{"Ramen", "Barbecue"}, onboardingTagNames(suggestions.Groups[2]))
+	assert.Equal(t, int64(1), suggestions.Groups[2].Tags[0].Popularity)
+}
+
+func TestGetOnboardingTags_InterestsSeedAndLimit(t *testing.T) {
+	db := setupEventDomainDB(t)
+	user := createTestUser(t, db, "User")
+
+	popular := createKindTag(t, db, "Museums", models.TagKindActivity)
+	createKindTag(t, db, "Snorkeling", models.TagKindActivity)
+	createKindTag(t, db, "Street Food", models.TagKindFood)
+	require.NoError(t, db.Create(&models.UserTag{UserID: user.ID, TagID: popular.ID}).Error)
+
+	suggestions, err := service.NewTagService().GetOnboardingTags(" snorkel , STREET ,", 1)
+	require.NoError(t, err)
+	require.Len(t, suggestions.Groups, 2)
+
+	// A matched tag outranks a more popular one and the limit applies per kind
+	assert.Equal(t, []string{"Snorkeling"}, onboardingTagNames(suggestions.Groups[0]))
+	assert.True(t, suggestions.Groups[0].Tags[0].Matched)
+	assert.Equal(t, []string{"Street Food"}, onboardingTagNames(suggestions.Groups[1]))
+	assert.True(t, suggestions.Groups[1].Tags[0].Matched)
+}
+
+func TestGetOnboardingTags_NoTags(t *testing.T) {
+	setupEventDomainDB(t)
+
+	suggestions, err := service.NewTagService().GetOnboardingTags("hiking", 8)
+	require.NoError(t, err)
+	assert.Empty(t, suggestions.Groups)
+	assert.NotNil(t, suggestions.Groups)
+}
@@ -0,0 +1,133 @@
+package service_test
+
+import (
+	"strings"
+	"testing"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSMSSender records sent messages instead of delivering them
+type mockSMSSender struct {
+	to   []string
+	body []string
+}
+
+func (m *mockSMSSender) SendSMS(to, body string) error {
+	m.to = append(m.to, to)
+	m.body = append(m.body, body)
+	return nil
+}
+
+func createOTPUser(t *testing.T, email string, phone *string, verified bool, channel *string) *models.User {
+	user := &models.User{
+		Email:         &email,
+		Provider:      models.AuthProviderPassword,
+		Phone:         phone,
+		PhoneVerified: verified,
+		OTPChannel:    channel,
+	}
+	require.NoError(t, database.DB.Create(user).Error)
+	return user
+}
+
+func latestResetToken(t *testing.T, user *models.User) string {
+	var reset models.PasswordReset
+	require.NoError(t, database.DB.Where("user_id = ?", user.ID).First(&reset).Error)
+	return reset.Token
+}
+
+func TestSendPasswordResetOTPVia_SMSWithVerifiedPhone(t *testing.T) {
+	setupAuthServiceTest(t)
+	sender := &mockSMSSender{}
+	authService := service.NewAuthServiceWithSMSSender(sender)
+	issued := captureOTPs(t)
+
+	phone := "+66812345678"
+	user := createOTPUser(t, "sms-verified@example.com", &phone, true, nil)
+
+	err := authService.SendPasswordResetOTPVia("sms-verified@example.com", models.OTPChannelSMS)
+	require.NoError(t, err)
+
+	require.Len(t, sender.to, 1)
+	assert.Equal(t, phone, sender.to[0])
+	assert.True(t, strings.Contains(sender.body[0], issued["sms-verified@example.com"]))
+	assert.Equal(t, hashedOTP(issued["sms-verified@example.com"]), latestResetToken(t, user))
+}
+
+func TestSendPasswordResetOTPVia_UnverifiedPhoneFallsBackToEmail(t *testing.T) {
+	setupAuthServiceTest(t)
+	sender := &mockSMSSender{}
+	authService := service.NewAuthServiceWithSMSSender(sender)
+	issued := captureOTPs(t)
+
+	phone := "+66812345679"
+	user := createOTPUser(t, "sms-unverified@example.com", &phone, false, nil)
+
+	// Email delivery fails without SMTP, but the SMS sender must not be used
+	_ = authService.SendPasswordResetOTPVia("sms-unverified@example.com", models.OTPChannelSMS)
+
+	assert.Empty(t, sender.to)
+	assert.Len(t, issued["sms-unverified@example.com"], 6)
+	assert.Equal(t, hashedOTP(issued["sms-unverified@example.com"]), latestResetToken(t, user))
+}
+
+func TestSendPasswordResetOTP_UsesUserPreference(t *testing.T) {
+	setupAuthServiceTest(t)
+	sender := &mockSMSSender{}
+	authService := service.NewAuthServiceWithSMSSender(sender)
+
+	phone := "+66812345680"
+	channel := models.OTPChannelSMS
+	createOTPUser(t, "sms-preferred@example.com", &phone, true, &channel)
+
+	err := authService.SendPasswordResetOTP("sms-preferred@example.com")
+	require.NoError(t, err)
+	assert.Len(t, sender.to, 1)
+}
+
+func TestSendPasswordResetOTPVia_RequestOverridesPreference(t *testing.T) {
+	setupAuthServiceTest(t)
+	sender := &mockSMSSender{}
+	authService := service.NewAuthServiceWithSMSSender(sender)
+
+	phone := "+66812345681"
+	channel := models.OTPChannelSMS
+	createOTPUser(t, "sms-override@example.com", &phone, true, &channel)
+
+	_ = authService.SendPasswordResetOTPVia("sms-override@example.com", models.OTPChannelEmail)
+	assert.Empty(t, sender.to)
+}
+
+func TestSendPasswordResetOTP_UsesConfiguredDefault(t *testing.T) {
+	setupAuthServiceTest(t)
+	config.AppConfig.OTP.DefaultChannel = models.OTPChannelSMS
+	sender := &mockSMSSender{}
+	authService := service.NewAuthServiceWithSMSSender(sender)
+
+	phone := "+66812345682"
+	createOTPUser(t, "sms-default@example.com", &phone, true, nil)
+
+	err := authService.SendPasswordResetOTP("sms-default@example.com")
+	require.NoError(t, err)
+	assert.Len(t, sender.to, 1)
+}
+
+func TestSendPasswordResetOTPVia_NoSenderFallsBackToEmail(t *testing.T) {
+	setupAuthServiceTest(t)
+	authService := service.NewAuthServiceWithSMSSender(nil)
+	issued := captureOTPs(t)
+
+	phone := "+66812345683"
+	user := createOTPUser(t, "sms-nosender@example.com", &phone, true, nil)
+
+	_ = authService.SendPasswordResetOTPVia("sms-nosender@example.com", models.OTPChannelSMS)
+	assert.Len(t, issued["sms-nosender@example.com"], 6)
+	assert.Equal(t, hashedOTP(issued["sms-nosender@example.com"]), latestResetToken(t, user))
+}
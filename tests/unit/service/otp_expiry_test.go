@@ -0,0 +1,117 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/email"
+	"TinderTrip-Backend/tests/testdb"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// setupOTPExpiryTest creates an auth service on a fake clock with email delivery stubbed out
+func setupOTPExpiryTest(t *testing.T) (*gorm.DB, *service.AuthService, *utils.FakeClock) {
+	db := testdb.SQLite(t, &models.User{}, &models.PasswordReset{}, &models.EmailVerification{}, &models.AuditLog{})
+	config.AppConfig = &config.Config{
+		JWT: config.JWTConfig{Secret: "test-secret", ExpireHours: 24},
+		OTP: config.OTPConfig{Pepper: testOTPPepper},
+	}
+
+	clock := utils.NewFakeClock(time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC))
+	mailer := email.NewSMTPClientWithTransport(func(*email.EmailMessage) error { return nil })
+	authService := service.NewAuthServiceWithEmailClient(mailer, clock)
+	t.Cleanup(authService.StopCleanup)
+
+	return db, authService, clock
+}
+
+func TestAuthService_PasswordResetOTPExpiry(t *testing.T) {
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+		wantErr bool
+	}{
+		{name: "Just issued", elapsed: 0, wantErr: false},
+		{name: "Just before expiry", elapsed: 3*time.Minute - time.Second, wantErr: false},
+		{name: "Just after expiry", elapsed: 3*time.Minute + time.Second, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, authService, clock := setupOTPExpiryTest(t)
+			issued := captureOTPs(t)
+
+			address := "reset@example.com"
+			hashedPass, _ := utils.HashPassword("TestPass123!")
+			user := &models.User{Email: &address, Provider: models.AuthProviderPassword, PasswordHash: &hashedPass}
+			require.NoError(t, db.Create(user).Error)
+
+			require.NoError(t, authService.SendPasswordResetOTP(address))
+			var reset models.PasswordReset
+			require.NoError(t, db.Where("user_id = ?", user.ID).First(&reset).Error)
+			assert.True(t, reset.ExpiresAt.Equal(clock.Now().Add(3*time.Minute)), "expiry should come from the clock")
+
+			clock.Advance(tt.elapsed)
+			err := authService.VerifyOTP(address, issued[address])
+			if tt.wantErr {
+				assert.EqualError(t, err, "invalid or expired OTP")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAuthService_EmailVerificationOTPExpiry(t *testing.T) {
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+		wantErr bool
+	}{
+		{name: "Just before expiry", elapsed: 10*time.Minute - time.Second, wantErr: false},
+		{name: "Just after expiry", elapsed: 10*time.Minute + time.Second, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, authService, clock := setupOTPExpiryTest(t)
+			issued := captureOTPs(t)
+
+			address := "verify@example.com"
+			registerEmail(t, authService, address, "Verifier")
+
+			clock.Advance(tt.elapsed)
+			user, err := authService.VerifyEmailOTP(address, issued[address], "TestPass123!", "Verifier")
+			if tt.wantErr {
+				assert.EqualError(t, err, "invalid or expired OTP")
+				assert.Nil(t, user)
+			} else {
+				require.NoError(t, err)
+				assert.True(t, user.EmailVerified)
+			}
+		})
+	}
+}
+
+func TestAuthService_ResendVerificationRestartsExpiry(t *testing.T) {
+	_, authService, clock := setupOTPExpiryTest(t)
+	issued := captureOTPs(t)
+
+	address := "resend@example.com"
+	registerEmail(t, authService, address, "Resender")
+
+	// Resending near the end of the window issues a code valid for another 10 minutes
+	clock.Advance(9 * time.Minute)
+	require.NoError(t, authService.ResendEmailVerificationOTP(address))
+
+	clock.Advance(9 * time.Minute)
+	_, err := authService.VerifyEmailOTP(address, issued[address], "TestPass123!", "Resender")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,58 @@
+package service_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOTPHandler_ShowsOnlyEmailVerificationCodes(t *testing.T) {
+	db, authService := setupAuthServiceTest(t)
+	issued := captureOTPs(t)
+	otpHandler := handlers.NewOTPHandler()
+	t.Cleanup(func() { service.SetEmailVerificationObserver(nil) })
+
+	// A password reset code must not show up against the same email's verification row
+	createPasswordUser(t, db, "reset@example.com", "OldPass123!")
+	require.NoError(t, authService.SendPasswordResetOTP("reset@example.com"))
+	require.NotEmpty(t, issued["reset@example.com"])
+	require.NoError(t, db.Create(&models.EmailVerification{
+		Email:     "reset@example.com",
+		OTP:       hashedOTP("000000"),
+		ExpiresAt: time.Now().Add(10 * time.Minute),
+	}).Error)
+
+	registerEmail(t, authService, "verify@example.com", "Verify")
+	require.NotEmpty(t, issued["verify@example.com"])
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/dev/otp", otpHandler.GetOTPs)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/dev/otp", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp struct {
+		Data struct {
+			OTPs []handlers.OTPInfo `json:"otps"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	shown := make(map[string]string)
+	for _, info := range resp.Data.OTPs {
+		shown[info.Email] = info.OTP
+	}
+	require.Len(t, shown, 2)
+	assert.Equal(t, issued["verify@example.com"], shown["verify@example.com"])
+	assert.Empty(t, shown["reset@example.com"])
+}
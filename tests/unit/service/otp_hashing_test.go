@@ -0,0 +1,67 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthService_StoresEmailOTPHashed(t *testing.T) {
+	db, authService := setupAuthServiceTest(t)
+	issued := captureOTPs(t)
+
+	address := "hashed@example.com"
+	// Email delivery fails without SMTP, but the code is stored first
+	_, _ = authService.SendEmailVerificationOTP(address, "Hashed")
+
+	var verification models.EmailVerification
+	require.NoError(t, db.Where("email = ?", address).First(&verification).Error)
+	require.Len(t, issued[address], 6)
+	assert.NotEqual(t, issued[address], verification.OTP)
+	assert.Equal(t, hashedOTP(issued[address]), verification.OTP)
+
+	user, err := authService.VerifyEmailOTP(address, issued[address], "TestPass123!", "Hashed")
+	require.NoError(t, err)
+	assert.Equal(t, address, *user.Email)
+}
+
+func TestAuthService_LeakedOTPValuesDontVerify(t *testing.T) {
+	db, authService := setupAuthServiceTest(t)
+
+	address := "leaked@example.com"
+	hashedPass, _ := utils.HashPassword("TestPass123!")
+	user := &models.User{Email: &address, Provider: models.AuthProviderPassword, PasswordHash: &hashedPass}
+	require.NoError(t, db.Create(user).Error)
+	reset := &models.PasswordReset{UserID: user.ID, Token: hashedOTP("246810"), ExpiresAt: time.Now().Add(10 * time.Minute)}
+	require.NoError(t, db.Create(reset).Error)
+
+	// The stored value itself is not a valid code
+	assert.EqualError(t, authService.VerifyOTP(address, reset.Token), "invalid or expired OTP")
+	assert.EqualError(t, authService.ResetPassword(address, reset.Token, "NewPass123!"), "invalid or expired OTP")
+
+	// Nor is the code once the pepper changes
+	config.AppConfig.OTP.Pepper = "rotated-pepper"
+	assert.EqualError(t, authService.VerifyOTP(address, "246810"), "invalid or expired OTP")
+
+	config.AppConfig.OTP.Pepper = testOTPPepper
+	assert.NoError(t, authService.VerifyOTP(address, "246810"))
+}
+
+func TestAuthService_OTPPepperFallsBackToJWTSecret(t *testing.T) {
+	db, authService := setupAuthServiceTest(t)
+	config.AppConfig.OTP.Pepper = ""
+	issued := captureOTPs(t)
+
+	address := "fallback@example.com"
+	_, _ = authService.SendEmailVerificationOTP(address, "Fallback")
+
+	var verification models.EmailVerification
+	require.NoError(t, db.Where("email = ?", address).First(&verification).Error)
+	assert.Equal(t, utils.HashOTP(issued[address], config.AppConfig.JWT.Secret), verification.OTP)
+}
@@ -0,0 +1,88 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyEmailOTP_LastAttemptCanSucceed(t *testing.T) {
+	db, authService := setupAuthServiceTest(t)
+
+	address := "fifth@example.com"
+	require.NoError(t, db.Create(&models.EmailVerification{
+		Email: address, OTP: hashedOTP("123456"), ExpiresAt: time.Now().Add(10 * time.Minute),
+	}).Error)
+
+	for i := 0; i < 4; i++ {
+		_, err := authService.VerifyEmailOTP(address, "000000", "TestPass123!", "Fifth")
+		assert.EqualError(t, err, "invalid or expired OTP")
+	}
+
+	_, err := authService.VerifyEmailOTP(address, "123456", "TestPass123!", "Fifth")
+	assert.NoError(t, err)
+}
+
+func TestVerifyEmailOTP_LockoutAndResend(t *testing.T) {
+	db, authService := setupAuthServiceTest(t)
+	issued := captureOTPs(t)
+
+	address := "locked@example.com"
+	require.NoError(t, db.Create(&models.EmailVerification{
+		Email: address, OTP: hashedOTP("123456"), ExpiresAt: time.Now().Add(10 * time.Minute),
+	}).Error)
+
+	for i := 0; i < 4; i++ {
+		_, err := authService.VerifyEmailOTP(address, "000000", "TestPass123!", "Locked")
+		assert.EqualError(t, err, "invalid or expired OTP")
+	}
+	// The fifth wrong code uses up the OTP
+	_, err := authService.VerifyEmailOTP(address, "000000", "TestPass123!", "Locked")
+	assert.EqualError(t, err, "too many attempts")
+
+	// Even the right code is refused now
+	_, err = authService.VerifyEmailOTP(address, "123456", "TestPass123!", "Locked")
+	assert.EqualError(t, err, "too many attempts")
+
+	// A resent OTP starts with a fresh count
+	require.NoError(t, authService.ResendEmailVerificationOTP(address))
+	_, err = authService.VerifyEmailOTP(address, "000000", "TestPass123!", "Locked")
+	assert.EqualError(t, err, "invalid or expired OTP")
+	user, err := authService.VerifyEmailOTP(address, issued[address], "TestPass123!", "Locked")
+	require.NoError(t, err)
+	assert.Equal(t, address, *user.Email)
+}
+
+func TestResetPassword_LockoutAndResend(t *testing.T) {
+	db, authService := setupAuthServiceTest(t)
+	issued := captureOTPs(t)
+
+	address := "reset-locked@example.com"
+	hashedPass, _ := utils.HashPassword("TestPass123!")
+	user := &models.User{Email: &address, Provider: models.AuthProviderPassword, PasswordHash: &hashedPass}
+	require.NoError(t, db.Create(user).Error)
+	require.NoError(t, db.Create(&models.PasswordReset{
+		UserID: user.ID, Token: hashedOTP("123456"), ExpiresAt: time.Now().Add(10 * time.Minute),
+	}).Error)
+
+	// Checking the code and resetting with it draw on the same attempts
+	for i := 0; i < 2; i++ {
+		assert.EqualError(t, authService.VerifyOTP(address, "000000"), "invalid or expired OTP")
+		assert.EqualError(t, authService.ResetPassword(address, "000000", "NewPass123!"), "invalid or expired OTP")
+	}
+	assert.EqualError(t, authService.ResetPassword(address, "000000", "NewPass123!"), "too many attempts")
+	assert.EqualError(t, authService.ResetPassword(address, "123456", "NewPass123!"), "too many attempts")
+
+	var stored models.PasswordReset
+	require.NoError(t, db.First(&stored, "user_id = ?", user.ID).Error)
+	assert.Equal(t, 5, stored.Attempts)
+
+	// A new OTP can be used again
+	require.NoError(t, authService.SendPasswordResetOTP(address))
+	assert.NoError(t, authService.ResetPassword(address, issued[address], "NewPass123!"))
+}
@@ -0,0 +1,147 @@
+package service_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// joinedAt backdates when the user joined the event
+func joinedAt(t *testing.T, db *gorm.DB, event *models.Event, user *models.User, at time.Time) {
+	t.Helper()
+	require.NoError(t, db.Model(&models.EventMember{}).
+		Where("event_id = ? AND user_id = ?", event.ID, user.ID).Update("joined_at", at).Error)
+}
+
+// memberStatus returns the user's membership status on the event
+func memberStatus(t *testing.T, db *gorm.DB, event *models.Event, user *models.User) models.MemberStatus {
+	t.Helper()
+	var member models.EventMember
+	require.NoError(t, db.Where("event_id = ? AND user_id = ?", event.ID, user.ID).First(&member).Error)
+	return member.Status
+}
+
+func TestExpireStalePendingMembers_Threshold(t *testing.T) {
+	db := setupEventDomainDB(t)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	eventService := service.NewEventServiceWithClock(utils.NewFakeClock(now))
+
+	creator := createTestUser(t, db, "expiry-creator")
+	stale := createTestUser(t, db, "expiry-stale")
+	atTTL := createTestUser(t, db, "expiry-at-ttl")
+	fresh := createTestUser(t, db, "expiry-fresh")
+	confirmed := createTestUser(t, db, "expiry-confirmed")
+	event := createTestEvent(t, db, creator, "Sunrise hike")
+	require.NoError(t, db.Model(event).Update("start_at", now.Add(7*24*time.Hour)).Error)
+
+	addTestMember(t, db, event, stale, models.MemberRoleParticipant, models.MemberStatusPending)
+	joinedAt(t, db, event, stale, now.Add(-100*time.Hour))
+	addTestMember(t, db, event, atTTL, models.MemberRoleParticipant, models.MemberStatusPending)
+	joinedAt(t, db, event, atTTL, now.Add(-72*time.Hour))
+	addTestMember(t, db, event, fresh, models.MemberRoleParticipant, models.MemberStatusPending)
+	joinedAt(t, db, event, fresh, now.Add(-71*time.Hour))
+	addTestMember(t, db, event, confirmed, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	joinedAt(t, db, event, confirmed, now.Add(-100*time.Hour))
+
+	expired, err := eventService.ExpireStalePendingMembers()
+	require.NoError(t, err)
+	assert.Equal(t, 2, expired)
+
+	assert.Equal(t, models.MemberStatusDeclined, memberStatus(t, db, event, stale))
+	assert.Equal(t, models.MemberStatusDeclined, memberStatus(t, db, event, atTTL))
+	assert.Equal(t, models.MemberStatusPending, memberStatus(t, db, event, fresh))
+	assert.Equal(t, models.MemberStatusConfirmed, memberStatus(t, db, event, confirmed))
+	assertConfirmedCount(t, db, event.ID, 2)
+
+	// Nothing left to expire
+	expired, err = eventService.ExpireStalePendingMembers()
+	require.NoError(t, err)
+	assert.Zero(t, expired)
+}
+
+func TestExpireStalePendingMembers_OnlyUpcomingPublishedEvents(t *testing.T) {
+	db := setupEventDomainDB(t)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	eventService := service.NewEventServiceWithClock(utils.NewFakeClock(now))
+
+	creator := createTestUser(t, db, "scope-expiry-creator")
+	member := createTestUser(t, db, "scope-expiry-member")
+
+	started := createTestEvent(t, db, creator, "Already started")
+	require.NoError(t, db.Model(started).Update("start_at", now.Add(-time.Hour)).Error)
+	cancelled := createTestEvent(t, db, creator, "Cancelled")
+	require.NoError(t, db.Model(cancelled).Update("status", models.EventStatusCancelled).Error)
+	undated := createTestEvent(t, db, creator, "Some day")
+
+	for _, event := range []*models.Event{started, cancelled, undated} {
+		addTestMember(t, db, event, member, models.MemberRoleParticipant, models.MemberStatusPending)
+		joinedAt(t, db, event, member, now.Add(-30*24*time.Hour))
+	}
+
+	expired, err := eventService.ExpireStalePendingMembers()
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired)
+
+	assert.Equal(t, models.MemberStatusPending, memberStatus(t, db, started, member))
+	assert.Equal(t, models.MemberStatusPending, memberStatus(t, db, cancelled, member))
+	assert.Equal(t, models.MemberStatusDeclined, memberStatus(t, db, undated, member))
+}
+
+func TestExpireEventPendingMembers_CreatorOnly(t *testing.T) {
+	db := setupEventDomainDB(t)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	eventService := service.NewEventServiceWithClock(utils.NewFakeClock(now))
+
+	creator := createTestUser(t, db, "action-creator")
+	member := createTestUser(t, db, "action-member")
+	event := createTestEvent(t, db, creator, "Board games")
+	other := createTestEvent(t, db, creator, "Other night")
+	for _, e := range []*models.Event{event, other} {
+		addTestMember(t, db, e, member, models.MemberRoleParticipant, models.MemberStatusPending)
+		joinedAt(t, db, e, member, now.Add(-100*time.Hour))
+	}
+
+	_, err := eventService.ExpireEventPendingMembers(event.ID.String(), member.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "not authorized", err.Error())
+
+	expired, err := eventService.ExpireEventPendingMembers(event.ID.String(), creator.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired)
+
+	// Only the creator's chosen event is touched
+	assert.Equal(t, models.MemberStatusDeclined, memberStatus(t, db, event, member))
+	assert.Equal(t, models.MemberStatusPending, memberStatus(t, db, other, member))
+}
+
+func TestExpirePendingMembersHandler(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "handler-expiry-creator")
+	event := createTestEvent(t, db, creator, "Handler event")
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", creator.ID.String())
+		c.Next()
+	})
+	router.POST("/events/:id/pending/expire", handlers.NewEventHandler().ExpirePendingMembers)
+
+	w := serveEventRequest(router, "POST", "/events/"+event.ID.String()+"/pending/expire", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"expired":0`)
+
+	w = serveEventRequest(router, "POST", "/events/"+uuid.New().String()+"/pending/expire", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,209 @@
+package service_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// sentOTP extracts the 6-digit code from the last SMS
+func sentOTP(t *testing.T, sender *mockSMSSender) string {
+	require.NotEmpty(t, sender.body)
+	body := sender.body[len(sender.body)-1]
+	idx := strings.Index(body, "code is ")
+	require.GreaterOrEqual(t, idx, 0)
+	return body[idx+len("code is ") : idx+len("code is ")+6]
+}
+
+func reloadUser(t *testing.T, db *gorm.DB, user *models.User) *models.User {
+	var reloaded models.User
+	require.NoError(t, db.First(&reloaded, "id = ?", user.ID).Error)
+	return &reloaded
+}
+
+func TestPhoneService_RequestAndVerify(t *testing.T) {
+	db := setupEventDomainDB(t)
+	sender := &mockSMSSender{}
+	phoneService := service.NewPhoneServiceWithSMSSender(sender)
+	user := createTestUser(t, db, "phone-user")
+
+	phone, err := phoneService.RequestPhoneVerification(user.ID.String(), "+66 81 234 5678")
+	require.NoError(t, err)
+	assert.Equal(t, "+66812345678", phone)
+	require.Len(t, sender.to, 1)
+	assert.Equal(t, "+66812345678", sender.to[0])
+
+	// The phone is not saved until verified
+	assert.Nil(t, reloadUser(t, db, user).Phone)
+
+	verified, err := phoneService.VerifyPhone(user.ID.String(), sentOTP(t, sender))
+	require.NoError(t, err)
+	assert.Equal(t, "+66812345678", verified)
+
+	reloaded := reloadUser(t, db, user)
+	require.NotNil(t, reloaded.Phone)
+	assert.Equal(t, "+66812345678", *reloaded.Phone)
+	assert.True(t, reloaded.PhoneVerified)
+
+	var pending int64
+	db.Model(&models.PhoneVerification{}).Where("user_id = ?", user.ID).Count(&pending)
+	assert.Zero(t, pending)
+}
+
+func TestPhoneService_RejectsInvalidNumber(t *testing.T) {
+	db := setupEventDomainDB(t)
+	sender := &mockSMSSender{}
+	phoneService := service.NewPhoneServiceWithSMSSender(sender)
+	user := createTestUser(t, db, "phone-invalid")
+
+	_, err := phoneService.RequestPhoneVerification(user.ID.String(), "081-234-5678")
+	require.Error(t, err)
+	assert.Equal(t, "invalid phone number", err.Error())
+	assert.Empty(t, sender.to)
+}
+
+func TestPhoneService_WrongOrExpiredOTP(t *testing.T) {
+	db := setupEventDomainDB(t)
+	sender := &mockSMSSender{}
+	phoneService := service.NewPhoneServiceWithSMSSender(sender)
+	user := createTestUser(t, db, "phone-wrong")
+
+	_, err := phoneService.RequestPhoneVerification(user.ID.String(), "+66812345678")
+	require.NoError(t, err)
+
+	otp := sentOTP(t, sender)
+	wrong := "000000"
+	if otp == wrong {
+		wrong = "111111"
+	}
+	_, err = phoneService.VerifyPhone(user.ID.String(), wrong)
+	require.Error(t, err)
+	assert.Equal(t, "invalid or expired OTP", err.Error())
+
+	// Expire the pending verification
+	db.Model(&models.PhoneVerification{}).Where("user_id = ?", user.ID).Update("expires_at", time.Now().Add(-time.Minute))
+	_, err = phoneService.VerifyPhone(user.ID.String(), otp)
+	require.Error(t, err)
+	assert.Equal(t, "invalid or expired OTP", err.Error())
+	assert.False(t, reloadUser(t, db, user).PhoneVerified)
+}
+
+func TestPhoneService_EnforcesUniqueness(t *testing.T) {
+	db := setupEventDomainDB(t)
+	sender := &mockSMSSender{}
+	phoneService := service.NewPhoneServiceWithSMSSender(sender)
+	owner := createTestUser(t, db, "phone-owner")
+	other := createTestUser(t, db, "phone-other")
+
+	phone := "+66812345678"
+	require.NoError(t, db.Model(owner).Updates(map[string]interface{}{"phone": phone, "phone_verified": true}).Error)
+
+	_, err := phoneService.RequestPhoneVerification(other.ID.String(), "0066812345678")
+	require.Error(t, err)
+	assert.Equal(t, "phone number already in use", err.Error())
+
+	// The owner can re-verify their own number
+	_, err = phoneService.RequestPhoneVerification(owner.ID.String(), phone)
+	assert.NoError(t, err)
+}
+
+func TestPhoneService_TakenBeforeVerification(t *testing.T) {
+	db := setupEventDomainDB(t)
+	sender := &mockSMSSender{}
+	phoneService := service.NewPhoneServiceWithSMSSender(sender)
+	first := createTestUser(t, db, "phone-first")
+	second := createTestUser(t, db, "phone-second")
+
+	_, err := phoneService.RequestPhoneVerification(first.ID.String(), "+66812345678")
+	require.NoError(t, err)
+	otp := sentOTP(t, sender)
+
+	// Someone else verifies the number first
+	require.NoError(t, db.Model(second).Update("phone", "+66812345678").Error)
+
+	_, err = phoneService.VerifyPhone(first.ID.String(), otp)
+	require.Error(t, err)
+	assert.Equal(t, "phone number already in use", err.Error())
+}
+
+func TestPhoneService_RequiresSMSSender(t *testing.T) {
+	db := setupEventDomainDB(t)
+	phoneService := service.NewPhoneServiceWithSMSSender(nil)
+	user := createTestUser(t, db, "phone-nosender")
+
+	_, err := phoneService.RequestPhoneVerification(user.ID.String(), "+66812345678")
+	require.Error(t, err)
+	assert.Equal(t, "SMS delivery is not configured", err.Error())
+}
+
+func TestPhoneService_StoresHashedOTPAndLocksOut(t *testing.T) {
+	db := setupEventDomainDB(t)
+	sender := &mockSMSSender{}
+	phoneService := service.NewPhoneServiceWithSMSSender(sender)
+	user := createTestUser(t, db, "phone-locked")
+
+	_, err := phoneService.RequestPhoneVerification(user.ID.String(), "+66812345678")
+	require.NoError(t, err)
+	otp := sentOTP(t, sender)
+
+	var verification models.PhoneVerification
+	require.NoError(t, db.Where("user_id = ?", user.ID).First(&verification).Error)
+	assert.NotEqual(t, otp, verification.OTP)
+	assert.Equal(t, hashedOTP(otp), verification.OTP)
+
+	wrong := "000000"
+	if otp == wrong {
+		wrong = "111111"
+	}
+	for i := 1; i < 5; i++ {
+		_, err = phoneService.VerifyPhone(user.ID.String(), wrong)
+		require.Error(t, err)
+		assert.Equal(t, "invalid or expired OTP", err.Error())
+	}
+	_, err = phoneService.VerifyPhone(user.ID.String(), wrong)
+	require.Error(t, err)
+	assert.Equal(t, "too many attempts", err.Error())
+
+	// The right code no longer works once the attempts are used up
+	_, err = phoneService.VerifyPhone(user.ID.String(), otp)
+	require.Error(t, err)
+	assert.Equal(t, "too many attempts", err.Error())
+	assert.False(t, reloadUser(t, db, user).PhoneVerified)
+}
+
+func TestPhoneService_UniqueViolationIsConflict(t *testing.T) {
+	db := setupEventDomainDB(t)
+	require.NoError(t, db.Exec("CREATE UNIQUE INDEX ux_users_phone ON users(phone) WHERE phone IS NOT NULL AND deleted_at IS NULL").Error)
+	sender := &mockSMSSender{}
+	phoneService := service.NewPhoneServiceWithSMSSender(sender)
+	first := createTestUser(t, db, "phone-race-first")
+	second := createTestUser(t, db, "phone-race-second")
+
+	_, err := phoneService.RequestPhoneVerification(first.ID.String(), "+66812345678")
+	require.NoError(t, err)
+	otp := sentOTP(t, sender)
+
+	// The other user takes the number between the availability check and the update
+	raced := false
+	require.NoError(t, db.Callback().Update().Before("gorm:update").Register("test:phone_race", func(tx *gorm.DB) {
+		if raced || tx.Statement.Table != "users" {
+			return
+		}
+		raced = true
+		tx.Session(&gorm.Session{NewDB: true}).Exec("UPDATE users SET phone = ? WHERE id = ?", "+66812345678", second.ID)
+	}))
+	t.Cleanup(func() { _ = db.Callback().Update().Remove("test:phone_race") })
+
+	_, err = phoneService.VerifyPhone(first.ID.String(), otp)
+	require.Error(t, err)
+	assert.Equal(t, "phone number already in use", err.Error())
+	assert.True(t, raced)
+	assert.False(t, reloadUser(t, db, first).PhoneVerified)
+}
@@ -0,0 +1,154 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"mime/multipart"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubUploader records uploads instead of talking to storage
+type stubUploader struct {
+	mu   sync.Mutex
+	keys []string
+}
+
+func (u *stubUploader) Upload(ctx context.Context, key string, r io.Reader, contentType string) (string, error) {
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		return "", err
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.keys = append(u.keys, key)
+	return "https://storage.test/" + key, nil
+}
+
+// testPNG returns a small but valid PNG image
+func testPNG(t *testing.T) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, 32, 32))
+	for x := 0; x < 32; x++ {
+		for y := 0; y < 32; y++ {
+			img.Set(x, y, color.RGBA{uint8(x * 8), uint8(y * 8), 128, 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+// multipartFiles builds files[] headers from name/content pairs
+func multipartFiles(t *testing.T, files map[string][]byte, order []string) []*multipart.FileHeader {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for _, name := range order {
+		part, err := writer.CreateFormFile("files[]", name)
+		require.NoError(t, err)
+		_, err = part.Write(files[name])
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest("POST", "/events/x/photos", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	require.NoError(t, req.ParseMultipartForm(10<<20))
+	return req.MultipartForm.File["files[]"]
+}
+
+func TestUploadImages_PartialFailure(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Photographer")
+	event := createTestEvent(t, db, creator, "Gallery Trip")
+
+	uploader := &stubUploader{}
+	fs, err := service.NewFileServiceWithUploader(uploader)
+	require.NoError(t, err)
+
+	files := map[string][]byte{
+		"first.png":  testPNG(t),
+		"notes.txt":  bytes.Repeat([]byte("not an image "), 20),
+		"second.png": testPNG(t),
+		"empty.png":  {},
+	}
+	order := []string{"first.png", "notes.txt", "second.png", "empty.png"}
+
+	result := fs.UploadImages(context.Background(), "event_photos", multipartFiles(t, files, order))
+
+	assert.Equal(t, 2, result.Uploaded)
+	assert.Equal(t, 2, result.Failed)
+	require.Len(t, result.Results, 4)
+	assert.Len(t, uploader.keys, 2)
+
+	// Results keep the request order with either a URL or an error
+	for i, name := range order {
+		item := result.Results[i]
+		assert.Equal(t, name, item.Filename)
+		if name == "first.png" || name == "second.png" {
+			require.NotNil(t, item.URL, name)
+			assert.Nil(t, item.Error, name)
+		} else {
+			assert.Nil(t, item.URL, name)
+			require.NotNil(t, item.Error, name)
+		}
+	}
+	assert.Contains(t, *result.Results[1].Error, "unsupported content type")
+
+	// Only the successful uploads are persisted
+	eventService := service.NewEventService()
+	require.NoError(t, eventService.AppendEventPhotos(creator.ID.String(), event.ID.String(), result.URLs))
+
+	var photos []models.EventPhoto
+	require.NoError(t, db.Where("event_id = ?", event.ID).Find(&photos).Error)
+	assert.Len(t, photos, 2)
+}
+
+func TestVerifyEventCreator(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Owner")
+	other := createTestUser(t, db, "Visitor")
+	event := createTestEvent(t, db, creator, "Owned Event")
+
+	eventService := service.NewEventService()
+	assert.NoError(t, eventService.VerifyEventCreator(creator.ID.String(), event.ID.String()))
+
+	err := eventService.VerifyEventCreator(other.ID.String(), event.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "permission denied", err.Error())
+
+	err = eventService.VerifyEventCreator(creator.ID.String(), fmt.Sprintf("%s-bad", event.ID))
+	require.Error(t, err)
+}
+
+func TestAppendEventPhotos_ContinuesSortOrder(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Photographer")
+	event := createTestEvent(t, db, creator, "Photo Walk")
+	eventService := service.NewEventService()
+
+	require.NoError(t, eventService.AppendEventPhotos(creator.ID.String(), event.ID.String(),
+		[]string{"events/a.png", "events/b.png", "events/c.png"}))
+	require.NoError(t, eventService.AppendEventPhotos(creator.ID.String(), event.ID.String(),
+		[]string{"events/d.png", "events/e.png"}))
+
+	var photos []models.EventPhoto
+	require.NoError(t, db.Where("event_id = ?", event.ID).Order("sort_no ASC").Find(&photos).Error)
+	require.Len(t, photos, 5)
+	for i, photo := range photos {
+		require.NotNil(t, photo.SortNo)
+		assert.Equal(t, i, *photo.SortNo)
+	}
+	assert.Equal(t, "events/d.png", photos[3].URL)
+	assert.Equal(t, "events/e.png", photos[4].URL)
+}
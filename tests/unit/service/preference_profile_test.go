@@ -0,0 +1,93 @@
+package service_test
+
+import (
+	"testing"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreferenceProfile_CachedUntilBudgetUpdated(t *testing.T) {
+	db := setupEventDomainDB(t)
+	user := createTestUser(t, db, "Planner")
+
+	profile, err := service.LoadPreferenceProfile(user.ID)
+	require.NoError(t, err)
+	assert.Nil(t, profile.Budget)
+
+	// A row written behind the service's back is not seen while the profile is cached
+	require.NoError(t, db.Create(&models.PrefBudget{UserID: user.ID, Currency: "THB"}).Error)
+	profile, err = service.LoadPreferenceProfile(user.ID)
+	require.NoError(t, err)
+	assert.Nil(t, profile.Budget)
+
+	// Updating through the service invalidates the cached profile
+	unlimited := true
+	_, err = service.NewPreferenceService().UpdateBudget(user.ID.String(), dto.UpdatePrefBudgetRequest{Unlimited: &unlimited})
+	require.NoError(t, err)
+
+	profile, err = service.LoadPreferenceProfile(user.ID)
+	require.NoError(t, err)
+	require.NotNil(t, profile.Budget)
+	assert.True(t, profile.Budget.Unlimited)
+}
+
+func TestPreferenceProfile_TravelAndFoodChangesInvalidate(t *testing.T) {
+	db := setupEventDomainDB(t)
+	user := createTestUser(t, db, "Foodie")
+
+	profile, err := service.LoadPreferenceProfile(user.ID)
+	require.NoError(t, err)
+	assert.Empty(t, profile.TravelStyles)
+	assert.Empty(t, profile.FoodLevels)
+
+	travelService := service.NewTravelPreferenceService()
+	require.NoError(t, travelService.AddTravelPreference(user.ID.String(), dto.AddTravelPreferenceRequest{TravelStyle: "karaoke"}))
+	profile, err = service.LoadPreferenceProfile(user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"karaoke"}, profile.TravelStyles)
+
+	require.NoError(t, service.NewFoodPreferenceService().UpdateFoodPreference(user.ID.String(), dto.UpdateFoodPreferenceRequest{
+		FoodCategory:    "thai_food",
+		PreferenceLevel: 3,
+	}))
+	profile, err = service.LoadPreferenceProfile(user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"thai_food": 3}, profile.FoodLevels)
+
+	require.NoError(t, travelService.DeleteTravelPreference(user.ID.String(), "karaoke"))
+	profile, err = service.LoadPreferenceProfile(user.ID)
+	require.NoError(t, err)
+	assert.Empty(t, profile.TravelStyles)
+}
+
+func TestPreferenceProfile_ScoringUsesFreshProfile(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Creator")
+	viewer := createTestUser(t, db, "Viewer")
+	budgetMax := 100
+	event := createTestEvent(t, db, creator, "Pricey dinner")
+	require.NoError(t, db.Model(&models.Event{}).Where("id = ?", event.ID).Update("budget_max", 5000).Error)
+
+	tagService := service.NewTagService()
+	match, err := tagService.GetEventMatch(viewer.ID.String(), event.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, 50.0, match.Components[service.MatchComponentEventType])
+
+	_, err = service.NewPreferenceService().UpdateBudget(viewer.ID.String(), dto.UpdatePrefBudgetRequest{MealMax: &budgetMax})
+	require.NoError(t, err)
+
+	match, err = tagService.GetEventMatch(viewer.ID.String(), event.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, 70.0, match.Components[service.MatchComponentEventType])
+
+	// Profiles are kept per user
+	other, err := service.LoadPreferenceProfile(uuid.New())
+	require.NoError(t, err)
+	assert.Nil(t, other.Budget)
+}
@@ -0,0 +1,96 @@
+package service_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/pkg/push"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// mockPushSender records pushed messages instead of sending them to FCM.
+// Tokens in invalid are rejected as unregistered.
+type mockPushSender struct {
+	mu      sync.Mutex
+	invalid map[string]bool
+	sent    map[string][]*push.Message
+}
+
+func (m *mockPushSender) SendPush(token string, message *push.Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.invalid[token] {
+		return push.ErrInvalidToken
+	}
+	if m.sent == nil {
+		m.sent = map[string][]*push.Message{}
+	}
+	m.sent[token] = append(m.sent[token], message)
+	return nil
+}
+
+func (m *mockPushSender) sentTo(token string) []*push.Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.sent[token]
+}
+
+// addTestDevice registers a push token for the user
+func addTestDevice(t *testing.T, db *gorm.DB, user *models.User, token string) {
+	t.Helper()
+	require.NoError(t, db.Create(&models.DeviceToken{UserID: user.ID, Token: token, Platform: models.DevicePlatformAndroid}).Error)
+}
+
+func TestSendPushNotification_PushesToDevicesAndPrunesInvalidTokens(t *testing.T) {
+	db := setupEventDomainDB(t)
+	sender := &mockPushSender{invalid: map[string]bool{"expired-token": true}}
+	mailer, _ := captureEmails()
+	notificationService := service.NewNotificationServiceWithSenders(sender, mailer)
+
+	user := createTestUser(t, db, "pushed")
+	other := createTestUser(t, db, "not-pushed")
+	addTestDevice(t, db, user, "phone-token")
+	addTestDevice(t, db, user, "expired-token")
+	addTestDevice(t, db, other, "other-token")
+
+	err := notificationService.SendPushNotification(user.ID.String(), "Trip update", "The boat leaves at 9", map[string]interface{}{
+		"type":     "event_reminder",
+		"event_id": "abc",
+		"count":    3,
+	})
+	require.NoError(t, err)
+
+	// Saved as before
+	var notification models.Notification
+	require.NoError(t, db.Where("user_id = ?", user.ID).First(&notification).Error)
+	assert.Equal(t, "Trip update", notification.Title)
+
+	require.Eventually(t, func() bool {
+		var count int64
+		db.Model(&models.DeviceToken{}).Where("token = ?", "expired-token").Count(&count)
+		return count == 0
+	}, 2*time.Second, 10*time.Millisecond, "expired token should be pruned")
+
+	require.Eventually(t, func() bool { return len(sender.sentTo("phone-token")) == 1 }, 2*time.Second, 10*time.Millisecond)
+	message := sender.sentTo("phone-token")[0]
+	assert.Equal(t, "Trip update", message.Title)
+	assert.Equal(t, "The boat leaves at 9", message.Body)
+	assert.Equal(t, map[string]string{
+		"notification_id": notification.ID.String(),
+		"type":            "event_reminder",
+		"event_id":        "abc",
+		"count":           "3",
+	}, message.Data)
+
+	// Only the user's own devices, and valid tokens are kept
+	assert.Empty(t, sender.sentTo("other-token"))
+	var remaining []string
+	require.NoError(t, db.Model(&models.DeviceToken{}).Order("token").Pluck("token", &remaining).Error)
+	assert.Equal(t, []string{"other-token", "phone-token"}, remaining)
+}
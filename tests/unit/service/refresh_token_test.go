@@ -0,0 +1,294 @@
+package service_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/api/middleware"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/database"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"gorm.io/gorm"
+)
+
+// setupRefreshTokenTest creates an auth service on a fake clock and a user to issue tokens for
+func setupRefreshTokenTest(t *testing.T) (*gorm.DB, *service.AuthService, *utils.FakeClock, *models.User) {
+	db, _ := setupAuthServiceTest(t)
+	clock := utils.NewFakeClock(time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC))
+	authService := service.NewAuthServiceWithClock(clock)
+	t.Cleanup(authService.StopCleanup)
+
+	email := "refresh@example.com"
+	user := &models.User{Email: &email, Provider: models.AuthProviderPassword}
+	require.NoError(t, db.Create(user).Error)
+	return db, authService, clock, user
+}
+
+func TestRotateRefreshToken_IssuesReplacement(t *testing.T) {
+	db, authService, _, user := setupRefreshTokenTest(t)
+
+	first, err := authService.IssueRefreshToken(user.ID)
+	require.NoError(t, err)
+
+	// Only the hash is stored
+	var stored models.RefreshToken
+	require.NoError(t, db.First(&stored, "user_id = ?", user.ID).Error)
+	assert.NotEqual(t, first, stored.TokenHash)
+	assert.NotContains(t, stored.TokenHash, first)
+
+	owner, second, err := authService.RotateRefreshToken(first)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, owner.ID)
+	assert.NotEqual(t, first, second)
+
+	// The old token is revoked and points at its replacement, in the same family
+	var tokens []models.RefreshToken
+	require.NoError(t, db.Find(&tokens, "user_id = ?", user.ID).Error)
+	require.Len(t, tokens, 2)
+	var old, current models.RefreshToken
+	for _, token := range tokens {
+		if token.ID == stored.ID {
+			old = token
+		} else {
+			current = token
+		}
+	}
+	require.NotNil(t, old.RevokedAt)
+	require.NotNil(t, old.ReplacedBy)
+	assert.Equal(t, current.ID, *old.ReplacedBy)
+	assert.Nil(t, current.RevokedAt)
+	assert.Equal(t, old.FamilyID, current.FamilyID)
+
+	// The replacement keeps working
+	_, third, err := authService.RotateRefreshToken(second)
+	require.NoError(t, err)
+	assert.NotEmpty(t, third)
+}
+
+func TestRotateRefreshToken_ReuseRevokesChain(t *testing.T) {
+	db, authService, _, user := setupRefreshTokenTest(t)
+
+	first, err := authService.IssueRefreshToken(user.ID)
+	require.NoError(t, err)
+	_, second, err := authService.RotateRefreshToken(first)
+	require.NoError(t, err)
+	_, third, err := authService.RotateRefreshToken(second)
+	require.NoError(t, err)
+
+	// Another login on another device is a separate family
+	otherDevice, err := authService.IssueRefreshToken(user.ID)
+	require.NoError(t, err)
+
+	// Replaying a used token revokes every token descended from the same login
+	_, _, err = authService.RotateRefreshToken(first)
+	assert.EqualError(t, err, "refresh token reused")
+
+	_, _, err = authService.RotateRefreshToken(third)
+	assert.EqualError(t, err, "refresh token reused")
+	var live int64
+	require.NoError(t, db.Model(&models.RefreshToken{}).Where("revoked_at IS NULL").Count(&live).Error)
+	assert.Equal(t, int64(1), live)
+
+	_, _, err = authService.RotateRefreshToken(otherDevice)
+	assert.NoError(t, err)
+}
+
+func TestRotateRefreshToken_InvalidTokens(t *testing.T) {
+	_, authService, clock, user := setupRefreshTokenTest(t)
+
+	_, _, err := authService.RotateRefreshToken("not-a-token")
+	assert.EqualError(t, err, "invalid or expired refresh token")
+
+	// Expires 30 days after it was issued
+	token, err := authService.IssueRefreshToken(user.ID)
+	require.NoError(t, err)
+	clock.Advance(30*24*time.Hour - time.Second)
+	_, token, err = authService.RotateRefreshToken(token)
+	require.NoError(t, err)
+
+	clock.Advance(30 * 24 * time.Hour)
+	_, _, err = authService.RotateRefreshToken(token)
+	assert.EqualError(t, err, "invalid or expired refresh token")
+
+	// Deleting the account revokes its tokens
+	token, err = authService.IssueRefreshToken(user.ID)
+	require.NoError(t, err)
+	require.NoError(t, authService.DeleteUser(user.ID.String()))
+	_, _, err = authService.RotateRefreshToken(token)
+	assert.Error(t, err)
+}
+
+func TestRefreshTokenEndpoint(t *testing.T) {
+	db, authService := setupAuthServiceTest(t)
+	email := "refresh-endpoint@example.com"
+	user := &models.User{Email: &email, Provider: models.AuthProviderPassword}
+	require.NoError(t, db.Create(user).Error)
+	token, err := authService.IssueRefreshToken(user.ID)
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/auth/refresh", handlers.NewAuthHandler().RefreshToken)
+	refresh := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/auth/refresh", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := refresh(`{"refresh_token":"` + token + `"}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"token":"`)
+	assert.Contains(t, w.Body.String(), `"refresh_token":"`)
+	assert.NotContains(t, w.Body.String(), token)
+
+	// The token sent is used up
+	w = refresh(`{"refresh_token":"` + token + `"}`)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w = refresh(`{}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGoogleCallback_IssuesRefreshToken(t *testing.T) {
+	db, authService := setupAuthServiceTest(t)
+	config.AppConfig.Server.FrontendURL = "http://frontend.test"
+
+	// Nothing listens here, so the OAuth state check is skipped as when Redis is down
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	listener.Close()
+	previousRedis := database.RedisClient
+	database.RedisClient = redis.NewClient(&redis.Options{Addr: addr, DisableIndentity: true, MaxRetries: -1})
+	t.Cleanup(func() {
+		database.RedisClient.Close()
+		database.RedisClient = previousRedis
+	})
+
+	// Stand in for Google's token and userinfo endpoints
+	google := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/token":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "google-access-token",
+				"token_type":   "Bearer",
+				"expires_in":   3600,
+			})
+		case "/userinfo":
+			json.NewEncoder(w).Encode(service.GoogleUserInfo{
+				ID:            "google-user-12345",
+				Email:         "google-refresh@example.com",
+				VerifiedEmail: true,
+				Name:          "Google Refresh",
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(google.Close)
+
+	googleOAuth := service.NewGoogleOAuthServiceWithEndpoints(
+		oauth2.Endpoint{AuthURL: google.URL + "/auth", TokenURL: google.URL + "/token"},
+		google.URL+"/userinfo",
+	)
+	authHandler := handlers.NewAuthHandlerWithGoogleOAuth(googleOAuth)
+	t.Cleanup(authHandler.StopCleanup)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/auth/google/callback", authHandler.GoogleCallback)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/auth/google/callback?code=auth-code&state=some-state", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusFound, w.Code)
+
+	location, err := url.Parse(w.Header().Get("Location"))
+	require.NoError(t, err)
+	assert.Equal(t, "/callback", location.Path)
+	assert.NotEmpty(t, location.Query().Get("token"))
+
+	// The refresh token is only in the fragment, never in the query string
+	assert.Empty(t, location.Query().Get("refresh_token"))
+	fragment, err := url.ParseQuery(location.Fragment)
+	require.NoError(t, err)
+	refreshToken := fragment.Get("refresh_token")
+	require.NotEmpty(t, refreshToken)
+
+	var user models.User
+	require.NoError(t, db.First(&user, "google_id = ?", "google-user-12345").Error)
+	assert.Equal(t, user.ID.String(), location.Query().Get("user_id"))
+
+	// The token keeps the Google session alive past the access token's expiry
+	owner, rotated, err := authService.RotateRefreshToken(refreshToken)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, owner.ID)
+	assert.NotEmpty(t, rotated)
+}
+
+func TestLogout_RevokesRefreshTokenFamily(t *testing.T) {
+	db, authService := setupAuthServiceTest(t)
+	email := "logout@example.com"
+	user := &models.User{Email: &email, Provider: models.AuthProviderPassword}
+	require.NoError(t, db.Create(user).Error)
+
+	// This session's token has been rotated once; another device has its own login
+	first, err := authService.IssueRefreshToken(user.ID)
+	require.NoError(t, err)
+	_, current, err := authService.RotateRefreshToken(first)
+	require.NoError(t, err)
+	otherDevice, err := authService.IssueRefreshToken(user.ID)
+	require.NoError(t, err)
+
+	accessToken, err := utils.GenerateToken(user.ID.String(), email, string(user.Provider))
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	authHandler := handlers.NewAuthHandler()
+	t.Cleanup(authHandler.StopCleanup)
+	router.POST("/auth/logout", middleware.AuthMiddleware(), authHandler.Logout)
+	logout := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/auth/logout", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	require.Equal(t, http.StatusOK, logout(`{"refresh_token":"`+current+`"}`).Code)
+
+	_, _, err = authService.RotateRefreshToken(current)
+	assert.Error(t, err, "the session's refresh token must stop working")
+	var live []models.RefreshToken
+	require.NoError(t, db.Where("revoked_at IS NULL").Find(&live).Error)
+	require.Len(t, live, 1)
+
+	// The other device stays signed in
+	_, _, err = authService.RotateRefreshToken(otherDevice)
+	assert.NoError(t, err)
+
+	// Logging out without a body, or with an unknown token, still succeeds
+	assert.Equal(t, http.StatusOK, logout(``).Code)
+	assert.Equal(t, http.StatusOK, logout(`{"refresh_token":"not-a-token"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, logout(`{"refresh_token":`).Code)
+}
@@ -0,0 +1,161 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// completedEventWithMembers creates an event with confirmed members and completes it
+func completedEventWithMembers(t *testing.T, db *gorm.DB, creator *models.User, members ...*models.User) *models.Event {
+	event := createTestEvent(t, db, creator, "Completed trip")
+	for _, member := range members {
+		addTestMember(t, db, event, member, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	}
+	require.NoError(t, service.NewEventService().CompleteEvent(event.ID.String(), creator.ID.String()))
+	require.NoError(t, db.First(event, "id = ?", event.ID).Error)
+	return event
+}
+
+func TestReliabilityService_ReportNoShowEligibility(t *testing.T) {
+	db := setupEventDomainDB(t)
+	reliabilityService := service.NewReliabilityService()
+
+	creator := createTestUser(t, db, "ns-creator")
+	member := createTestUser(t, db, "ns-member")
+	absent := createTestUser(t, db, "ns-absent")
+	pending := createTestUser(t, db, "ns-pending")
+	outsider := createTestUser(t, db, "ns-outsider")
+
+	event := completedEventWithMembers(t, db, creator, member, absent)
+	addTestMember(t, db, event, pending, models.MemberRoleParticipant, models.MemberStatusPending)
+	eventID := event.ID.String()
+
+	tests := []struct {
+		name     string
+		reporter *models.User
+		reported *models.User
+		errMsg   string
+	}{
+		{name: "Outsider cannot report", reporter: outsider, reported: absent, errMsg: "not authorized"},
+		{name: "Pending member cannot report", reporter: pending, reported: absent, errMsg: "not authorized"},
+		{name: "Cannot report non member", reporter: member, reported: outsider, errMsg: "member not found"},
+		{name: "Cannot report pending member", reporter: member, reported: pending, errMsg: "member not found"},
+		{name: "Cannot report yourself", reporter: member, reported: member, errMsg: "cannot report yourself"},
+		{name: "Confirmed member can report", reporter: member, reported: absent},
+		{name: "Duplicate report rejected", reporter: member, reported: absent, errMsg: "already reported"},
+		{name: "Creator can report", reporter: creator, reported: absent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := reliabilityService.ReportNoShow(eventID, tt.reporter.ID.String(), tt.reported.ID.String())
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.errMsg, err.Error())
+		})
+	}
+}
+
+func TestReliabilityService_ReportNoShowRequiresCompletedEvent(t *testing.T) {
+	db := setupEventDomainDB(t)
+	reliabilityService := service.NewReliabilityService()
+
+	creator := createTestUser(t, db, "open-creator")
+	member := createTestUser(t, db, "open-member")
+	event := createTestEvent(t, db, creator, "Upcoming trip")
+	addTestMember(t, db, event, member, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+
+	err := reliabilityService.ReportNoShow(event.ID.String(), creator.ID.String(), member.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "event is not completed", err.Error())
+}
+
+func TestReliabilityService_ReportWindowCloses(t *testing.T) {
+	db := setupEventDomainDB(t)
+	endedAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	clock := utils.NewFakeClock(endedAt)
+	reliabilityService := service.NewReliabilityServiceWithClock(clock)
+
+	creator := createTestUser(t, db, "old-creator")
+	member := createTestUser(t, db, "old-member")
+	event := completedEventWithMembers(t, db, creator, member)
+	require.NoError(t, db.Model(&models.Event{}).Where("id = ?", event.ID).Update("end_at", endedAt).Error)
+
+	// Measured against the service clock, not the wall clock
+	clock.Advance(8 * 24 * time.Hour)
+	err := reliabilityService.ReportNoShow(event.ID.String(), creator.ID.String(), member.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "no-show report window has closed", err.Error())
+}
+
+func TestReliabilityService_ScoreImpact(t *testing.T) {
+	db := setupEventDomainDB(t)
+	reliabilityService := service.NewReliabilityService()
+
+	creator := createTestUser(t, db, "score-creator")
+	subject := createTestUser(t, db, "score-subject")
+	peerA := createTestUser(t, db, "score-peer-a")
+	peerB := createTestUser(t, db, "score-peer-b")
+
+	// No completed events yet: no score
+	reliability, err := reliabilityService.GetUserReliability(subject.ID.String())
+	require.NoError(t, err)
+	assert.Zero(t, reliability.CompletedEvents)
+	assert.Nil(t, reliability.ReliabilityScore)
+	assert.Nil(t, reliability.NoShowRate)
+
+	first := completedEventWithMembers(t, db, creator, subject, peerA, peerB)
+	second := completedEventWithMembers(t, db, creator, subject, peerA, peerB)
+	completedEventWithMembers(t, db, creator, subject)
+	completedEventWithMembers(t, db, creator, subject)
+
+	reliability, err = reliabilityService.GetUserReliability(subject.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), reliability.CompletedEvents)
+	require.NotNil(t, reliability.ReliabilityScore)
+	assert.Equal(t, 100, *reliability.ReliabilityScore)
+
+	// A single member report does not count on its own
+	require.NoError(t, reliabilityService.ReportNoShow(first.ID.String(), peerA.ID.String(), subject.ID.String()))
+	reliability, err = reliabilityService.GetUserReliability(subject.ID.String())
+	require.NoError(t, err)
+	assert.Zero(t, reliability.NoShows)
+	assert.Equal(t, 100, *reliability.ReliabilityScore)
+
+	// A second member agreeing upholds it
+	require.NoError(t, reliabilityService.ReportNoShow(first.ID.String(), peerB.ID.String(), subject.ID.String()))
+	// A creator report counts immediately
+	require.NoError(t, reliabilityService.ReportNoShow(second.ID.String(), creator.ID.String(), subject.ID.String()))
+
+	reliability, err = reliabilityService.GetUserReliability(subject.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), reliability.NoShows)
+	require.NotNil(t, reliability.NoShowRate)
+	assert.Equal(t, 0.5, *reliability.NoShowRate)
+	assert.Equal(t, 50, *reliability.ReliabilityScore)
+
+	// Reporters are unaffected
+	reliability, err = reliabilityService.GetUserReliability(peerA.ID.String())
+	require.NoError(t, err)
+	assert.Zero(t, reliability.NoShows)
+}
+
+func TestReliabilityService_UnknownUser(t *testing.T) {
+	setupEventDomainDB(t)
+	reliabilityService := service.NewReliabilityService()
+
+	_, err := reliabilityService.GetUserReliability("00000000-0000-0000-0000-000000000001")
+	require.Error(t, err)
+	assert.Equal(t, "user not found", err.Error())
+}
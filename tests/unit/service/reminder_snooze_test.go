@@ -0,0 +1,153 @@
+package service_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnoozeNotification_QueuesDelayedReminder(t *testing.T) {
+	db := setupEventDomainDB(t)
+	now := time.Now().UTC().Truncate(time.Second)
+	clock := utils.NewFakeClock(now)
+	notificationService := service.NewNotificationServiceWithClock(clock)
+
+	creator := createTestUser(t, db, "snooze-creator")
+	member := createTestUser(t, db, "snooze-member")
+	event := createTestEvent(t, db, creator, "Sunrise hike")
+	require.NoError(t, db.Model(event).Update("start_at", now.Add(3*time.Hour)).Error)
+	addTestMember(t, db, event, member, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	reminder := addTestNotification(t, db, member, map[string]interface{}{"event_id": event.ID.String(), "type": "event_reminder"})
+
+	snooze, err := notificationService.SnoozeNotification(member.ID.String(), reminder.ID.String(), 30*time.Minute)
+	require.NoError(t, err)
+	assert.True(t, snooze.RemindAt.Equal(now.Add(30*time.Minute)))
+
+	// A delayed job is queued and the original reminder is handled
+	var queued models.ReminderSnooze
+	require.NoError(t, db.First(&queued, "notification_id = ?", reminder.ID).Error)
+	assert.Equal(t, member.ID, queued.UserID)
+	assert.Equal(t, event.ID, queued.EventID)
+	assert.True(t, queued.DueAt.Equal(now.Add(30*time.Minute)))
+	var original models.Notification
+	require.NoError(t, db.First(&original, "id = ?", reminder.ID).Error)
+	assert.True(t, original.Read)
+
+	_, err = notificationService.SnoozeNotification(member.ID.String(), reminder.ID.String(), 30*time.Minute)
+	assert.EqualError(t, err, "notification already snoozed")
+
+	// Nothing is sent before the snooze ends
+	_, err = notificationService.ProcessDueReminderSnoozes(10)
+	require.NoError(t, err)
+	var reminders int64
+	require.NoError(t, db.Model(&models.Notification{}).Where("user_id = ?", member.ID).Count(&reminders).Error)
+	assert.Equal(t, int64(1), reminders)
+
+	clock.Advance(30 * time.Minute)
+	processed, err := notificationService.ProcessDueReminderSnoozes(10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+
+	var followUp models.Notification
+	require.NoError(t, db.Where("user_id = ? AND id != ?", member.ID, reminder.ID).First(&followUp).Error)
+	assert.Equal(t, "event_reminder", followUp.Data["type"])
+	assert.Equal(t, event.ID.String(), followUp.Data["event_id"])
+	assert.False(t, followUp.Read)
+	var remaining int64
+	require.NoError(t, db.Model(&models.ReminderSnooze{}).Count(&remaining).Error)
+	assert.Zero(t, remaining)
+}
+
+func TestSnoozeNotification_Validation(t *testing.T) {
+	db := setupEventDomainDB(t)
+	now := time.Now().UTC().Truncate(time.Second)
+	notificationService := service.NewNotificationServiceWithClock(utils.NewFakeClock(now))
+
+	creator := createTestUser(t, db, "snooze-validation-creator")
+	member := createTestUser(t, db, "snooze-validation-member")
+	event := createTestEvent(t, db, creator, "Kayak trip")
+	require.NoError(t, db.Model(event).Update("start_at", now.Add(time.Hour)).Error)
+	addTestMember(t, db, event, member, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+
+	reminder := addTestNotification(t, db, member, map[string]interface{}{"event_id": event.ID.String(), "type": "event_reminder"})
+	update := addTestNotification(t, db, member, map[string]interface{}{"event_id": event.ID.String(), "type": "event_update"})
+
+	_, err := notificationService.SnoozeNotification(member.ID.String(), update.ID.String(), 10*time.Minute)
+	assert.EqualError(t, err, "notification cannot be snoozed")
+	_, err = notificationService.SnoozeNotification(creator.ID.String(), reminder.ID.String(), 10*time.Minute)
+	assert.EqualError(t, err, "notification not found")
+	_, err = notificationService.SnoozeNotification(member.ID.String(), reminder.ID.String(), time.Hour)
+	assert.EqualError(t, err, "snooze ends after the event starts")
+
+	require.NoError(t, db.Model(event).Update("status", models.EventStatusCancelled).Error)
+	_, err = notificationService.SnoozeNotification(member.ID.String(), reminder.ID.String(), 10*time.Minute)
+	assert.EqualError(t, err, "event is no longer upcoming")
+
+	var queued int64
+	require.NoError(t, db.Model(&models.ReminderSnooze{}).Count(&queued).Error)
+	assert.Zero(t, queued)
+}
+
+func TestSnoozeNotification_DroppedWhenMemberLeaves(t *testing.T) {
+	db := setupEventDomainDB(t)
+	now := time.Now().UTC().Truncate(time.Second)
+	clock := utils.NewFakeClock(now)
+	notificationService := service.NewNotificationServiceWithClock(clock)
+
+	creator := createTestUser(t, db, "snooze-left-creator")
+	member := createTestUser(t, db, "snooze-left-member")
+	event := createTestEvent(t, db, creator, "Night safari")
+	require.NoError(t, db.Model(event).Update("start_at", now.Add(3*time.Hour)).Error)
+	addTestMember(t, db, event, member, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	reminder := addTestNotification(t, db, member, map[string]interface{}{"event_id": event.ID.String(), "type": "event_reminder"})
+
+	_, err := notificationService.SnoozeNotification(member.ID.String(), reminder.ID.String(), 15*time.Minute)
+	require.NoError(t, err)
+	require.NoError(t, db.Model(&models.EventMember{}).Where("event_id = ? AND user_id = ?", event.ID, member.ID).
+		Update("status", models.MemberStatusLeft).Error)
+
+	clock.Advance(15 * time.Minute)
+	_, err = notificationService.ProcessDueReminderSnoozes(10)
+	require.NoError(t, err)
+
+	var notifications, queued int64
+	require.NoError(t, db.Model(&models.Notification{}).Where("user_id = ?", member.ID).Count(&notifications).Error)
+	assert.Equal(t, int64(1), notifications)
+	require.NoError(t, db.Model(&models.ReminderSnooze{}).Count(&queued).Error)
+	assert.Zero(t, queued)
+}
+
+func TestSnoozeNotificationHandler(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "snooze-handler-creator")
+	event := createTestEvent(t, db, creator, "Cooking class")
+	require.NoError(t, db.Model(event).Update("start_at", time.Now().Add(24*time.Hour)).Error)
+	reminder := addTestNotification(t, db, creator, map[string]interface{}{"event_id": event.ID.String(), "type": "event_reminder"})
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", creator.ID.String())
+		c.Next()
+	})
+	notificationHandler := handlers.NewNotificationHandler()
+	router.POST("/notifications/:id/snooze", notificationHandler.SnoozeNotification)
+
+	path := "/notifications/" + reminder.ID.String() + "/snooze"
+	w := serveEventRequest(router, "POST", path, `{"minutes": 1}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = serveEventRequest(router, "POST", path, `{"minutes": 60}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"remind_at"`)
+	w = serveEventRequest(router, "POST", path, `{"minutes": 60}`)
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
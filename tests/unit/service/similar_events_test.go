@@ -0,0 +1,90 @@
+package service_test
+
+import (
+	"testing"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func createTestTag(t *testing.T, db *gorm.DB, name string) *models.Tag {
+	tag := &models.Tag{Name: name, Kind: "activity"}
+	require.NoError(t, db.Create(tag).Error)
+	return tag
+}
+
+func tagEvent(t *testing.T, db *gorm.DB, event *models.Event, tags ...*models.Tag) {
+	for _, tag := range tags {
+		require.NoError(t, db.Create(&models.EventTag{EventID: event.ID, TagID: tag.ID}).Error)
+	}
+}
+
+func TestEventService_GetSimilarEventsRanksByTagOverlap(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+
+	creator := createTestUser(t, db, "similar-creator")
+	viewer := createTestUser(t, db, "similar-viewer")
+
+	hiking := createTestTag(t, db, "hiking")
+	camping := createTestTag(t, db, "camping")
+	photo := createTestTag(t, db, "photography")
+
+	source := createTestEvent(t, db, creator, "Mountain weekend")
+	tagEvent(t, db, source, hiking, camping, photo)
+
+	best := createTestEvent(t, db, creator, "Another mountain weekend")
+	tagEvent(t, db, best, hiking, camping, photo)
+
+	partial := createTestEvent(t, db, creator, "Day hike")
+	tagEvent(t, db, partial, hiking)
+
+	typeOnly := createTestEvent(t, db, creator, "Dinner")
+
+	joined := createTestEvent(t, db, creator, "Joined hike")
+	tagEvent(t, db, joined, hiking, camping, photo)
+	addTestMember(t, db, joined, viewer, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+
+	unrelated := createTestEvent(t, db, creator, "Beach trip")
+	require.NoError(t, db.Model(unrelated).Update("event_type", models.EventTypeOvernight).Error)
+
+	similar, total, err := eventService.GetSimilarEvents(source.ID.String(), viewer.ID.String(), 1, 10)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(3), total)
+	require.Len(t, similar, 3)
+	assert.Equal(t, best.ID.String(), similar[0].Event.ID)
+	assert.Equal(t, partial.ID.String(), similar[1].Event.ID)
+	assert.Equal(t, typeOnly.ID.String(), similar[2].Event.ID)
+	assert.Greater(t, similar[0].MatchScore, similar[1].MatchScore)
+	assert.Greater(t, similar[1].MatchScore, similar[2].MatchScore)
+	assert.Len(t, similar[0].MatchedTags, 3)
+	assert.Len(t, similar[1].MatchedTags, 1)
+
+	for _, item := range similar {
+		assert.NotEqual(t, source.ID.String(), item.Event.ID)
+		assert.NotEqual(t, joined.ID.String(), item.Event.ID)
+		assert.NotEqual(t, unrelated.ID.String(), item.Event.ID)
+	}
+
+	// Pagination keeps the ranking
+	page2, total2, err := eventService.GetSimilarEvents(source.ID.String(), viewer.ID.String(), 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total2)
+	require.Len(t, page2, 1)
+	assert.Equal(t, typeOnly.ID.String(), page2[0].Event.ID)
+}
+
+func TestEventService_GetSimilarEventsNotFound(t *testing.T) {
+	db := setupEventDomainDB(t)
+	eventService := service.NewEventService()
+	viewer := createTestUser(t, db, "similar-missing")
+
+	_, _, err := eventService.GetSimilarEvents("00000000-0000-0000-0000-000000000001", viewer.ID.String(), 1, 10)
+	require.Error(t, err)
+	assert.Equal(t, "event not found", err.Error())
+}
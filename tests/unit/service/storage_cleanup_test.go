@@ -0,0 +1,183 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const stubStorageBase = "https://storage.test/"
+
+// stubDeleter records delete calls and can be told to fail
+type stubDeleter struct {
+	mu      sync.Mutex
+	deleted []string
+	fail    bool
+}
+
+func (d *stubDeleter) Delete(ctx context.Context, key string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.fail {
+		return fmt.Errorf("storage unavailable")
+	}
+	d.deleted = append(d.deleted, key)
+	return nil
+}
+
+func (d *stubDeleter) KeyFromURL(rawURL string) (string, bool) {
+	if !strings.HasPrefix(rawURL, stubStorageBase) {
+		return "", false
+	}
+	return strings.TrimPrefix(rawURL, stubStorageBase), true
+}
+
+func TestAdminPurgeEvent_DeletesStorageObjects(t *testing.T) {
+	db := setupEventDomainDB(t)
+	admin := createTestUser(t, db, "Admin")
+	creator := createTestUser(t, db, "Creator")
+	event := createTestEvent(t, db, creator, "Purged Trip")
+
+	cover := stubStorageBase + "event_covers/cover.jpg"
+	require.NoError(t, db.Model(event).Update("cover_image_url", cover).Error)
+	require.NoError(t, db.Create(&models.EventPhoto{EventID: event.ID, URL: stubStorageBase + "event_photos/a.jpg"}).Error)
+	require.NoError(t, db.Create(&models.EventPhoto{EventID: event.ID, URL: "https://elsewhere.test/b.jpg"}).Error)
+
+	deleter := &stubDeleter{}
+	eventService := service.NewEventServiceWithStorageCleanup(service.NewStorageCleanupServiceWithDeleter(deleter))
+
+	require.NoError(t, eventService.AdminPurgeEvent(event.ID.String(), admin.ID.String()))
+
+	// Only objects in our storage are deleted
+	assert.ElementsMatch(t, []string{"event_covers/cover.jpg", "event_photos/a.jpg"}, deleter.deleted)
+
+	var count int64
+	db.Model(&models.Event{}).Where("id = ?", event.ID).Count(&count)
+	assert.Equal(t, int64(0), count)
+	db.Model(&models.EventPhoto{}).Where("event_id = ?", event.ID).Count(&count)
+	assert.Equal(t, int64(0), count)
+
+	err := eventService.AdminPurgeEvent(event.ID.String(), admin.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "event not found", err.Error())
+}
+
+func TestRemoveEventPhoto_DeletesStorageObject(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Creator")
+	other := createTestUser(t, db, "Other")
+	event := createTestEvent(t, db, creator, "Gallery")
+
+	photo := models.EventPhoto{EventID: event.ID, URL: stubStorageBase + "event_photos/remove.jpg"}
+	require.NoError(t, db.Create(&photo).Error)
+
+	deleter := &stubDeleter{}
+	eventService := service.NewEventServiceWithStorageCleanup(service.NewStorageCleanupServiceWithDeleter(deleter))
+
+	err := eventService.RemoveEventPhoto(other.ID.String(), event.ID.String(), photo.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "permission denied", err.Error())
+	assert.Empty(t, deleter.deleted)
+
+	require.NoError(t, eventService.RemoveEventPhoto(creator.ID.String(), event.ID.String(), photo.ID.String()))
+	assert.Equal(t, []string{"event_photos/remove.jpg"}, deleter.deleted)
+
+	err = eventService.RemoveEventPhoto(creator.ID.String(), event.ID.String(), photo.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "photo not found", err.Error())
+}
+
+func TestUpdateCoverImageURL_DeletesReplacedCover(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Creator")
+	event := createTestEvent(t, db, creator, "Cover Trip")
+
+	deleter := &stubDeleter{}
+	eventService := service.NewEventServiceWithStorageCleanup(service.NewStorageCleanupServiceWithDeleter(deleter))
+
+	first := stubStorageBase + "event_covers/first.jpg"
+	second := stubStorageBase + "event_covers/second.jpg"
+	require.NoError(t, eventService.UpdateCoverImageURL(creator.ID.String(), event.ID.String(), &first))
+	assert.Empty(t, deleter.deleted)
+
+	require.NoError(t, eventService.UpdateCoverImageURL(creator.ID.String(), event.ID.String(), &second))
+	assert.Equal(t, []string{"event_covers/first.jpg"}, deleter.deleted)
+}
+
+func TestSetCoverFromPhoto(t *testing.T) {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Creator")
+	other := createTestUser(t, db, "Other")
+	event := createTestEvent(t, db, creator, "Cover From Gallery")
+	otherEvent := createTestEvent(t, db, other, "Someone Else's Trip")
+
+	uploaded := stubStorageBase + "event_covers/uploaded.jpg"
+	require.NoError(t, db.Model(event).Update("cover_image_url", uploaded).Error)
+	photo := models.EventPhoto{EventID: event.ID, URL: stubStorageBase + "event_photos/gallery.jpg"}
+	require.NoError(t, db.Create(&photo).Error)
+	foreign := models.EventPhoto{EventID: otherEvent.ID, URL: stubStorageBase + "event_photos/foreign.jpg"}
+	require.NoError(t, db.Create(&foreign).Error)
+
+	deleter := &stubDeleter{}
+	eventService := service.NewEventServiceWithStorageCleanup(service.NewStorageCleanupServiceWithDeleter(deleter))
+
+	// Only the creator can change the cover
+	_, err := eventService.SetCoverFromPhoto(other.ID.String(), event.ID.String(), photo.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "permission denied", err.Error())
+
+	// The photo must belong to the event
+	_, err = eventService.SetCoverFromPhoto(creator.ID.String(), event.ID.String(), foreign.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "photo not found", err.Error())
+
+	url, err := eventService.SetCoverFromPhoto(creator.ID.String(), event.ID.String(), photo.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, photo.URL, url)
+
+	var updated models.Event
+	require.NoError(t, db.First(&updated, "id = ?", event.ID).Error)
+	require.NotNil(t, updated.CoverImageURL)
+	assert.Equal(t, photo.URL, *updated.CoverImageURL)
+
+	// The replaced upload is removed; the gallery image stays
+	assert.Equal(t, []string{"event_covers/uploaded.jpg"}, deleter.deleted)
+}
+
+func TestStorageCleanup_RetriesFailedDeletes(t *testing.T) {
+	db := setupEventDomainDB(t)
+
+	deleter := &stubDeleter{fail: true}
+	cleanup := service.NewStorageCleanupServiceWithDeleter(deleter)
+
+	// A failed delete is queued instead of returning an error
+	cleanup.RemoveObjects([]string{stubStorageBase + "event_photos/retry.jpg"})
+
+	var deletion models.StorageDeletion
+	require.NoError(t, db.First(&deletion).Error)
+	assert.Equal(t, models.StorageDeletionPending, deletion.Status)
+	assert.Equal(t, 1, deletion.Attempts)
+	require.NotNil(t, deletion.LastError)
+
+	// Make the retry due and let storage recover
+	require.NoError(t, db.Model(&deletion).Update("next_attempt_at", time.Now().Add(-time.Minute)).Error)
+	deleter.fail = false
+
+	processed, err := cleanup.ProcessPendingDeletions(10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.Equal(t, []string{"event_photos/retry.jpg"}, deleter.deleted)
+
+	var remaining int64
+	db.Model(&models.StorageDeletion{}).Count(&remaining)
+	assert.Equal(t, int64(0), remaining)
+}
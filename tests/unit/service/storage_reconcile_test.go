@@ -0,0 +1,88 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/service/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubLister returns a fixed storage listing
+type stubLister struct {
+	objects []storage.Object
+}
+
+func (l *stubLister) List(ctx context.Context, prefix string) ([]storage.Object, error) {
+	return l.objects, nil
+}
+
+// seedReconcileFixtures creates referenced rows and a listing with orphans
+func seedReconcileFixtures(t *testing.T) *stubLister {
+	db := setupEventDomainDB(t)
+	creator := createTestUser(t, db, "Creator")
+	event := createTestEvent(t, db, creator, "Stored Trip")
+
+	cover := stubStorageBase + "tindertrip/event_covers/cover.jpg"
+	require.NoError(t, db.Model(event).Update("cover_image_url", cover).Error)
+	require.NoError(t, db.Create(&models.EventPhoto{EventID: event.ID, URL: stubStorageBase + "tindertrip/event_photos/kept.jpg"}).Error)
+	avatar := stubStorageBase + "tindertrip/avatars/me.png"
+	require.NoError(t, db.Create(&models.UserProfile{UserID: creator.ID, AvatarURL: &avatar}).Error)
+
+	old := time.Now().Add(-72 * time.Hour)
+	return &stubLister{objects: []storage.Object{
+		{Key: "tindertrip/event_covers/cover.jpg", ModifiedAt: old},
+		{Key: "tindertrip/event_photos/kept.jpg", ModifiedAt: old},
+		{Key: "tindertrip/avatars/me.png", ModifiedAt: old},
+		{Key: "tindertrip/event_photos/orphan.jpg", ModifiedAt: old},
+		{Key: "tindertrip/chat_images/orphan.png", ModifiedAt: old},
+		{Key: "tindertrip/event_photos/uploading.jpg", ModifiedAt: time.Now().Add(-time.Minute)},
+	}}
+}
+
+func TestStorageReconcile_DryRunReportsOnly(t *testing.T) {
+	lister := seedReconcileFixtures(t)
+	deleter := &stubDeleter{}
+	reconcile := service.NewStorageReconcileServiceWithStorage(lister, deleter)
+
+	report, err := reconcile.Reconcile(true)
+	require.NoError(t, err)
+
+	assert.True(t, report.DryRun)
+	assert.Equal(t, 6, report.Scanned)
+	assert.Equal(t, 3, report.Referenced)
+	assert.Equal(t, 1, report.InGrace)
+	assert.Equal(t, 2, report.Orphaned)
+	assert.Equal(t, 0, report.Deleted)
+	assert.ElementsMatch(t, []string{"tindertrip/event_photos/orphan.jpg", "tindertrip/chat_images/orphan.png"}, report.OrphanedKeys)
+	assert.Empty(t, deleter.deleted)
+}
+
+func TestStorageReconcile_DeletesOrphansOutsideGrace(t *testing.T) {
+	lister := seedReconcileFixtures(t)
+	deleter := &stubDeleter{}
+	reconcile := service.NewStorageReconcileServiceWithStorage(lister, deleter)
+
+	report, err := reconcile.Reconcile(false)
+	require.NoError(t, err)
+
+	assert.False(t, report.DryRun)
+	assert.Equal(t, 2, report.Deleted)
+	assert.Equal(t, 0, report.Failed)
+	// Referenced objects and recent uploads are never deleted
+	assert.ElementsMatch(t, []string{"tindertrip/event_photos/orphan.jpg", "tindertrip/chat_images/orphan.png"}, deleter.deleted)
+}
+
+func TestStorageReconcile_RequiresStorage(t *testing.T) {
+	setupEventDomainDB(t)
+	reconcile := service.NewStorageReconcileServiceWithStorage(nil, nil)
+
+	_, err := reconcile.Reconcile(true)
+	require.Error(t, err)
+	assert.Equal(t, "storage is not configured", err.Error())
+}
@@ -0,0 +1,79 @@
+package service_test
+
+import (
+	"testing"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEventTags_FilterAndPaging(t *testing.T) {
+	db := setupEventDomainDB(t)
+	tagService := service.NewTagService()
+	creator := createTestUser(t, db, "tag-list-creator")
+	event := createTestEvent(t, db, creator, "Tagged trip")
+
+	for _, tag := range []*models.Tag{
+		createKindTag(t, db, "Beach", models.TagKindLocation),
+		createKindTag(t, db, "Camping", models.TagKindActivity),
+		createKindTag(t, db, "Diving", models.TagKindActivity),
+		createKindTag(t, db, "Hiking", models.TagKindActivity),
+	} {
+		require.NoError(t, db.Create(&models.EventTag{EventID: event.ID, TagID: tag.ID}).Error)
+	}
+	eventID := event.ID.String()
+
+	// No limit returns everything, sorted by name
+	all, total, err := tagService.GetEventTags(eventID, 1, 0, "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), total)
+	assert.Equal(t, []string{"Beach", "Camping", "Diving", "Hiking"}, tagNames(all))
+
+	activities, total, err := tagService.GetEventTags(eventID, 1, 0, string(models.TagKindActivity))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	assert.Equal(t, []string{"Camping", "Diving", "Hiking"}, tagNames(activities))
+
+	page2, total, err := tagService.GetEventTags(eventID, 2, 2, string(models.TagKindActivity))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	assert.Equal(t, []string{"Hiking"}, tagNames(page2))
+
+	_, _, err = tagService.GetEventTags("not-a-uuid", 1, 0, "")
+	assert.EqualError(t, err, "invalid event ID")
+}
+
+func TestGetUserTags_FilterAndPaging(t *testing.T) {
+	db := setupEventDomainDB(t)
+	tagService := service.NewTagService()
+	user := createTestUser(t, db, "tag-list-user")
+	other := createTestUser(t, db, "tag-list-other")
+
+	for _, tag := range []*models.Tag{
+		createKindTag(t, db, "Street food", models.TagKindFood),
+		createKindTag(t, db, "Noodles", models.TagKindFood),
+		createKindTag(t, db, "Museums", models.TagKindInterest),
+	} {
+		require.NoError(t, db.Create(&models.UserTag{UserID: user.ID, TagID: tag.ID}).Error)
+	}
+	otherTag := createKindTag(t, db, "Karaoke", models.TagKindFood)
+	require.NoError(t, db.Create(&models.UserTag{UserID: other.ID, TagID: otherTag.ID}).Error)
+
+	all, total, err := tagService.GetUserTags(user.ID.String(), 1, 0, "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	assert.Equal(t, []string{"Museums", "Noodles", "Street food"}, tagNames(all))
+
+	food, total, err := tagService.GetUserTags(user.ID.String(), 1, 1, string(models.TagKindFood))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.Equal(t, []string{"Noodles"}, tagNames(food))
+
+	beyond, total, err := tagService.GetUserTags(user.ID.String(), 5, 10, "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	assert.Empty(t, beyond)
+}
@@ -0,0 +1,124 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func pickTitles(picks []dto.EventSuggestionItem) []string {
+	titles := make([]string, 0, len(picks))
+	for _, pick := range picks {
+		titles = append(titles, pick.Event.Title)
+	}
+	return titles
+}
+
+// seedTopPicks creates a viewer who likes cafes and sports, and events that match them to varying degrees
+func seedTopPicks(t *testing.T, db *gorm.DB) *models.User {
+	t.Helper()
+	creator := createTestUser(t, db, "Creator")
+	viewer := createTestUser(t, db, "Viewer")
+	now := time.Now()
+	require.NoError(t, db.Model(viewer).Update("last_login_at", now).Error)
+
+	cafe := createTestInterest(t, db, "cafe_hopping", "cafe")
+	climbing := createTestInterest(t, db, "climbing", "sport")
+	karaoke := createTestInterest(t, db, "karaoke", "activity")
+	for _, interest := range []*models.Interest{cafe, climbing} {
+		require.NoError(t, db.Create(&models.UserInterest{UserID: viewer.ID, InterestID: interest.ID}).Error)
+	}
+
+	best := createTestEvent(t, db, creator, "Cafe and climbing")
+	good := createTestEvent(t, db, creator, "Cafe and karaoke")
+	createTestEvent(t, db, creator, "Karaoke only")
+	own := createTestEvent(t, db, viewer, "My own cafe trip")
+	joined := createTestEvent(t, db, creator, "Joined cafe trip")
+	addTestMember(t, db, joined, viewer, models.MemberRoleParticipant, models.MemberStatusConfirmed)
+	past := createTestEvent(t, db, creator, "Past cafe trip")
+	require.NoError(t, db.Model(past).Update("start_at", now.Add(-time.Hour)).Error)
+
+	for _, link := range []models.EventInterest{
+		{EventID: best.ID, InterestID: cafe.ID},
+		{EventID: best.ID, InterestID: climbing.ID},
+		{EventID: good.ID, InterestID: cafe.ID},
+		{EventID: good.ID, InterestID: karaoke.ID},
+		{EventID: own.ID, InterestID: cafe.ID},
+		{EventID: joined.ID, InterestID: cafe.ID},
+		{EventID: past.ID, InterestID: cafe.ID},
+	} {
+		require.NoError(t, db.Create(&link).Error)
+	}
+
+	return viewer
+}
+
+func TestTopPicks_NightlyRecomputeReflectsPreferences(t *testing.T) {
+	db := setupEventDomainDB(t)
+	viewer := seedTopPicks(t, db)
+
+	// A user who has not logged in recently is skipped
+	inactive := createTestUser(t, db, "Inactive")
+	require.NoError(t, db.Model(inactive).Update("last_login_at", time.Now().AddDate(0, -3, 0)).Error)
+
+	topPicksService := service.NewTopPicksService()
+	updated, err := topPicksService.RecomputeAllTopPicks()
+	require.NoError(t, err)
+	assert.Equal(t, 1, updated)
+
+	var stored int64
+	db.Model(&models.UserTopPick{}).Where("user_id = ?", inactive.ID).Count(&stored)
+	assert.Equal(t, int64(0), stored)
+
+	picks, err := topPicksService.GetTopPicks(viewer.ID.String())
+	require.NoError(t, err)
+	assert.False(t, picks.Live)
+	assert.Equal(t, []string{"Cafe and climbing", "Cafe and karaoke", "Karaoke only"}, pickTitles(picks.Picks))
+	assert.Equal(t, 100.0, picks.Picks[0].MatchScore)
+	assert.Greater(t, picks.Picks[1].MatchScore, picks.Picks[2].MatchScore)
+}
+
+func TestTopPicks_LiveFallbackForNewUser(t *testing.T) {
+	db := setupEventDomainDB(t)
+	viewer := seedTopPicks(t, db)
+
+	topPicksService := service.NewTopPicksService()
+	picks, err := topPicksService.GetTopPicks(viewer.ID.String())
+	require.NoError(t, err)
+	assert.True(t, picks.Live)
+	require.NotEmpty(t, picks.Picks)
+	assert.Equal(t, "Cafe and climbing", picks.Picks[0].Event.Title)
+
+	// The live result is stored, so the next request is served from it
+	var stored int64
+	db.Model(&models.UserTopPick{}).Where("user_id = ?", viewer.ID).Count(&stored)
+	assert.Equal(t, int64(len(picks.Picks)), stored)
+
+	picks, err = topPicksService.GetTopPicks(viewer.ID.String())
+	require.NoError(t, err)
+	assert.False(t, picks.Live)
+}
+
+func TestTopPicks_SkipsEventsJoinedSinceRecompute(t *testing.T) {
+	db := setupEventDomainDB(t)
+	viewer := seedTopPicks(t, db)
+
+	topPicksService := service.NewTopPicksService()
+	_, err := topPicksService.RecomputeAllTopPicks()
+	require.NoError(t, err)
+
+	var best models.Event
+	require.NoError(t, db.Where("title = ?", "Cafe and climbing").First(&best).Error)
+	addTestMember(t, db, &best, viewer, models.MemberRoleParticipant, models.MemberStatusPending)
+
+	picks, err := topPicksService.GetTopPicks(viewer.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Cafe and karaoke", "Karaoke only"}, pickTitles(picks.Picks))
+}
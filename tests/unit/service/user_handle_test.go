@@ -0,0 +1,141 @@
+package service_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/api/handlers"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// setHandle gives the user a handle, as sign-up would
+func setHandle(t *testing.T, db *gorm.DB, user *models.User, handle string) {
+	t.Helper()
+	require.NoError(t, db.Model(user).Update("handle", handle).Error)
+}
+
+func TestChangeHandle_ValidationAndUniqueness(t *testing.T) {
+	db := setupEventDomainDB(t)
+	userService := service.NewUserService()
+
+	user := createTestUser(t, db, "handle-user")
+	other := createTestUser(t, db, "handle-other")
+	setHandle(t, db, user, "handle_user_1234")
+	setHandle(t, db, other, "somchai")
+
+	for raw, want := range map[string]string{
+		"ab":       "invalid handle",
+		"som chai": "invalid handle",
+		"_somchai": "invalid handle",
+		"admin":    "handle is reserved",
+		"somchai":  "handle already taken",
+		"@SomChai": "handle already taken",
+	} {
+		_, err := userService.ChangeHandle(user.ID.String(), raw)
+		if assert.Error(t, err, raw) {
+			assert.Equal(t, want, err.Error(), raw)
+		}
+	}
+
+	// A deleted user's handle is free again
+	require.NoError(t, db.Model(other).Update("deleted_at", time.Now()).Error)
+	changed, err := userService.ChangeHandle(user.ID.String(), "@SomChai")
+	require.NoError(t, err)
+	assert.Equal(t, "somchai", changed.Handle)
+	require.NotNil(t, changed.NextChangeAt)
+
+	var stored models.User
+	require.NoError(t, db.First(&stored, "id = ?", user.ID).Error)
+	assert.Equal(t, "somchai", stored.GetHandle())
+	require.NotNil(t, stored.HandleChangedAt)
+}
+
+func TestChangeHandle_Cooldown(t *testing.T) {
+	db := setupEventDomainDB(t)
+	userService := service.NewUserService()
+
+	user := createTestUser(t, db, "cooldown-user")
+	setHandle(t, db, user, "cooldown_user_0001")
+
+	// The generated handle can be replaced right away
+	_, err := userService.ChangeHandle(user.ID.String(), "wanderer")
+	require.NoError(t, err)
+
+	// Keeping it is fine, changing it again is not
+	kept, err := userService.ChangeHandle(user.ID.String(), "Wanderer")
+	require.NoError(t, err)
+	assert.Equal(t, "wanderer", kept.Handle)
+	_, err = userService.ChangeHandle(user.ID.String(), "wanderer_two")
+	require.Error(t, err)
+	assert.Equal(t, "handle changed recently", err.Error())
+
+	// Allowed again once the 30 day default cooldown has passed
+	require.NoError(t, db.Model(user).Update("handle_changed_at", time.Now().Add(-31*24*time.Hour)).Error)
+	changed, err := userService.ChangeHandle(user.ID.String(), "wanderer_two")
+	require.NoError(t, err)
+	assert.Equal(t, "wanderer_two", changed.Handle)
+}
+
+func TestGetPublicProfileByHandle(t *testing.T) {
+	db := setupEventDomainDB(t)
+	userService := service.NewUserService()
+
+	user := createTestUser(t, db, "public-user")
+	setHandle(t, db, user, "somchai")
+	require.NoError(t, db.Create(&models.UserProfile{UserID: user.ID, Bio: strPtr("Weekend hiker")}).Error)
+
+	profile, err := userService.GetPublicProfileByHandle("@SomChai")
+	require.NoError(t, err)
+	assert.Equal(t, user.ID.String(), profile.UserID)
+	assert.Equal(t, "somchai", profile.Handle)
+	assert.Equal(t, *user.DisplayName, profile.DisplayName)
+	require.NotNil(t, profile.Bio)
+	assert.Equal(t, "Weekend hiker", *profile.Bio)
+
+	_, err = userService.GetPublicProfileByHandle("nobody")
+	require.Error(t, err)
+	assert.Equal(t, "user not found", err.Error())
+}
+
+func TestHandleHandlers(t *testing.T) {
+	db := setupEventDomainDB(t)
+	user := createTestUser(t, db, "handler-user")
+	other := createTestUser(t, db, "handler-other")
+	setHandle(t, db, user, "handler_user_0001")
+	setHandle(t, db, other, "taken")
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", user.ID.String())
+		c.Next()
+	})
+	userHandler := handlers.NewUserHandler()
+	router.PUT("/users/me/handle", userHandler.UpdateHandle)
+	router.GET("/users/handle/:handle", userHandler.GetPublicProfile)
+	router.GET("/users/:id/reliability", userHandler.GetReliability)
+
+	w := serveEventRequest(router, "PUT", "/users/me/handle", `{"handle": "no way"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = serveEventRequest(router, "PUT", "/users/me/handle", `{"handle": "taken"}`)
+	assert.Equal(t, http.StatusConflict, w.Code)
+	w = serveEventRequest(router, "PUT", "/users/me/handle", `{"handle": "explorer"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"handle":"explorer"`)
+	w = serveEventRequest(router, "PUT", "/users/me/handle", `{"handle": "explorer_two"}`)
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+
+	w = serveEventRequest(router, "GET", "/users/handle/explorer", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), user.ID.String())
+	assert.NotContains(t, w.Body.String(), *user.Email)
+	w = serveEventRequest(router, "GET", "/users/handle/nobody", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
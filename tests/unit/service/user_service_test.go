@@ -8,10 +8,12 @@ import (
 	"TinderTrip-Backend/internal/dto"
 	"TinderTrip-Backend/internal/models"
 	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
 	"TinderTrip-Backend/pkg/database"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
@@ -38,6 +40,12 @@ func setupUserServiceTest(t *testing.T) (*gorm.DB, *service.UserService) {
 			email_verified BOOLEAN NOT NULL DEFAULT 0,
 			google_id TEXT,
 			display_name TEXT,
+			display_name_shared BOOLEAN NOT NULL DEFAULT 0,
+			handle TEXT,
+			handle_changed_at DATETIME,
+			phone TEXT,
+			phone_verified BOOLEAN NOT NULL DEFAULT 0,
+			otp_channel TEXT,
 			last_login_at DATETIME,
 			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
@@ -346,7 +354,7 @@ func TestUserService_DeleteProfile(t *testing.T) {
 				// Valid UUID but no profile
 				return uuid.New().String()
 			},
-			wantErr: false, // GORM doesn't error on updates that affect 0 rows
+			wantErr: true, // there is no account to delete
 		},
 		{
 			name: "Invalid user ID",
@@ -371,6 +379,40 @@ func TestUserService_DeleteProfile(t *testing.T) {
 	}
 }
 
+func TestUserService_DeleteProfile_DeletesAccount(t *testing.T) {
+	db, authService := setupAuthServiceTest(t)
+	mailer, sentTo := captureEmails()
+	userService := service.NewUserService()
+
+	user := createPasswordUser(t, db, "gone@example.com", "TestPass123!")
+	require.NoError(t, db.Create(&models.UserProfile{UserID: user.ID}).Error)
+	refreshToken, err := authService.IssueRefreshToken(user.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, userService.DeleteProfile(user.ID.String()))
+
+	// The account is gone, not just the profile
+	var stored models.User
+	require.NoError(t, db.Unscoped().First(&stored, "id = ?", user.ID).Error)
+	assert.NotNil(t, stored.DeletedAt)
+	_, err = authService.Login("gone@example.com", "TestPass123!")
+	assert.Error(t, err)
+
+	// Audited, signed out and told how to restore it
+	var audits int64
+	require.NoError(t, db.Model(&models.AuditLog{}).Where("entity_table = ? AND action = ?", "users", "DELETE").Count(&audits).Error)
+	assert.Equal(t, int64(1), audits)
+	_, _, err = authService.RotateRefreshToken(refreshToken)
+	assert.Error(t, err)
+	_, err = service.NewEmailOutboxServiceWithEmailClient(mailer, utils.RealClock{}).ProcessPendingEmails(10)
+	require.NoError(t, err)
+	assert.Len(t, sentTo("gone@example.com"), 1)
+
+	err = userService.DeleteProfile(user.ID.String())
+	require.Error(t, err)
+	assert.Equal(t, "user not found", err.Error())
+}
+
 func TestUserService_ProfileWithFullData(t *testing.T) {
 	db, userService := setupUserServiceTest(t)
 
@@ -0,0 +1,160 @@
+package service_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/dto"
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubReceiver records webhook requests and fails the first `failures` requests
+type stubReceiver struct {
+	mu       sync.Mutex
+	secret   string
+	failures int
+	bodies   [][]byte
+	valid    []bool
+	events   []string
+}
+
+func (r *stubReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	mac := hmac.New(sha256.New, []byte(r.secret))
+	mac.Write(body)
+	expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bodies = append(r.bodies, body)
+	r.valid = append(r.valid, hmac.Equal([]byte(expected), []byte(req.Header.Get(service.WebhookSignatureHeader))))
+	r.events = append(r.events, req.Header.Get(service.WebhookEventHeader))
+
+	if r.failures > 0 {
+		r.failures--
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func TestWebhookService_DeliversSignedPayload(t *testing.T) {
+	db := setupEventDomainDB(t)
+	webhookService := service.NewWebhookService()
+	eventService := service.NewEventService()
+
+	admin := createTestUser(t, db, "admin")
+	receiver := &stubReceiver{secret: "super-secret-signing-key"}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	webhook, err := webhookService.CreateWebhook(admin.ID.String(), dto.CreateWebhookRequest{
+		URL:    server.URL,
+		Events: []string{models.WebhookEventCreated},
+		Secret: &receiver.secret,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, webhook.Secret)
+
+	// Creating an event queues a delivery
+	event, err := eventService.CreateEvent(admin.ID.String(), dto.CreateEventRequest{
+		Title:     "Webhook dinner",
+		EventType: string(models.EventTypeMeal),
+	})
+	require.NoError(t, err)
+
+	processed, err := webhookService.ProcessPendingDeliveries(10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+
+	require.Len(t, receiver.bodies, 1)
+	assert.True(t, receiver.valid[0], "signature should verify with the shared secret")
+	assert.Equal(t, models.WebhookEventCreated, receiver.events[0])
+
+	var payload dto.WebhookPayload
+	require.NoError(t, json.Unmarshal(receiver.bodies[0], &payload))
+	assert.Equal(t, models.WebhookEventCreated, payload.Event)
+	assert.Equal(t, event.ID, payload.Data.EventID)
+
+	deliveries, total, err := webhookService.GetDeliveries(webhook.ID, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, string(models.WebhookDeliverySucceeded), deliveries[0].Status)
+	assert.Equal(t, 1, deliveries[0].Attempts)
+
+	// Unsubscribed events are not queued
+	require.NoError(t, eventService.CompleteEvent(event.ID, admin.ID.String()))
+	processed, err = webhookService.ProcessPendingDeliveries(10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, processed)
+}
+
+func TestWebhookService_RetriesFailedDelivery(t *testing.T) {
+	db := setupEventDomainDB(t)
+	webhookService := service.NewWebhookService()
+
+	admin := createTestUser(t, db, "admin")
+	receiver := &stubReceiver{secret: "another-signing-secret", failures: 1}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	webhook, err := webhookService.CreateWebhook(admin.ID.String(), dto.CreateWebhookRequest{
+		URL:    server.URL,
+		Secret: &receiver.secret,
+	})
+	require.NoError(t, err)
+
+	event := createTestEvent(t, db, admin, "Retry trip")
+	require.NoError(t, webhookService.Dispatch(models.WebhookEventCancelled, dto.WebhookEventData{
+		EventID: event.ID.String(),
+		Title:   event.Title,
+		Status:  string(models.EventStatusCancelled),
+	}))
+
+	// First attempt fails and is rescheduled
+	_, err = webhookService.ProcessPendingDeliveries(10)
+	require.NoError(t, err)
+
+	var delivery models.WebhookDelivery
+	require.NoError(t, db.First(&delivery).Error)
+	assert.Equal(t, models.WebhookDeliveryPending, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	require.NotNil(t, delivery.LastStatusCode)
+	assert.Equal(t, http.StatusInternalServerError, *delivery.LastStatusCode)
+	assert.True(t, delivery.NextAttemptAt.After(time.Now()))
+
+	// Not due yet
+	processed, err := webhookService.ProcessPendingDeliveries(10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, processed)
+
+	// Make the retry due and process again
+	require.NoError(t, db.Model(&delivery).Update("next_attempt_at", time.Now().Add(-time.Second)).Error)
+	processed, err = webhookService.ProcessPendingDeliveries(10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+
+	deliveries, _, err := webhookService.GetDeliveries(webhook.ID, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, string(models.WebhookDeliverySucceeded), deliveries[0].Status)
+	assert.Equal(t, 2, deliveries[0].Attempts)
+
+	// The same signed body is sent on every attempt
+	require.Len(t, receiver.bodies, 2)
+	assert.Equal(t, receiver.bodies[0], receiver.bodies[1])
+	assert.True(t, receiver.valid[0])
+	assert.True(t, receiver.valid[1])
+}
@@ -0,0 +1,168 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/models"
+	"TinderTrip-Backend/internal/service"
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
+	"TinderTrip-Backend/pkg/database"
+	"TinderTrip-Backend/pkg/email"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// suppressOnboardingEmails sets SUPPRESS_ONBOARDING_EMAILS for the rest of the test
+func suppressOnboardingEmails(t *testing.T, suppress bool) {
+	t.Helper()
+	if config.AppConfig == nil {
+		config.AppConfig = &config.Config{}
+	}
+	previous := config.AppConfig.Email.SuppressOnboarding
+	config.AppConfig.Email.SuppressOnboarding = suppress
+	t.Cleanup(func() { config.AppConfig.Email.SuppressOnboarding = previous })
+}
+
+// pendingWelcomeEmails returns the welcome emails still in the outbox
+func pendingWelcomeEmails(t *testing.T) []models.EmailOutbox {
+	t.Helper()
+	var entries []models.EmailOutbox
+	require.NoError(t, database.DB.Where("kind = ?", models.EmailKindWelcome).Find(&entries).Error)
+	return entries
+}
+
+func TestQueueWelcomeEmail(t *testing.T) {
+	setupEventDomainDB(t)
+	suppressOnboardingEmails(t, false)
+	mailer, sentTo := captureEmails()
+	outbox := service.NewEmailOutboxServiceWithEmailClient(mailer, utils.RealClock{})
+
+	// Normal signups get the welcome email, sent by the worker rather than inline
+	queued, err := outbox.QueueWelcomeEmail("new@example.com", "Somchai", true)
+	require.NoError(t, err)
+	assert.True(t, queued)
+	assert.Empty(t, sentTo("new@example.com"))
+
+	// An account created with sendWelcome off, such as an imported one, does not
+	queued, err = outbox.QueueWelcomeEmail("imported@example.com", "Malee", false)
+	require.NoError(t, err)
+	assert.False(t, queued)
+	require.Len(t, pendingWelcomeEmails(t), 1)
+
+	processed, err := outbox.ProcessPendingEmails(50)
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	require.Len(t, sentTo("new@example.com"), 1)
+	assert.Contains(t, sentTo("new@example.com")[0].Subject, "Welcome")
+	assert.Empty(t, sentTo("imported@example.com"))
+	assert.Empty(t, pendingWelcomeEmails(t))
+}
+
+func TestQueueWelcomeEmail_Suppressed(t *testing.T) {
+	setupEventDomainDB(t)
+	suppressOnboardingEmails(t, true)
+	outbox := service.NewEmailOutboxService()
+
+	// The global switch wins even when the user asked for the email
+	queued, err := outbox.QueueWelcomeEmail("bulk@example.com", "Bulk", true)
+	require.NoError(t, err)
+	assert.False(t, queued)
+	assert.Empty(t, pendingWelcomeEmails(t))
+}
+
+func TestWelcomeEmail_RetriedAfterTransientFailure(t *testing.T) {
+	setupEventDomainDB(t)
+	suppressOnboardingEmails(t, false)
+	clock := utils.NewFakeClock(time.Now().Truncate(time.Second))
+
+	// The SMTP server is down for the first attempt only
+	var sent []*email.EmailMessage
+	calls := 0
+	mailer := email.NewSMTPClientWithTransport(func(message *email.EmailMessage) error {
+		calls++
+		if calls == 1 {
+			return errors.New("dial tcp: connection refused")
+		}
+		sent = append(sent, message)
+		return nil
+	})
+	outbox := service.NewEmailOutboxServiceWithEmailClient(mailer, clock)
+
+	_, err := outbox.QueueWelcomeEmail("new@example.com", "Somchai", true)
+	require.NoError(t, err)
+
+	_, err = outbox.ProcessPendingEmails(50)
+	require.NoError(t, err)
+	assert.Empty(t, sent)
+	entries := pendingWelcomeEmails(t)
+	require.Len(t, entries, 1)
+	assert.Equal(t, models.EmailOutboxPending, entries[0].Status)
+	assert.Equal(t, 1, entries[0].Attempts)
+	require.NotNil(t, entries[0].LastError)
+	assert.Contains(t, *entries[0].LastError, "connection refused")
+
+	// Not retried before the backoff has passed
+	processed, err := outbox.ProcessPendingEmails(50)
+	require.NoError(t, err)
+	assert.Equal(t, 0, processed)
+
+	clock.Advance(time.Minute)
+	processed, err = outbox.ProcessPendingEmails(50)
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	require.Len(t, sent, 1)
+	assert.Equal(t, []string{"new@example.com"}, sent[0].To)
+	assert.Empty(t, pendingWelcomeEmails(t))
+}
+
+func TestWelcomeEmail_GivesUpAfterMaxAttempts(t *testing.T) {
+	setupEventDomainDB(t)
+	suppressOnboardingEmails(t, false)
+	clock := utils.NewFakeClock(time.Now().Truncate(time.Second))
+	mailer := email.NewSMTPClientWithTransport(func(message *email.EmailMessage) error {
+		return errors.New("dial tcp: connection refused")
+	})
+	outbox := service.NewEmailOutboxServiceWithEmailClient(mailer, clock)
+
+	_, err := outbox.QueueWelcomeEmail("new@example.com", "Somchai", true)
+	require.NoError(t, err)
+
+	for i := 0; i < 8; i++ {
+		_, err := outbox.ProcessPendingEmails(50)
+		require.NoError(t, err)
+		clock.Advance(6 * time.Hour)
+	}
+
+	// Kept as failed for inspection and no longer picked up
+	entries := pendingWelcomeEmails(t)
+	require.Len(t, entries, 1)
+	assert.Equal(t, models.EmailOutboxFailed, entries[0].Status)
+	assert.Equal(t, 8, entries[0].Attempts)
+
+	processed, err := outbox.ProcessPendingEmails(50)
+	require.NoError(t, err)
+	assert.Equal(t, 0, processed)
+}
+
+func TestVerifyEmailOTP_QueuesWelcomeEmail(t *testing.T) {
+	_, authService := setupAuthServiceTest(t)
+	suppressOnboardingEmails(t, false)
+
+	// The signup itself queues the welcome email; callers can't opt out
+	_, err := signUp(t, authService, "signup@example.com", "Somchai")
+	require.NoError(t, err)
+	entries := pendingWelcomeEmails(t)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "signup@example.com", entries[0].Recipient)
+	assert.Equal(t, "Somchai", entries[0].Data["name"])
+
+	// Only the global switch holds it back
+	suppressOnboardingEmails(t, true)
+	_, err = signUp(t, authService, "during-import@example.com", "Malee")
+	require.NoError(t, err)
+	assert.Len(t, pendingWelcomeEmails(t), 1)
+}
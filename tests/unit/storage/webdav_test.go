@@ -0,0 +1,108 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"TinderTrip-Backend/internal/service/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const davRoot = "/remote.php/dav/files/tester"
+
+// davListing maps collection paths to their PROPFIND response entries
+var davListing = map[string][]string{
+	davRoot + "/tindertrip/": {
+		davEntry(davRoot+"/tindertrip/", true, ""),
+		davEntry(davRoot+"/tindertrip/avatars/", true, ""),
+		davEntry(davRoot+"/tindertrip/readme.txt", false, "Mon, 01 Jan 2024 10:00:00 GMT"),
+	},
+	davRoot + "/tindertrip/avatars/": {
+		davEntry(davRoot+"/tindertrip/avatars/", true, ""),
+		davEntry(davRoot+"/tindertrip/avatars/me.png", false, "Tue, 02 Jan 2024 10:00:00 GMT"),
+	},
+}
+
+func davEntry(href string, collection bool, modified string) string {
+	resourceType := ""
+	if collection {
+		resourceType = "<d:collection/>"
+	}
+	return fmt.Sprintf(`<d:response><d:href>%s</d:href><d:propstat><d:prop>`+
+		`<d:resourcetype>%s</d:resourcetype><d:getcontentlength>120</d:getcontentlength>`+
+		`<d:getlastmodified>%s</d:getlastmodified></d:prop><d:status>HTTP/1.1 200 OK</d:status></d:propstat></d:response>`,
+		href, resourceType, modified)
+}
+
+func newWebDAVServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PROPFIND" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		assert.Equal(t, "1", r.Header.Get("Depth"))
+
+		path := strings.TrimRight(r.URL.Path, "/") + "/"
+		entries, ok := davListing[path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusMultiStatus)
+		fmt.Fprintf(w, `<?xml version="1.0"?><d:multistatus xmlns:d="DAV:">%s</d:multistatus>`, strings.Join(entries, ""))
+	}))
+	t.Cleanup(server.Close)
+
+	t.Setenv("NEXTCLOUD_BASE_URL", server.URL+"/remote.php/dav/files")
+	t.Setenv("NEXTCLOUD_USERNAME", "tester")
+	t.Setenv("NEXTCLOUD_PASSWORD", "secret")
+	return server
+}
+
+func TestWebDAVList(t *testing.T) {
+	server := newWebDAVServer(t)
+
+	uploader, err := storage.NewWebDAVUploader()
+	require.NoError(t, err)
+
+	objects, err := uploader.List(context.Background(), "tindertrip")
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+
+	byKey := map[string]storage.Object{}
+	for _, object := range objects {
+		byKey[object.Key] = object
+	}
+
+	avatar, ok := byKey["tindertrip/avatars/me.png"]
+	require.True(t, ok)
+	assert.Equal(t, int64(120), avatar.Size)
+	assert.Equal(t, 2024, avatar.ModifiedAt.Year())
+	assert.Equal(t, server.URL+davRoot+"/tindertrip/avatars/me.png", avatar.URL)
+
+	_, ok = byKey["tindertrip/readme.txt"]
+	assert.True(t, ok)
+}
+
+func TestWebDAVKeyFromURL(t *testing.T) {
+	server := newWebDAVServer(t)
+
+	uploader, err := storage.NewWebDAVUploader()
+	require.NoError(t, err)
+
+	key, ok := uploader.KeyFromURL(server.URL + davRoot + "/tindertrip/event_photos/a.jpg")
+	assert.True(t, ok)
+	assert.Equal(t, "tindertrip/event_photos/a.jpg", key)
+
+	_, ok = uploader.KeyFromURL("https://lh3.googleusercontent.com/a/avatar.jpg")
+	assert.False(t, ok)
+
+	_, ok = uploader.KeyFromURL(server.URL + "/remote.php/dav/files/someone-else/x.jpg")
+	assert.False(t, ok)
+}
@@ -0,0 +1,33 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRealClock_Now(t *testing.T) {
+	before := time.Now()
+	now := utils.RealClock{}.Now()
+	assert.False(t, now.Before(before))
+	assert.False(t, now.After(time.Now()))
+}
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := utils.NewFakeClock(start)
+
+	// Time stands still until the clock is moved
+	assert.Equal(t, start, clock.Now())
+	assert.Equal(t, start, clock.Now())
+
+	clock.Advance(90 * time.Second)
+	assert.Equal(t, start.Add(90*time.Second), clock.Now())
+
+	later := start.Add(24 * time.Hour)
+	clock.Set(later)
+	assert.Equal(t, later, clock.Now())
+}
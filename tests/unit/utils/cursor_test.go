@@ -0,0 +1,53 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecodeCursor(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 10, 30, 0, 123456789, time.UTC)
+	id := "3f2b1c4e-8a9d-4e2f-9b1a-6c7d8e9f0a1b"
+
+	encoded := utils.EncodeCursor(createdAt, id)
+	assert.NotEmpty(t, encoded)
+
+	cursor, err := utils.DecodeCursor(encoded)
+	require.NoError(t, err)
+	require.NotNil(t, cursor)
+	assert.True(t, createdAt.Equal(cursor.CreatedAt))
+	assert.Equal(t, id, cursor.ID)
+}
+
+func TestDecodeCursor(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantNil bool
+		wantErr bool
+	}{
+		{name: "Empty cursor", value: "", wantNil: true},
+		{name: "Not base64", value: "%%%", wantErr: true},
+		{name: "Missing separator", value: "bm8tc2VwYXJhdG9y", wantErr: true},
+		{name: "Bad timestamp", value: "bm90LWEtdGltZXxhYmM", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := utils.DecodeCursor(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			if tt.wantNil {
+				assert.Nil(t, cursor)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package utils_test
+
+import (
+	"testing"
+
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistanceMeters(t *testing.T) {
+	// Same point
+	assert.InDelta(t, 0, utils.DistanceMeters(13.7563, 100.5018, 13.7563, 100.5018), 0.001)
+
+	// One thousandth of a degree of latitude is about 111 meters
+	assert.InDelta(t, 111, utils.DistanceMeters(13.7563, 100.5018, 13.7573, 100.5018), 1)
+
+	// Bangkok to Chiang Mai is roughly 585 km
+	assert.InDelta(t, 585000, utils.DistanceMeters(13.7563, 100.5018, 18.7883, 98.9853), 10000)
+}
+
+func TestBoundingBoxAround(t *testing.T) {
+	box := utils.BoundingBoxAround(13.7563, 100.5018, 10000)
+
+	// 10 km is about 0.09 degrees of latitude, and a little more longitude away from the equator
+	assert.InDelta(t, 13.7563-0.0899, box.MinLat, 0.001)
+	assert.InDelta(t, 13.7563+0.0899, box.MaxLat, 0.001)
+	assert.InDelta(t, 100.5018-0.0926, box.MinLng, 0.001)
+	assert.InDelta(t, 100.5018+0.0926, box.MaxLng, 0.001)
+	assert.False(t, box.CrossesAntimeridian())
+
+	// Points just inside the radius in every direction are in the box
+	assert.True(t, box.Contains(13.7563+0.0895, 100.5018))
+	assert.True(t, box.Contains(13.7563, 100.5018-0.0920))
+	assert.False(t, box.Contains(13.7563+0.1, 100.5018))
+	assert.False(t, box.Contains(13.7563, 100.5018+0.1))
+}
+
+func TestBoundingBoxAround_Antimeridian(t *testing.T) {
+	box := utils.BoundingBoxAround(-17.0, 179.95, 20000)
+
+	assert.True(t, box.CrossesAntimeridian())
+	assert.True(t, box.Contains(-17.0, 179.99))
+	assert.True(t, box.Contains(-17.0, -179.9))
+	assert.False(t, box.Contains(-17.0, 0))
+	assert.False(t, box.Contains(-17.0, 179.5))
+}
+
+func TestBoundingBoxAround_Pole(t *testing.T) {
+	box := utils.BoundingBoxAround(89.95, 10, 20000)
+
+	// Every longitude is within reach across the pole
+	assert.Equal(t, 90.0, box.MaxLat)
+	assert.Equal(t, -180.0, box.MinLng)
+	assert.Equal(t, 180.0, box.MaxLng)
+	assert.True(t, box.Contains(89.96, -170))
+}
@@ -0,0 +1,52 @@
+package utils_test
+
+import (
+	"strings"
+	"testing"
+
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeHandle(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr string
+	}{
+		{name: "Valid", input: "somchai_j", want: "somchai_j"},
+		{name: "Lowercased", input: "SomChai", want: "somchai"},
+		{name: "Leading @ and spaces dropped", input: "  @somchai ", want: "somchai"},
+		{name: "Digits", input: "trip2025", want: "trip2025"},
+		{name: "Starts with digit", input: "9lives", want: "9lives"},
+		{name: "Shortest", input: "abc", want: "abc"},
+		{name: "Longest", input: strings.Repeat("a", 30), want: strings.Repeat("a", 30)},
+		{name: "Too short", input: "ab", wantErr: "invalid handle"},
+		{name: "Too long", input: strings.Repeat("a", 31), wantErr: "invalid handle"},
+		{name: "Leading underscore", input: "_somchai", wantErr: "invalid handle"},
+		{name: "Trailing underscore", input: "somchai_", wantErr: "invalid handle"},
+		{name: "Dash", input: "som-chai", wantErr: "invalid handle"},
+		{name: "Space", input: "som chai", wantErr: "invalid handle"},
+		{name: "Dot", input: "som.chai", wantErr: "invalid handle"},
+		{name: "Non-ASCII", input: "สมชาย", wantErr: "invalid handle"},
+		{name: "Empty", input: "", wantErr: "invalid handle"},
+		{name: "Reserved", input: "admin", wantErr: "handle is reserved"},
+		{name: "Reserved in other case", input: "@Support", wantErr: "handle is reserved"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := utils.NormalizeHandle(tt.input)
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, tt.wantErr, err.Error())
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package utils_test
+
+import (
+	"testing"
+
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []utils.Mention
+	}{
+		{name: "None", input: "see you at 7", want: nil},
+		{name: "Start of text", input: "@somchai hi", want: []utils.Mention{{Handle: "somchai", Start: 0, Length: 8}}},
+		{name: "Lowercased", input: "hi @SomChai", want: []utils.Mention{{Handle: "somchai", Start: 3, Length: 8}}},
+		{name: "Trailing punctuation", input: "thanks @somchai!", want: []utils.Mention{{Handle: "somchai", Start: 7, Length: 8}}},
+		{name: "Trailing underscore", input: "@somchai_ ok", want: []utils.Mention{{Handle: "somchai", Start: 0, Length: 8}}},
+		{name: "Several", input: "@malee and @somchai", want: []utils.Mention{
+			{Handle: "malee", Start: 0, Length: 6},
+			{Handle: "somchai", Start: 11, Length: 8},
+		}},
+		{name: "Offsets in runes", input: "สวัสดี @somchai", want: []utils.Mention{{Handle: "somchai", Start: 7, Length: 8}}},
+		{name: "Email address", input: "mail somchai@example.com", want: nil},
+		{name: "Too short", input: "@ab", want: nil},
+		{name: "Reserved", input: "@admin help", want: nil},
+		{name: "Bare @", input: "meet @ 7", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, utils.ParseMentions(tt.input))
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package utils_test
+
+import (
+	"regexp"
+	"testing"
+
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashOTP(t *testing.T) {
+	hash := utils.HashOTP("123456", "pepper")
+
+	assert.Len(t, hash, 64) // hex encoded SHA-256
+	assert.NotContains(t, hash, "123456")
+	assert.Equal(t, hash, utils.HashOTP("123456", "pepper"), "hashing should be deterministic")
+	assert.NotEqual(t, hash, utils.HashOTP("123457", "pepper"))
+	assert.NotEqual(t, hash, utils.HashOTP("123456", "other-pepper"))
+}
+
+func TestGenerateOTP_SixDigitsAndUniform(t *testing.T) {
+	const samples = 20000
+	sixDigits := regexp.MustCompile(`^[1-9][0-9]{5}$`)
+
+	var firstDigits [10]int
+	var lastDigits [10]int
+	for i := 0; i < samples; i++ {
+		otp, err := utils.GenerateOTP(6)
+		require.NoError(t, err)
+		require.Regexp(t, sixDigits, otp)
+		firstDigits[otp[0]-'0']++
+		lastDigits[otp[5]-'0']++
+	}
+
+	// Each leading digit 1-9 and each trailing digit 0-9 should show up about equally often;
+	// 15% either way is far outside what chance produces at this sample size
+	for digit := 1; digit <= 9; digit++ {
+		assert.InDelta(t, samples/9, firstDigits[digit], samples/9*0.15, "leading digit %d", digit)
+	}
+	assert.Zero(t, firstDigits[0])
+	for digit := 0; digit <= 9; digit++ {
+		assert.InDelta(t, samples/10, lastDigits[digit], samples/10*0.15, "trailing digit %d", digit)
+	}
+}
+
+func TestGenerateOTP_Lengths(t *testing.T) {
+	for _, length := range []int{1, 4, 8, 18} {
+		otp, err := utils.GenerateOTP(length)
+		require.NoError(t, err)
+		assert.Len(t, otp, length)
+		assert.NotEqual(t, byte('0'), otp[0])
+	}
+
+	for _, length := range []int{0, -1, 19} {
+		_, err := utils.GenerateOTP(length)
+		assert.EqualError(t, err, "invalid OTP length")
+	}
+}
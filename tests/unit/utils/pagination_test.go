@@ -0,0 +1,56 @@
+package utils_test
+
+import (
+	"testing"
+
+	"TinderTrip-Backend/internal/utils"
+	"TinderTrip-Backend/pkg/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePagination_GroupDefaults(t *testing.T) {
+	previous := config.AppConfig
+	config.AppConfig = nil
+	t.Cleanup(func() { config.AppConfig = previous })
+
+	// Omitted limit (0) uses each group's built-in default
+	_, limit := utils.ValidatePagination(utils.PaginationEvents, 1, 0)
+	assert.Equal(t, 10, limit)
+	_, limit = utils.ValidatePagination(utils.PaginationSuggestions, 1, 0)
+	assert.Equal(t, 20, limit)
+	_, limit = utils.ValidatePagination(utils.PaginationTags, 1, 0)
+	assert.Equal(t, 50, limit)
+
+	// Limits above the group max are capped
+	_, limit = utils.ValidatePagination(utils.PaginationHome, 1, 500)
+	assert.Equal(t, 20, limit)
+
+	// Unknown groups and invalid pages fall back to safe values
+	page, limit := utils.ValidatePagination("unknown", -3, 0)
+	assert.Equal(t, 1, page)
+	assert.Equal(t, 10, limit)
+}
+
+func TestValidatePagination_ConfiguredLimits(t *testing.T) {
+	previous := config.AppConfig
+	config.AppConfig = &config.Config{
+		Pagination: config.PaginationConfig{
+			Limits: map[string]config.PageLimit{
+				utils.PaginationEvents: {Default: 25, Max: 40},
+			},
+		},
+	}
+	t.Cleanup(func() { config.AppConfig = previous })
+
+	_, limit := utils.ValidatePagination(utils.PaginationEvents, 1, 0)
+	assert.Equal(t, 25, limit)
+	_, limit = utils.ValidatePagination(utils.PaginationEvents, 1, 80)
+	assert.Equal(t, 40, limit)
+	_, limit = utils.ValidatePagination(utils.PaginationEvents, 1, 15)
+	assert.Equal(t, 15, limit)
+
+	// Groups missing from the config keep their built-in sizes
+	_, limit = utils.ValidatePagination(utils.PaginationSuggestions, 1, 0)
+	assert.Equal(t, 20, limit)
+}
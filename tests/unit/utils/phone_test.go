@@ -0,0 +1,42 @@
+package utils_test
+
+import (
+	"testing"
+
+	"TinderTrip-Backend/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "E.164 unchanged", input: "+66812345678", want: "+66812345678"},
+		{name: "Spaces and dashes removed", input: "+66 81-234-5678", want: "+66812345678"},
+		{name: "Parentheses and dots removed", input: "+1 (415) 555.2671", want: "+14155552671"},
+		{name: "Double zero prefix", input: "0066812345678", want: "+66812345678"},
+		{name: "Surrounding whitespace", input: "  +447911123456 ", want: "+447911123456"},
+		{name: "Missing country code", input: "0812345678", wantErr: true},
+		{name: "Letters", input: "+66abc45678", wantErr: true},
+		{name: "Too short", input: "+6612345", wantErr: true},
+		{name: "Too long", input: "+1234567890123456", wantErr: true},
+		{name: "Country code starting with zero", input: "+0812345678", wantErr: true},
+		{name: "Empty", input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := utils.NormalizePhone(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}